@@ -17,7 +17,10 @@ limitations under the License.
 package common
 
 import (
+	"fmt"
 	"sort"
+	"strings"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -45,6 +48,23 @@ const (
 	// as a system condition. See the tracking issue for more details
 	// https://github.com/crossplane/crossplane/issues/5643.
 	TypeHealthy ConditionType = "Healthy"
+
+	// TypeDriftLoop resources are believed to be fighting another system that
+	// mutates their external resource. Each time Crossplane updates the
+	// external resource to match the desired state, something else changes it
+	// back.
+	TypeDriftLoop ConditionType = "DriftLoop"
+
+	// TypeReferencesResolved resources have resolved all of their references
+	// to other resources.
+	TypeReferencesResolved ConditionType = "ReferencesResolved"
+
+	// TypeProviderConfigResolved resources have resolved the ProviderConfig
+	// they use to connect to their external system.
+	TypeProviderConfigResolved ConditionType = "ProviderConfigResolved"
+
+	// TypeDegraded resources are observed, but can't be fully managed.
+	TypeDegraded ConditionType = "Degraded"
 )
 
 // A ConditionReason represents the reason a resource is in a condition.
@@ -56,6 +76,8 @@ const (
 	ReasonUnavailable ConditionReason = "Unavailable"
 	ReasonCreating    ConditionReason = "Creating"
 	ReasonDeleting    ConditionReason = "Deleting"
+
+	ReasonExternalDeleting ConditionReason = "ExternalResourceDeleting"
 )
 
 // Reasons a resource is or is not synced.
@@ -65,6 +87,39 @@ const (
 	ReasonReconcilePaused  ConditionReason = "ReconcilePaused"
 )
 
+// Reasons a resource is not synced because of a problem with the external
+// system. These are more specific than ReasonReconcileError.
+const (
+	ReasonThrottled           ConditionReason = "Throttled"
+	ReasonQuotaExceeded       ConditionReason = "QuotaExceeded"
+	ReasonUpstreamUnavailable ConditionReason = "UpstreamUnavailable"
+)
+
+// Reasons a resource is or is not in a drift loop.
+const (
+	ReasonDriftLoopDetected ConditionReason = "DriftLoopDetected"
+	ReasonNoDriftLoop       ConditionReason = "NoDriftLoop"
+)
+
+// Reasons a resource's references, or its ProviderConfig, are or are not
+// resolved.
+const (
+	ReasonResolved   ConditionReason = "Resolved"
+	ReasonUnresolved ConditionReason = "Unresolved"
+)
+
+// Reasons a resource is or is not degraded.
+const (
+	ReasonAccessDenied ConditionReason = "AccessDenied"
+	ReasonFullAccess   ConditionReason = "FullAccess"
+)
+
+// Reasons a resource's external resource won't be deleted.
+const (
+	ReasonDeletionBlocked   ConditionReason = "DeletionBlocked"
+	ReasonDeletionProtected ConditionReason = "DeletionProtected"
+)
+
 // See https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#typical-status-properties
 
 // A Condition that may apply to a resource.
@@ -119,6 +174,67 @@ func (c Condition) WithObservedGeneration(gen int64) Condition {
 	return c
 }
 
+// A ConditionOption modifies a condition.
+type ConditionOption func(c *Condition)
+
+// WithReason sets the reason of a condition.
+func WithReason(r ConditionReason) ConditionOption {
+	return func(c *Condition) {
+		c.Reason = r
+	}
+}
+
+// WithMessage sets the message of a condition.
+func WithMessage(msg string) ConditionOption {
+	return func(c *Condition) {
+		c.Message = msg
+	}
+}
+
+// WithMessageTemplate sets the message of a condition by formatting the
+// supplied arguments according to the supplied format specifier.
+func WithMessageTemplate(format string, args ...any) ConditionOption {
+	return func(c *Condition) {
+		c.Message = fmt.Sprintf(format, args...)
+	}
+}
+
+// WithObservedGeneration sets the observed generation of a condition.
+func WithObservedGeneration(gen int64) ConditionOption {
+	return func(c *Condition) {
+		c.ObservedGeneration = gen
+	}
+}
+
+// WithLastTransitionTime sets the last transition time of a condition.
+func WithLastTransitionTime(t metav1.Time) ConditionOption {
+	return func(c *Condition) {
+		c.LastTransitionTime = t
+	}
+}
+
+// NewCondition returns a condition of the supplied type, status, and reason
+// that last transitioned now, modified by the supplied options.
+func NewCondition(t ConditionType, s corev1.ConditionStatus, r ConditionReason, o ...ConditionOption) Condition {
+	c := Condition{
+		Type:               t,
+		Status:             s,
+		LastTransitionTime: metav1.Now(),
+		Reason:             r,
+	}
+
+	return c.With(o...)
+}
+
+// With returns a copy of the condition modified by the supplied options.
+func (c Condition) With(o ...ConditionOption) Condition {
+	for _, fn := range o {
+		fn(&c)
+	}
+
+	return c
+}
+
 // IsSystemConditionType returns true if the condition is owned by the
 // Crossplane system (e.g, Ready, Synced, Healthy).
 func IsSystemConditionType(t ConditionType) bool {
@@ -171,21 +287,25 @@ func (s *ConditionedStatus) GetCondition(ct ConditionType) Condition {
 // of the same type. This is a no-op if all supplied conditions are identical,
 // ignoring the last transition time, to those already set.
 func (s *ConditionedStatus) SetConditions(c ...Condition) {
+	if s.Conditions == nil && len(c) > 0 {
+		s.Conditions = make([]Condition, 0, len(c))
+	}
+
 	for _, cond := range c {
 		exists := false
 
-		for i, existing := range s.Conditions {
-			if existing.Type != cond.Type {
+		// Index rather than range over the conditions, to avoid copying each
+		// one.
+		for i := range s.Conditions {
+			if s.Conditions[i].Type != cond.Type {
 				continue
 			}
 
-			if existing.Equal(cond) {
-				exists = true
-				continue
-			}
-
-			s.Conditions[i] = cond
 			exists = true
+
+			if !s.Conditions[i].Equal(cond) {
+				s.Conditions[i] = cond
+			}
 		}
 
 		if !exists {
@@ -194,6 +314,36 @@ func (s *ConditionedStatus) SetConditions(c ...Condition) {
 	}
 }
 
+// MergeCondition sets the supplied condition, modified by the supplied
+// options, replacing any existing condition of the same type. This is a no-op
+// if the supplied condition is identical, ignoring the last transition time, to
+// the one already set. Unlike SetConditions the existing last transition time
+// is preserved if the status of the condition did not change, per the
+// Kubernetes API conventions.
+func (s *ConditionedStatus) MergeCondition(c Condition, o ...ConditionOption) {
+	c = c.With(o...)
+
+	for i, existing := range s.Conditions {
+		if existing.Type != c.Type {
+			continue
+		}
+
+		if existing.Equal(c) {
+			return
+		}
+
+		if existing.Status == c.Status {
+			c.LastTransitionTime = existing.LastTransitionTime
+		}
+
+		s.Conditions[i] = c
+
+		return
+	}
+
+	s.Conditions = append(s.Conditions, c)
+}
+
 // Equal returns true if the status is identical to the supplied status,
 // ignoring the LastTransitionTimes and order of statuses.
 func (s *ConditionedStatus) Equal(other *ConditionedStatus) bool {
@@ -246,6 +396,18 @@ func Deleting() Condition {
 	}
 }
 
+// ExternalDeleting returns a condition that indicates the resource's external
+// resource is currently being deleted, though the resource itself was not.
+func ExternalDeleting() Condition {
+	return Condition{
+		Type:               TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonExternalDeleting,
+		Message:            "The external resource is being deleted",
+	}
+}
+
 // Available returns a condition that indicates the resource is
 // currently observed to be available for use.
 func Available() Condition {
@@ -305,3 +467,178 @@ func ReconcilePaused() Condition {
 		Reason:             ReasonReconcilePaused,
 	}
 }
+
+// Throttled returns a condition indicating that Crossplane could not reconcile
+// the resource because the external system is throttling requests. Crossplane
+// will retry after the supplied duration, if it is known.
+func Throttled(retryAfter time.Duration) Condition {
+	msg := "Requests to the external system are being throttled"
+	if retryAfter > 0 {
+		msg = fmt.Sprintf("%s; retrying after %s", msg, retryAfter)
+	}
+
+	return Condition{
+		Type:               TypeSynced,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonThrottled,
+		Message:            msg,
+	}
+}
+
+// QuotaExceeded returns a condition indicating that Crossplane could not
+// reconcile the resource because doing so would exceed a quota in the
+// external system.
+func QuotaExceeded(msg string) Condition {
+	return Condition{
+		Type:               TypeSynced,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonQuotaExceeded,
+		Message:            msg,
+	}
+}
+
+// UpstreamUnavailable returns a condition indicating that Crossplane could not
+// reconcile the resource because the external system is unavailable.
+func UpstreamUnavailable(msg string) Condition {
+	return Condition{
+		Type:               TypeSynced,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonUpstreamUnavailable,
+		Message:            msg,
+	}
+}
+
+// DriftLoopDetected returns a condition indicating that Crossplane updated the
+// external resource the supplied number of consecutive times without ever
+// observing it to be up to date, suggesting another system is mutating it.
+func DriftLoopDetected(updates int) Condition {
+	return Condition{
+		Type:               TypeDriftLoop,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonDriftLoopDetected,
+		Message:            fmt.Sprintf("External resource was updated %d consecutive times without being observed as up to date; another system may be mutating it", updates),
+	}
+}
+
+// NoDriftLoop returns a condition indicating that the external resource was
+// observed to be up to date after a previously detected drift loop.
+func NoDriftLoop() Condition {
+	return Condition{
+		Type:               TypeDriftLoop,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonNoDriftLoop,
+	}
+}
+
+// ReferencesResolved returns a condition indicating that the resource resolved
+// the supplied number of references to other resources.
+func ReferencesResolved(count int) Condition {
+	return Condition{
+		Type:               TypeReferencesResolved,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonResolved,
+		Message:            fmt.Sprintf("Resolved %d references", count),
+	}
+}
+
+// ReferencesUnresolved returns a condition indicating that the resource could
+// not resolve its references to other resources.
+func ReferencesUnresolved(err error) Condition {
+	return Condition{
+		Type:               TypeReferencesResolved,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonUnresolved,
+		Message:            err.Error(),
+	}
+}
+
+// ProviderConfigResolved returns a condition indicating that the resource
+// resolved the supplied ProviderConfig. The namespace is empty if the
+// ProviderConfig is cluster scoped.
+func ProviderConfigResolved(kind, namespace, name string) Condition {
+	msg := fmt.Sprintf("Using %s %q", kind, name)
+	if namespace != "" {
+		msg = fmt.Sprintf("Using %s %q in namespace %q", kind, name, namespace)
+	}
+
+	return Condition{
+		Type:               TypeProviderConfigResolved,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonResolved,
+		Message:            msg,
+	}
+}
+
+// ProviderConfigUnresolved returns a condition indicating that the resource
+// could not resolve its ProviderConfig.
+func ProviderConfigUnresolved(err error) Condition {
+	return Condition{
+		Type:               TypeProviderConfigResolved,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonUnresolved,
+		Message:            err.Error(),
+	}
+}
+
+// DeletionBlocked returns a condition indicating that the resource's external
+// resource won't be deleted until the supplied dependents are deleted.
+func DeletionBlocked(dependents []TypedReference) Condition {
+	names := make([]string, len(dependents))
+	for i, d := range dependents {
+		names[i] = d.Kind + "/" + d.Name
+	}
+
+	return Condition{
+		Type:               TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonDeletionBlocked,
+		Message:            fmt.Sprintf("Waiting for dependents to be deleted: %s", strings.Join(names, ", ")),
+	}
+}
+
+// DeletionProtected returns a condition indicating that the resource's
+// external resource won't be deleted because the resource has the
+// crossplane.io/deletion-protection annotation.
+func DeletionProtected() Condition {
+	return Condition{
+		Type:               TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonDeletionProtected,
+		Message:            "Remove the crossplane.io/deletion-protection annotation to delete the external resource",
+	}
+}
+
+// AccessDenied returns a condition indicating that the resource is only being
+// observed, because Crossplane lacks the permission or quota to fully manage
+// its external resource.
+func AccessDenied(err error) Condition {
+	return Condition{
+		Type:               TypeDegraded,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonAccessDenied,
+		Message:            err.Error(),
+	}
+}
+
+// FullAccess returns a condition indicating that Crossplane may fully manage
+// the resource's external resource.
+func FullAccess() Condition {
+	return Condition{
+		Type:               TypeDegraded,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonFullAccess,
+	}
+}
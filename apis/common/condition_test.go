@@ -18,6 +18,7 @@ package common
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	corev1 "k8s.io/api/core/v1"
@@ -287,6 +288,101 @@ func TestConditionWithObservedGeneration(t *testing.T) {
 	}
 }
 
+func TestNewCondition(t *testing.T) {
+	then := metav1.NewTime(metav1.Now().Add(-time.Hour))
+
+	cases := map[string]struct {
+		o    []ConditionOption
+		want Condition
+	}{
+		"NoOptions": {
+			want: Condition{Type: TypeSynced, Status: corev1.ConditionFalse, Reason: ReasonReconcileError},
+		},
+		"WithOptions": {
+			o: []ConditionOption{
+				WithReason(ReasonQuotaExceeded),
+				WithMessageTemplate("%d of %d used", 10, 10),
+				WithObservedGeneration(3),
+				WithLastTransitionTime(then),
+			},
+			want: Condition{
+				Type:               TypeSynced,
+				Status:             corev1.ConditionFalse,
+				LastTransitionTime: then,
+				Reason:             ReasonQuotaExceeded,
+				Message:            "10 of 10 used",
+				ObservedGeneration: 3,
+			},
+		},
+		"LaterOptionsWin": {
+			o:    []ConditionOption{WithMessage("a"), WithMessage("b")},
+			want: Condition{Type: TypeSynced, Status: corev1.ConditionFalse, Reason: ReasonReconcileError, Message: "b"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := NewCondition(TypeSynced, corev1.ConditionFalse, ReasonReconcileError, tc.o...)
+
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("NewCondition(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMergeCondition(t *testing.T) {
+	then := metav1.NewTime(metav1.Now().Add(-time.Hour).Truncate(time.Second))
+
+	cases := map[string]struct {
+		cs       *ConditionedStatus
+		c        Condition
+		o        []ConditionOption
+		want     *ConditionedStatus
+		wantTime metav1.Time
+	}{
+		"ConditionDoesNotExist": {
+			cs:       NewConditionedStatus(ReconcileSuccess()),
+			c:        Available().With(WithLastTransitionTime(then)),
+			want:     NewConditionedStatus(ReconcileSuccess(), Available()),
+			wantTime: then,
+		},
+		"StatusUnchanged": {
+			cs:       NewConditionedStatus(Unavailable().With(WithLastTransitionTime(then))),
+			c:        Unavailable(),
+			o:        []ConditionOption{WithMessage("Database is restarting")},
+			want:     NewConditionedStatus(Unavailable().WithMessage("Database is restarting")),
+			wantTime: then,
+		},
+		"StatusChanged": {
+			cs:   NewConditionedStatus(Available().With(WithLastTransitionTime(then))),
+			c:    Unavailable(),
+			o:    []ConditionOption{WithObservedGeneration(2)},
+			want: NewConditionedStatus(Unavailable().WithObservedGeneration(2)),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tc.cs.MergeCondition(tc.c, tc.o...)
+
+			got := tc.cs
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("tc.cs.MergeCondition(...): -want, +got:\n%s", diff)
+			}
+
+			c := got.GetCondition(tc.c.Type)
+			if !tc.wantTime.IsZero() && !c.LastTransitionTime.Equal(&tc.wantTime) {
+				t.Errorf("tc.cs.MergeCondition(...): want last transition time %s, got %s", tc.wantTime, c.LastTransitionTime)
+			}
+
+			if tc.wantTime.IsZero() && c.LastTransitionTime.Equal(&then) {
+				t.Errorf("tc.cs.MergeCondition(...): want last transition time to change, got %s", c.LastTransitionTime)
+			}
+		})
+	}
+}
+
 func TestIsSystemConditionType(t *testing.T) {
 	cases := map[string]struct {
 		c    Condition
@@ -318,3 +414,13 @@ func TestIsSystemConditionType(t *testing.T) {
 		})
 	}
 }
+
+func BenchmarkSetConditions(b *testing.B) {
+	b.ReportAllocs()
+
+	for b.Loop() {
+		s := &ConditionedStatus{}
+		s.SetConditions(Available(), ReconcileSuccess())
+		s.SetConditions(Available(), ReconcileSuccess())
+	}
+}
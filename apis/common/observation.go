@@ -16,6 +16,10 @@ limitations under the License.
 
 package common
 
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
 // ObservedStatus contains the recent reconciliation stats.
 type ObservedStatus struct {
 	// ObservedGeneration is the latest metadata.generation
@@ -35,3 +39,56 @@ func (s *ObservedStatus) SetObservedGeneration(generation int64) {
 func (s *ObservedStatus) GetObservedGeneration() int64 {
 	return s.ObservedGeneration
 }
+
+// An OperationType is a type of operation on an external resource.
+type OperationType string
+
+// Operation types.
+const (
+	OperationCreate OperationType = "Create"
+	OperationUpdate OperationType = "Update"
+	OperationDelete OperationType = "Delete"
+)
+
+// OperationDetails are details of the most recent successful operation on an
+// external resource, for example an operation ID or a console URL.
+type OperationDetails struct {
+	// Operation is the type of operation.
+	Operation OperationType `json:"operation"`
+
+	// Time at which the operation was requested.
+	Time metav1.Time `json:"time"`
+
+	// Details returned by the provider when it requested the operation.
+	// +optional
+	Details map[string]string `json:"details,omitempty"`
+
+	// Truncated is true if some details were omitted because they exceeded
+	// the size limit.
+	// +optional
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// SetOperationDetails sets the details of the most recent successful
+// operation on the external resource.
+func (s *ResourceStatus) SetOperationDetails(d *OperationDetails) {
+	s.OperationDetails = d
+}
+
+// GetOperationDetails returns the details of the most recent successful
+// operation on the external resource.
+func (s *ResourceStatus) GetOperationDetails() *OperationDetails {
+	return s.OperationDetails
+}
+
+// SetObservedStateHash sets the hash of the most recently observed state of
+// the external resource.
+func (s *ResourceStatus) SetObservedStateHash(h string) {
+	s.ObservedStateHash = h
+}
+
+// GetObservedStateHash returns the hash of the most recently observed state of
+// the external resource.
+func (s *ResourceStatus) GetObservedStateHash() string {
+	return s.ObservedStateHash
+}
@@ -77,6 +77,15 @@ type SecretKeySelector struct {
 
 	// The key to select.
 	Key string `json:"key"`
+
+	// Optional specifies whether the secret or its key may be missing. A
+	// missing optional key resolves to its default, or to nothing.
+	// +optional
+	Optional *bool `json:"optional,omitempty"`
+
+	// Default value of the key, used if the secret or its key is missing.
+	// +optional
+	Default *string `json:"default,omitempty"`
 }
 
 // A LocalSecretKeySelector is a reference to a secret key
@@ -85,6 +94,15 @@ type LocalSecretKeySelector struct {
 	LocalSecretReference `json:",inline"`
 
 	Key string `json:"key"`
+
+	// Optional specifies whether the secret or its key may be missing. A
+	// missing optional key resolves to its default, or to nothing.
+	// +optional
+	Optional *bool `json:"optional,omitempty"`
+
+	// Default value of the key, used if the secret or its key is missing.
+	// +optional
+	Default *string `json:"default,omitempty"`
 }
 
 // ToSecretKeySelector is a convenience method for converting the
@@ -95,7 +113,9 @@ func (ls *LocalSecretKeySelector) ToSecretKeySelector(namespace string) *SecretK
 			Name:      ls.Name,
 			Namespace: namespace,
 		},
-		Key: ls.Key,
+		Key:      ls.Key,
+		Optional: ls.Optional,
+		Default:  ls.Default,
 	}
 }
 
@@ -232,10 +252,58 @@ func (obj *TypedReference) GroupVersionKind() schema.GroupVersionKind {
 // GetObjectKind get the ObjectKind of a TypedReference.
 func (obj *TypedReference) GetObjectKind() schema.ObjectKind { return obj }
 
+// A NamespacedTypedReference refers to an object of any kind by APIVersion,
+// Kind, Name, and Namespace. It's commonly used by namespaced managed
+// resources to reference objects that aren't managed resources, for example
+// ConfigMaps.
+type NamespacedTypedReference struct {
+	// APIVersion of the referenced object.
+	APIVersion string `json:"apiVersion"`
+
+	// Kind of the referenced object.
+	Kind string `json:"kind"`
+
+	// Name of the referenced object.
+	Name string `json:"name"`
+
+	// Namespace of the referenced object. Defaults to the namespace of the
+	// referencing object.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Policies for referencing.
+	// +optional
+	Policy *Policy `json:"policy,omitempty"`
+}
+
+// SetGroupVersionKind sets the Kind and APIVersion of a
+// NamespacedTypedReference.
+func (obj *NamespacedTypedReference) SetGroupVersionKind(gvk schema.GroupVersionKind) {
+	obj.APIVersion, obj.Kind = gvk.ToAPIVersionAndKind()
+}
+
+// GroupVersionKind gets the GroupVersionKind of a NamespacedTypedReference.
+func (obj *NamespacedTypedReference) GroupVersionKind() schema.GroupVersionKind {
+	return schema.FromAPIVersionAndKind(obj.APIVersion, obj.Kind)
+}
+
+// GetObjectKind get the ObjectKind of a NamespacedTypedReference.
+func (obj *NamespacedTypedReference) GetObjectKind() schema.ObjectKind { return obj }
+
 // ResourceStatus represents the observed state of a managed resource.
 type ResourceStatus struct {
 	ConditionedStatus `json:",inline"`
 	ObservedStatus    `json:",inline"`
+
+	// OperationDetails are details of the most recent successful operation on
+	// the external resource, if the provider records them.
+	// +optional
+	OperationDetails *OperationDetails `json:"operationDetails,omitempty"`
+
+	// ObservedStateHash is a hash of the most recently observed state of the
+	// external resource, if the provider records it.
+	// +optional
+	ObservedStateHash string `json:"observedStateHash,omitempty"`
 }
 
 // A CredentialsSource is a source from which provider credentials may be
@@ -305,6 +373,12 @@ type ProviderConfigStatus struct {
 
 	// Users of this provider configuration.
 	Users int64 `json:"users,omitempty"`
+
+	// Identity the provider configuration's credentials authenticate as, as
+	// reported by the provider's most recent health check. For example an
+	// account ID and ARN.
+	// +optional
+	Identity map[string]string `json:"identity,omitempty"`
 }
 
 // A ProviderConfigUsage is a record that a particular managed resource is using
@@ -17,6 +17,11 @@ limitations under the License.
 package v1
 
 import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 	"github.com/crossplane/crossplane-runtime/v2/apis/common"
 )
 
@@ -42,6 +47,23 @@ const (
 	// as a system condition. See the tracking issue for more details
 	// https://github.com/crossplane/crossplane/issues/5643.
 	TypeHealthy ConditionType = common.TypeHealthy
+
+	// TypeDriftLoop resources are believed to be fighting another system that
+	// mutates their external resource. Each time Crossplane updates the
+	// external resource to match the desired state, something else changes it
+	// back.
+	TypeDriftLoop ConditionType = common.TypeDriftLoop
+
+	// TypeReferencesResolved resources have resolved all of their references
+	// to other resources.
+	TypeReferencesResolved ConditionType = common.TypeReferencesResolved
+
+	// TypeProviderConfigResolved resources have resolved the ProviderConfig
+	// they use to connect to their external system.
+	TypeProviderConfigResolved ConditionType = common.TypeProviderConfigResolved
+
+	// TypeDegraded resources are observed, but can't be fully managed.
+	TypeDegraded ConditionType = common.TypeDegraded
 )
 
 // A ConditionReason represents the reason a resource is in a condition.
@@ -53,6 +75,8 @@ const (
 	ReasonUnavailable = common.ReasonUnavailable
 	ReasonCreating    = common.ReasonCreating
 	ReasonDeleting    = common.ReasonDeleting
+
+	ReasonExternalDeleting = common.ReasonExternalDeleting
 )
 
 // Reasons a resource is or is not synced.
@@ -62,11 +86,79 @@ const (
 	ReasonReconcilePaused  = common.ReasonReconcilePaused
 )
 
+// Reasons a resource is not synced because of a problem with the external
+// system. These are more specific than ReasonReconcileError.
+const (
+	ReasonThrottled           = common.ReasonThrottled
+	ReasonQuotaExceeded       = common.ReasonQuotaExceeded
+	ReasonUpstreamUnavailable = common.ReasonUpstreamUnavailable
+)
+
+// Reasons a resource is or is not in a drift loop.
+const (
+	ReasonDriftLoopDetected = common.ReasonDriftLoopDetected
+	ReasonNoDriftLoop       = common.ReasonNoDriftLoop
+)
+
+// Reasons a resource's references, or its ProviderConfig, are or are not
+// resolved.
+const (
+	ReasonResolved   = common.ReasonResolved
+	ReasonUnresolved = common.ReasonUnresolved
+)
+
+// Reasons a resource is or is not degraded.
+const (
+	ReasonAccessDenied = common.ReasonAccessDenied
+	ReasonFullAccess   = common.ReasonFullAccess
+)
+
+// Reasons a resource's external resource won't be deleted.
+const (
+	ReasonDeletionBlocked   = common.ReasonDeletionBlocked
+	ReasonDeletionProtected = common.ReasonDeletionProtected
+)
+
 // See https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#typical-status-properties
 
 // A Condition that may apply to a resource.
 type Condition = common.Condition
 
+// A ConditionOption modifies a condition.
+type ConditionOption = common.ConditionOption
+
+// WithReason sets the reason of a condition.
+func WithReason(r ConditionReason) ConditionOption {
+	return common.WithReason(r)
+}
+
+// WithMessage sets the message of a condition.
+func WithMessage(msg string) ConditionOption {
+	return common.WithMessage(msg)
+}
+
+// WithMessageTemplate sets the message of a condition by formatting the
+// supplied arguments according to the supplied format specifier.
+func WithMessageTemplate(format string, args ...any) ConditionOption {
+	return common.WithMessageTemplate(format, args...)
+}
+
+// WithObservedGeneration sets the observed generation of a condition.
+func WithObservedGeneration(gen int64) ConditionOption {
+	return common.WithObservedGeneration(gen)
+}
+
+// WithLastTransitionTime sets the last transition time of a condition.
+func WithLastTransitionTime(t metav1.Time) ConditionOption {
+	return common.WithLastTransitionTime(t)
+}
+
+// NewCondition returns a condition of the supplied type, status, and reason
+// that last transitioned now, modified by the supplied options.
+func NewCondition(t ConditionType, s corev1.ConditionStatus, r ConditionReason, o ...ConditionOption) Condition {
+	return common.NewCondition(t, s, r, o...)
+}
+
 // IsSystemConditionType returns true if the condition is owned by the
 // Crossplane system (e.g, Ready, Synced, Healthy).
 func IsSystemConditionType(t ConditionType) bool {
@@ -101,6 +193,12 @@ func Deleting() Condition {
 	return common.Deleting()
 }
 
+// ExternalDeleting returns a condition that indicates the resource's external
+// resource is currently being deleted, though the resource itself was not.
+func ExternalDeleting() Condition {
+	return common.ExternalDeleting()
+}
+
 // Available returns a condition that indicates the resource is
 // currently observed to be available for use.
 func Available() Condition {
@@ -134,3 +232,87 @@ func ReconcileError(err error) Condition {
 func ReconcilePaused() Condition {
 	return common.ReconcilePaused()
 }
+
+// Throttled returns a condition indicating that Crossplane could not reconcile
+// the resource because the external system is throttling requests. Crossplane
+// will retry after the supplied duration, if it is known.
+func Throttled(retryAfter time.Duration) Condition {
+	return common.Throttled(retryAfter)
+}
+
+// QuotaExceeded returns a condition indicating that Crossplane could not
+// reconcile the resource because doing so would exceed a quota in the
+// external system.
+func QuotaExceeded(msg string) Condition {
+	return common.QuotaExceeded(msg)
+}
+
+// UpstreamUnavailable returns a condition indicating that Crossplane could not
+// reconcile the resource because the external system is unavailable.
+func UpstreamUnavailable(msg string) Condition {
+	return common.UpstreamUnavailable(msg)
+}
+
+// DriftLoopDetected returns a condition indicating that Crossplane updated the
+// external resource the supplied number of consecutive times without ever
+// observing it to be up to date, suggesting another system is mutating it.
+func DriftLoopDetected(updates int) Condition {
+	return common.DriftLoopDetected(updates)
+}
+
+// NoDriftLoop returns a condition indicating that the external resource was
+// observed to be up to date after a previously detected drift loop.
+func NoDriftLoop() Condition {
+	return common.NoDriftLoop()
+}
+
+// ReferencesResolved returns a condition indicating that the resource resolved
+// the supplied number of references to other resources.
+func ReferencesResolved(count int) Condition {
+	return common.ReferencesResolved(count)
+}
+
+// ReferencesUnresolved returns a condition indicating that the resource could
+// not resolve its references to other resources.
+func ReferencesUnresolved(err error) Condition {
+	return common.ReferencesUnresolved(err)
+}
+
+// ProviderConfigResolved returns a condition indicating that the resource
+// resolved the supplied ProviderConfig. The namespace is empty if the
+// ProviderConfig is cluster scoped.
+func ProviderConfigResolved(kind, namespace, name string) Condition {
+	return common.ProviderConfigResolved(kind, namespace, name)
+}
+
+// ProviderConfigUnresolved returns a condition indicating that the resource
+// could not resolve its ProviderConfig.
+func ProviderConfigUnresolved(err error) Condition {
+	return common.ProviderConfigUnresolved(err)
+}
+
+// DeletionBlocked returns a condition indicating that the resource's external
+// resource won't be deleted until the supplied dependents are deleted.
+func DeletionBlocked(dependents []TypedReference) Condition {
+	return common.DeletionBlocked(dependents)
+}
+
+// DeletionProtected returns a condition indicating that the resource's
+// external resource won't be deleted because the resource has the
+// crossplane.io/deletion-protection annotation.
+func DeletionProtected() Condition {
+	return common.DeletionProtected()
+}
+
+// AccessDenied returns a condition indicating that the resource is only being
+// observed, because Crossplane lacks the permission or quota to fully manage
+// its external resource.
+func AccessDenied(err error) Condition {
+	return common.AccessDenied(err)
+}
+
+// FullAccess returns a condition indicating that Crossplane may fully manage
+// the resource's external resource.
+func FullAccess() Condition {
+	return common.FullAccess()
+}
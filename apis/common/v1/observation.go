@@ -22,3 +22,41 @@ import (
 
 // ObservedStatus contains the recent reconciliation stats.
 type ObservedStatus = common.ObservedStatus
+
+// An OperationType is a type of operation on an external resource.
+type OperationType = common.OperationType
+
+// Operation types.
+const (
+	OperationCreate = common.OperationCreate
+	OperationUpdate = common.OperationUpdate
+	OperationDelete = common.OperationDelete
+)
+
+// OperationDetails are details of the most recent successful operation on an
+// external resource, for example an operation ID or a console URL.
+type OperationDetails = common.OperationDetails
+
+// SetOperationDetails sets the details of the most recent successful
+// operation on the external resource.
+func (s *ResourceStatus) SetOperationDetails(d *OperationDetails) {
+	s.OperationDetails = d
+}
+
+// GetOperationDetails returns the details of the most recent successful
+// operation on the external resource.
+func (s *ResourceStatus) GetOperationDetails() *OperationDetails {
+	return s.OperationDetails
+}
+
+// SetObservedStateHash sets the hash of the most recently observed state of
+// the external resource.
+func (s *ResourceStatus) SetObservedStateHash(h string) {
+	s.ObservedStateHash = h
+}
+
+// GetObservedStateHash returns the hash of the most recently observed state of
+// the external resource.
+func (s *ResourceStatus) GetObservedStateHash() string {
+	return s.ObservedStateHash
+}
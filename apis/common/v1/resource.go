@@ -18,6 +18,7 @@ package v1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/crossplane/crossplane-runtime/v2/apis/common"
 )
@@ -84,6 +85,10 @@ type NamespacedReference = common.NamespacedReference
 // namespace is already known.
 type TypedReference = common.TypedReference
 
+// A NamespacedTypedReference refers to an object of any kind by APIVersion,
+// Kind, Name, and Namespace.
+type NamespacedTypedReference = common.NamespacedTypedReference
+
 // A Selector selects an object.
 type Selector = common.Selector
 
@@ -136,12 +141,31 @@ type ResourceSpec struct {
 	// +optional
 	// +kubebuilder:default=Delete
 	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// PollInterval overrides how often the provider checks whether the
+	// external resource has drifted from the desired state, e.g. "10m". The
+	// provider may clamp the supplied interval to a minimum and maximum.
+	// +optional
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1s') && duration(self) <= duration('24h')",message="pollInterval must be between 1s and 24h"
+	PollInterval *metav1.Duration `json:"pollInterval,omitempty"`
 }
 
 // ResourceStatus represents the observed state of a managed resource.
 type ResourceStatus struct {
 	ConditionedStatus `json:",inline"`
 	ObservedStatus    `json:",inline"`
+
+	// OperationDetails are details of the most recent successful operation on
+	// the external resource, if the provider records them.
+	// +optional
+	OperationDetails *OperationDetails `json:"operationDetails,omitempty"`
+
+	// ObservedStateHash is a hash of the most recently observed state of the
+	// external resource, if the provider records it.
+	// +optional
+	ObservedStateHash string `json:"observedStateHash,omitempty"`
 }
 
 // A CredentialsSource is a source from which provider credentials may be
@@ -0,0 +1,30 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"github.com/crossplane/crossplane-runtime/v2/apis/common"
+)
+
+// A Duration is a length of time, e.g. "30s" or "1h5m". It's encoded as a
+// string in the format accepted by time.ParseDuration.
+type Duration = common.Duration
+
+// A Quantity is a fixed-point representation of a number, e.g. "10Gi" or
+// "500m". It's encoded as a string in the format accepted by
+// resource.ParseQuantity.
+type Quantity = common.Quantity
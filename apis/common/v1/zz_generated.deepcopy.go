@@ -22,6 +22,7 @@ package v1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -42,6 +43,11 @@ func (in *ResourceSpec) DeepCopyInto(out *ResourceSpec) {
 		*out = make(ManagementPolicies, len(*in))
 		copy(*out, *in)
 	}
+	if in.PollInterval != nil {
+		in, out := &in.PollInterval, &out.PollInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceSpec.
@@ -59,6 +65,11 @@ func (in *ResourceStatus) DeepCopyInto(out *ResourceStatus) {
 	*out = *in
 	in.ConditionedStatus.DeepCopyInto(&out.ConditionedStatus)
 	in.ObservedStatus.DeepCopyInto(&out.ObservedStatus)
+	if in.OperationDetails != nil {
+		in, out := &in.OperationDetails, &out.OperationDetails
+		*out = new(OperationDetails)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceStatus.
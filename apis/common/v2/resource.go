@@ -17,6 +17,8 @@ limitations under the License.
 package v2
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 	"github.com/crossplane/crossplane-runtime/v2/apis/common"
 )
 
@@ -44,6 +46,15 @@ type ManagedResourceSpec struct {
 	// +optional
 	// +kubebuilder:default={"*"}
 	ManagementPolicies common.ManagementPolicies `json:"managementPolicies,omitempty"`
+
+	// PollInterval overrides how often the provider checks whether the
+	// external resource has drifted from the desired state, e.g. "10m". The
+	// provider may clamp the supplied interval to a minimum and maximum.
+	// +optional
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1s') && duration(self) <= duration('24h')",message="pollInterval must be between 1s and 24h"
+	PollInterval *metav1.Duration `json:"pollInterval,omitempty"`
 }
 
 // A TypedProviderConfigUsage is a record that a particular managed resource is using
@@ -22,6 +22,7 @@ package v2
 
 import (
 	"github.com/crossplane/crossplane-runtime/v2/apis/common"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -42,6 +43,11 @@ func (in *ManagedResourceSpec) DeepCopyInto(out *ManagedResourceSpec) {
 		*out = make(common.ManagementPolicies, len(*in))
 		copy(*out, *in)
 	}
+	if in.PollInterval != nil {
+		in, out := &in.PollInterval, &out.PollInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedResourceSpec.
@@ -0,0 +1,99 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"encoding/json"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// A Duration is a length of time, e.g. "30s" or "1h5m". It's encoded as a
+// string in the format accepted by time.ParseDuration. Use it for fields like
+// poll intervals and timeouts.
+// +kubebuilder:validation:Type=string
+// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`
+type Duration struct {
+	time.Duration `json:"-"`
+}
+
+// UnmarshalJSON parses a Duration from a JSON string.
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	pd, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+
+	d.Duration = pd
+
+	return nil
+}
+
+// MarshalJSON encodes a Duration as a JSON string.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// ValueOr returns the length of time, or the supplied default if the Duration
+// is nil. It's useful for optional fields.
+func (d *Duration) ValueOr(def time.Duration) time.Duration {
+	if d == nil {
+		return def
+	}
+
+	return d.Duration
+}
+
+// A Quantity is a fixed-point representation of a number, e.g. "10Gi" or
+// "500m". It's encoded as a string in the format accepted by
+// resource.ParseQuantity, but may be written as a number. Use it for fields
+// like sizes and capacities.
+// +kubebuilder:validation:XIntOrString
+// +kubebuilder:validation:Pattern=`^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$`
+type Quantity struct {
+	resource.Quantity `json:"-"`
+}
+
+// UnmarshalJSON parses a Quantity from a JSON string or number.
+func (q *Quantity) UnmarshalJSON(b []byte) error {
+	return q.Quantity.UnmarshalJSON(b)
+}
+
+// MarshalJSON encodes a Quantity as a JSON string.
+func (q Quantity) MarshalJSON() ([]byte, error) {
+	return q.Quantity.MarshalJSON()
+}
+
+// ValueOr returns the quantity, or the supplied default if the Quantity is
+// nil. It's useful for optional fields.
+func (q *Quantity) ValueOr(def resource.Quantity) resource.Quantity {
+	if q == nil {
+		return def
+	}
+
+	return q.Quantity
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+type values struct {
+	Interval *Duration `json:"interval,omitempty"`
+	Size     *Quantity `json:"size,omitempty"`
+}
+
+func TestValuesJSON(t *testing.T) {
+	type want struct {
+		interval time.Duration
+		size     int64
+		json     string
+		err      bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		json   string
+		want   want
+	}{
+		"Strings": {
+			reason: "Durations and quantities should round trip as strings.",
+			json:   `{"interval":"1m30s","size":"10Gi"}`,
+			want: want{
+				interval: 90 * time.Second,
+				size:     10 * 1024 * 1024 * 1024,
+				json:     `{"interval":"1m30s","size":"10Gi"}`,
+			},
+		},
+		"NumericQuantity": {
+			reason: "A quantity written as a number should be encoded as a string.",
+			json:   `{"interval":"5s","size":3}`,
+			want: want{
+				interval: 5 * time.Second,
+				size:     3,
+				json:     `{"interval":"5s","size":"3"}`,
+			},
+		},
+		"InvalidDuration": {
+			reason: "We should return an error if a duration can't be parsed.",
+			json:   `{"interval":"soon"}`,
+			want:   want{err: true},
+		},
+		"InvalidQuantity": {
+			reason: "We should return an error if a quantity can't be parsed.",
+			json:   `{"size":"lots"}`,
+			want:   want{err: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			v := &values{}
+
+			err := json.Unmarshal([]byte(tc.json), v)
+			if diff := cmp.Diff(tc.want.err, err != nil); diff != "" {
+				t.Fatalf("\n%s\njson.Unmarshal(...): -want error, +got error:\n%s\n%v", tc.reason, diff, err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.want.interval, v.Interval.Duration); diff != "" {
+				t.Errorf("\n%s\njson.Unmarshal(...): -want interval, +got interval:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.want.size, v.Size.Value()); diff != "" {
+				t.Errorf("\n%s\njson.Unmarshal(...): -want size, +got size:\n%s", tc.reason, diff)
+			}
+
+			j, err := json.Marshal(v)
+			if err != nil {
+				t.Fatalf("\n%s\njson.Marshal(...): %v", tc.reason, err)
+			}
+
+			if diff := cmp.Diff(tc.want.json, string(j)); diff != "" {
+				t.Errorf("\n%s\njson.Marshal(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestValuesValueOr(t *testing.T) {
+	var d *Duration
+	if diff := cmp.Diff(time.Minute, d.ValueOr(time.Minute)); diff != "" {
+		t.Errorf("d.ValueOr(...): nil Duration: -want, +got:\n%s", diff)
+	}
+
+	d = &Duration{Duration: time.Second}
+	if diff := cmp.Diff(time.Second, d.ValueOr(time.Minute)); diff != "" {
+		t.Errorf("d.ValueOr(...): -want, +got:\n%s", diff)
+	}
+
+	var q *Quantity
+	if got := q.ValueOr(resource.MustParse("1Ki")); !got.Equal(resource.MustParse("1Ki")) {
+		t.Errorf("q.ValueOr(...): nil Quantity: want 1Ki, got %s", got.String())
+	}
+
+	q = &Quantity{Quantity: resource.MustParse("2Ki")}
+	if got := q.ValueOr(resource.MustParse("1Ki")); !got.Equal(resource.MustParse("2Ki")) {
+		t.Errorf("q.ValueOr(...): want 2Ki, got %s", got.String())
+	}
+}
+
+func TestQuantityDeepCopy(t *testing.T) {
+	in := &Quantity{Quantity: resource.MustParse("1Gi")}
+	out := in.DeepCopy()
+
+	in.Add(resource.MustParse("1Gi"))
+
+	if diff := cmp.Diff("1Gi", out.String()); diff != "" {
+		t.Errorf("in.DeepCopy(): changing the original shouldn't change the copy: -want, +got:\n%s", diff)
+	}
+}
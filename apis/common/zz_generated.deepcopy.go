@@ -38,7 +38,7 @@ func (in *CommonCredentialSelectors) DeepCopyInto(out *CommonCredentialSelectors
 	if in.SecretRef != nil {
 		in, out := &in.SecretRef, &out.SecretRef
 		*out = new(SecretKeySelector)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -90,6 +90,21 @@ func (in *ConditionedStatus) DeepCopy() *ConditionedStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Duration) DeepCopyInto(out *Duration) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Duration.
+func (in *Duration) DeepCopy() *Duration {
+	if in == nil {
+		return nil
+	}
+	out := new(Duration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EnvSelector) DeepCopyInto(out *EnvSelector) {
 	*out = *in
@@ -124,6 +139,16 @@ func (in *FsSelector) DeepCopy() *FsSelector {
 func (in *LocalSecretKeySelector) DeepCopyInto(out *LocalSecretKeySelector) {
 	*out = *in
 	out.LocalSecretReference = in.LocalSecretReference
+	if in.Optional != nil {
+		in, out := &in.Optional, &out.Optional
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalSecretKeySelector.
@@ -247,6 +272,26 @@ func (in *NamespacedSelector) DeepCopy() *NamespacedSelector {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespacedTypedReference) DeepCopyInto(out *NamespacedTypedReference) {
+	*out = *in
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = new(Policy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacedTypedReference.
+func (in *NamespacedTypedReference) DeepCopy() *NamespacedTypedReference {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespacedTypedReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ObservedStatus) DeepCopyInto(out *ObservedStatus) {
 	*out = *in
@@ -262,6 +307,29 @@ func (in *ObservedStatus) DeepCopy() *ObservedStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperationDetails) DeepCopyInto(out *OperationDetails) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	if in.Details != nil {
+		in, out := &in.Details, &out.Details
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperationDetails.
+func (in *OperationDetails) DeepCopy() *OperationDetails {
+	if in == nil {
+		return nil
+	}
+	out := new(OperationDetails)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Policy) DeepCopyInto(out *Policy) {
 	*out = *in
@@ -306,6 +374,13 @@ func (in *ProviderConfigReference) DeepCopy() *ProviderConfigReference {
 func (in *ProviderConfigStatus) DeepCopyInto(out *ProviderConfigStatus) {
 	*out = *in
 	in.ConditionedStatus.DeepCopyInto(&out.ConditionedStatus)
+	if in.Identity != nil {
+		in, out := &in.Identity, &out.Identity
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfigStatus.
@@ -335,6 +410,22 @@ func (in *ProviderConfigUsage) DeepCopy() *ProviderConfigUsage {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Quantity) DeepCopyInto(out *Quantity) {
+	*out = *in
+	out.Quantity = in.Quantity.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Quantity.
+func (in *Quantity) DeepCopy() *Quantity {
+	if in == nil {
+		return nil
+	}
+	out := new(Quantity)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Reference) DeepCopyInto(out *Reference) {
 	*out = *in
@@ -360,6 +451,11 @@ func (in *ResourceStatus) DeepCopyInto(out *ResourceStatus) {
 	*out = *in
 	in.ConditionedStatus.DeepCopyInto(&out.ConditionedStatus)
 	out.ObservedStatus = in.ObservedStatus
+	if in.OperationDetails != nil {
+		in, out := &in.OperationDetails, &out.OperationDetails
+		*out = new(OperationDetails)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceStatus.
@@ -376,6 +472,16 @@ func (in *ResourceStatus) DeepCopy() *ResourceStatus {
 func (in *SecretKeySelector) DeepCopyInto(out *SecretKeySelector) {
 	*out = *in
 	out.SecretReference = in.SecretReference
+	if in.Optional != nil {
+		in, out := &in.Optional, &out.Optional
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretKeySelector.
@@ -0,0 +1,290 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package backup snapshots managed resources and their connection secrets so
+// they can be restored into another cluster.
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+)
+
+// ArchiveVersion is the version of the archive format written by
+// WriteArchive.
+const ArchiveVersion = "v1alpha1"
+
+// Error strings.
+const (
+	errGetGVK           = "cannot determine the kind of managed resource"
+	errToUnstructured   = "cannot convert managed resource to unstructured"
+	errGetSecret        = "cannot get connection secret"
+	errCreateResource   = "cannot create managed resource"
+	errGetResource      = "cannot get existing managed resource"
+	errCreateSecret     = "cannot create connection secret"
+	errNoResource       = "snapshot does not contain a managed resource"
+	errNoExternalName   = "refusing to restore a managed resource without an external name; it would create a new external resource"
+	errEncodeArchive    = "cannot encode archive"
+	errDecodeArchive    = "cannot decode archive"
+	errFmtArchiveFormat = "unsupported archive version %q"
+)
+
+// A Snapshot of a managed resource and its connection secret. The snapshot
+// omits status and any metadata that is populated by the API server, but
+// preserves labels and annotations, including the external name.
+type Snapshot struct {
+	// Resource is the managed resource.
+	Resource *unstructured.Unstructured `json:"resource"`
+
+	// ConnectionSecret is the managed resource's connection secret, if any.
+	ConnectionSecret *corev1.Secret `json:"connectionSecret,omitempty"`
+}
+
+// A Snapshotter takes snapshots of managed resources.
+type Snapshotter struct {
+	client client.Client
+}
+
+// NewSnapshotter returns a Snapshotter that reads connection secrets using
+// the supplied client. The client's scheme is used to determine the kind of
+// typed managed resources.
+func NewSnapshotter(c client.Client) *Snapshotter {
+	return &Snapshotter{client: c}
+}
+
+// Snapshot the supplied managed resource. A connection secret that has not
+// yet been written is omitted from the snapshot.
+func (s *Snapshotter) Snapshot(ctx context.Context, mg resource.Managed) (*Snapshot, error) {
+	gvk, err := apiutil.GVKForObject(mg, s.client.Scheme())
+	if err != nil {
+		return nil, errors.Wrap(err, errGetGVK)
+	}
+
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errToUnstructured)
+	}
+
+	u := &unstructured.Unstructured{Object: obj}
+	u.SetGroupVersionKind(gvk)
+	sanitize(u)
+	delete(u.Object, "status")
+
+	snap := &Snapshot{Resource: u}
+
+	nn := connectionSecretFor(mg)
+	if nn == nil {
+		return snap, nil
+	}
+
+	sec := &corev1.Secret{}
+	if err := s.client.Get(ctx, *nn, sec); err != nil {
+		if kerrors.IsNotFound(err) {
+			return snap, nil
+		}
+
+		return nil, errors.Wrap(err, errGetSecret)
+	}
+
+	sec.ObjectMeta = metav1.ObjectMeta{
+		Name:        sec.GetName(),
+		Namespace:   sec.GetNamespace(),
+		Labels:      sec.GetLabels(),
+		Annotations: sec.GetAnnotations(),
+	}
+	snap.ConnectionSecret = sec
+
+	return snap, nil
+}
+
+// connectionSecretFor returns the name of the supplied managed resource's
+// connection secret, or nil if it doesn't write one.
+func connectionSecretFor(mg resource.Managed) *types.NamespacedName {
+	switch cw := mg.(type) {
+	case resource.LocalConnectionSecretWriterTo:
+		if ref := cw.GetWriteConnectionSecretToReference(); ref != nil {
+			return &types.NamespacedName{Namespace: mg.GetNamespace(), Name: ref.Name}
+		}
+	case resource.ConnectionSecretWriterTo:
+		if ref := cw.GetWriteConnectionSecretToReference(); ref != nil {
+			return &types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}
+		}
+	}
+
+	return nil
+}
+
+// sanitize removes all metadata except name, namespace, labels and
+// annotations. The remaining metadata is either populated by the API server
+// or, like owner references and finalizers, meaningless in another cluster.
+func sanitize(u *unstructured.Unstructured) {
+	om := map[string]any{"name": u.GetName()}
+	if ns := u.GetNamespace(); ns != "" {
+		om["namespace"] = ns
+	}
+
+	if l := u.GetLabels(); len(l) > 0 {
+		om["labels"] = toAny(l)
+	}
+
+	if a := u.GetAnnotations(); len(a) > 0 {
+		om["annotations"] = toAny(a)
+	}
+
+	u.Object["metadata"] = om
+}
+
+func toAny(in map[string]string) map[string]any {
+	out := make(map[string]any, len(in))
+	for k, v := range in {
+		out[k] = v
+	}
+
+	return out
+}
+
+// A RestoreOption configures a Restorer.
+type RestoreOption func(r *Restorer)
+
+// WithNamespace restores namespaced managed resources, and their connection
+// secrets, to the supplied namespace rather than the one they were snapshot
+// from.
+func WithNamespace(ns string) RestoreOption {
+	return func(r *Restorer) {
+		r.namespace = ns
+	}
+}
+
+// WithPaused pauses reconciliation of restored managed resources, allowing
+// them to be inspected before they're reconciled with the external system.
+func WithPaused() RestoreOption {
+	return func(r *Restorer) {
+		r.paused = true
+	}
+}
+
+// A Restorer restores managed resources from snapshots.
+type Restorer struct {
+	client    client.Client
+	namespace string
+	paused    bool
+}
+
+// NewRestorer returns a Restorer that creates managed resources and their
+// connection secrets using the supplied client.
+func NewRestorer(c client.Client, o ...RestoreOption) *Restorer {
+	r := &Restorer{client: c}
+	for _, fn := range o {
+		fn(r)
+	}
+
+	return r
+}
+
+// Restore the supplied snapshot. The restored managed resource adopts the
+// external resource it was snapshot from: its external name is preserved, and
+// any annotations recording an incomplete or failed create are removed so the
+// managed reconciler observes rather than creates the external resource. The
+// restored connection secret is controlled by the restored managed resource.
+// Restore is idempotent; a managed resource or connection secret that already
+// exists, for example because a previous restore partially failed, is left as
+// is.
+func (r *Restorer) Restore(ctx context.Context, s *Snapshot) error {
+	if s.Resource == nil {
+		return errors.New(errNoResource)
+	}
+
+	u := s.Resource.DeepCopy()
+	if meta.GetExternalName(u) == "" {
+		return errors.New(errNoExternalName)
+	}
+
+	if r.namespace != "" && u.GetNamespace() != "" {
+		u.SetNamespace(r.namespace)
+	}
+
+	meta.RemoveAnnotations(u, meta.AnnotationKeyExternalCreatePending, meta.AnnotationKeyExternalCreateFailed)
+
+	if r.paused {
+		meta.AddAnnotations(u, map[string]string{meta.AnnotationKeyReconciliationPaused: "true"})
+	}
+
+	if err := r.client.Create(ctx, u); err != nil {
+		if !kerrors.IsAlreadyExists(err) {
+			return errors.Wrap(err, errCreateResource)
+		}
+
+		// We need the existing managed resource's UID to control the
+		// connection secret.
+		if err := r.client.Get(ctx, types.NamespacedName{Namespace: u.GetNamespace(), Name: u.GetName()}, u); err != nil {
+			return errors.Wrap(err, errGetResource)
+		}
+	}
+
+	if s.ConnectionSecret == nil {
+		return nil
+	}
+
+	sec := s.ConnectionSecret.DeepCopy()
+	if u.GetNamespace() != "" {
+		sec.SetNamespace(u.GetNamespace())
+	}
+
+	meta.AddOwnerReference(sec, meta.AsController(meta.TypedReferenceTo(u, u.GroupVersionKind())))
+
+	return errors.Wrap(resource.Ignore(kerrors.IsAlreadyExists, r.client.Create(ctx, sec)), errCreateSecret)
+}
+
+// An archive of snapshots.
+type archive struct {
+	Version   string     `json:"version"`
+	Snapshots []Snapshot `json:"snapshots"`
+}
+
+// WriteArchive writes the supplied snapshots to the supplied writer.
+func WriteArchive(w io.Writer, s ...Snapshot) error {
+	a := archive{Version: ArchiveVersion, Snapshots: s}
+	return errors.Wrap(json.NewEncoder(w).Encode(a), errEncodeArchive)
+}
+
+// ReadArchive reads snapshots written by WriteArchive from the supplied
+// reader.
+func ReadArchive(r io.Reader) ([]Snapshot, error) {
+	a := archive{}
+	if err := json.NewDecoder(r).Decode(&a); err != nil {
+		return nil, errors.Wrap(err, errDecodeArchive)
+	}
+
+	if a.Version != ArchiveVersion {
+		return nil, errors.Errorf(errFmtArchiveFormat, a.Version)
+	}
+
+	return a.Snapshots, nil
+}
@@ -0,0 +1,363 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	umanaged "github.com/crossplane/crossplane-runtime/v2/pkg/resource/unstructured/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+)
+
+var gvk = schema.GroupVersionKind{Group: "example.org", Version: "v1", Kind: "Cool"}
+
+func managed() *umanaged.Unstructured {
+	mg := umanaged.New(umanaged.WithGroupVersionKind(gvk))
+	mg.SetNamespace("default")
+	mg.SetName("cool")
+	mg.SetUID("some-uid")
+	mg.SetResourceVersion("42")
+	mg.SetFinalizers([]string{"finalizer.managedresource.crossplane.io"})
+	mg.SetAnnotations(map[string]string{meta.AnnotationKeyExternalName: "cool-external"})
+	mg.SetConditions(xpv1.Available())
+
+	return mg
+}
+
+func snapshotted() *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "example.org/v1",
+		"kind":       "Cool",
+		"metadata": map[string]any{
+			"namespace":   "default",
+			"name":        "cool",
+			"annotations": map[string]any{meta.AnnotationKeyExternalName: "cool-external"},
+		},
+	}}
+}
+
+func TestSnapshot(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	withSecret := func() *umanaged.Unstructured {
+		mg := managed()
+		mg.SetWriteConnectionSecretToReference(&xpv1.LocalSecretReference{Name: "cool-secret"})
+		return mg
+	}
+
+	withSecretRef := snapshotted()
+	withSecretRef.Object["spec"] = map[string]any{
+		"writeConnectionSecretToRef": map[string]any{"name": "cool-secret"},
+	}
+
+	type args struct {
+		c  client.Client
+		mg resource.Managed
+	}
+	type want struct {
+		s   *Snapshot
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"NoConnectionSecret": {
+			reason: "We should snapshot a managed resource without its status or server populated metadata.",
+			args: args{
+				c:  &test.MockClient{MockScheme: test.NewMockSchemeFn(runtime.NewScheme())},
+				mg: managed(),
+			},
+			want: want{
+				s: &Snapshot{Resource: snapshotted()},
+			},
+		},
+		"ConnectionSecret": {
+			reason: "We should snapshot a managed resource's connection secret.",
+			args: args{
+				c: &test.MockClient{
+					MockScheme: test.NewMockSchemeFn(runtime.NewScheme()),
+					MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+						if diff := cmp.Diff(types.NamespacedName{Namespace: "default", Name: "cool-secret"}, key); diff != "" {
+							t.Errorf("Get(...): -want key, +got key:\n%s", diff)
+						}
+						s := obj.(*corev1.Secret)
+						s.SetNamespace("default")
+						s.SetName("cool-secret")
+						s.SetUID("secret-uid")
+						s.Data = map[string][]byte{"password": []byte("secret")}
+						return nil
+					},
+				},
+				mg: withSecret(),
+			},
+			want: want{
+				s: &Snapshot{
+					Resource: withSecretRef,
+					ConnectionSecret: &corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "cool-secret"},
+						Data:       map[string][]byte{"password": []byte("secret")},
+					},
+				},
+			},
+		},
+		"ConnectionSecretNotFound": {
+			reason: "We should omit a connection secret that has not been written yet.",
+			args: args{
+				c: &test.MockClient{
+					MockScheme: test.NewMockSchemeFn(runtime.NewScheme()),
+					MockGet:    test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "cool-secret")),
+				},
+				mg: withSecret(),
+			},
+			want: want{
+				s: &Snapshot{Resource: withSecretRef},
+			},
+		},
+		"GetConnectionSecretError": {
+			reason: "We should return any error encountered getting the connection secret.",
+			args: args{
+				c: &test.MockClient{
+					MockScheme: test.NewMockSchemeFn(runtime.NewScheme()),
+					MockGet:    test.NewMockGetFn(errBoom),
+				},
+				mg: withSecret(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := NewSnapshotter(tc.args.c).Snapshot(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nSnapshot(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.want.s, got); diff != "" {
+				t.Errorf("\n%s\nSnapshot(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestRestore(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	noExternalName := snapshotted()
+	noExternalName.SetAnnotations(nil)
+
+	pending := snapshotted()
+	meta.AddAnnotations(pending, map[string]string{meta.AnnotationKeyExternalCreatePending: "2026-01-01T00:00:00Z"})
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "cool-secret"},
+		Data:       map[string][]byte{"password": []byte("secret")},
+	}
+
+	type args struct {
+		c client.Client
+		o []RestoreOption
+		s *Snapshot
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   error
+	}{
+		"NoResource": {
+			reason: "We should return an error if the snapshot contains no managed resource.",
+			args: args{
+				c: &test.MockClient{},
+				s: &Snapshot{},
+			},
+			want: errors.New(errNoResource),
+		},
+		"NoExternalName": {
+			reason: "We should refuse to restore a managed resource without an external name.",
+			args: args{
+				c: &test.MockClient{},
+				s: &Snapshot{Resource: noExternalName},
+			},
+			want: errors.New(errNoExternalName),
+		},
+		"CreateResourceError": {
+			reason: "We should return any error encountered creating the managed resource.",
+			args: args{
+				c: &test.MockClient{MockCreate: test.NewMockCreateFn(errBoom)},
+				s: &Snapshot{Resource: snapshotted()},
+			},
+			want: errors.Wrap(errBoom, errCreateResource),
+		},
+		"AdoptExternalResource": {
+			reason: "We should remove create annotations, and optionally pause and move the managed resource.",
+			args: args{
+				c: &test.MockClient{
+					MockCreate: test.NewMockCreateFn(nil, func(obj client.Object) error {
+						want := snapshotted()
+						want.SetNamespace("elsewhere")
+						meta.AddAnnotations(want, map[string]string{meta.AnnotationKeyReconciliationPaused: "true"})
+						if diff := cmp.Diff(want, obj.(*unstructured.Unstructured)); diff != "" {
+							t.Errorf("Create(...): -want, +got:\n%s", diff)
+						}
+						return nil
+					}),
+				},
+				o: []RestoreOption{WithNamespace("elsewhere"), WithPaused()},
+				s: &Snapshot{Resource: pending},
+			},
+		},
+		"ConnectionSecret": {
+			reason: "We should restore the connection secret, controlled by the restored managed resource.",
+			args: args{
+				c: &test.MockClient{
+					MockCreate: func(_ context.Context, obj client.Object, _ ...client.CreateOption) error {
+						if s, ok := obj.(*corev1.Secret); ok {
+							if diff := cmp.Diff("restored-uid", string(s.GetOwnerReferences()[0].UID)); diff != "" {
+								t.Errorf("Create(...): -want owner UID, +got owner UID:\n%s", diff)
+							}
+							return nil
+						}
+						obj.SetUID("restored-uid")
+						return nil
+					},
+				},
+				s: &Snapshot{Resource: snapshotted(), ConnectionSecret: secret},
+			},
+		},
+		"CreateSecretError": {
+			reason: "We should return any error encountered creating the connection secret.",
+			args: args{
+				c: &test.MockClient{
+					MockCreate: func(_ context.Context, obj client.Object, _ ...client.CreateOption) error {
+						if _, ok := obj.(*corev1.Secret); ok {
+							return errBoom
+						}
+						return nil
+					},
+				},
+				s: &Snapshot{Resource: snapshotted(), ConnectionSecret: secret},
+			},
+			want: errors.Wrap(errBoom, errCreateSecret),
+		},
+		"RetryAfterCreateSecretError": {
+			reason: "We should create the connection secret, controlled by the existing managed resource, when retrying a partially failed restore.",
+			args: args{
+				c: &test.MockClient{
+					MockCreate: func(_ context.Context, obj client.Object, _ ...client.CreateOption) error {
+						if s, ok := obj.(*corev1.Secret); ok {
+							if diff := cmp.Diff("existing-uid", string(s.GetOwnerReferences()[0].UID)); diff != "" {
+								t.Errorf("Create(...): -want owner UID, +got owner UID:\n%s", diff)
+							}
+							return nil
+						}
+						return kerrors.NewAlreadyExists(schema.GroupResource{Group: gvk.Group, Resource: "cools"}, obj.GetName())
+					},
+					MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+						obj.SetUID("existing-uid")
+						return nil
+					},
+				},
+				s: &Snapshot{Resource: snapshotted(), ConnectionSecret: secret},
+			},
+		},
+		"GetExistingResourceError": {
+			reason: "We should return any error encountered getting a managed resource that already exists.",
+			args: args{
+				c: &test.MockClient{
+					MockCreate: test.NewMockCreateFn(kerrors.NewAlreadyExists(schema.GroupResource{Group: gvk.Group, Resource: "cools"}, "cool")),
+					MockGet:    test.NewMockGetFn(errBoom),
+				},
+				s: &Snapshot{Resource: snapshotted(), ConnectionSecret: secret},
+			},
+			want: errors.Wrap(errBoom, errGetResource),
+		},
+		"SecretAlreadyExists": {
+			reason: "We should not return an error if the connection secret already exists.",
+			args: args{
+				c: &test.MockClient{
+					MockCreate: func(_ context.Context, obj client.Object, _ ...client.CreateOption) error {
+						if _, ok := obj.(*corev1.Secret); ok {
+							return kerrors.NewAlreadyExists(schema.GroupResource{Resource: "secrets"}, obj.GetName())
+						}
+						return nil
+					},
+				},
+				s: &Snapshot{Resource: snapshotted(), ConnectionSecret: secret},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := NewRestorer(tc.args.c, tc.args.o...).Restore(context.Background(), tc.args.s)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nRestore(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestArchive(t *testing.T) {
+	want := []Snapshot{{
+		Resource: snapshotted(),
+		ConnectionSecret: &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "cool-secret"},
+			Data:       map[string][]byte{"password": []byte("secret")},
+		},
+	}}
+
+	b := &bytes.Buffer{}
+	if err := WriteArchive(b, want...); err != nil {
+		t.Fatalf("WriteArchive(...): %s", err)
+	}
+
+	got, err := ReadArchive(b)
+	if err != nil {
+		t.Fatalf("ReadArchive(...): %s", err)
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ReadArchive(WriteArchive(...)): -want, +got:\n%s", diff)
+	}
+
+	_, err = ReadArchive(bytes.NewBufferString(`{"version":"v0"}`))
+	if diff := cmp.Diff(errors.Errorf(errFmtArchiveFormat, "v0"), err, test.EquateErrors()); diff != "" {
+		t.Errorf("ReadArchive(...): -want error, +got error:\n%s", diff)
+	}
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conditions
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+// Condition types used by kstatus, the status conventions understood by tools
+// like Argo CD, Flux, and kubectl wait. See
+// https://github.com/kubernetes-sigs/cli-utils/blob/master/pkg/kstatus/README.md
+const (
+	// TypeReconciling objects are being worked on by their controller, and
+	// have not yet reached their desired state.
+	TypeReconciling xpv1.ConditionType = "Reconciling"
+
+	// TypeStalled objects have encountered a problem their controller can't
+	// resolve without intervention.
+	TypeStalled xpv1.ConditionType = "Stalled"
+)
+
+// Reasons an object is or is not reconciling or stalled, when no more
+// specific reason is available.
+const (
+	ReasonReconciling       xpv1.ConditionReason = "Reconciling"
+	ReasonGenerationChanged xpv1.ConditionReason = "GenerationChanged"
+	ReasonReconciled        xpv1.ConditionReason = "Reconciled"
+)
+
+// A KStatus summarizes the status of an object per kstatus conventions.
+type KStatus string
+
+// Statuses of an object.
+const (
+	KStatusInProgress  KStatus = "InProgress"
+	KStatusFailed      KStatus = "Failed"
+	KStatusCurrent     KStatus = "Current"
+	KStatusTerminating KStatus = "Terminating"
+)
+
+// KStatusConditions derives kstatus Reconciling and Stalled conditions from the
+// supplied object's Ready and Synced conditions.
+//
+//   - An object is stalled if it isn't synced because of an error that
+//     retrying alone is unlikely to fix, or if it isn't ready and its
+//     reconciliation is paused.
+//   - An object is reconciling if it's being deleted, isn't ready, is retrying
+//     a transient error like throttling, or its conditions were observed for
+//     an older generation.
+func KStatusConditions(o ObjectWithConditions) (reconciling, stalled xpv1.Condition) {
+	ready := o.GetCondition(xpv1.TypeReady)
+	synced := o.GetCondition(xpv1.TypeSynced)
+
+	reconciling = kstatusCondition(TypeReconciling, corev1.ConditionFalse, reasonOr(ready.Reason, ReasonReconciled), "")
+	stalled = kstatusCondition(TypeStalled, corev1.ConditionFalse, reasonOr(synced.Reason, ReasonReconciled), "")
+
+	switch {
+	case synced.Status == corev1.ConditionFalse && (synced.Reason == xpv1.ReasonReconcileError || synced.Reason == xpv1.ReasonQuotaExceeded):
+		stalled = kstatusCondition(TypeStalled, corev1.ConditionTrue, synced.Reason, synced.Message)
+	case synced.Reason == xpv1.ReasonReconcilePaused && ready.Status != corev1.ConditionTrue:
+		stalled = kstatusCondition(TypeStalled, corev1.ConditionTrue, synced.Reason, synced.Message)
+	}
+
+	switch {
+	case o.GetDeletionTimestamp() != nil:
+		reconciling = kstatusCondition(TypeReconciling, corev1.ConditionTrue, xpv1.ReasonDeleting, "")
+	case synced.Status == corev1.ConditionFalse && (synced.Reason == xpv1.ReasonThrottled || synced.Reason == xpv1.ReasonUpstreamUnavailable):
+		reconciling = kstatusCondition(TypeReconciling, corev1.ConditionTrue, synced.Reason, synced.Message)
+	case synced.ObservedGeneration != 0 && synced.ObservedGeneration < o.GetGeneration():
+		reconciling = kstatusCondition(TypeReconciling, corev1.ConditionTrue, ReasonGenerationChanged, "")
+	case ready.Status != corev1.ConditionTrue && stalled.Status != corev1.ConditionTrue:
+		reconciling = kstatusCondition(TypeReconciling, corev1.ConditionTrue, reasonOr(ready.Reason, ReasonReconciling), ready.Message)
+	}
+
+	return reconciling, stalled
+}
+
+// KStatusOf returns the kstatus of the supplied object, derived from its Ready
+// and Synced conditions. See KStatusConditions.
+func KStatusOf(o ObjectWithConditions) KStatus {
+	if o.GetDeletionTimestamp() != nil {
+		return KStatusTerminating
+	}
+
+	reconciling, stalled := KStatusConditions(o)
+
+	switch {
+	case stalled.Status == corev1.ConditionTrue:
+		return KStatusFailed
+	case reconciling.Status == corev1.ConditionTrue:
+		return KStatusInProgress
+	default:
+		return KStatusCurrent
+	}
+}
+
+// KStatusManager is a Manager that marks kstatus Reconciling and Stalled
+// conditions each time conditions are marked, using the wrapped Manager. Use
+// it with a managed resource Reconciler to make tools that understand kstatus
+// aware of the managed resource's health, e.g.:
+//
+//	managed.WithConditionsManager(conditions.KStatusManager{Manager: new(conditions.ObservedGenerationPropagationManager)})
+type KStatusManager struct {
+	Manager
+}
+
+// For implements Manager.For.
+func (m KStatusManager) For(o ObjectWithConditions) ConditionSet {
+	cs := m.Manager.For(o)
+
+	return ConditionSetFn(func(c ...xpv1.Condition) {
+		cs.MarkConditions(c...)
+
+		reconciling, stalled := KStatusConditions(o)
+		cs.MarkConditions(reconciling, stalled)
+	})
+}
+
+func kstatusCondition(t xpv1.ConditionType, s corev1.ConditionStatus, r xpv1.ConditionReason, msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               t,
+		Status:             s,
+		LastTransitionTime: metav1.Now(),
+		Reason:             r,
+		Message:            msg,
+	}
+}
+
+func reasonOr(r, fallback xpv1.ConditionReason) xpv1.ConditionReason {
+	if r == "" {
+		return fallback
+	}
+
+	return r
+}
@@ -0,0 +1,195 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conditions
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+)
+
+var _ Manager = KStatusManager{}
+
+func TestKStatusOf(t *testing.T) {
+	now := metav1.Now()
+
+	type want struct {
+		status      KStatus
+		reconciling xpv1.Condition
+		stalled     xpv1.Condition
+	}
+
+	cases := map[string]struct {
+		reason     string
+		generation int64
+		deleted    *metav1.Time
+		conditions []xpv1.Condition
+		want       want
+	}{
+		"NoConditions": {
+			reason: "An object without conditions hasn't been reconciled yet.",
+			want: want{
+				status:      KStatusInProgress,
+				reconciling: kstatusCondition(TypeReconciling, corev1.ConditionTrue, ReasonReconciling, ""),
+				stalled:     kstatusCondition(TypeStalled, corev1.ConditionFalse, ReasonReconciled, ""),
+			},
+		},
+		"Creating": {
+			reason: "An object that is being created is in progress.",
+			conditions: []xpv1.Condition{
+				xpv1.Creating(),
+				xpv1.ReconcileSuccess(),
+			},
+			want: want{
+				status:      KStatusInProgress,
+				reconciling: kstatusCondition(TypeReconciling, corev1.ConditionTrue, xpv1.ReasonCreating, ""),
+				stalled:     kstatusCondition(TypeStalled, corev1.ConditionFalse, xpv1.ReasonReconcileSuccess, ""),
+			},
+		},
+		"Current": {
+			reason: "An object that is ready and synced is current.",
+			conditions: []xpv1.Condition{
+				xpv1.Available(),
+				xpv1.ReconcileSuccess(),
+			},
+			want: want{
+				status:      KStatusCurrent,
+				reconciling: kstatusCondition(TypeReconciling, corev1.ConditionFalse, xpv1.ReasonAvailable, ""),
+				stalled:     kstatusCondition(TypeStalled, corev1.ConditionFalse, xpv1.ReasonReconcileSuccess, ""),
+			},
+		},
+		"ReconcileError": {
+			reason: "An object that can't be reconciled is stalled.",
+			conditions: []xpv1.Condition{
+				xpv1.Creating(),
+				xpv1.ReconcileError(errors.New("boom")),
+			},
+			want: want{
+				status:      KStatusFailed,
+				reconciling: kstatusCondition(TypeReconciling, corev1.ConditionFalse, xpv1.ReasonCreating, ""),
+				stalled:     kstatusCondition(TypeStalled, corev1.ConditionTrue, xpv1.ReasonReconcileError, "boom"),
+			},
+		},
+		"Throttled": {
+			reason: "An object whose reconciliation is throttled is in progress.",
+			conditions: []xpv1.Condition{
+				xpv1.Available(),
+				xpv1.Throttled(0),
+			},
+			want: want{
+				status:      KStatusInProgress,
+				reconciling: kstatusCondition(TypeReconciling, corev1.ConditionTrue, xpv1.ReasonThrottled, xpv1.Throttled(0).Message),
+				stalled:     kstatusCondition(TypeStalled, corev1.ConditionFalse, xpv1.ReasonThrottled, ""),
+			},
+		},
+		"PausedAndReady": {
+			reason: "A ready object whose reconciliation is paused is current.",
+			conditions: []xpv1.Condition{
+				xpv1.Available(),
+				xpv1.ReconcilePaused(),
+			},
+			want: want{
+				status:      KStatusCurrent,
+				reconciling: kstatusCondition(TypeReconciling, corev1.ConditionFalse, xpv1.ReasonAvailable, ""),
+				stalled:     kstatusCondition(TypeStalled, corev1.ConditionFalse, xpv1.ReasonReconcilePaused, ""),
+			},
+		},
+		"PausedAndNotReady": {
+			reason: "An object that isn't ready and whose reconciliation is paused is stalled.",
+			conditions: []xpv1.Condition{
+				xpv1.Creating(),
+				xpv1.ReconcilePaused(),
+			},
+			want: want{
+				status:      KStatusFailed,
+				reconciling: kstatusCondition(TypeReconciling, corev1.ConditionFalse, xpv1.ReasonCreating, ""),
+				stalled:     kstatusCondition(TypeStalled, corev1.ConditionTrue, xpv1.ReasonReconcilePaused, xpv1.ReconcilePaused().Message),
+			},
+		},
+		"GenerationChanged": {
+			reason:     "An object whose conditions were observed for an older generation is in progress.",
+			generation: 2,
+			conditions: []xpv1.Condition{
+				xpv1.Available().WithObservedGeneration(1),
+				xpv1.ReconcileSuccess().WithObservedGeneration(1),
+			},
+			want: want{
+				status:      KStatusInProgress,
+				reconciling: kstatusCondition(TypeReconciling, corev1.ConditionTrue, ReasonGenerationChanged, ""),
+				stalled:     kstatusCondition(TypeStalled, corev1.ConditionFalse, xpv1.ReasonReconcileSuccess, ""),
+			},
+		},
+		"Deleting": {
+			reason:  "An object that is being deleted is terminating.",
+			deleted: &now,
+			conditions: []xpv1.Condition{
+				xpv1.Deleting(),
+				xpv1.ReconcileSuccess(),
+			},
+			want: want{
+				status:      KStatusTerminating,
+				reconciling: kstatusCondition(TypeReconciling, corev1.ConditionTrue, xpv1.ReasonDeleting, ""),
+				stalled:     kstatusCondition(TypeStalled, corev1.ConditionFalse, xpv1.ReasonReconcileSuccess, ""),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o := &fake.Managed{ObjectMeta: metav1.ObjectMeta{Generation: tc.generation, DeletionTimestamp: tc.deleted}}
+			o.SetConditions(tc.conditions...)
+
+			if diff := cmp.Diff(tc.want.status, KStatusOf(o)); diff != "" {
+				t.Errorf("\n%s\nKStatusOf(...): -want, +got:\n%s", tc.reason, diff)
+			}
+
+			reconciling, stalled := KStatusConditions(o)
+			if diff := cmp.Diff(tc.want.reconciling, reconciling, cmpopts.EquateApproxTime(time.Minute)); diff != "" {
+				t.Errorf("\n%s\nKStatusConditions(...): -want reconciling, +got reconciling:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.want.stalled, stalled, cmpopts.EquateApproxTime(time.Minute)); diff != "" {
+				t.Errorf("\n%s\nKStatusConditions(...): -want stalled, +got stalled:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestKStatusManager(t *testing.T) {
+	o := &fake.Managed{ObjectMeta: metav1.ObjectMeta{Generation: 42}}
+
+	KStatusManager{Manager: new(ObservedGenerationPropagationManager)}.For(o).MarkConditions(xpv1.Available(), xpv1.ReconcileSuccess())
+
+	want := []xpv1.Condition{
+		xpv1.Available().WithObservedGeneration(42),
+		xpv1.ReconcileSuccess().WithObservedGeneration(42),
+		kstatusCondition(TypeReconciling, corev1.ConditionFalse, xpv1.ReasonAvailable, "").WithObservedGeneration(42),
+		kstatusCondition(TypeStalled, corev1.ConditionFalse, xpv1.ReasonReconcileSuccess, "").WithObservedGeneration(42),
+	}
+
+	if diff := cmp.Diff(want, o.Conditions, cmpopts.EquateApproxTime(time.Minute)); diff != "" {
+		t.Errorf("MarkConditions(...): -want, +got:\n%s", diff)
+	}
+}
@@ -34,6 +34,14 @@ type Manager interface {
 	For(o ObjectWithConditions) ConditionSet
 }
 
+// A ManagerFn is a function that satisfies the Manager interface.
+type ManagerFn func(o ObjectWithConditions) ConditionSet
+
+// For calls ManagerFn.
+func (fn ManagerFn) For(o ObjectWithConditions) ConditionSet {
+	return fn(o)
+}
+
 // ConditionSet holds operations for interacting with an object's conditions.
 type ConditionSet interface {
 	// MarkConditions adds or updates the conditions onto the managed resource object. Unlike a "Set" method, this also
@@ -42,6 +50,14 @@ type ConditionSet interface {
 	MarkConditions(condition ...xpv1.Condition)
 }
 
+// A ConditionSetFn is a function that satisfies the ConditionSet interface.
+type ConditionSetFn func(condition ...xpv1.Condition)
+
+// MarkConditions calls ConditionSetFn.
+func (fn ConditionSetFn) MarkConditions(condition ...xpv1.Condition) {
+	fn(condition...)
+}
+
 // ObservedGenerationPropagationManager is the top level factor for producing a ConditionSet
 // on behalf of a ObjectWithConditions resource, the ConditionSet is only currently concerned with
 // propagating observedGeneration to conditions that are being updated.
@@ -64,6 +80,16 @@ func (c *observedGenerationPropagationConditionSet) MarkConditions(condition ...
 	if c == nil || c.o == nil {
 		return
 	}
+	// Objects that can merge conditions preserve the last transition time of
+	// conditions whose status didn't change.
+	if m, ok := c.o.(conditionMerger); ok {
+		for _, cond := range condition {
+			m.MergeCondition(cond, xpv1.WithObservedGeneration(c.o.GetGeneration()))
+		}
+
+		return
+	}
+
 	// Foreach condition we have been sent to mark, update the observed generation.
 	for i := range condition {
 		condition[i].ObservedGeneration = c.o.GetGeneration()
@@ -71,3 +97,9 @@ func (c *observedGenerationPropagationConditionSet) MarkConditions(condition ...
 
 	c.o.SetConditions(condition...)
 }
+
+// A conditionMerger can merge a condition into its existing conditions. Types
+// that embed xpv1.ConditionedStatus satisfy this interface.
+type conditionMerger interface {
+	MergeCondition(c xpv1.Condition, o ...xpv1.ConditionOption)
+}
@@ -23,6 +23,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
@@ -87,6 +88,19 @@ func TestOGConditionSetMark(t *testing.T) {
 		})
 	}
 
+	t.Run("PreserveLastTransitionTime", func(t *testing.T) {
+		// The condition's status didn't change, so it didn't transition.
+		then := metav1.NewTime(time.Now().Add(-time.Hour))
+		ut := newManaged(42, xpv1.ReconcileSuccess().WithObservedGeneration(1).With(xpv1.WithLastTransitionTime(then)))
+
+		manager.For(ut).MarkConditions(xpv1.ReconcileSuccess())
+
+		want := []xpv1.Condition{xpv1.ReconcileSuccess().WithObservedGeneration(42).With(xpv1.WithLastTransitionTime(then))}
+		if diff := cmp.Diff(want, ut.Conditions); diff != "" {
+			t.Errorf("\nMarkConditions(...): -want, +got:\n%s", diff)
+		}
+	})
+
 	t.Run("ManageNilObject", func(t *testing.T) {
 		c := manager.For(nil)
 		if c == nil {
@@ -0,0 +1,251 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package conformance tests that an ExternalClient behaves the way the managed
+// resource reconciler expects. Run the suite from a provider's tests, against
+// an ExternalClient connected to a fake external system:
+//
+//	func TestConformance(t *testing.T) {
+//		conformance.Suite{Setup: func(t *testing.T) conformance.Fixture {
+//			api := fakeapi.New()
+//			return conformance.Fixture{
+//				Client:   &external{api: api},
+//				Managed:  &v1.Bucket{ObjectMeta: metav1.ObjectMeta{Name: "test"}},
+//				Snapshot: api.Snapshot,
+//			}
+//		}}.Run(t)
+//	}
+package conformance
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+)
+
+// A Fixture is an ExternalClient under test, connected to a fake external
+// system.
+type Fixture struct {
+	// Client under test.
+	Client managed.ExternalClient
+
+	// Managed resource whose external resource doesn't exist yet.
+	Managed resource.Managed
+
+	// Snapshot returns the state of the fake external system. Two snapshots
+	// of the same state must be equal according to cmp.Diff. The external
+	// system must delete resources synchronously.
+	Snapshot func() any
+
+	// Recorder records the HTTP requests the client makes, if the client uses
+	// HTTP. It's optional. If it's supplied the suite checks that Observe
+	// only makes safe requests, like GET.
+	Recorder *RecordingTransport
+}
+
+// A Suite of conformance tests.
+type Suite struct {
+	// Setup returns a new fixture. It's called once per test.
+	Setup func(t *testing.T) Fixture
+}
+
+// Run the suite's conformance tests.
+func (s Suite) Run(t *testing.T) {
+	t.Helper()
+
+	t.Run("ObserveNonExistent", s.observeNonExistent)
+	t.Run("ObserveDoesNotMutate", s.observeDoesNotMutate)
+	t.Run("CreateIsIdempotent", s.createIsIdempotent)
+	t.Run("ConnectionDetailsAreStable", s.connectionDetailsAreStable)
+	t.Run("DeleteNonExistent", s.deleteNonExistent)
+}
+
+// observeNonExistent checks that observing an external resource that doesn't
+// exist reports that it doesn't exist, rather than returning an error.
+func (s Suite) observeNonExistent(t *testing.T) {
+	f := s.Setup(t)
+
+	o, err := f.Client.Observe(t.Context(), f.Managed)
+	if err != nil {
+		t.Fatalf("Observe(...): an external resource that doesn't exist should be observed without error: %v", err)
+	}
+
+	if o.ResourceExists {
+		t.Errorf("Observe(...): an external resource that doesn't exist should be observed not to exist")
+	}
+}
+
+// observeDoesNotMutate checks that observing an external resource doesn't
+// change the external system.
+func (s Suite) observeDoesNotMutate(t *testing.T) {
+	f := s.Setup(t)
+	mustCreate(t, f)
+
+	before := f.Snapshot()
+	f.Recorder.Reset()
+
+	o, err := f.Client.Observe(t.Context(), f.Managed)
+	if err != nil {
+		t.Fatalf("Observe(...): %v", err)
+	}
+
+	if !o.ResourceExists {
+		t.Errorf("Observe(...): a created external resource should be observed to exist")
+	}
+
+	if diff := cmp.Diff(before, f.Snapshot()); diff != "" {
+		t.Errorf("Observe(...): observing shouldn't change the external system: -before, +after:\n%s", diff)
+	}
+
+	if m := f.Recorder.Unsafe(); len(m) > 0 {
+		t.Errorf("Observe(...): observing should only make safe requests, but made %v", m)
+	}
+}
+
+// createIsIdempotent checks that creating an external resource that already
+// exists doesn't create another one. Create may return an error.
+func (s Suite) createIsIdempotent(t *testing.T) {
+	f := s.Setup(t)
+	mustCreate(t, f)
+
+	before := f.Snapshot()
+
+	_, _ = f.Client.Create(t.Context(), f.Managed)
+
+	if diff := cmp.Diff(before, f.Snapshot()); diff != "" {
+		t.Errorf("Create(...): creating an external resource that already exists shouldn't change the external system: -before, +after:\n%s", diff)
+	}
+}
+
+// connectionDetailsAreStable checks that observing an unchanged external
+// resource returns the same connection details each time.
+func (s Suite) connectionDetailsAreStable(t *testing.T) {
+	f := s.Setup(t)
+	mustCreate(t, f)
+
+	first, err := f.Client.Observe(t.Context(), f.Managed)
+	if err != nil {
+		t.Fatalf("Observe(...): %v", err)
+	}
+
+	second, err := f.Client.Observe(t.Context(), f.Managed)
+	if err != nil {
+		t.Fatalf("Observe(...): %v", err)
+	}
+
+	if diff := cmp.Diff(first.ConnectionDetails, second.ConnectionDetails); diff != "" {
+		t.Errorf("Observe(...): observing an unchanged external resource should return the same connection details: -first, +second:\n%s", diff)
+	}
+}
+
+// deleteNonExistent checks that deleting an external resource that was
+// already deleted succeeds.
+func (s Suite) deleteNonExistent(t *testing.T) {
+	f := s.Setup(t)
+	mustCreate(t, f)
+
+	if _, err := f.Client.Delete(t.Context(), f.Managed); err != nil {
+		t.Fatalf("Delete(...): %v", err)
+	}
+
+	o, err := f.Client.Observe(t.Context(), f.Managed)
+	if err != nil {
+		t.Fatalf("Observe(...): %v", err)
+	}
+
+	if o.ResourceExists {
+		t.Errorf("Observe(...): a deleted external resource should be observed not to exist")
+	}
+
+	if _, err := f.Client.Delete(t.Context(), f.Managed); err != nil {
+		t.Errorf("Delete(...): deleting an external resource that doesn't exist should succeed: %v", err)
+	}
+}
+
+func mustCreate(t *testing.T, f Fixture) {
+	t.Helper()
+
+	if _, err := f.Client.Create(t.Context(), f.Managed); err != nil {
+		t.Fatalf("Create(...): %v", err)
+	}
+}
+
+// A RecordingTransport is an http.RoundTripper that records the requests made
+// using it. Use it as the transport of the HTTP client an ExternalClient uses
+// to talk to a fake external system. It's safe for concurrent use. A nil
+// RecordingTransport has no recorded requests.
+type RecordingTransport struct {
+	// Transport used to make requests. http.DefaultTransport is used if it's
+	// nil.
+	Transport http.RoundTripper
+
+	mu       sync.Mutex
+	requests []string
+}
+
+// RoundTrip records the supplied request, then makes it.
+func (rt *RecordingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	rt.mu.Lock()
+	rt.requests = append(rt.requests, r.Method+" "+r.URL.String())
+	rt.mu.Unlock()
+
+	t := rt.Transport
+	if t == nil {
+		t = http.DefaultTransport
+	}
+
+	return t.RoundTrip(r)
+}
+
+// Requests returns the recorded requests, e.g. GET https://example.org/v1/buckets/a.
+func (rt *RecordingTransport) Requests() []string {
+	if rt == nil {
+		return nil
+	}
+
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+
+	return slices.Clone(rt.requests)
+}
+
+// Unsafe returns the recorded requests that may change the external system,
+// i.e. those that don't use the GET, HEAD, or OPTIONS methods.
+func (rt *RecordingTransport) Unsafe() []string {
+	return slices.DeleteFunc(rt.Requests(), func(r string) bool {
+		m, _, _ := strings.Cut(r, " ")
+		return m == http.MethodGet || m == http.MethodHead || m == http.MethodOptions
+	})
+}
+
+// Reset forgets the recorded requests.
+func (rt *RecordingTransport) Reset() {
+	if rt == nil {
+		return
+	}
+
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+
+	rt.requests = nil
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conformance
+
+import (
+	"context"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+)
+
+// A bucketAPI is a fake external system that stores buckets.
+type bucketAPI struct {
+	mu      sync.Mutex
+	buckets map[string]string
+}
+
+func (a *bucketAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	name := strings.TrimPrefix(r.URL.Path, "/buckets/")
+
+	switch r.Method {
+	case http.MethodGet:
+		if _, ok := a.buckets[name]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		_, _ = w.Write([]byte(a.buckets[name]))
+	case http.MethodPut:
+		if _, ok := a.buckets[name]; ok {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+
+		a.buckets[name] = "https://" + name + ".example.org"
+	case http.MethodDelete:
+		delete(a.buckets, name)
+	}
+}
+
+func (a *bucketAPI) Snapshot() any {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return maps.Clone(a.buckets)
+}
+
+// An external client of the bucket API.
+type external struct {
+	url    string
+	client *http.Client
+}
+
+func (e *external) do(ctx context.Context, method string, mg resource.Managed) (*http.Response, error) {
+	r, err := http.NewRequestWithContext(ctx, method, e.url+"/buckets/"+meta.GetExternalName(mg), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.client.Do(r)
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	rsp, err := e.do(ctx, http.MethodGet, mg)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	defer rsp.Body.Close() //nolint:errcheck // Only used in tests.
+
+	if rsp.StatusCode == http.StatusNotFound {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  true,
+		ConnectionDetails: managed.ConnectionDetails{"endpoint": []byte("https://" + meta.GetExternalName(mg) + ".example.org")},
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	rsp, err := e.do(ctx, http.MethodPut, mg)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	defer rsp.Body.Close() //nolint:errcheck // Only used in tests.
+
+	if rsp.StatusCode == http.StatusConflict {
+		return managed.ExternalCreation{}, errors.New("bucket already exists")
+	}
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	rsp, err := e.do(ctx, http.MethodDelete, mg)
+	if err != nil {
+		return managed.ExternalDelete{}, err
+	}
+	defer rsp.Body.Close() //nolint:errcheck // Only used in tests.
+
+	return managed.ExternalDelete{}, nil
+}
+
+func (e *external) Disconnect(_ context.Context) error {
+	return nil
+}
+
+func TestSuite(t *testing.T) {
+	Suite{Setup: func(t *testing.T) Fixture {
+		t.Helper()
+
+		api := &bucketAPI{buckets: map[string]string{}}
+		srv := httptest.NewServer(api)
+		t.Cleanup(srv.Close)
+
+		rec := &RecordingTransport{}
+
+		mg := &fake.ModernManaged{}
+		meta.SetExternalName(mg, "cool-bucket")
+
+		return Fixture{
+			Client:   &external{url: srv.URL, client: &http.Client{Transport: rec}},
+			Managed:  mg,
+			Snapshot: api.Snapshot,
+			Recorder: rec,
+		}
+	}}.Run(t)
+}
+
+func TestRecordingTransport(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {}))
+	defer srv.Close()
+
+	rec := &RecordingTransport{}
+	c := &http.Client{Transport: rec}
+
+	for _, m := range []string{http.MethodGet, http.MethodPost, http.MethodHead, http.MethodDelete} {
+		r, _ := http.NewRequestWithContext(t.Context(), m, srv.URL+"/a", nil)
+
+		rsp, err := c.Do(r)
+		if err != nil {
+			t.Fatalf("c.Do(...): %v", err)
+		}
+
+		_ = rsp.Body.Close()
+	}
+
+	want := []string{"POST " + srv.URL + "/a", "DELETE " + srv.URL + "/a"}
+	if diff := cmp.Diff(want, rec.Unsafe()); diff != "" {
+		t.Errorf("rec.Unsafe(): -want, +got:\n%s", diff)
+	}
+
+	rec.Reset()
+
+	if diff := cmp.Diff([]string(nil), rec.Requests()); diff != "" {
+		t.Errorf("rec.Requests(): after Reset -want, +got:\n%s", diff)
+	}
+}
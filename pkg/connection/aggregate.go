@@ -0,0 +1,165 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package connection aggregates the connection details of multiple resources,
+// for example the composed resources of a composite resource.
+package connection
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+)
+
+// Error strings.
+const (
+	errFmtConflict = "connection detail %q has conflicting values from sources %s"
+)
+
+// Details of a connection, keyed by name.
+type Details map[string][]byte
+
+// A Source of connection details, for example a composed resource.
+type Source struct {
+	// Name of the source. Used to configure precedence and report conflicts.
+	Name string
+
+	// Details supplied by the source.
+	Details Details
+}
+
+// A Conflict occurs when multiple sources supply different values for the
+// same connection detail, and no precedence is configured for it.
+type Conflict struct {
+	// Key of the conflicting connection detail.
+	Key string
+
+	// Sources that supplied the key, in the order they were supplied. The
+	// value of the first source was used.
+	Sources []string
+}
+
+// An Option configures an Aggregator.
+type Option func(a *Aggregator)
+
+// WithPrecedence configures the sources whose value is used for the supplied
+// connection detail key, highest precedence first. Sources not in the list
+// have lower precedence than those that are, in the order they're supplied.
+func WithPrecedence(key string, sources ...string) Option {
+	return func(a *Aggregator) {
+		a.precedence[key] = sources
+	}
+}
+
+// WithConflictErrors configures an Aggregator to return an error if any
+// connection detail has a conflict.
+func WithConflictErrors() Option {
+	return func(a *Aggregator) {
+		a.strict = true
+	}
+}
+
+// An Aggregator aggregates connection details from multiple sources.
+type Aggregator struct {
+	precedence map[string][]string
+	strict     bool
+}
+
+// NewAggregator returns a new Aggregator. By default the value supplied by
+// the first source of each connection detail is used.
+func NewAggregator(o ...Option) *Aggregator {
+	a := &Aggregator{precedence: make(map[string][]string)}
+	for _, fn := range o {
+		fn(a)
+	}
+
+	return a
+}
+
+// Aggregate the connection details of the supplied sources. It returns the
+// aggregated details, and any conflicts between sources that supplied
+// different values for a connection detail without a configured precedence.
+// Conflicts are sorted by key. An error is returned only if the Aggregator
+// was configured WithConflictErrors and there are conflicts.
+func (a *Aggregator) Aggregate(sources ...Source) (Details, []Conflict, error) {
+	out := make(Details)
+	suppliers := make(map[string][]Source)
+
+	for _, s := range sources {
+		for k := range s.Details {
+			suppliers[k] = append(suppliers[k], s)
+		}
+	}
+
+	conflicts := make([]Conflict, 0)
+
+	for k, ss := range suppliers {
+		winner, explicit := a.pick(k, ss)
+		out[k] = winner.Details[k]
+
+		if explicit || !differ(k, ss) {
+			continue
+		}
+
+		c := Conflict{Key: k, Sources: make([]string, len(ss))}
+		for i, s := range ss {
+			c.Sources[i] = s.Name
+		}
+
+		conflicts = append(conflicts, c)
+	}
+
+	slices.SortFunc(conflicts, func(x, y Conflict) int { return strings.Compare(x.Key, y.Key) })
+
+	if !a.strict || len(conflicts) == 0 {
+		return out, conflicts, nil
+	}
+
+	errs := make([]error, len(conflicts))
+	for i, c := range conflicts {
+		errs[i] = errors.Errorf(errFmtConflict, c.Key, c.Sources)
+	}
+
+	return out, conflicts, errors.Join(errs...)
+}
+
+// pick the source whose value should be used for the supplied key. It
+// returns true if the source was picked by a configured precedence.
+func (a *Aggregator) pick(key string, ss []Source) (Source, bool) {
+	for _, name := range a.precedence[key] {
+		for _, s := range ss {
+			if s.Name == name {
+				return s, true
+			}
+		}
+	}
+
+	return ss[0], false
+}
+
+// differ returns true if the supplied sources supply different values for the
+// supplied key.
+func differ(key string, ss []Source) bool {
+	for _, s := range ss[1:] {
+		if !bytes.Equal(ss[0].Details[key], s.Details[key]) {
+			return true
+		}
+	}
+
+	return false
+}
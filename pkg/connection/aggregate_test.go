@@ -0,0 +1,117 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package connection
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+)
+
+func TestAggregate(t *testing.T) {
+	db := Source{Name: "db", Details: Details{"host": []byte("db.example.org"), "password": []byte("db-secret")}}
+	user := Source{Name: "user", Details: Details{"username": []byte("admin"), "password": []byte("user-secret")}}
+	same := Source{Name: "same", Details: Details{"host": []byte("db.example.org")}}
+
+	type args struct {
+		o       []Option
+		sources []Source
+	}
+
+	type want struct {
+		details   Details
+		conflicts []Conflict
+		err       error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"NoSources": {
+			reason: "Aggregating no sources should produce no details.",
+			want: want{
+				details:   Details{},
+				conflicts: []Conflict{},
+			},
+		},
+		"NoConflicts": {
+			reason: "Sources that supply the same value for a key don't conflict.",
+			args: args{
+				sources: []Source{db, same},
+			},
+			want: want{
+				details:   Details{"host": []byte("db.example.org"), "password": []byte("db-secret")},
+				conflicts: []Conflict{},
+			},
+		},
+		"FirstSourceWins": {
+			reason: "By default the first source to supply a key should win, and the conflict should be reported.",
+			args: args{
+				sources: []Source{db, user},
+			},
+			want: want{
+				details:   Details{"host": []byte("db.example.org"), "username": []byte("admin"), "password": []byte("db-secret")},
+				conflicts: []Conflict{{Key: "password", Sources: []string{"db", "user"}}},
+			},
+		},
+		"Precedence": {
+			reason: "A configured precedence should pick the winning source, and resolve the conflict.",
+			args: args{
+				o:       []Option{WithPrecedence("password", "user")},
+				sources: []Source{db, user},
+			},
+			want: want{
+				details:   Details{"host": []byte("db.example.org"), "username": []byte("admin"), "password": []byte("user-secret")},
+				conflicts: []Conflict{},
+			},
+		},
+		"ConflictErrors": {
+			reason: "We should return an error for conflicts if asked to.",
+			args: args{
+				o:       []Option{WithConflictErrors()},
+				sources: []Source{db, user},
+			},
+			want: want{
+				details:   Details{"host": []byte("db.example.org"), "username": []byte("admin"), "password": []byte("db-secret")},
+				conflicts: []Conflict{{Key: "password", Sources: []string{"db", "user"}}},
+				err:       errors.Join(errors.Errorf(errFmtConflict, "password", []string{"db", "user"})),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			details, conflicts, err := NewAggregator(tc.args.o...).Aggregate(tc.args.sources...)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nAggregate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.want.details, details); diff != "" {
+				t.Errorf("\n%s\nAggregate(...): -want details, +got details:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.want.conflicts, conflicts); diff != "" {
+				t.Errorf("\n%s\nAggregate(...): -want conflicts, +got conflicts:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// A CacheTransformOption configures the transform returned by
+// NewCacheTransform.
+type CacheTransformOption func(t *cacheTransform)
+
+// WithMaxCachedAnnotationSize configures the transform to strip annotations
+// whose values are larger than the supplied number of bytes, for example the
+// kubectl.kubernetes.io/last-applied-configuration annotation. Annotations
+// are not stripped by default.
+//
+// Objects read from the cache don't have the stripped annotations. Updating
+// such an object removes the stripped annotations from the API server, so
+// kinds that are updated using objects read from the cache, like the managed
+// resources a provider reconciles, should usually be opted out using
+// WithoutCacheTransform.
+func WithMaxCachedAnnotationSize(n int) CacheTransformOption {
+	return func(t *cacheTransform) {
+		t.maxAnnotationSize = n
+	}
+}
+
+// WithoutCacheTransform opts the supplied kinds out of the transform. Objects
+// of these kinds are cached unmodified.
+func WithoutCacheTransform(gvks ...schema.GroupVersionKind) CacheTransformOption {
+	return func(t *cacheTransform) {
+		for _, gvk := range gvks {
+			t.optOut[gvk] = true
+		}
+	}
+}
+
+type cacheTransform struct {
+	scheme            *runtime.Scheme
+	maxAnnotationSize int
+	optOut            map[schema.GroupVersionKind]bool
+}
+
+// NewCacheTransform returns a transform that reduces the memory used by a
+// controller's cache. By default it strips the managed fields of every
+// cached object. The supplied scheme is used to determine the kind of typed
+// objects that were opted out of the transform.
+func NewCacheTransform(s *runtime.Scheme, o ...CacheTransformOption) toolscache.TransformFunc {
+	t := &cacheTransform{scheme: s, optOut: make(map[schema.GroupVersionKind]bool)}
+	for _, fn := range o {
+		fn(t)
+	}
+
+	return t.Transform
+}
+
+// WithCacheTransform returns a copy of the supplied cache options that use
+// NewCacheTransform as their default transform. Kinds configured in ByObject
+// with their own transform don't use the default transform.
+func WithCacheTransform(co cache.Options, o ...CacheTransformOption) cache.Options {
+	co.DefaultTransform = NewCacheTransform(co.Scheme, o...)
+	return co
+}
+
+// Transform the supplied object before it's committed to the cache.
+func (t *cacheTransform) Transform(in any) (any, error) {
+	obj, ok := in.(runtime.Object)
+	if !ok {
+		return in, nil
+	}
+
+	if t.optOut[t.kindOf(obj)] {
+		return in, nil
+	}
+
+	a, err := meta.Accessor(obj)
+	if err != nil {
+		return in, nil //nolint:nilerr // Objects without metadata can't be stripped.
+	}
+
+	// Avoid setting nil managed fields. See
+	// https://github.com/kubernetes/kubernetes/issues/124337.
+	if a.GetManagedFields() != nil {
+		a.SetManagedFields(nil)
+	}
+
+	if t.maxAnnotationSize <= 0 {
+		return in, nil
+	}
+
+	an := a.GetAnnotations()
+	stripped := false
+
+	for k, v := range an {
+		if len(v) > t.maxAnnotationSize {
+			delete(an, k)
+
+			stripped = true
+		}
+	}
+
+	if stripped {
+		a.SetAnnotations(an)
+	}
+
+	return in, nil
+}
+
+func (t *cacheTransform) kindOf(obj runtime.Object) schema.GroupVersionKind {
+	if len(t.optOut) == 0 {
+		return schema.GroupVersionKind{}
+	}
+
+	gvk := obj.GetObjectKind().GroupVersionKind()
+	if !gvk.Empty() || t.scheme == nil {
+		return gvk
+	}
+
+	// Typed objects don't usually have their type metadata set.
+	gvk, _ = apiutil.GVKForObject(obj, t.scheme) //nolint:errcheck // An unknown kind can't be opted out.
+
+	return gvk
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes/scheme"
+)
+
+func TestCacheTransform(t *testing.T) {
+	large := strings.Repeat("x", 16)
+	mf := []metav1.ManagedFieldsEntry{{Manager: "cool"}}
+
+	pod := func(mf []metav1.ManagedFieldsEntry, a map[string]string) *corev1.Pod {
+		return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "cool", ManagedFields: mf, Annotations: a}}
+	}
+
+	type args struct {
+		o  []CacheTransformOption
+		in any
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   any
+	}{
+		"NotAnObject": {
+			reason: "Anything that isn't an object should be returned unmodified.",
+			args: args{
+				in: "cool",
+			},
+			want: "cool",
+		},
+		"StripManagedFields": {
+			reason: "Managed fields should be stripped by default.",
+			args: args{
+				in: pod(mf, map[string]string{"big": large}),
+			},
+			want: pod(nil, map[string]string{"big": large}),
+		},
+		"StripLargeAnnotations": {
+			reason: "Annotations larger than the configured size should be stripped.",
+			args: args{
+				o:  []CacheTransformOption{WithMaxCachedAnnotationSize(8)},
+				in: pod(mf, map[string]string{"big": large, "small": "cool"}),
+			},
+			want: pod(nil, map[string]string{"small": "cool"}),
+		},
+		"OptOutTyped": {
+			reason: "Typed objects of a kind that was opted out should be returned unmodified.",
+			args: args{
+				o: []CacheTransformOption{
+					WithMaxCachedAnnotationSize(8),
+					WithoutCacheTransform(corev1.SchemeGroupVersion.WithKind("Pod")),
+				},
+				in: pod(mf, map[string]string{"big": large}),
+			},
+			want: pod(mf, map[string]string{"big": large}),
+		},
+		"OptOutUnstructured": {
+			reason: "Unstructured objects of a kind that was opted out should be returned unmodified.",
+			args: args{
+				o: []CacheTransformOption{
+					WithMaxCachedAnnotationSize(8),
+					WithoutCacheTransform(corev1.SchemeGroupVersion.WithKind("ConfigMap")),
+				},
+				in: &unstructured.Unstructured{Object: map[string]any{
+					"apiVersion": "v1",
+					"kind":       "ConfigMap",
+					"metadata":   map[string]any{"annotations": map[string]any{"big": large}},
+				}},
+			},
+			want: &unstructured.Unstructured{Object: map[string]any{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+				"metadata":   map[string]any{"annotations": map[string]any{"big": large}},
+			}},
+		},
+		"OtherKind": {
+			reason: "Objects of a kind that wasn't opted out should be transformed.",
+			args: args{
+				o: []CacheTransformOption{
+					WithMaxCachedAnnotationSize(8),
+					WithoutCacheTransform(corev1.SchemeGroupVersion.WithKind("ConfigMap")),
+				},
+				in: pod(mf, map[string]string{"big": large}),
+			},
+			want: pod(nil, map[string]string{}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := NewCacheTransform(scheme.Scheme, tc.args.o...)(tc.args.in)
+			if err != nil {
+				t.Fatalf("\n%s\nTransform(...): %v", tc.reason, err)
+			}
+
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nTransform(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
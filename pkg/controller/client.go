@@ -0,0 +1,71 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// WithUncachedSecrets returns a copy of the supplied client options that read
+// Secrets from the API server rather than from the cache. This prevents a
+// controller manager from caching, and needing RBAC to list and watch, every
+// Secret in the cluster just because it reads or writes a few of them, for
+// example to publish connection details. Use it to configure a controller
+// manager's client, e.g:
+//
+//	mgr, err := ctrl.NewManager(cfg, ctrl.Options{
+//		Client: controller.WithUncachedSecrets(client.Options{}),
+//	})
+func WithUncachedSecrets(co client.Options) client.Options {
+	c := &client.CacheOptions{}
+	if co.Cache != nil {
+		*c = *co.Cache
+	}
+
+	c.DisableFor = append(slices.Clone(c.DisableFor), &corev1.Secret{})
+	co.Cache = c
+
+	return co
+}
+
+// WithSecretNamespaceCache returns a copy of the supplied cache options that
+// only cache Secrets in the supplied namespace, for example the namespace a
+// provider is deployed to. Reading a Secret in any other namespace from the
+// cache returns an error, so this is only suitable for controllers that read
+// Secrets from a single namespace. Use WithUncachedSecrets otherwise.
+func WithSecretNamespaceCache(co cache.Options, namespace string) cache.Options {
+	byObject := make(map[client.Object]cache.ByObject, len(co.ByObject)+1)
+	for o, bo := range co.ByObject {
+		// Replace any existing Secret configuration.
+		if _, ok := o.(*corev1.Secret); ok {
+			continue
+		}
+
+		byObject[o] = bo
+	}
+
+	byObject[&corev1.Secret{}] = cache.ByObject{
+		Namespaces: map[string]cache.Config{namespace: {}},
+	}
+	co.ByObject = byObject
+
+	return co
+}
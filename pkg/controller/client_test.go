@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestWithUncachedSecrets(t *testing.T) {
+	cm := &corev1.ConfigMap{}
+
+	cases := map[string]struct {
+		reason string
+		co     client.Options
+		want   client.Options
+	}{
+		"NoCacheOptions": {
+			reason: "Secrets should be read from the API server when no cache options are configured.",
+			co:     client.Options{},
+			want:   client.Options{Cache: &client.CacheOptions{DisableFor: []client.Object{&corev1.Secret{}}}},
+		},
+		"ExistingCacheOptions": {
+			reason: "Existing cache options should be preserved.",
+			co:     client.Options{Cache: &client.CacheOptions{Unstructured: true, DisableFor: []client.Object{cm}}},
+			want:   client.Options{Cache: &client.CacheOptions{Unstructured: true, DisableFor: []client.Object{cm, &corev1.Secret{}}}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := WithUncachedSecrets(tc.co)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nWithUncachedSecrets(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestWithSecretNamespaceCache(t *testing.T) {
+	cm := &corev1.ConfigMap{}
+	cmo := cache.ByObject{Namespaces: map[string]cache.Config{"other": {}}}
+
+	co := cache.Options{ByObject: map[client.Object]cache.ByObject{
+		cm:               cmo,
+		&corev1.Secret{}: {Namespaces: map[string]cache.Config{"other": {}}},
+	}}
+
+	got := WithSecretNamespaceCache(co, "crossplane-system")
+
+	if len(got.ByObject) != 2 {
+		t.Fatalf("WithSecretNamespaceCache(...): want 2 ByObject entries, got %d", len(got.ByObject))
+	}
+
+	if diff := cmp.Diff(cmo, got.ByObject[cm]); diff != "" {
+		t.Errorf("WithSecretNamespaceCache(...): -want ConfigMap config, +got ConfigMap config:\n%s", diff)
+	}
+
+	for o, bo := range got.ByObject {
+		if _, ok := o.(*corev1.Secret); !ok {
+			continue
+		}
+
+		want := cache.ByObject{Namespaces: map[string]cache.Config{"crossplane-system": {}}}
+		if diff := cmp.Diff(want, bo); diff != "" {
+			t.Errorf("WithSecretNamespaceCache(...): -want Secret config, +got Secret config:\n%s", diff)
+		}
+	}
+
+	if len(co.ByObject) != 2 {
+		t.Errorf("WithSecretNamespaceCache(...): the supplied options should not be modified")
+	}
+}
@@ -0,0 +1,230 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+)
+
+var _ manager.LeaderElectionRunnable = &Drainer{}
+
+const (
+	// DefaultDrainTimeout is how long a Drainer waits for in-flight
+	// reconciles by default. It leaves time to flush buffers within
+	// controller-runtime's default graceful shutdown timeout of 30 seconds.
+	DefaultDrainTimeout = 20 * time.Second
+
+	defaultFlushTimeout = 5 * time.Second
+)
+
+const errFlush = "cannot flush buffered data"
+
+// A Flusher writes any data it buffers, for example change log entries or
+// deferred status updates.
+type Flusher interface {
+	Flush(ctx context.Context) error
+}
+
+// A FlusherFn is a function that satisfies the Flusher interface.
+type FlusherFn func(ctx context.Context) error
+
+// Flush calls FlusherFn.
+func (fn FlusherFn) Flush(ctx context.Context) error {
+	return fn(ctx)
+}
+
+// A DrainerOption configures a Drainer.
+type DrainerOption func(d *Drainer)
+
+// WithDrainTimeout configures how long a Drainer waits for in-flight
+// reconciles when the controller manager stops. DefaultDrainTimeout is used
+// by default.
+func WithDrainTimeout(t time.Duration) DrainerOption {
+	return func(d *Drainer) {
+		d.timeout = t
+	}
+}
+
+// WithDrainLogger configures the logger a Drainer uses to log a summary of
+// the drain.
+func WithDrainLogger(l logging.Logger) DrainerOption {
+	return func(d *Drainer) {
+		d.log = l
+	}
+}
+
+// WithFlushers configures the Flushers a Drainer calls once in-flight
+// reconciles are drained, for example to write buffered change log entries
+// or a managed.WriteBehindStatusClient's deferred status updates.
+func WithFlushers(f ...Flusher) DrainerOption {
+	return func(d *Drainer) {
+		d.flushers = append(d.flushers, f...)
+	}
+}
+
+// A Drainer gracefully stops reconcilers when their controller manager stops,
+// for example because the provider pod received SIGTERM during a rollout.
+// Without a Drainer the context of every in-flight reconcile is cancelled,
+// which may interrupt a reconcile after it asked an external system to create
+// a resource but before it recorded that it did.
+//
+// Wrap each reconciler using the Drainer's Reconciler method, then add the
+// Drainer to the controller manager as a Runnable. When the manager stops the
+// Drainer stops accepting new reconciles, waits a bounded time for in-flight
+// reconciles to finish, flushes buffered data, and logs a summary.
+type Drainer struct {
+	timeout  time.Duration
+	log      logging.Logger
+	flushers []Flusher
+
+	// abort cancels in-flight reconciles that didn't finish in time.
+	abort       context.Context
+	cancelAbort context.CancelFunc
+
+	mu       sync.Mutex
+	draining bool
+	inflight int
+	rejected int
+	wg       sync.WaitGroup
+}
+
+// NewDrainer returns a new Drainer.
+func NewDrainer(o ...DrainerOption) *Drainer {
+	d := &Drainer{timeout: DefaultDrainTimeout, log: logging.NewNopLogger()}
+	d.abort, d.cancelAbort = context.WithCancel(context.Background())
+
+	for _, fn := range o {
+		fn(d)
+	}
+
+	return d
+}
+
+// Reconciler returns a reconciler that reconciles using the supplied
+// reconciler, unless the Drainer is draining. The context of a reconcile
+// isn't cancelled when the controller manager stops; it's cancelled only if
+// the reconcile doesn't finish before the drain times out.
+func (d *Drainer) Reconciler(r reconcile.Reconciler) reconcile.Reconciler {
+	return reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+		if !d.begin() {
+			return reconcile.Result{Requeue: true}, nil
+		}
+		defer d.end()
+
+		rctx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		defer cancel()
+
+		stop := context.AfterFunc(d.abort, cancel)
+		defer stop()
+
+		return r.Reconcile(rctx, req)
+	})
+}
+
+func (d *Drainer) begin() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.draining {
+		d.rejected++
+		return false
+	}
+
+	d.inflight++
+	d.wg.Add(1)
+
+	return true
+}
+
+func (d *Drainer) end() {
+	d.mu.Lock()
+	d.inflight--
+	d.mu.Unlock()
+
+	d.wg.Done()
+}
+
+// Start blocks until the supplied context is done, then drains in-flight
+// reconciles. It allows a Drainer to be added to a controller manager.
+func (d *Drainer) Start(ctx context.Context) error {
+	<-ctx.Done()
+
+	d.mu.Lock()
+	d.draining = true
+	inflight := d.inflight
+	d.mu.Unlock()
+
+	d.log.Info("Draining in-flight reconciles", "in-flight", inflight, "timeout", d.timeout)
+
+	drained := make(chan struct{})
+	go func() {
+		d.wg.Wait()
+		close(drained)
+	}()
+
+	t := time.NewTimer(d.timeout)
+	defer t.Stop()
+
+	select {
+	case <-drained:
+	case <-t.C:
+		// Don't wait for the aborted reconciles to return. We've already
+		// waited as long as we said we would.
+		d.cancelAbort()
+	}
+
+	d.mu.Lock()
+	abandoned, rejected := d.inflight, d.rejected
+	d.mu.Unlock()
+
+	fctx, cancel := context.WithTimeout(context.Background(), defaultFlushTimeout)
+	defer cancel()
+
+	errs := make([]error, 0, len(d.flushers))
+	for _, f := range d.flushers {
+		errs = append(errs, f.Flush(fctx))
+	}
+
+	err := errors.Join(errs...)
+
+	d.log.Info("Drained in-flight reconciles",
+		"completed", inflight-abandoned,
+		"abandoned", abandoned,
+		"rejected", rejected,
+		"flush-error", err,
+	)
+
+	if err != nil {
+		return errors.Wrap(err, errFlush)
+	}
+
+	return nil
+}
+
+// NeedLeaderElection returns false. Reconciles should be drained on every
+// replica.
+func (d *Drainer) NeedLeaderElection() bool {
+	return false
+}
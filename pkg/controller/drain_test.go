@@ -0,0 +1,152 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+)
+
+func TestDrainer(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		err       error
+		cancelled bool
+		rejected  bool
+		flushed   bool
+	}
+
+	cases := map[string]struct {
+		reason  string
+		timeout time.Duration
+		flush   error
+		want    want
+	}{
+		"Drained": {
+			reason:  "An in-flight reconcile should finish without its context being cancelled, and new reconciles should be rejected.",
+			timeout: time.Minute,
+			want: want{
+				rejected: true,
+				flushed:  true,
+			},
+		},
+		"TimedOut": {
+			reason:  "An in-flight reconcile that doesn't finish before the drain times out should have its context cancelled.",
+			timeout: time.Millisecond,
+			want: want{
+				cancelled: true,
+				rejected:  true,
+				flushed:   true,
+			},
+		},
+		"FlushError": {
+			reason:  "Errors flushing buffered data should be returned.",
+			timeout: time.Minute,
+			flush:   errBoom,
+			want: want{
+				err:      errors.Wrap(errors.Join(errBoom), errFlush),
+				rejected: true,
+				flushed:  true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			flushed := false
+			d := NewDrainer(
+				WithDrainTimeout(tc.timeout),
+				WithFlushers(FlusherFn(func(_ context.Context) error {
+					flushed = true
+					return tc.flush
+				})),
+			)
+
+			started := make(chan struct{})
+			finish := make(chan struct{})
+			cancelled := make(chan bool, 1)
+
+			r := d.Reconciler(reconcile.Func(func(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+				close(started)
+				select {
+				case <-finish:
+					cancelled <- false
+				case <-ctx.Done():
+					cancelled <- true
+				}
+
+				return reconcile.Result{}, nil
+			}))
+
+			mctx, stop := context.WithCancel(context.Background())
+			go func() {
+				_, _ = r.Reconcile(mctx, reconcile.Request{})
+			}()
+			<-started
+
+			errs := make(chan error, 1)
+			go func() { errs <- d.Start(mctx) }()
+
+			// Stop the manager, then wait for the Drainer to start draining.
+			stop()
+
+			for {
+				d.mu.Lock()
+				draining := d.draining
+				d.mu.Unlock()
+
+				if draining {
+					break
+				}
+
+				time.Sleep(time.Millisecond)
+			}
+
+			res, _ := r.Reconcile(context.Background(), reconcile.Request{})
+			rejected := res.Requeue
+
+			if !tc.want.cancelled {
+				close(finish)
+			}
+
+			err := <-errs
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nd.Start(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.want.cancelled, <-cancelled); diff != "" {
+				t.Errorf("\n%s\nd.Reconciler(...): -want cancelled, +got cancelled:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.want.rejected, rejected); diff != "" {
+				t.Errorf("\n%s\nd.Reconciler(...): -want rejected, +got rejected:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.want.flushed, flushed); diff != "" {
+				t.Errorf("\n%s\nd.Start(...): -want flushed, +got flushed:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -21,7 +21,10 @@ import (
 	"crypto/tls"
 	"time"
 
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/crossplane/crossplane-runtime/v2/pkg/feature"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
@@ -72,17 +75,46 @@ type Options struct {
 
 	// Gate implements a gated function callback pattern.
 	Gate Gate
+
+	// PrioritizeChanges processes requests triggered by changes to watched
+	// resources before those triggered by poll or error requeues.
+	PrioritizeChanges bool
+
+	// AllReplicas runs controllers on every replica, even when leader
+	// election is enabled, so they keep working during leader failover.
+	// Only use it for controllers that don't change the resources they
+	// reconcile, like those of observe-only kinds. See RunOnAllReplicas.
+	AllReplicas bool
+}
+
+// RunOnAllReplicas returns a copy of the options that run controllers on every
+// replica, even when leader election is enabled.
+func (o Options) RunOnAllReplicas() Options {
+	o.AllReplicas = true
+	return o
 }
 
 // ForControllerRuntime extracts options for controller-runtime.
 func (o Options) ForControllerRuntime() controller.Options {
 	recoverPanic := true
 
-	return controller.Options{
+	co := controller.Options{
 		MaxConcurrentReconciles: o.MaxConcurrentReconciles,
 		RateLimiter:             ratelimiter.NewController(),
 		RecoverPanic:            &recoverPanic,
 	}
+
+	if o.AllReplicas {
+		co.NeedLeaderElection = ptr.To(false)
+	}
+
+	if o.PrioritizeChanges {
+		co.NewQueue = func(_ string, rl workqueue.TypedRateLimiter[reconcile.Request]) workqueue.TypedRateLimitingInterface[reconcile.Request] {
+			return NewPriorityQueue(rl)
+		}
+	}
+
+	return co
 }
 
 // ESSOptions for External Secret Stores.
@@ -0,0 +1,284 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+)
+
+var _ workqueue.TypedRateLimitingInterface[reconcile.Request] = &PriorityQueue{}
+
+// A PriorityQueueOption configures a PriorityQueue.
+type PriorityQueueOption func(q *PriorityQueue)
+
+// WithPriorityQueueClock configures the clock used to delay requests added
+// using AddAfter.
+func WithPriorityQueueClock(c clock.WithDelayedExecution) PriorityQueueOption {
+	return func(q *PriorityQueue) {
+		q.clock = c
+	}
+}
+
+// A PriorityQueue is a rate limiting work queue with two lanes. Requests
+// added immediately, which controller-runtime does when a watched resource
+// changes, are queued in an urgent lane. Requests added after a delay or
+// rate limit, which controller-runtime does when a reconciler asks to be
+// requeued, are queued in a normal lane. Requests in the urgent lane are
+// always processed first, so changes made by users don't wait behind the poll
+// requeues of thousands of other resources.
+//
+// Like the queues in k8s.io/client-go/util/workqueue a request is never
+// processed concurrently, and a request that is added several times before
+// it's processed is processed once.
+type PriorityQueue struct {
+	limiter ratelimiter.ControllerRateLimiter
+	clock   clock.WithDelayedExecution
+
+	mx   sync.Mutex
+	cond *sync.Cond
+
+	urgent []reconcile.Request
+	normal []reconcile.Request
+
+	// dirty requests need processing. The value is true if they're urgent.
+	dirty      map[reconcile.Request]bool
+	processing map[reconcile.Request]bool
+
+	// waiting requests will be added after a delay. There's at most one
+	// pending delayed add per request.
+	waiting map[reconcile.Request]*delayedAdd
+
+	shuttingDown bool
+}
+
+// NewPriorityQueue returns a PriorityQueue that uses the supplied rate
+// limiter.
+func NewPriorityQueue(rl ratelimiter.ControllerRateLimiter, o ...PriorityQueueOption) *PriorityQueue {
+	q := &PriorityQueue{
+		limiter:    rl,
+		clock:      clock.RealClock{},
+		dirty:      make(map[reconcile.Request]bool),
+		processing: make(map[reconcile.Request]bool),
+		waiting:    make(map[reconcile.Request]*delayedAdd),
+	}
+	q.cond = sync.NewCond(&q.mx)
+
+	for _, fn := range o {
+		fn(q)
+	}
+
+	return q
+}
+
+// Add the supplied request to the urgent lane.
+func (q *PriorityQueue) Add(item reconcile.Request) {
+	q.add(item, true)
+}
+
+func (q *PriorityQueue) add(item reconcile.Request, urgent bool) {
+	q.mx.Lock()
+	defer q.mx.Unlock()
+
+	if q.shuttingDown {
+		return
+	}
+
+	if wasUrgent, ok := q.dirty[item]; ok {
+		if !urgent || wasUrgent {
+			return
+		}
+
+		// Promote a queued request to the urgent lane. Requests that are
+		// being processed aren't in a lane; they're queued when done.
+		q.dirty[item] = true
+		if q.processing[item] {
+			return
+		}
+		q.normal = slices.DeleteFunc(q.normal, func(r reconcile.Request) bool { return r == item })
+		q.urgent = append(q.urgent, item)
+
+		return
+	}
+
+	q.dirty[item] = urgent
+	if q.processing[item] {
+		return
+	}
+
+	q.enqueue(item, urgent)
+}
+
+func (q *PriorityQueue) enqueue(item reconcile.Request, urgent bool) {
+	if urgent {
+		q.urgent = append(q.urgent, item)
+	} else {
+		q.normal = append(q.normal, item)
+	}
+	q.cond.Signal()
+}
+
+// Len returns the number of queued requests.
+func (q *PriorityQueue) Len() int {
+	q.mx.Lock()
+	defer q.mx.Unlock()
+
+	return len(q.urgent) + len(q.normal)
+}
+
+// Get blocks until it can return a request to process, preferring requests
+// in the urgent lane. It returns shutdown true if the queue is shutting down.
+func (q *PriorityQueue) Get() (item reconcile.Request, shutdown bool) {
+	q.mx.Lock()
+	defer q.mx.Unlock()
+
+	for len(q.urgent)+len(q.normal) == 0 && !q.shuttingDown {
+		q.cond.Wait()
+	}
+
+	switch {
+	case len(q.urgent) > 0:
+		item, q.urgent = q.urgent[0], q.urgent[1:]
+	case len(q.normal) > 0:
+		item, q.normal = q.normal[0], q.normal[1:]
+	default:
+		return reconcile.Request{}, true
+	}
+
+	q.processing[item] = true
+	delete(q.dirty, item)
+
+	return item, false
+}
+
+// Done marks the supplied request as processed. If it was added again while
+// being processed it's queued again.
+func (q *PriorityQueue) Done(item reconcile.Request) {
+	q.mx.Lock()
+	defer q.mx.Unlock()
+
+	delete(q.processing, item)
+
+	if urgent, ok := q.dirty[item]; ok {
+		q.enqueue(item, urgent)
+	}
+
+	if len(q.processing) == 0 {
+		// Wake ShutDownWithDrain.
+		q.cond.Broadcast()
+	}
+}
+
+// ShutDown the queue. Requests added after shutdown are ignored, and Get
+// returns immediately.
+func (q *PriorityQueue) ShutDown() {
+	q.mx.Lock()
+	defer q.mx.Unlock()
+
+	q.shuttingDown = true
+	q.cond.Broadcast()
+}
+
+// ShutDownWithDrain shuts down the queue, then blocks until all requests that
+// are being processed are done.
+func (q *PriorityQueue) ShutDownWithDrain() {
+	q.mx.Lock()
+	defer q.mx.Unlock()
+
+	q.shuttingDown = true
+	q.cond.Broadcast()
+
+	for len(q.processing) > 0 {
+		q.cond.Wait()
+	}
+}
+
+// ShuttingDown returns true if the queue is shutting down.
+func (q *PriorityQueue) ShuttingDown() bool {
+	q.mx.Lock()
+	defer q.mx.Unlock()
+
+	return q.shuttingDown
+}
+
+// A delayedAdd is a pending delayed add of a request.
+type delayedAdd struct {
+	at time.Time
+}
+
+// AddAfter adds the supplied request to the normal lane after the supplied
+// duration. Like the delaying queue in k8s.io/client-go/util/workqueue there's
+// at most one pending delayed add per request. If the request is already
+// waiting to be added the earliest of the two deadlines wins.
+func (q *PriorityQueue) AddAfter(item reconcile.Request, d time.Duration) {
+	if q.ShuttingDown() {
+		return
+	}
+
+	if d <= 0 {
+		q.add(item, false)
+		return
+	}
+
+	// Don't call the clock while holding the lock. A fake clock calls
+	// AfterFunc functions, which take the lock, while holding its own.
+	w := &delayedAdd{at: q.clock.Now().Add(d)}
+
+	q.mx.Lock()
+	if existing, ok := q.waiting[item]; ok && !w.at.Before(existing.at) {
+		q.mx.Unlock()
+		return
+	}
+	q.waiting[item] = w
+	q.mx.Unlock()
+
+	q.clock.AfterFunc(d, func() {
+		q.mx.Lock()
+		if q.waiting[item] != w {
+			// Superseded by an earlier delayed add.
+			q.mx.Unlock()
+			return
+		}
+		delete(q.waiting, item)
+		q.mx.Unlock()
+
+		q.add(item, false)
+	})
+}
+
+// AddRateLimited adds the supplied request to the normal lane once the rate
+// limiter allows it.
+func (q *PriorityQueue) AddRateLimited(item reconcile.Request) {
+	q.AddAfter(item, q.limiter.When(item))
+}
+
+// Forget the supplied request's rate limiting history.
+func (q *PriorityQueue) Forget(item reconcile.Request) {
+	q.limiter.Forget(item)
+}
+
+// NumRequeues returns how many times the supplied request was rate limited.
+func (q *PriorityQueue) NumRequeues(item reconcile.Request) int {
+	return q.limiter.NumRequeues(item)
+}
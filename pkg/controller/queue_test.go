@@ -0,0 +1,160 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/types"
+	clocktesting "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/ratelimiter"
+)
+
+func req(name string) reconcile.Request {
+	return reconcile.Request{NamespacedName: types.NamespacedName{Name: name}}
+}
+
+func TestPriorityQueue(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		add    func(q *PriorityQueue)
+		want   []reconcile.Request
+	}{
+		"UrgentFirst": {
+			reason: "Requests added immediately should be processed before requests added after a delay.",
+			add: func(q *PriorityQueue) {
+				q.AddAfter(req("poll-a"), 0)
+				q.AddAfter(req("poll-b"), 0)
+				q.Add(req("change"))
+			},
+			want: []reconcile.Request{req("change"), req("poll-a"), req("poll-b")},
+		},
+		"Deduplicate": {
+			reason: "A request added several times should be processed once.",
+			add: func(q *PriorityQueue) {
+				q.Add(req("a"))
+				q.Add(req("a"))
+				q.AddAfter(req("a"), 0)
+			},
+			want: []reconcile.Request{req("a")},
+		},
+		"Promote": {
+			reason: "A queued request should be promoted to the urgent lane if it's added immediately.",
+			add: func(q *PriorityQueue) {
+				q.AddAfter(req("a"), 0)
+				q.AddAfter(req("b"), 0)
+				q.Add(req("b"))
+			},
+			want: []reconcile.Request{req("b"), req("a")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			q := NewPriorityQueue(ratelimiter.NewController())
+			tc.add(q)
+
+			got := make([]reconcile.Request, 0, q.Len())
+			for q.Len() > 0 {
+				r, _ := q.Get()
+				got = append(got, r)
+				q.Done(r)
+			}
+
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nq.Get(): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestPriorityQueueProcessing(t *testing.T) {
+	q := NewPriorityQueue(ratelimiter.NewController())
+
+	q.Add(req("a"))
+	r, _ := q.Get()
+
+	// A request that is added while it's being processed should not be
+	// processed concurrently, but should be queued again once done.
+	q.Add(req("a"))
+	if got := q.Len(); got != 0 {
+		t.Errorf("q.Len(): want 0 while processing, got %d", got)
+	}
+
+	q.Done(r)
+	if got := q.Len(); got != 1 {
+		t.Errorf("q.Len(): want 1 after done, got %d", got)
+	}
+}
+
+func TestPriorityQueueAddAfter(t *testing.T) {
+	c := clocktesting.NewFakeClock(time.Now())
+	q := NewPriorityQueue(ratelimiter.NewController(), WithPriorityQueueClock(c))
+
+	q.AddAfter(req("a"), time.Minute)
+	if got := q.Len(); got != 0 {
+		t.Errorf("q.Len(): want 0 before delay, got %d", got)
+	}
+
+	c.Step(time.Minute)
+	if got := q.Len(); got != 1 {
+		t.Errorf("q.Len(): want 1 after delay, got %d", got)
+	}
+
+	q.ShutDown()
+	if _, shutdown := q.Get(); shutdown {
+		t.Errorf("q.Get(): want queued request after shutdown")
+	}
+	if _, shutdown := q.Get(); !shutdown {
+		t.Errorf("q.Get(): want shutdown once the queue is empty")
+	}
+}
+
+func TestPriorityQueueAddAfterDedupe(t *testing.T) {
+	c := clocktesting.NewFakeClock(time.Now())
+	q := NewPriorityQueue(ratelimiter.NewController(), WithPriorityQueueClock(c))
+
+	// Each reconcile of a resource asks to be requeued after its poll
+	// interval. Only the earliest pending requeue should be kept.
+	q.AddAfter(req("a"), 2*time.Minute)
+	q.AddAfter(req("a"), time.Minute)
+	q.AddAfter(req("a"), 3*time.Minute)
+	q.Add(req("a"))
+
+	item, _ := q.Get()
+	q.Done(item)
+
+	reconciles := 0
+
+	for range 10 {
+		c.Step(time.Minute)
+
+		for q.Len() > 0 {
+			item, _ := q.Get()
+			q.Done(item)
+			reconciles++
+		}
+	}
+
+	if diff := cmp.Diff(1, reconciles); diff != "" {
+		t.Errorf("q.AddAfter(...): -want delayed reconciles, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,41 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+var _ manager.LeaderElectionRunnable = allReplicas{}
+
+type allReplicas struct {
+	manager.Runnable
+}
+
+func (allReplicas) NeedLeaderElection() bool {
+	return false
+}
+
+// OnAllReplicas returns a Runnable that runs the supplied Runnable on every
+// replica, even when leader election is enabled. Use it to keep exporting
+// observability data, for example a statemetrics.MRStateRecorder's metrics,
+// during leader failover:
+//
+//	mgr.Add(controller.OnAllReplicas(recorder))
+func OnAllReplicas(r manager.Runnable) manager.Runnable {
+	return allReplicas{Runnable: r}
+}
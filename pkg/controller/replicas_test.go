@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+func TestOnAllReplicas(t *testing.T) {
+	started := false
+	r := OnAllReplicas(manager.RunnableFunc(func(_ context.Context) error {
+		started = true
+		return nil
+	}))
+
+	ler, ok := r.(manager.LeaderElectionRunnable)
+	if !ok {
+		t.Fatalf("OnAllReplicas(...): want a LeaderElectionRunnable")
+	}
+
+	if diff := cmp.Diff(false, ler.NeedLeaderElection()); diff != "" {
+		t.Errorf("NeedLeaderElection(): -want, +got:\n%s", diff)
+	}
+
+	if err := r.Start(context.Background()); err != nil {
+		t.Errorf("Start(...): %s", err)
+	}
+
+	if diff := cmp.Diff(true, started); diff != "" {
+		t.Errorf("Start(...): -want started, +got started:\n%s", diff)
+	}
+}
+
+func TestRunOnAllReplicas(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		o      Options
+		want   *bool
+	}{
+		"Default": {
+			reason: "Controllers should use the manager's leader election setting by default.",
+			o:      DefaultOptions(),
+			want:   nil,
+		},
+		"AllReplicas": {
+			reason: "Controllers should run on all replicas if asked.",
+			o:      DefaultOptions().RunOnAllReplicas(),
+			want:   ptr.To(false),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := tc.o.ForControllerRuntime().NeedLeaderElection
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nForControllerRuntime().NeedLeaderElection: -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
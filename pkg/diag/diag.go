@@ -0,0 +1,275 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package diag wires common diagnostic endpoints - pprof, health and
+// readiness checks, and a runtime configuration dump - into a controller
+// manager.
+package diag
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+)
+
+// Paths at which diagnostic endpoints are served by the manager's metrics
+// server.
+const (
+	PathPprof  = "/debug/pprof/"
+	PathConfig = "/debug/config"
+)
+
+// DefaultCacheSyncTimeout is the default time a readiness check waits for the
+// manager's caches to sync.
+const DefaultCacheSyncTimeout = 1 * time.Second
+
+const (
+	errAddHealthz      = "cannot add healthz check"
+	errAddReadyz       = "cannot add readyz check"
+	errFmtAddHandler   = "cannot add diagnostic handler for path %q"
+	errCachesNotSynced = "caches are not synced"
+	errFmtNotStarted   = "controllers are not started: %s"
+)
+
+// Readiness tracks whether a set of controllers have started. Controllers
+// that are gated on the presence of CRDs don't start until their gate opens,
+// so a provider isn't ready until all of its expected controllers have.
+type Readiness struct {
+	mu      sync.RWMutex
+	started map[string]bool
+}
+
+// NewReadiness returns a Readiness that expects no controllers.
+func NewReadiness() *Readiness {
+	return &Readiness{started: make(map[string]bool)}
+}
+
+// Expect the named controller to start before the manager is ready.
+func (r *Readiness) Expect(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.started[name]; !ok {
+		r.started[name] = false
+	}
+}
+
+// Started marks the named controller as started.
+func (r *Readiness) Started(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.started[name] = true
+}
+
+// Gated expects the named controller to start, and returns a function that
+// calls the supplied function then marks the controller as started. It's
+// intended to wrap the callback passed to a controller.Gate.
+func (r *Readiness) Gated(name string, fn func()) func() {
+	r.Expect(name)
+
+	return func() {
+		fn()
+		r.Started(name)
+	}
+}
+
+// Pending returns the sorted names of expected controllers that have not yet
+// started.
+func (r *Readiness) Pending() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	pending := make([]string, 0)
+
+	for name, started := range r.started {
+		if !started {
+			pending = append(pending, name)
+		}
+	}
+
+	slices.Sort(pending)
+
+	return pending
+}
+
+// Check returns an error if any expected controller has not yet started. It
+// satisfies healthz.Checker.
+func (r *Readiness) Check(_ *http.Request) error {
+	if p := r.Pending(); len(p) > 0 {
+		return errors.Errorf(errFmtNotStarted, strings.Join(p, ", "))
+	}
+
+	return nil
+}
+
+// CacheSyncChecker returns a healthz.Checker that returns an error unless the
+// supplied cache syncs within the supplied timeout.
+func CacheSyncChecker(c cache.Cache, timeout time.Duration) healthz.Checker {
+	return func(req *http.Request) error {
+		ctx, cancel := context.WithTimeout(req.Context(), timeout)
+		defer cancel()
+
+		if !c.WaitForCacheSync(ctx) {
+			return errors.New(errCachesNotSynced)
+		}
+
+		return nil
+	}
+}
+
+// RuntimeInfo describes the Go runtime a provider is running in.
+type RuntimeInfo struct {
+	GoVersion  string `json:"goVersion"`
+	GOOS       string `json:"goos"`
+	GOARCH     string `json:"goarch"`
+	GOMAXPROCS int    `json:"gomaxprocs"`
+	NumCPU     int    `json:"numCPU"`
+	Goroutines int    `json:"goroutines"`
+}
+
+// A ConfigDump is served by the config endpoint.
+type ConfigDump struct {
+	Runtime RuntimeInfo `json:"runtime"`
+	Config  any         `json:"config,omitempty"`
+}
+
+// ConfigHandler returns an http.Handler that serves a JSON dump of the Go
+// runtime and the supplied configuration. The configuration must be JSON
+// serializable, and should not contain secrets.
+func ConfigHandler(cfg any) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		d := ConfigDump{
+			Runtime: RuntimeInfo{
+				GoVersion:  runtime.Version(),
+				GOOS:       runtime.GOOS,
+				GOARCH:     runtime.GOARCH,
+				GOMAXPROCS: runtime.GOMAXPROCS(0),
+				NumCPU:     runtime.NumCPU(),
+				Goroutines: runtime.NumGoroutine(),
+			},
+			Config: cfg,
+		}
+
+		b, err := json.MarshalIndent(d, "", "  ")
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(b)
+	})
+}
+
+// An Option configures the diagnostic endpoints.
+type Option func(o *options)
+
+type options struct {
+	readiness        *Readiness
+	config           any
+	pprof            bool
+	cacheSyncTimeout time.Duration
+}
+
+// WithReadiness configures the readyz endpoint to report whether the
+// controllers expected by the supplied Readiness have started.
+func WithReadiness(r *Readiness) Option {
+	return func(o *options) {
+		o.readiness = r
+	}
+}
+
+// WithConfig configures the configuration served by the config endpoint.
+func WithConfig(cfg any) Option {
+	return func(o *options) {
+		o.config = cfg
+	}
+}
+
+// WithPprof enables the pprof endpoints. They're disabled by default because
+// the metrics server that serves them is often reachable from across the
+// cluster, and pprof exposes sensitive information such as the process's
+// command line.
+func WithPprof() Option {
+	return func(o *options) {
+		o.pprof = true
+	}
+}
+
+// WithCacheSyncTimeout configures how long the readyz endpoint waits for the
+// manager's caches to sync.
+func WithCacheSyncTimeout(d time.Duration) Option {
+	return func(o *options) {
+		o.cacheSyncTimeout = d
+	}
+}
+
+// Setup adds diagnostic endpoints to the supplied manager. Health and
+// readiness checks are served by the manager's health probe server, so the
+// manager must be configured with a HealthProbeBindAddress. The config
+// endpoint, and the pprof endpoints if enabled, are served by the manager's
+// metrics server.
+func Setup(mgr manager.Manager, o ...Option) error {
+	opts := &options{cacheSyncTimeout: DefaultCacheSyncTimeout}
+	for _, fn := range o {
+		fn(opts)
+	}
+
+	if err := mgr.AddHealthzCheck("ping", healthz.Ping); err != nil {
+		return errors.Wrap(err, errAddHealthz)
+	}
+
+	if err := mgr.AddReadyzCheck("caches", CacheSyncChecker(mgr.GetCache(), opts.cacheSyncTimeout)); err != nil {
+		return errors.Wrap(err, errAddReadyz)
+	}
+
+	if opts.readiness != nil {
+		if err := mgr.AddReadyzCheck("controllers", opts.readiness.Check); err != nil {
+			return errors.Wrap(err, errAddReadyz)
+		}
+	}
+
+	handlers := map[string]http.Handler{PathConfig: ConfigHandler(opts.config)}
+
+	if opts.pprof {
+		handlers[PathPprof] = http.HandlerFunc(pprof.Index)
+		handlers[PathPprof+"cmdline"] = http.HandlerFunc(pprof.Cmdline)
+		handlers[PathPprof+"profile"] = http.HandlerFunc(pprof.Profile)
+		handlers[PathPprof+"symbol"] = http.HandlerFunc(pprof.Symbol)
+		handlers[PathPprof+"trace"] = http.HandlerFunc(pprof.Trace)
+	}
+
+	for path, h := range handlers {
+		if err := mgr.AddMetricsServerExtraHandler(path, h); err != nil {
+			return errors.Wrapf(err, errFmtAddHandler, path)
+		}
+	}
+
+	return nil
+}
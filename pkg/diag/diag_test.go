@@ -0,0 +1,248 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diag
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+)
+
+type mockCache struct {
+	cache.Cache
+
+	synced bool
+}
+
+func (c *mockCache) WaitForCacheSync(_ context.Context) bool { return c.synced }
+
+type mockManager struct {
+	fake.Manager
+
+	healthz  []string
+	readyz   []string
+	handlers []string
+	err      error
+}
+
+func (m *mockManager) AddHealthzCheck(name string, _ healthz.Checker) error {
+	m.healthz = append(m.healthz, name)
+	return m.err
+}
+
+func (m *mockManager) AddReadyzCheck(name string, _ healthz.Checker) error {
+	m.readyz = append(m.readyz, name)
+	return m.err
+}
+
+func (m *mockManager) AddMetricsServerExtraHandler(path string, _ http.Handler) error {
+	m.handlers = append(m.handlers, path)
+	return m.err
+}
+
+func TestReadiness(t *testing.T) {
+	errBoom := errors.Errorf(errFmtNotStarted, "a, c")
+
+	type args struct {
+		expect  []string
+		started []string
+		gated   []string
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   error
+	}{
+		"NoControllers": {
+			reason: "We should be ready if we expect no controllers.",
+			args:   args{},
+			want:   nil,
+		},
+		"AllStarted": {
+			reason: "We should be ready if all expected controllers have started.",
+			args: args{
+				expect:  []string{"a", "b"},
+				started: []string{"a", "b"},
+				gated:   []string{"c"},
+			},
+			want: nil,
+		},
+		"SomePending": {
+			reason: "We should not be ready if any expected controllers have not started.",
+			args: args{
+				expect:  []string{"c", "b", "a"},
+				started: []string{"b"},
+			},
+			want: errBoom,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := NewReadiness()
+			for _, n := range tc.args.expect {
+				r.Expect(n)
+			}
+
+			for _, n := range tc.args.started {
+				r.Started(n)
+			}
+
+			for _, n := range tc.args.gated {
+				r.Gated(n, func() {})()
+			}
+
+			got := r.Check(httptest.NewRequest(http.MethodGet, "/readyz", nil))
+			if diff := cmp.Diff(tc.want, got, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nr.Check(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCacheSyncChecker(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		c      cache.Cache
+		want   error
+	}{
+		"Synced": {
+			reason: "We should be ready if our caches are synced.",
+			c:      &mockCache{synced: true},
+			want:   nil,
+		},
+		"NotSynced": {
+			reason: "We should not be ready if our caches are not synced.",
+			c:      &mockCache{synced: false},
+			want:   errors.New(errCachesNotSynced),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := CacheSyncChecker(tc.c, DefaultCacheSyncTimeout)(httptest.NewRequest(http.MethodGet, "/readyz", nil))
+			if diff := cmp.Diff(tc.want, got, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCacheSyncChecker(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestConfigHandler(t *testing.T) {
+	type config struct {
+		PollInterval string `json:"pollInterval"`
+	}
+
+	w := httptest.NewRecorder()
+	ConfigHandler(config{PollInterval: "1m"}).ServeHTTP(w, httptest.NewRequest(http.MethodGet, PathConfig, nil))
+
+	got := struct {
+		Runtime RuntimeInfo `json:"runtime"`
+		Config  config      `json:"config"`
+	}{}
+	if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+		t.Fatalf("json.Unmarshal(...): %v", err)
+	}
+
+	if diff := cmp.Diff(config{PollInterval: "1m"}, got.Config); diff != "" {
+		t.Errorf("ConfigHandler(...): -want config, +got config:\n%s", diff)
+	}
+
+	if got.Runtime.GoVersion == "" {
+		t.Errorf("ConfigHandler(...): want Go version, got none")
+	}
+}
+
+func TestSetup(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type args struct {
+		err error
+		o   []Option
+	}
+	type want struct {
+		err      error
+		healthz  []string
+		readyz   []string
+		handlers []string
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"Defaults": {
+			reason: "We should add health checks, a cache readiness check, and the config endpoint, but not pprof, by default.",
+			args:   args{},
+			want: want{
+				healthz:  []string{"ping"},
+				readyz:   []string{"caches"},
+				handlers: []string{PathConfig},
+			},
+		},
+		"ReadinessWithPprof": {
+			reason: "We should add a controller readiness check, and the pprof endpoints if asked.",
+			args: args{
+				o: []Option{WithReadiness(NewReadiness()), WithPprof()},
+			},
+			want: want{
+				healthz:  []string{"ping"},
+				readyz:   []string{"caches", "controllers"},
+				handlers: []string{PathConfig, PathPprof, PathPprof + "cmdline", PathPprof + "profile", PathPprof + "symbol", PathPprof + "trace"},
+			},
+		},
+		"AddHealthzError": {
+			reason: "We should return any error encountered adding a health check.",
+			args: args{
+				err: errBoom,
+			},
+			want: want{
+				err:     errors.Wrap(errBoom, errAddHealthz),
+				healthz: []string{"ping"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			m := &mockManager{Manager: fake.Manager{Cache: &mockCache{}}, err: tc.args.err}
+			err := Setup(m, tc.args.o...)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nSetup(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+
+			got := want{err: err, healthz: m.healthz, readyz: m.readyz, handlers: m.handlers}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{}), test.EquateErrors(), cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+				t.Errorf("\n%s\nSetup(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package errors
+
+import (
+	"time"
+)
+
+// A throttled error indicates the external system is throttling requests.
+type throttled interface {
+	Throttled() bool
+	RetryAfter() time.Duration
+}
+
+// A quotaExceeded error indicates a request would exceed a quota in the
+// external system.
+type quotaExceeded interface {
+	QuotaExceeded() bool
+}
+
+// An upstreamUnavailable error indicates the external system is unavailable.
+type upstreamUnavailable interface {
+	UpstreamUnavailable() bool
+}
+
+// An unauthorized error indicates the external system rejected a request's
+// credentials, for example because they expired.
+type unauthorized interface {
+	Unauthorized() bool
+}
+
+type throttledError struct {
+	error
+	retryAfter time.Duration
+}
+
+func (e throttledError) Unwrap() error             { return e.error }
+func (e throttledError) Throttled() bool           { return true }
+func (e throttledError) RetryAfter() time.Duration { return e.retryAfter }
+
+// Throttled classifies the supplied error as indicating that the external
+// system is throttling requests, and that they may be retried after the
+// supplied duration. A duration of zero indicates it is unknown when requests
+// may be retried. Throttled returns nil if the supplied error is nil.
+func Throttled(err error, retryAfter time.Duration) error {
+	if err == nil {
+		return nil
+	}
+
+	return throttledError{error: err, retryAfter: retryAfter}
+}
+
+// IsThrottled returns true if the supplied error, or any error it wraps,
+// indicates that the external system is throttling requests. It also returns
+// when requests may be retried, if known. Errors may indicate they're
+// throttled by implementing Throttled() bool and RetryAfter() time.Duration.
+func IsThrottled(err error) (time.Duration, bool) {
+	var t throttled
+	if As(err, &t) && t.Throttled() {
+		return t.RetryAfter(), true
+	}
+
+	return 0, false
+}
+
+type quotaExceededError struct{ error }
+
+func (e quotaExceededError) Unwrap() error       { return e.error }
+func (e quotaExceededError) QuotaExceeded() bool { return true }
+
+// QuotaExceeded classifies the supplied error as indicating that a request
+// would exceed a quota in the external system. It returns nil if the supplied
+// error is nil.
+func QuotaExceeded(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return quotaExceededError{err}
+}
+
+// IsQuotaExceeded returns true if the supplied error, or any error it wraps,
+// indicates that a request would exceed a quota in the external system.
+// Errors may indicate this by implementing QuotaExceeded() bool.
+func IsQuotaExceeded(err error) bool {
+	var q quotaExceeded
+	return As(err, &q) && q.QuotaExceeded()
+}
+
+type upstreamUnavailableError struct{ error }
+
+func (e upstreamUnavailableError) Unwrap() error             { return e.error }
+func (e upstreamUnavailableError) UpstreamUnavailable() bool { return true }
+
+// UpstreamUnavailable classifies the supplied error as indicating that the
+// external system is unavailable. It returns nil if the supplied error is nil.
+func UpstreamUnavailable(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return upstreamUnavailableError{err}
+}
+
+// IsUpstreamUnavailable returns true if the supplied error, or any error it
+// wraps, indicates that the external system is unavailable. Errors may
+// indicate this by implementing UpstreamUnavailable() bool.
+func IsUpstreamUnavailable(err error) bool {
+	var u upstreamUnavailable
+	return As(err, &u) && u.UpstreamUnavailable()
+}
+
+type unauthorizedError struct{ error }
+
+func (e unauthorizedError) Unwrap() error      { return e.error }
+func (e unauthorizedError) Unauthorized() bool { return true }
+
+// Unauthorized classifies the supplied error as indicating that the external
+// system rejected a request's credentials, for example because they expired.
+// It returns nil if the supplied error is nil.
+func Unauthorized(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return unauthorizedError{err}
+}
+
+// IsUnauthorized returns true if the supplied error, or any error it wraps,
+// indicates that the external system rejected a request's credentials. Errors
+// may indicate this by implementing Unauthorized() bool.
+func IsUnauthorized(err error) bool {
+	var u unauthorized
+	return As(err, &u) && u.Unauthorized()
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package errors
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestClassify(t *testing.T) {
+	type want struct {
+		throttled           bool
+		retryAfter          time.Duration
+		quotaExceeded       bool
+		upstreamUnavailable bool
+		unauthorized        bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		err    error
+		want   want
+	}{
+		"Unclassified": {
+			reason: "An unclassified error should not be classified.",
+			err:    New("boom"),
+		},
+		"WrappedThrottled": {
+			reason: "A wrapped throttled error should be classified as throttled.",
+			err:    Wrap(Throttled(New("boom"), time.Minute), "context"),
+			want:   want{throttled: true, retryAfter: time.Minute},
+		},
+		"QuotaExceeded": {
+			reason: "A quota exceeded error should be classified as such.",
+			err:    QuotaExceeded(New("boom")),
+			want:   want{quotaExceeded: true},
+		},
+		"UpstreamUnavailable": {
+			reason: "An upstream unavailable error should be classified as such.",
+			err:    Wrap(UpstreamUnavailable(New("boom")), "context"),
+			want:   want{upstreamUnavailable: true},
+		},
+		"Unauthorized": {
+			reason: "An unauthorized error should be classified as such.",
+			err:    Wrap(Unauthorized(New("boom")), "context"),
+			want:   want{unauthorized: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := want{}
+			got.retryAfter, got.throttled = IsThrottled(tc.err)
+			got.quotaExceeded = IsQuotaExceeded(tc.err)
+			got.upstreamUnavailable = IsUpstreamUnavailable(tc.err)
+			got.unauthorized = IsUnauthorized(tc.err)
+
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nIs...(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+
+	if err := Throttled(nil, time.Minute); err != nil {
+		t.Errorf("Throttled(nil): want nil, got %v", err)
+	}
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package event
+
+import (
+	"encoding/json"
+	"io"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+)
+
+// Error strings.
+const (
+	errFmtNotCamelCase    = "event reason %q is not CamelCase"
+	errFmtDuplicateReason = "event reason %q is registered %d times"
+	errFmtNoDescription   = "event reason %q has no description"
+	errFmtInvalidType     = "event reason %q has invalid type %q"
+	errExportCatalog      = "cannot export event reason catalog"
+)
+
+// Event reasons must be CamelCase, per the Kubernetes API conventions.
+var camelCase = regexp.MustCompile(`^[A-Z][a-zA-Z0-9]*$`)
+
+// A ReasonDescription describes an event reason.
+type ReasonDescription struct {
+	// Reason an event occurred.
+	Reason Reason `json:"reason"`
+
+	// Type of the events emitted with this reason.
+	Type Type `json:"type"`
+
+	// Description of when events are emitted with this reason.
+	Description string `json:"description"`
+}
+
+// A Catalog of the event reasons a provider emits. Providers register each of
+// their event reasons with a Catalog, validate the Catalog at startup, and
+// may export it so that alerting rules can be written against it, e.g:
+//
+//	var (
+//		catalog = event.NewCatalog()
+//
+//		reasonCannotSync = catalog.Register("CannotSync", event.TypeWarning, "The bucket could not be synced.")
+//	)
+//
+//	func main() {
+//		kingpin.FatalIfError(catalog.Validate(), "invalid event reasons")
+//	}
+type Catalog struct {
+	mu      sync.RWMutex
+	reasons []ReasonDescription
+}
+
+// NewCatalog returns an empty Catalog.
+func NewCatalog() *Catalog {
+	return &Catalog{}
+}
+
+// Register the supplied event reason, and return it. Register doesn't reject
+// invalid reasons, so that it may be used to initialize package variables.
+// Use Validate to check the registered reasons.
+func (c *Catalog) Register(r Reason, t Type, description string) Reason {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.reasons = append(c.reasons, ReasonDescription{Reason: r, Type: t, Description: description})
+
+	return r
+}
+
+// Validate returns an error if any registered event reason is not CamelCase,
+// is registered more than once, has an invalid type, or has no description.
+func (c *Catalog) Validate() error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	errs := make([]error, 0)
+	count := make(map[Reason]int, len(c.reasons))
+
+	for _, r := range c.reasons {
+		count[r.Reason]++
+
+		// Only report a duplicate reason once.
+		if count[r.Reason] > 1 {
+			continue
+		}
+
+		if !camelCase.MatchString(string(r.Reason)) {
+			errs = append(errs, errors.Errorf(errFmtNotCamelCase, r.Reason))
+		}
+
+		if r.Type != TypeNormal && r.Type != TypeWarning {
+			errs = append(errs, errors.Errorf(errFmtInvalidType, r.Reason, r.Type))
+		}
+
+		if strings.TrimSpace(r.Description) == "" {
+			errs = append(errs, errors.Errorf(errFmtNoDescription, r.Reason))
+		}
+	}
+
+	for _, r := range c.reasons {
+		if n := count[r.Reason]; n > 1 {
+			errs = append(errs, errors.Errorf(errFmtDuplicateReason, r.Reason, n))
+			count[r.Reason] = 0
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Reasons returns the registered event reasons, sorted by reason.
+func (c *Catalog) Reasons() []ReasonDescription {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	out := slices.Clone(c.reasons)
+	slices.SortStableFunc(out, func(a, b ReasonDescription) int {
+		return strings.Compare(string(a.Reason), string(b.Reason))
+	})
+
+	return out
+}
+
+// Export writes the registered event reasons to the supplied writer as a JSON
+// array, sorted by reason.
+func (c *Catalog) Export(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return errors.Wrap(enc.Encode(c.Reasons()), errExportCatalog)
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package event
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+)
+
+func TestCatalogValidate(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		reasons []ReasonDescription
+		want    error
+	}{
+		"Valid": {
+			reason: "A catalog of unique, CamelCase, described reasons should be valid.",
+			reasons: []ReasonDescription{
+				{Reason: "CannotSync", Type: TypeWarning, Description: "The bucket could not be synced."},
+				{Reason: "Synced", Type: TypeNormal, Description: "The bucket was synced."},
+			},
+		},
+		"Invalid": {
+			reason: "We should return every problem with the registered reasons, reporting each duplicate once.",
+			reasons: []ReasonDescription{
+				{Reason: "cannot-sync", Type: TypeWarning, Description: "The bucket could not be synced."},
+				{Reason: "Synced", Type: "Info", Description: "The bucket was synced."},
+				{Reason: "Deleted", Type: TypeNormal},
+				{Reason: "Synced", Type: TypeNormal, Description: "The bucket was synced again."},
+				{Reason: "Synced", Type: TypeNormal, Description: "The bucket was synced yet again."},
+			},
+			want: errors.Join(
+				errors.Errorf(errFmtNotCamelCase, "cannot-sync"),
+				errors.Errorf(errFmtInvalidType, "Synced", "Info"),
+				errors.Errorf(errFmtNoDescription, "Deleted"),
+				errors.Errorf(errFmtDuplicateReason, "Synced", 3),
+			),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := NewCatalog()
+			for _, r := range tc.reasons {
+				c.Register(r.Reason, r.Type, r.Description)
+			}
+
+			err := c.Validate()
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nc.Validate(): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCatalogExport(t *testing.T) {
+	c := NewCatalog()
+
+	if got := c.Register("Synced", TypeNormal, "The bucket was synced."); got != "Synced" {
+		t.Errorf("c.Register(...): want the registered reason, got %q", got)
+	}
+
+	c.Register("CannotSync", TypeWarning, "The bucket could not be synced.")
+
+	b := &bytes.Buffer{}
+	if err := c.Export(b); err != nil {
+		t.Fatalf("c.Export(...): %v", err)
+	}
+
+	got := []ReasonDescription{}
+	if err := json.Unmarshal(b.Bytes(), &got); err != nil {
+		t.Fatalf("json.Unmarshal(...): %v", err)
+	}
+
+	want := []ReasonDescription{
+		{Reason: "CannotSync", Type: TypeWarning, Description: "The bucket could not be synced."},
+		{Reason: "Synced", Type: TypeNormal, Description: "The bucket was synced."},
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("c.Export(...): -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,392 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fake provides an in-memory fake external system. It's intended to
+// be used as the backend of example providers, and to test how the managed
+// resource reconciler copes with a realistic external API: one that's
+// eventually consistent, throttles its callers, and sometimes fails.
+//
+// Writes are applied immediately, but only become visible to reads once the
+// store's propagation delay has passed. Like a real eventually consistent
+// API, creating a resource that was just created fails even though it can't
+// be read yet.
+package fake
+
+import (
+	"context"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	"k8s.io/utils/clock"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+)
+
+// Error strings.
+const (
+	errFmtGet    = "cannot get %q"
+	errFmtCreate = "cannot create %q"
+	errFmtUpdate = "cannot update %q"
+	errFmtDelete = "cannot delete %q"
+	errFmtOp     = "cannot %s %q"
+)
+
+// Errors returned by a Store. Use errors.Is to check for them.
+var (
+	ErrNotFound      = errors.New("resource not found")
+	ErrAlreadyExists = errors.New("resource already exists")
+	ErrThrottled     = errors.New("request throttled")
+)
+
+// An Operation on a Store.
+type Operation string
+
+// Operations on a Store.
+const (
+	OperationGet    Operation = "Get"
+	OperationList   Operation = "List"
+	OperationCreate Operation = "Create"
+	OperationUpdate Operation = "Update"
+	OperationDelete Operation = "Delete"
+)
+
+// A FailureFn may inject a failure into an operation on the resource with the
+// supplied ID. The ID is empty for List operations. The operation fails with
+// the returned error, if any.
+type FailureFn func(op Operation, id string) error
+
+// FailEvery returns a FailureFn that fails every nth operation with the
+// supplied error.
+func FailEvery(n int, err error) FailureFn {
+	mu := sync.Mutex{}
+	calls := 0
+
+	return func(_ Operation, _ string) error {
+		mu.Lock()
+		defer mu.Unlock()
+
+		calls++
+		if n > 0 && calls%n == 0 {
+			return err
+		}
+
+		return nil
+	}
+}
+
+// FailRandomly returns a FailureFn that fails the supplied fraction of
+// operations with the supplied error, e.g. 0.1 fails one in ten. Operations
+// are chosen using a random number generator seeded with the supplied seed,
+// so that failures are reproducible.
+func FailRandomly(fraction float64, seed uint64, err error) FailureFn {
+	mu := sync.Mutex{}
+	r := rand.New(rand.NewPCG(seed, seed)) //nolint:gosec // Doesn't need to be cryptographically secure.
+
+	return func(_ Operation, _ string) error {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if r.Float64() < fraction {
+			return err
+		}
+
+		return nil
+	}
+}
+
+// An Option configures a Store.
+type Option func(o *options)
+
+type options struct {
+	delay   time.Duration
+	latency time.Duration
+	limiter *rate.Limiter
+	fail    FailureFn
+	clock   clock.PassiveClock
+}
+
+// WithPropagationDelay configures how long writes take to become visible to
+// reads. Writes are visible immediately by default.
+func WithPropagationDelay(d time.Duration) Option {
+	return func(o *options) {
+		o.delay = d
+	}
+}
+
+// WithLatency configures how long each operation takes. Operations return
+// immediately by default. Operations that are still waiting when their
+// context is done return the context's error.
+func WithLatency(d time.Duration) Option {
+	return func(o *options) {
+		o.latency = d
+	}
+}
+
+// WithThrottling configures the store to throttle operations to the supplied
+// rate, allowing bursts of the supplied size. Throttled operations fail with
+// ErrThrottled. Operations aren't throttled by default.
+func WithThrottling(r rate.Limit, burst int) Option {
+	return func(o *options) {
+		o.limiter = rate.NewLimiter(r, burst)
+	}
+}
+
+// WithFailures configures the store to inject the failures returned by the
+// supplied function. Failures are injected before an operation is applied,
+// so a failed write doesn't change the store.
+func WithFailures(fn FailureFn) Option {
+	return func(o *options) {
+		o.fail = fn
+	}
+}
+
+// WithClock configures the clock used to determine when writes become visible,
+// and to throttle operations. It doesn't affect latency.
+func WithClock(c clock.PassiveClock) Option {
+	return func(o *options) {
+		o.clock = c
+	}
+}
+
+// A version of a resource, written at a point in time.
+type version[T any] struct {
+	value   T
+	deleted bool
+	visible time.Time
+}
+
+// A Store is an in-memory fake external system that stores resources of type
+// T, keyed by ID. It's safe for concurrent use. Resources are stored by value,
+// so T shouldn't contain pointers, maps, or slices that callers mutate after
+// writing them.
+type Store[T any] struct {
+	opts options
+
+	mu        sync.Mutex
+	resources map[string][]version[T]
+}
+
+// New returns an empty store.
+func New[T any](o ...Option) *Store[T] {
+	opts := options{clock: clock.RealClock{}}
+	for _, fn := range o {
+		fn(&opts)
+	}
+
+	return &Store[T]{opts: opts, resources: make(map[string][]version[T])}
+}
+
+// Get the resource with the supplied ID, as visible to readers.
+func (s *Store[T]) Get(ctx context.Context, id string) (T, error) {
+	var zero T
+
+	if err := s.begin(ctx, OperationGet, id); err != nil {
+		return zero, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	v, ok := s.visible(id)
+	if !ok {
+		return zero, errors.Wrapf(ErrNotFound, errFmtGet, id)
+	}
+
+	return v, nil
+}
+
+// List all resources, as visible to readers.
+func (s *Store[T]) List(ctx context.Context) (map[string]T, error) {
+	if err := s.begin(ctx, OperationList, ""); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	out := make(map[string]T, len(s.resources))
+
+	for id := range s.resources {
+		if v, ok := s.visible(id); ok {
+			out[id] = v
+		}
+	}
+
+	return out, nil
+}
+
+// Create a resource with the supplied ID. It fails with ErrAlreadyExists if
+// the resource exists, even if it isn't yet visible to readers.
+func (s *Store[T]) Create(ctx context.Context, id string, v T) error {
+	if err := s.begin(ctx, OperationCreate, id); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.latest(id); ok {
+		return errors.Wrapf(ErrAlreadyExists, errFmtCreate, id)
+	}
+
+	s.write(id, version[T]{value: v})
+
+	return nil
+}
+
+// Update the resource with the supplied ID. It fails with ErrNotFound if the
+// resource doesn't exist, even if it's still visible to readers.
+func (s *Store[T]) Update(ctx context.Context, id string, v T) error {
+	if err := s.begin(ctx, OperationUpdate, id); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.latest(id); !ok {
+		return errors.Wrapf(ErrNotFound, errFmtUpdate, id)
+	}
+
+	s.write(id, version[T]{value: v})
+
+	return nil
+}
+
+// Delete the resource with the supplied ID. It fails with ErrNotFound if the
+// resource doesn't exist, even if it's still visible to readers.
+func (s *Store[T]) Delete(ctx context.Context, id string) error {
+	if err := s.begin(ctx, OperationDelete, id); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.latest(id); !ok {
+		return errors.Wrapf(ErrNotFound, errFmtDelete, id)
+	}
+
+	s.write(id, version[T]{deleted: true})
+
+	return nil
+}
+
+// Snapshot returns every resource in the store, including writes that aren't
+// yet visible to readers. It isn't subject to latency, throttling, or
+// failures, so it's suitable for use as a conformance.Fixture's Snapshot.
+// Deleted resources that are still visible to readers aren't included.
+func (s *Store[T]) Snapshot() map[string]T {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	out := make(map[string]T, len(s.resources))
+
+	for id := range s.resources {
+		if v, ok := s.latest(id); ok {
+			out[id] = v
+		}
+	}
+
+	return out
+}
+
+// begin an operation, waiting for its latency, then throttling it or
+// injecting a failure if configured to.
+func (s *Store[T]) begin(ctx context.Context, op Operation, id string) error {
+	if s.opts.latency > 0 {
+		t := time.NewTimer(s.opts.latency)
+		defer t.Stop()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if s.opts.limiter != nil && !s.opts.limiter.AllowN(s.opts.clock.Now(), 1) {
+		return errors.Wrapf(ErrThrottled, errFmtOp, op, id)
+	}
+
+	if s.opts.fail != nil {
+		return s.opts.fail(op, id)
+	}
+
+	return nil
+}
+
+// write a new version of the resource with the supplied ID. Versions that
+// will never be read again are discarded. The caller must hold the lock.
+func (s *Store[T]) write(id string, v version[T]) {
+	now := s.opts.clock.Now()
+	v.visible = now.Add(s.opts.delay)
+
+	versions := s.resources[id]
+
+	// Keep only the most recent visible version, and any that aren't yet
+	// visible. Older versions will never be read again.
+	for i := len(versions) - 1; i >= 0; i-- {
+		if !versions[i].visible.After(now) {
+			versions = versions[i:]
+			break
+		}
+	}
+
+	s.resources[id] = append(versions, v)
+}
+
+// latest returns the most recent version of the resource with the supplied
+// ID, whether or not it's visible. The caller must hold the lock.
+func (s *Store[T]) latest(id string) (T, bool) {
+	var zero T
+
+	versions := s.resources[id]
+	if len(versions) == 0 || versions[len(versions)-1].deleted {
+		return zero, false
+	}
+
+	return versions[len(versions)-1].value, true
+}
+
+// visible returns the most recent visible version of the resource with the
+// supplied ID. The caller must hold the lock.
+func (s *Store[T]) visible(id string) (T, bool) {
+	var zero T
+
+	now := s.opts.clock.Now()
+	versions := s.resources[id]
+
+	for i := len(versions) - 1; i >= 0; i-- {
+		if versions[i].visible.After(now) {
+			continue
+		}
+
+		if versions[i].deleted {
+			return zero, false
+		}
+
+		return versions[i].value, true
+	}
+
+	return zero, false
+}
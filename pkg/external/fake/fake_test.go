@@ -0,0 +1,230 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+)
+
+func TestStore(t *testing.T) {
+	ctx := context.Background()
+
+	s := New[string]()
+
+	if err := s.Create(ctx, "a", "cool"); err != nil {
+		t.Fatalf("s.Create(...): %v", err)
+	}
+
+	if err := s.Create(ctx, "a", "cool"); !errors.Is(err, ErrAlreadyExists) {
+		t.Errorf("s.Create(...): want ErrAlreadyExists creating a resource that exists, got %v", err)
+	}
+
+	if err := s.Update(ctx, "a", "cooler"); err != nil {
+		t.Fatalf("s.Update(...): %v", err)
+	}
+
+	got, err := s.Get(ctx, "a")
+	if err != nil {
+		t.Fatalf("s.Get(...): %v", err)
+	}
+
+	if diff := cmp.Diff("cooler", got); diff != "" {
+		t.Errorf("s.Get(...): -want, +got:\n%s", diff)
+	}
+
+	all, err := s.List(ctx)
+	if err != nil {
+		t.Fatalf("s.List(...): %v", err)
+	}
+
+	if diff := cmp.Diff(map[string]string{"a": "cooler"}, all); diff != "" {
+		t.Errorf("s.List(...): -want, +got:\n%s", diff)
+	}
+
+	if err := s.Delete(ctx, "a"); err != nil {
+		t.Fatalf("s.Delete(...): %v", err)
+	}
+
+	if _, err := s.Get(ctx, "a"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("s.Get(...): want ErrNotFound getting a deleted resource, got %v", err)
+	}
+
+	if err := s.Update(ctx, "a", "coolest"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("s.Update(...): want ErrNotFound updating a deleted resource, got %v", err)
+	}
+
+	if err := s.Delete(ctx, "a"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("s.Delete(...): want ErrNotFound deleting a deleted resource, got %v", err)
+	}
+}
+
+func TestPropagationDelay(t *testing.T) {
+	ctx := context.Background()
+	clk := clocktesting.NewFakePassiveClock(time.Now())
+
+	s := New[string](WithPropagationDelay(time.Minute), WithClock(clk))
+
+	if err := s.Create(ctx, "a", "cool"); err != nil {
+		t.Fatalf("s.Create(...): %v", err)
+	}
+
+	if _, err := s.Get(ctx, "a"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("s.Get(...): want ErrNotFound before a create propagates, got %v", err)
+	}
+
+	if err := s.Create(ctx, "a", "cool"); !errors.Is(err, ErrAlreadyExists) {
+		t.Errorf("s.Create(...): want ErrAlreadyExists before a create propagates, got %v", err)
+	}
+
+	if diff := cmp.Diff(map[string]string{"a": "cool"}, s.Snapshot()); diff != "" {
+		t.Errorf("s.Snapshot(): before a create propagates -want, +got:\n%s", diff)
+	}
+
+	clk.SetTime(clk.Now().Add(time.Minute))
+
+	if err := s.Update(ctx, "a", "cooler"); err != nil {
+		t.Fatalf("s.Update(...): %v", err)
+	}
+
+	got, err := s.Get(ctx, "a")
+	if err != nil {
+		t.Fatalf("s.Get(...): %v", err)
+	}
+
+	if diff := cmp.Diff("cool", got); diff != "" {
+		t.Errorf("s.Get(...): before an update propagates -want, +got:\n%s", diff)
+	}
+
+	clk.SetTime(clk.Now().Add(30 * time.Second))
+
+	if err := s.Delete(ctx, "a"); err != nil {
+		t.Fatalf("s.Delete(...): %v", err)
+	}
+
+	clk.SetTime(clk.Now().Add(30 * time.Second))
+
+	got, err = s.Get(ctx, "a")
+	if err != nil {
+		t.Fatalf("s.Get(...): %v", err)
+	}
+
+	if diff := cmp.Diff("cooler", got); diff != "" {
+		t.Errorf("s.Get(...): after an update propagates, before a delete propagates -want, +got:\n%s", diff)
+	}
+
+	if diff := cmp.Diff(map[string]string{}, s.Snapshot()); diff != "" {
+		t.Errorf("s.Snapshot(): before a delete propagates -want, +got:\n%s", diff)
+	}
+
+	clk.SetTime(clk.Now().Add(30 * time.Second))
+
+	if _, err := s.Get(ctx, "a"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("s.Get(...): want ErrNotFound after a delete propagates, got %v", err)
+	}
+}
+
+func TestThrottling(t *testing.T) {
+	ctx := context.Background()
+	clk := clocktesting.NewFakePassiveClock(time.Now())
+
+	s := New[string](WithThrottling(1, 2), WithClock(clk))
+
+	for i := range 2 {
+		if _, err := s.List(ctx); err != nil {
+			t.Errorf("s.List(...): call %d within burst: %v", i, err)
+		}
+	}
+
+	if _, err := s.List(ctx); !errors.Is(err, ErrThrottled) {
+		t.Errorf("s.List(...): want ErrThrottled after burst, got %v", err)
+	}
+
+	clk.SetTime(clk.Now().Add(time.Second))
+
+	if _, err := s.List(ctx); err != nil {
+		t.Errorf("s.List(...): after waiting: %v", err)
+	}
+}
+
+func TestFailures(t *testing.T) {
+	ctx := context.Background()
+	errBoom := errors.New("boom")
+
+	s := New[string](WithFailures(FailEvery(2, errBoom)))
+
+	if err := s.Create(ctx, "a", "cool"); err != nil {
+		t.Fatalf("s.Create(...): %v", err)
+	}
+
+	if err := s.Update(ctx, "a", "cooler"); !errors.Is(err, errBoom) {
+		t.Errorf("s.Update(...): want injected failure, got %v", err)
+	}
+
+	got, err := s.Get(ctx, "a")
+	if err != nil {
+		t.Fatalf("s.Get(...): %v", err)
+	}
+
+	if diff := cmp.Diff("cool", got); diff != "" {
+		t.Errorf("s.Get(...): a failed update shouldn't change the store: -want, +got:\n%s", diff)
+	}
+}
+
+func TestFailRandomly(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	count := func(fn FailureFn) int {
+		n := 0
+
+		for range 1000 {
+			if fn(OperationGet, "a") != nil {
+				n++
+			}
+		}
+
+		return n
+	}
+
+	first := count(FailRandomly(0.1, 42, errBoom))
+	second := count(FailRandomly(0.1, 42, errBoom))
+
+	if diff := cmp.Diff(first, second); diff != "" {
+		t.Errorf("FailRandomly(...): the same seed should fail the same operations: -first, +second:\n%s", diff)
+	}
+
+	if first < 50 || first > 150 {
+		t.Errorf("FailRandomly(...): want about 100 of 1000 operations to fail, got %d", first)
+	}
+}
+
+func TestLatency(t *testing.T) {
+	s := New[string](WithLatency(time.Minute))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+
+	if _, err := s.Get(ctx, "a"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("s.Get(...): want context.DeadlineExceeded when latency exceeds the deadline, got %v", err)
+	}
+}
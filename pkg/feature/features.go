@@ -25,3 +25,8 @@ const EnableBetaManagementPolicies Flag = "EnableBetaManagementPolicies"
 // reconciliation. See the following design for more details:
 // https://github.com/crossplane/crossplane/pull/5822
 const EnableAlphaChangeLogs Flag = "EnableAlphaChangeLogs"
+
+// EnableAlphaFaultInjection enables alpha support for injecting faults into
+// calls to external systems, to test how controllers behave when they fail.
+// It must never be enabled in production.
+const EnableAlphaFaultInjection Flag = "EnableAlphaFaultInjection"
@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fieldpath
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// Field paths that have caused, or could plausibly cause, trouble.
+var fuzzPaths = []string{
+	"",
+	".",
+	"[",
+	"]",
+	"[]",
+	"..",
+	"spec",
+	"spec.forProvider.name",
+	"spec.containers[0].name",
+	"spec.containers[*].args[*]",
+	"metadata.annotations[crossplane.io/external-name]",
+	"metadata.labels[app.kubernetes.io/name]",
+	"spec[a.b.c]",
+	"spec.list[4294967295]",
+	"spec.list[4294967296]",
+	"spec.list[-1]",
+	"spec.list[1][2][3]",
+	"spec.[0]",
+	"spec..name",
+	"spec.name.",
+	"spec[name",
+	"spec]name[",
+	"[*]",
+	"[0]",
+}
+
+func FuzzParse(f *testing.F) {
+	for _, p := range fuzzPaths {
+		f.Add(p)
+	}
+
+	f.Fuzz(func(_ *testing.T, path string) {
+		s, err := Parse(path)
+		if err != nil {
+			return
+		}
+
+		_ = s.String()
+	})
+}
+
+func FuzzPaved(f *testing.F) {
+	objects := []string{
+		`{}`,
+		`{"spec":{"forProvider":{"name":"cool"}}}`,
+		`{"spec":{"containers":[{"name":"cool","args":["a","b"]}]}}`,
+		`{"metadata":{"annotations":{"crossplane.io/external-name":"cool"}}}`,
+		`{"spec":{"list":[[1,2],[3,[4,5]]]}}`,
+		`{"spec":null}`,
+		`{"spec":[]}`,
+	}
+
+	for _, o := range objects {
+		for _, p := range fuzzPaths {
+			f.Add([]byte(o), p, []byte(`"value"`))
+		}
+	}
+
+	f.Add([]byte(`{"a":{"b":1}}`), "a.b.c", []byte(`{"d":[1,2,3]}`))
+	f.Add([]byte(`{"a":[1]}`), "a[100]", []byte(`null`))
+
+	f.Fuzz(func(_ *testing.T, object []byte, path string, value []byte) {
+		obj := map[string]any{}
+		if err := json.Unmarshal(object, &obj); err != nil {
+			return
+		}
+
+		var v any
+		if err := json.Unmarshal(value, &v); err != nil {
+			return
+		}
+
+		// None of these should panic, regardless of the object or path. Some
+		// mutate the object, so we run the reads both before and after.
+		p := Pave(obj)
+		_, _ = p.GetValue(path)
+		_, _ = p.ExpandWildcards(path)
+		_ = p.SetValue(path, v)
+		_, _ = p.GetValue(path)
+		_, _ = p.ExpandWildcards(path)
+		_ = p.MergeValue(path, v, nil)
+		_ = p.DeleteField(path)
+
+		lp := PaveJSON(object)
+		_, _ = lp.GetValue(path)
+		_ = lp.SetValue(path, v)
+		_, _ = lp.GetRaw(path)
+	})
+}
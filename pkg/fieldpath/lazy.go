@@ -0,0 +1,224 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fieldpath
+
+import (
+	"bytes"
+	"encoding/json"
+
+	utiljson "k8s.io/apimachinery/pkg/util/json"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+)
+
+var jsonNull = json.RawMessage("null")
+
+// A LazyPaved is a JSON object that can be read and written by field path
+// without decoding it into a map[string]any. Only the objects and arrays
+// along a field path are decoded, and only one level at a time, so reading a
+// small field of a very large object allocates far less than paving it.
+type LazyPaved struct {
+	raw json.RawMessage
+}
+
+// PaveJSON lazily paves the supplied JSON object. The data is not validated
+// until it's read or written.
+func PaveJSON(data []byte) *LazyPaved {
+	return &LazyPaved{raw: data}
+}
+
+// MarshalJSON returns the underlying JSON object.
+func (p *LazyPaved) MarshalJSON() ([]byte, error) {
+	if len(p.raw) == 0 {
+		return []byte("{}"), nil
+	}
+
+	return p.raw, nil
+}
+
+// UnmarshalJSON sets the underlying JSON object. The data is not validated
+// until it's read or written.
+func (p *LazyPaved) UnmarshalJSON(data []byte) error {
+	p.raw = bytes.Clone(data)
+	return nil
+}
+
+// GetRaw returns the JSON encoded value at the supplied field path.
+func (p *LazyPaved) GetRaw(path string) (json.RawMessage, error) {
+	segments, err := Parse(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot parse path %q", path)
+	}
+
+	return getRaw(p.raw, segments)
+}
+
+// GetValue of the supplied field path.
+func (p *LazyPaved) GetValue(path string) (any, error) {
+	var out any
+	if err := p.GetValueInto(path, &out); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// GetValueInto the supplied type. The value is decoded directly from the
+// underlying JSON object. Like Paved, integers decoded into an interface are
+// int64.
+func (p *LazyPaved) GetValueInto(path string, out any) error {
+	raw, err := p.GetRaw(path)
+	if err != nil {
+		return err
+	}
+
+	return errors.Wrap(utiljson.Unmarshal(raw, out), "cannot unmarshal value from JSON")
+}
+
+// GetString value of the supplied field path.
+func (p *LazyPaved) GetString(path string) (string, error) {
+	v, err := p.GetValue(path)
+	if err != nil {
+		return "", err
+	}
+
+	s, ok := v.(string)
+	if !ok {
+		return "", errors.Errorf("%s: not a string", path)
+	}
+
+	return s, nil
+}
+
+// SetValue at the supplied field path. Only the objects and arrays along the
+// field path are decoded and re-encoded.
+func (p *LazyPaved) SetValue(path string, value any) error {
+	segments, err := Parse(path)
+	if err != nil {
+		return errors.Wrapf(err, "cannot parse path %q", path)
+	}
+
+	for _, s := range segments {
+		if s.Type == SegmentIndex && s.Index > DefaultMaxFieldPathIndex {
+			return errors.Errorf("index %v is greater than max allowed index %d", s.Index, DefaultMaxFieldPathIndex)
+		}
+	}
+
+	v, err := json.Marshal(value)
+	if err != nil {
+		return errors.Wrap(err, "cannot marshal value to JSON")
+	}
+
+	raw, err := setRaw(p.raw, segments, 0, v)
+	if err != nil {
+		return err
+	}
+
+	p.raw = raw
+
+	return nil
+}
+
+func isNull(raw json.RawMessage) bool {
+	return len(raw) == 0 || bytes.Equal(bytes.TrimSpace(raw), jsonNull)
+}
+
+func getRaw(raw json.RawMessage, s Segments) (json.RawMessage, error) {
+	for i, current := range s {
+		switch current.Type {
+		case SegmentIndex:
+			var array []json.RawMessage
+			if isNull(raw) || json.Unmarshal(raw, &array) != nil {
+				return nil, errors.Errorf("%s: not an array", s[:i])
+			}
+
+			if current.Index >= uint(len(array)) {
+				return nil, notFoundError{errors.Errorf("%s: no such element", s[:i+1])}
+			}
+
+			raw = array[current.Index]
+		case SegmentField:
+			if isNull(raw) {
+				return nil, notFoundError{errors.Errorf("%s: expected map, got nil", s[:i])}
+			}
+
+			var object map[string]json.RawMessage
+			if err := json.Unmarshal(raw, &object); err != nil {
+				return nil, errors.Errorf("%s: not an object", s[:i])
+			}
+
+			v, ok := object[current.Field]
+			if !ok {
+				return nil, notFoundError{errors.Errorf("%s: no such field", s[:i+1])}
+			}
+
+			raw = v
+		}
+	}
+
+	return raw, nil
+}
+
+func setRaw(raw json.RawMessage, s Segments, i int, value json.RawMessage) (json.RawMessage, error) {
+	if i == len(s) {
+		return value, nil
+	}
+
+	current := s[i]
+
+	switch current.Type {
+	case SegmentIndex:
+		var array []json.RawMessage
+		if !isNull(raw) && json.Unmarshal(raw, &array) != nil {
+			return nil, errors.Errorf("%s: not an array", s[:i])
+		}
+
+		for uint(len(array)) <= current.Index {
+			array = append(array, jsonNull)
+		}
+
+		v, err := setRaw(array[current.Index], s, i+1, value)
+		if err != nil {
+			return nil, err
+		}
+
+		array[current.Index] = v
+
+		out, err := json.Marshal(array)
+
+		return out, errors.Wrap(err, "cannot marshal array to JSON")
+	case SegmentField:
+		object := make(map[string]json.RawMessage)
+		if !isNull(raw) && json.Unmarshal(raw, &object) != nil {
+			return nil, errors.Errorf("%s: not an object", s[:i])
+		}
+
+		v, err := setRaw(object[current.Field], s, i+1, value)
+		if err != nil {
+			return nil, err
+		}
+
+		object[current.Field] = v
+
+		out, err := json.Marshal(object)
+
+		return out, errors.Wrap(err, "cannot marshal object to JSON")
+	}
+
+	// This should be unreachable.
+	return raw, nil
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fieldpath
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+)
+
+// LazyPaved should behave exactly like Paved, so these tests compare the two.
+
+func TestLazyPavedGetValue(t *testing.T) {
+	data := []byte(`{"metadata":{"name":"cool","labels":null},"spec":{"containers":[{"name":"cool"}],"items":[["a","b"]],"size":3}}`)
+
+	cases := map[string]struct {
+		reason string
+		path   string
+	}{
+		"Field":          {reason: "We should get a field from a nested object.", path: "metadata.name"},
+		"Object":         {reason: "We should get a whole object.", path: "spec.containers[0]"},
+		"NestedArray":    {reason: "We should get a field from a nested array.", path: "spec.items[0][1]"},
+		"Number":         {reason: "We should get a number.", path: "spec.size"},
+		"NoSuchField":    {reason: "We should return a not found error for a missing field.", path: "metadata.namespace"},
+		"NoSuchElement":  {reason: "We should return a not found error for a missing element.", path: "spec.containers[1]"},
+		"NilParent":      {reason: "We should return a not found error for a field of a null object.", path: "metadata.labels.cool"},
+		"NotAnArray":     {reason: "We should return an error when indexing an object.", path: "metadata[0]"},
+		"NotAnObject":    {reason: "We should return an error when getting a field of an array.", path: "spec.containers.name"},
+		"MalformedPath":  {reason: "We should return an error for a malformed path.", path: "spec[]"},
+		"FieldOfAString": {reason: "We should return an error when getting a field of a string.", path: "metadata.name.cool"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := Pave(map[string]any{})
+			if err := json.Unmarshal(data, p); err != nil {
+				t.Fatal(err)
+			}
+
+			want, werr := p.GetValue(tc.path)
+			got, gerr := PaveJSON(data).GetValue(tc.path)
+
+			if diff := cmp.Diff(werr, gerr, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nGetValue(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(IsNotFound(werr), IsNotFound(gerr)); diff != "" {
+				t.Errorf("\n%s\nGetValue(...): -want not found, +got not found:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("\n%s\nGetValue(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestLazyPavedSetValue(t *testing.T) {
+	type want struct {
+		object map[string]any
+		err    error
+	}
+
+	cases := map[string]struct {
+		reason string
+		data   []byte
+		path   string
+		value  any
+		want   want
+	}{
+		"NewNestedField": {
+			reason: "We should create any missing objects along the path.",
+			data:   []byte(`{"metadata":{"name":"cool"}}`),
+			path:   "status.atProvider.id",
+			value:  "cool-id",
+			want: want{
+				object: map[string]any{
+					"metadata": map[string]any{"name": "cool"},
+					"status":   map[string]any{"atProvider": map[string]any{"id": "cool-id"}},
+				},
+			},
+		},
+		"ReplaceField": {
+			reason: "We should replace an existing field, leaving its siblings as is.",
+			data:   []byte(`{"spec":{"size":3,"region":"us-east-1"}}`),
+			path:   "spec.size",
+			value:  4,
+			want: want{
+				object: map[string]any{"spec": map[string]any{"size": float64(4), "region": "us-east-1"}},
+			},
+		},
+		"ExtendArray": {
+			reason: "We should pad an array with nulls when setting an index past its end.",
+			data:   []byte(`{"spec":{"items":["a"]}}`),
+			path:   "spec.items[2]",
+			value:  "c",
+			want: want{
+				object: map[string]any{"spec": map[string]any{"items": []any{"a", nil, "c"}}},
+			},
+		},
+		"NotAnObject": {
+			reason: "We should return an error when setting a field of an array.",
+			data:   []byte(`{"spec":["a"]}`),
+			path:   "spec.size",
+			value:  3,
+			want: want{
+				err: errors.New("spec: not an object"),
+			},
+		},
+		"IndexTooLarge": {
+			reason: "We should refuse to set an index greater than the max allowed index.",
+			data:   []byte(`{}`),
+			path:   "spec.items[1025]",
+			value:  "a",
+			want: want{
+				err: errors.Errorf("index 1025 is greater than max allowed index %d", DefaultMaxFieldPathIndex),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := PaveJSON(tc.data)
+
+			err := p.SetValue(tc.path, tc.value)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nSetValue(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+
+			if tc.want.err != nil {
+				return
+			}
+
+			j, err := p.MarshalJSON()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := map[string]any{}
+			if err := json.Unmarshal(j, &got); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(tc.want.object, got); diff != "" {
+				t.Errorf("\n%s\nSetValue(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
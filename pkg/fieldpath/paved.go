@@ -174,7 +174,7 @@ func (p *Paved) ExpandWildcards(path string) ([]string, error) {
 		return nil, errors.Wrapf(err, "cannot parse path %q", path)
 	}
 
-	segmentsArray, err := expandWildcards(p.object, segments)
+	segmentsArray, err := expandWildcards(p.object, segments, 0)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot expand wildcards for segments: %q", segments)
 	}
@@ -187,14 +187,31 @@ func (p *Paved) ExpandWildcards(path string) ([]string, error) {
 	return paths, nil
 }
 
-func expandWildcards(data any, segments Segments) ([]Segments, error) { //nolint:gocognit // See note below.
+// expandWildcards expands the wildcards in the supplied segments, starting
+// from the segment at the supplied index. Segments before it have already been
+// expanded; they may be fields literally named "*".
+func expandWildcards(data any, segments Segments, from int) ([]Segments, error) { //nolint:gocognit // See note below.
 	// Even complexity turns out to be high, it is mostly because we have duplicate
 	// logic for arrays and maps and a couple of error handling.
 	var res []Segments
 
 	it := data
 
-	for i, current := range segments {
+	if from > 0 {
+		var err error
+
+		it, err = getValueFromInterface(data, segments[:from])
+		if IsNotFound(err) {
+			return nil, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for i := from; i < len(segments); i++ {
+		current := segments[i]
 		// wildcards are regular fields with "*" as string
 		if current.Type == SegmentField && current.Field == wildcard {
 			switch mapOrArray := it.(type) {
@@ -204,7 +221,7 @@ func expandWildcards(data any, segments Segments) ([]Segments, error) { //nolint
 					copy(expanded, segments)
 					expanded = append(append(expanded[:i], FieldOrIndex(strconv.Itoa(ix))), expanded[i+1:]...)
 
-					r, err := expandWildcards(data, expanded)
+					r, err := expandWildcards(data, expanded, i+1)
 					if err != nil {
 						return nil, errors.Wrapf(err, "%q: cannot expand wildcards", expanded)
 					}
@@ -217,7 +234,7 @@ func expandWildcards(data any, segments Segments) ([]Segments, error) { //nolint
 					copy(expanded, segments)
 					expanded = append(append(expanded[:i], Field(k)), expanded[i+1:]...)
 
-					r, err := expandWildcards(data, expanded)
+					r, err := expandWildcards(data, expanded, i+1)
 					if err != nil {
 						return nil, errors.Wrapf(err, "%q: cannot expand wildcards", expanded)
 					}
@@ -867,6 +867,14 @@ func TestExpandWildcards(t *testing.T) {
 				expanded: []string{},
 			},
 		},
+		"WildcardMatchesFieldNamedWildcard": {
+			reason: "It should expand a wildcard that matches a field literally named *, rather than expanding it again",
+			path:   "spec[*].name",
+			data:   []byte(`{"spec":{"*":{"name":"cool"}}}`),
+			want: want{
+				expanded: []string{"spec[*].name"},
+			},
+		},
 		"NestedNoWildcardExisting": {
 			reason: "It should return same path if no wildcard in an existing path",
 			path:   "items[0][1]",
@@ -0,0 +1,171 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package function runs composition functions.
+//
+// The function protocol's RunFunctionRequest and RunFunctionResponse types are
+// generated from protobuf definitions maintained alongside Crossplane, not
+// this repository. This package's client is generic over them, so callers use
+// it with the generated types they already depend on, e.g.:
+//
+//	c := function.NewClient(conn, func() *fnv1.RunFunctionResponse { return &fnv1.RunFunctionResponse{} })
+//	rsp, err := c.Run(ctx, req)
+package function
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+)
+
+// RunFunctionMethod is the full gRPC method name of the function protocol's
+// RunFunction RPC.
+const RunFunctionMethod = "/apiextensions.fn.proto.v1.FunctionRunnerService/RunFunction"
+
+const defaultTimeout = 60 * time.Second
+
+// Error strings.
+const (
+	errRunFunction      = "cannot run function"
+	errInvalidResponse  = "invalid function response"
+	errFmtTagMismatch   = "response tag %q does not match request tag %q"
+	errFmtFatalResult   = "function returned a fatal result: %s"
+	errFmtNoMessageType = "field %q is not a message"
+)
+
+var defaultBackoff = wait.Backoff{
+	Steps:    3,
+	Duration: 100 * time.Millisecond,
+	Factor:   2.0,
+	Jitter:   0.1,
+}
+
+// A ResponseValidator validates the response a function returned to the
+// supplied request.
+type ResponseValidator func(req, rsp proto.Message) error
+
+// A ClientOption configures a Client.
+type ClientOption func(o *clientOptions)
+
+type clientOptions struct {
+	method   string
+	timeout  time.Duration
+	backoff  wait.Backoff
+	validate []ResponseValidator
+}
+
+// WithMethod configures the gRPC method the client calls. It's
+// RunFunctionMethod by default.
+func WithMethod(m string) ClientOption {
+	return func(o *clientOptions) {
+		o.method = m
+	}
+}
+
+// WithTimeout configures how long each attempt to run a function may take. It
+// is 60 seconds by default. The deadline of the context passed to Run applies
+// to all attempts.
+func WithTimeout(d time.Duration) ClientOption {
+	return func(o *clientOptions) {
+		o.timeout = d
+	}
+}
+
+// WithBackoff configures how the client retries a function that is
+// unavailable or overloaded. By default it makes up to 3 attempts.
+func WithBackoff(b wait.Backoff) ClientOption {
+	return func(o *clientOptions) {
+		o.backoff = b
+	}
+}
+
+// WithResponseValidators configures the client to validate each response
+// using the supplied validators, in order. By default responses are validated
+// using ValidateTag and ValidateNoFatalResults.
+func WithResponseValidators(v ...ResponseValidator) ClientOption {
+	return func(o *clientOptions) {
+		o.validate = v
+	}
+}
+
+// A Client runs a composition function using the function protocol.
+type Client[Req, Rsp proto.Message] struct {
+	conn   grpc.ClientConnInterface
+	newRsp func() Rsp
+	opts   clientOptions
+}
+
+// NewClient returns a client that runs a function using the supplied gRPC
+// connection. The supplied function must return a new, empty response.
+func NewClient[Req, Rsp proto.Message](conn grpc.ClientConnInterface, newRsp func() Rsp, o ...ClientOption) *Client[Req, Rsp] {
+	opts := clientOptions{
+		method:   RunFunctionMethod,
+		timeout:  defaultTimeout,
+		backoff:  defaultBackoff,
+		validate: []ResponseValidator{ValidateTag, ValidateNoFatalResults},
+	}
+
+	for _, fn := range o {
+		fn(&opts)
+	}
+
+	return &Client[Req, Rsp]{conn: conn, newRsp: newRsp, opts: opts}
+}
+
+// Run the function with the supplied request. Attempts that fail because the
+// function is unavailable or overloaded are retried.
+func (c *Client[Req, Rsp]) Run(ctx context.Context, req Req) (Rsp, error) {
+	var rsp Rsp
+
+	err := retry.OnError(c.opts.backoff, func(err error) bool { return ctx.Err() == nil && IsRetryable(err) }, func() error {
+		actx, cancel := context.WithTimeout(ctx, c.opts.timeout)
+		defer cancel()
+
+		rsp = c.newRsp()
+
+		return c.conn.Invoke(actx, c.opts.method, req, rsp)
+	})
+	if err != nil {
+		return rsp, errors.Wrap(err, errRunFunction)
+	}
+
+	for _, v := range c.opts.validate {
+		if err := v(req, rsp); err != nil {
+			return rsp, errors.Wrap(err, errInvalidResponse)
+		}
+	}
+
+	return rsp, nil
+}
+
+// IsRetryable returns true if the supplied error indicates the function was
+// unavailable or overloaded, and may succeed if retried.
+func IsRetryable(err error) bool {
+	switch status.Code(err) { //nolint:exhaustive // Other codes aren't retryable.
+	case codes.Unavailable, codes.ResourceExhausted:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,208 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package function
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/structpb"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+)
+
+// serve a fake function that responds to each request by calling the supplied
+// function, and return a connection to it.
+func serve(t *testing.T, fn func(req *structpb.Struct) (*structpb.Struct, error)) *grpc.ClientConn {
+	t.Helper()
+
+	lis := bufconn.Listen(1024 * 1024)
+	srv := grpc.NewServer()
+	srv.RegisterService(&grpc.ServiceDesc{
+		ServiceName: "apiextensions.fn.proto.v1.FunctionRunnerService",
+		HandlerType: (*any)(nil),
+		Methods: []grpc.MethodDesc{{
+			MethodName: "RunFunction",
+			Handler: func(_ any, _ context.Context, dec func(any) error, _ grpc.UnaryServerInterceptor) (any, error) {
+				req := &structpb.Struct{}
+				if err := dec(req); err != nil {
+					return nil, err
+				}
+				return fn(req)
+			},
+		}},
+	}, struct{}{})
+
+	go srv.Serve(lis) //nolint:errcheck // Serve returns when the server is stopped.
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("grpc.NewClient(...): %v", err)
+	}
+
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return conn
+}
+
+func TestClientRun(t *testing.T) {
+	errBoom := errors.New("boom")
+	req, _ := structpb.NewStruct(map[string]any{"cool": true})
+	rsp, _ := structpb.NewStruct(map[string]any{"cooler": true})
+
+	type args struct {
+		// errs are returned by successive calls before the function responds.
+		errs []error
+		o    []ClientOption
+	}
+
+	type want struct {
+		rsp   *structpb.Struct
+		err   error
+		calls int
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"Success": {
+			reason: "The function's response should be returned.",
+			want: want{
+				rsp:   rsp,
+				calls: 1,
+			},
+		},
+		"RetryUnavailable": {
+			reason: "A function that is unavailable should be retried.",
+			args: args{
+				errs: []error{status.Error(codes.Unavailable, "boom")},
+			},
+			want: want{
+				rsp:   rsp,
+				calls: 2,
+			},
+		},
+		"RetriesExhausted": {
+			reason: "An error should be returned if a function is unavailable for every attempt.",
+			args: args{
+				errs: []error{status.Error(codes.Unavailable, "boom"), status.Error(codes.Unavailable, "boom")},
+				o:    []ClientOption{WithBackoff(wait.Backoff{Steps: 2, Duration: time.Millisecond})},
+			},
+			want: want{
+				err:   errors.Wrap(status.Error(codes.Unavailable, "boom"), errRunFunction),
+				calls: 2,
+			},
+		},
+		"NotRetryable": {
+			reason: "A function that returns an error that isn't retryable should not be retried.",
+			args: args{
+				errs: []error{status.Error(codes.InvalidArgument, "boom")},
+			},
+			want: want{
+				err:   errors.Wrap(status.Error(codes.InvalidArgument, "boom"), errRunFunction),
+				calls: 1,
+			},
+		},
+		"InvalidResponse": {
+			reason: "An error should be returned if the response is invalid.",
+			args: args{
+				o: []ClientOption{WithResponseValidators(func(_, _ proto.Message) error { return errBoom })},
+			},
+			want: want{
+				rsp:   rsp,
+				err:   errors.Wrap(errBoom, errInvalidResponse),
+				calls: 1,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			calls := 0
+			conn := serve(t, func(got *structpb.Struct) (*structpb.Struct, error) {
+				calls++
+
+				if diff := cmp.Diff(req, got, protocmp.Transform()); diff != "" {
+					t.Errorf("\n%s\nRun(...): -want request, +got request:\n%s", tc.reason, diff)
+				}
+
+				if calls <= len(tc.args.errs) {
+					return nil, tc.args.errs[calls-1]
+				}
+
+				return rsp, nil
+			})
+
+			c := NewClient[*structpb.Struct](conn, func() *structpb.Struct { return &structpb.Struct{} }, tc.args.o...)
+
+			got, err := c.Run(context.Background(), req)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nRun(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+
+			if tc.want.rsp != nil {
+				if diff := cmp.Diff(tc.want.rsp, got, protocmp.Transform()); diff != "" {
+					t.Errorf("\n%s\nRun(...): -want, +got:\n%s", tc.reason, diff)
+				}
+			}
+
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("\n%s\nRun(...): -want calls, +got calls:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want bool
+	}{
+		"Nil":               {err: nil, want: false},
+		"NotStatus":         {err: errors.New("boom"), want: false},
+		"Unavailable":       {err: status.Error(codes.Unavailable, "boom"), want: true},
+		"ResourceExhausted": {err: status.Error(codes.ResourceExhausted, "boom"), want: true},
+		"Wrapped":           {err: errors.Wrap(status.Error(codes.Unavailable, "boom"), "wrapped"), want: true},
+		"InvalidArgument":   {err: status.Error(codes.InvalidArgument, "boom"), want: false},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsRetryable(tc.err); got != tc.want {
+				t.Errorf("IsRetryable(%v): want %t, got %t", tc.err, tc.want, got)
+			}
+		})
+	}
+}
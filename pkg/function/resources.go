@@ -0,0 +1,50 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package function
+
+import (
+	"google.golang.org/protobuf/types/known/structpb"
+	kunstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/protobuf"
+)
+
+// AsStruct returns the supplied resource, for example a composite or composed
+// resource, as a protobuf struct suitable for a function request.
+func AsStruct(o runtime.Object) (*structpb.Struct, error) {
+	return protobuf.AsStruct(o)
+}
+
+// AsStructs returns the supplied resources, keyed by name, as protobuf
+// structs suitable for a function request.
+func AsStructs[T runtime.Object](rs map[string]T) (map[string]*structpb.Struct, error) {
+	return protobuf.AsStructs(rs)
+}
+
+// FromStruct returns the supplied protobuf struct, for example a resource
+// from a function response, as an unstructured resource.
+func FromStruct(s *structpb.Struct) (*kunstructured.Unstructured, error) {
+	u := &kunstructured.Unstructured{}
+	return u, protobuf.FromStruct(s, u)
+}
+
+// FromStructs returns the supplied protobuf structs, keyed by name, as
+// unstructured resources.
+func FromStructs(ss map[string]*structpb.Struct) (map[string]*kunstructured.Unstructured, error) {
+	return protobuf.FromStructs(ss)
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package function
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	kunstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestStructsRoundTrip(t *testing.T) {
+	rs := map[string]*kunstructured.Unstructured{
+		"bucket": {Object: map[string]any{
+			"apiVersion": "example.org/v1",
+			"kind":       "Bucket",
+			"metadata":   map[string]any{"name": "cool"},
+			"spec":       map[string]any{"forProvider": map[string]any{"region": "us-east-1", "versioned": true, "retentionDays": int64(30)}},
+		}},
+	}
+
+	ss, err := AsStructs(rs)
+	if err != nil {
+		t.Fatalf("AsStructs(...): %v", err)
+	}
+
+	got, err := FromStructs(ss)
+	if err != nil {
+		t.Fatalf("FromStructs(...): %v", err)
+	}
+
+	if diff := cmp.Diff(rs, got); diff != "" {
+		t.Errorf("FromStructs(AsStructs(...)): -want, +got:\n%s", diff)
+	}
+}
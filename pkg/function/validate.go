@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package function
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+)
+
+// Field and enum value names of the function protocol.
+const (
+	fieldMeta     = "meta"
+	fieldTag      = "tag"
+	fieldResults  = "results"
+	fieldSeverity = "severity"
+	fieldMessage  = "message"
+
+	severityFatal = "SEVERITY_FATAL"
+)
+
+// ValidateTag validates that a response has the same tag as its request, if
+// the request has a tag. Functions must copy the tag of a request to its
+// response.
+func ValidateTag(req, rsp proto.Message) error {
+	want, err := tag(req)
+	if err != nil || want == "" {
+		return err
+	}
+
+	got, err := tag(rsp)
+	if err != nil {
+		return err
+	}
+
+	if got != want {
+		return errors.Errorf(errFmtTagMismatch, got, want)
+	}
+
+	return nil
+}
+
+// ValidateNoFatalResults validates that a response has no fatal results. A
+// function returns a fatal result when it can't produce a valid response.
+func ValidateNoFatalResults(_, rsp proto.Message) error {
+	m := rsp.ProtoReflect()
+
+	fd := m.Descriptor().Fields().ByName(fieldResults)
+	if fd == nil || !fd.IsList() || fd.Message() == nil {
+		return nil
+	}
+
+	severity := fd.Message().Fields().ByName(fieldSeverity)
+	message := fd.Message().Fields().ByName(fieldMessage)
+
+	if severity == nil || severity.Enum() == nil {
+		return nil
+	}
+
+	fatal := severity.Enum().Values().ByName(severityFatal)
+	if fatal == nil {
+		return nil
+	}
+
+	fatals := make([]string, 0)
+
+	results := m.Get(fd).List()
+	for i := range results.Len() {
+		r := results.Get(i).Message()
+		if r.Get(severity).Enum() != fatal.Number() {
+			continue
+		}
+
+		msg := ""
+		if message != nil {
+			msg = r.Get(message).String()
+		}
+
+		fatals = append(fatals, msg)
+	}
+
+	if len(fatals) == 0 {
+		return nil
+	}
+
+	return errors.Errorf(errFmtFatalResult, strings.Join(fatals, "; "))
+}
+
+// tag returns the tag of the supplied request or response's metadata, if any.
+func tag(msg proto.Message) (string, error) {
+	m := msg.ProtoReflect()
+
+	fd := m.Descriptor().Fields().ByName(fieldMeta)
+	if fd == nil {
+		return "", nil
+	}
+
+	if fd.Message() == nil {
+		return "", errors.Errorf(errFmtNoMessageType, fieldMeta)
+	}
+
+	if !m.Has(fd) {
+		return "", nil
+	}
+
+	meta := m.Get(fd).Message()
+
+	tf := meta.Descriptor().Fields().ByName(fieldTag)
+	if tf == nil {
+		return "", nil
+	}
+
+	return meta.Get(tf).String(), nil
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package function
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+)
+
+// message returns a descriptor of a message shaped like the parts of a
+// function request or response that are validated.
+func message(t *testing.T) protoreflect.MessageDescriptor {
+	t.Helper()
+
+	field := func(name string, n int32, typ descriptorpb.FieldDescriptorProto_Type, typeName string, label descriptorpb.FieldDescriptorProto_Label) *descriptorpb.FieldDescriptorProto {
+		f := &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(name),
+			JsonName: proto.String(name),
+			Number:   proto.Int32(n),
+			Type:     typ.Enum(),
+			Label:    label.Enum(),
+		}
+		if typeName != "" {
+			f.TypeName = proto.String(typeName)
+		}
+		return f
+	}
+
+	optional := descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL
+	repeated := descriptorpb.FieldDescriptorProto_LABEL_REPEATED
+
+	fdp := &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("test.proto"),
+		Package: proto.String("test"),
+		Syntax:  proto.String("proto3"),
+		EnumType: []*descriptorpb.EnumDescriptorProto{{
+			Name: proto.String("Severity"),
+			Value: []*descriptorpb.EnumValueDescriptorProto{
+				{Name: proto.String("SEVERITY_UNSPECIFIED"), Number: proto.Int32(0)},
+				{Name: proto.String("SEVERITY_FATAL"), Number: proto.Int32(1)},
+				{Name: proto.String("SEVERITY_WARNING"), Number: proto.Int32(2)},
+			},
+		}},
+		MessageType: []*descriptorpb.DescriptorProto{
+			{
+				Name:  proto.String("Meta"),
+				Field: []*descriptorpb.FieldDescriptorProto{field("tag", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING, "", optional)},
+			},
+			{
+				Name: proto.String("Result"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("severity", 1, descriptorpb.FieldDescriptorProto_TYPE_ENUM, ".test.Severity", optional),
+					field("message", 2, descriptorpb.FieldDescriptorProto_TYPE_STRING, "", optional),
+				},
+			},
+			{
+				Name: proto.String("Message"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("meta", 1, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, ".test.Meta", optional),
+					field("results", 2, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, ".test.Result", repeated),
+				},
+			},
+		},
+	}
+
+	fd, err := protodesc.NewFile(fdp, nil)
+	if err != nil {
+		t.Fatalf("protodesc.NewFile(...): %v", err)
+	}
+
+	return fd.Messages().ByName("Message")
+}
+
+type result struct {
+	severity protoreflect.EnumNumber
+	message  string
+}
+
+// newMessage returns a message with the supplied tag and results.
+func newMessage(md protoreflect.MessageDescriptor, tag string, results ...result) proto.Message {
+	m := dynamicpb.NewMessage(md)
+
+	if tag != "" {
+		meta := dynamicpb.NewMessage(md.Fields().ByName("meta").Message())
+		meta.Set(meta.Descriptor().Fields().ByName("tag"), protoreflect.ValueOfString(tag))
+		m.Set(md.Fields().ByName("meta"), protoreflect.ValueOfMessage(meta))
+	}
+
+	rfd := md.Fields().ByName("results")
+	list := m.Mutable(rfd).List()
+
+	for _, r := range results {
+		rm := dynamicpb.NewMessage(rfd.Message())
+		rm.Set(rfd.Message().Fields().ByName("severity"), protoreflect.ValueOfEnum(r.severity))
+		rm.Set(rfd.Message().Fields().ByName("message"), protoreflect.ValueOfString(r.message))
+		list.Append(protoreflect.ValueOfMessage(rm))
+	}
+
+	return m
+}
+
+func TestValidateTag(t *testing.T) {
+	md := message(t)
+
+	cases := map[string]struct {
+		reason string
+		req    proto.Message
+		rsp    proto.Message
+		want   error
+	}{
+		"NoMeta": {
+			reason: "Messages without metadata should be valid.",
+			req:    &structpb.Struct{},
+			rsp:    &structpb.Struct{},
+		},
+		"NoRequestTag": {
+			reason: "A response to a request without a tag should be valid.",
+			req:    newMessage(md, ""),
+			rsp:    newMessage(md, "cool"),
+		},
+		"TagMatches": {
+			reason: "A response with the same tag as its request should be valid.",
+			req:    newMessage(md, "cool"),
+			rsp:    newMessage(md, "cool"),
+		},
+		"TagMismatch": {
+			reason: "A response with a different tag than its request should be invalid.",
+			req:    newMessage(md, "cool"),
+			rsp:    newMessage(md, "uncool"),
+			want:   errors.Errorf(errFmtTagMismatch, "uncool", "cool"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateTag(tc.req, tc.rsp)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nValidateTag(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestValidateNoFatalResults(t *testing.T) {
+	md := message(t)
+
+	cases := map[string]struct {
+		reason string
+		rsp    proto.Message
+		want   error
+	}{
+		"NoResults": {
+			reason: "Messages without results should be valid.",
+			rsp:    &structpb.Struct{},
+		},
+		"Warning": {
+			reason: "A response with only warnings should be valid.",
+			rsp:    newMessage(md, "", result{severity: 2, message: "careful"}),
+		},
+		"Fatal": {
+			reason: "A response with fatal results should be invalid.",
+			rsp:    newMessage(md, "", result{severity: 2, message: "careful"}, result{severity: 1, message: "boom"}, result{severity: 1, message: "bang"}),
+			want:   errors.Errorf(errFmtFatalResult, "boom; bang"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateNoFatalResults(nil, tc.rsp)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nValidateNoFatalResults(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,272 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package grpcserver serves the gRPC endpoints of Crossplane runtime
+// extensions, such as change log collectors, external secret store plugins,
+// and function runners.
+package grpcserver
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/certificates"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+)
+
+const (
+	// DefaultAddress is the address a Server listens on by default.
+	DefaultAddress = ":9443"
+
+	// DefaultGracePeriod is how long a Server waits by default for in-flight
+	// RPCs to finish when it's stopped.
+	DefaultGracePeriod = 10 * time.Second
+)
+
+// Error strings.
+const (
+	errNoTLS     = "TLS is not configured; use WithInsecure to serve without TLS"
+	errLoadTLS   = "cannot load TLS certificates"
+	errServe     = "cannot serve gRPC"
+	errFmtListen = "cannot listen on %q"
+)
+
+// An Option configures a Server.
+type Option func(s *Server)
+
+// WithAddress configures the address a Server listens on. It's DefaultAddress
+// by default.
+func WithAddress(addr string) Option {
+	return func(s *Server) {
+		s.address = addr
+	}
+}
+
+// WithListener configures a Server to serve using the supplied listener,
+// rather than listening on its address.
+func WithListener(l net.Listener) Option {
+	return func(s *Server) {
+		s.listener = l
+	}
+}
+
+// WithTLSConfig configures a Server to serve using the supplied TLS config.
+func WithTLSConfig(c *tls.Config) Option {
+	return func(s *Server) {
+		s.tls = c
+	}
+}
+
+// WithTLSFiles configures a Server to serve using mutual TLS, loading its
+// certificate and the CA certificate that signs client certificates from the
+// supplied paths. See certificates.LoadMTLSConfig.
+func WithTLSFiles(caPath, certPath, keyPath string) Option {
+	return func(s *Server) {
+		s.tlsFiles = &tlsFiles{ca: caPath, cert: certPath, key: keyPath}
+	}
+}
+
+// WithInsecure configures a Server to serve without TLS. It's intended for
+// tests and for servers that only listen on a Unix socket or loopback
+// interface.
+func WithInsecure() Option {
+	return func(s *Server) {
+		s.insecure = true
+	}
+}
+
+// WithReflection configures whether a Server serves the gRPC reflection
+// service, which lets tools like grpcurl discover its services. It's disabled
+// by default.
+func WithReflection(enabled bool) Option {
+	return func(s *Server) {
+		s.reflection = enabled
+	}
+}
+
+// WithGracePeriod configures how long a Server waits for in-flight RPCs to
+// finish when it's stopped, before it forcibly closes their connections. It's
+// DefaultGracePeriod by default.
+func WithGracePeriod(d time.Duration) Option {
+	return func(s *Server) {
+		s.gracePeriod = d
+	}
+}
+
+// WithLogger configures the logger a Server uses.
+func WithLogger(l logging.Logger) Option {
+	return func(s *Server) {
+		s.log = l
+	}
+}
+
+// WithServerOptions configures the options used to create the underlying gRPC
+// server, for example to add interceptors.
+func WithServerOptions(o ...grpc.ServerOption) Option {
+	return func(s *Server) {
+		s.opts = append(s.opts, o...)
+	}
+}
+
+type tlsFiles struct {
+	ca, cert, key string
+}
+
+// A Server serves gRPC services. It always serves the gRPC health service,
+// which reports each registered service as serving while the Server runs.
+//
+// A Server is a controller-runtime Runnable, so it may be added to a
+// controller manager. It runs regardless of leader election.
+type Server struct {
+	address     string
+	listener    net.Listener
+	tls         *tls.Config
+	tlsFiles    *tlsFiles
+	insecure    bool
+	reflection  bool
+	gracePeriod time.Duration
+	log         logging.Logger
+	opts        []grpc.ServerOption
+
+	srv    *grpc.Server
+	health *health.Server
+}
+
+// New returns a Server. It must be configured to serve using TLS, or
+// explicitly configured to serve without it using WithInsecure.
+func New(o ...Option) (*Server, error) {
+	s := &Server{
+		address:     DefaultAddress,
+		gracePeriod: DefaultGracePeriod,
+		log:         logging.NewNopLogger(),
+		health:      health.NewServer(),
+	}
+
+	for _, fn := range o {
+		fn(s)
+	}
+
+	if s.tlsFiles != nil {
+		c, err := certificates.LoadMTLSConfig(s.tlsFiles.ca, s.tlsFiles.cert, s.tlsFiles.key, true)
+		if err != nil {
+			return nil, errors.Wrap(err, errLoadTLS)
+		}
+
+		s.tls = c
+	}
+
+	opts := make([]grpc.ServerOption, 0, len(s.opts)+1)
+
+	switch {
+	case s.tls != nil:
+		opts = append(opts, grpc.Creds(credentials.NewTLS(s.tls)))
+	case !s.insecure:
+		return nil, errors.New(errNoTLS)
+	}
+
+	s.srv = grpc.NewServer(append(opts, s.opts...)...)
+	healthpb.RegisterHealthServer(s.srv, s.health)
+
+	if s.reflection {
+		reflection.Register(s.srv)
+	}
+
+	return s, nil
+}
+
+// RegisterService registers a service and its implementation. It satisfies
+// grpc.ServiceRegistrar, so a Server may be passed to generated registration
+// functions. Services must be registered before the Server is started.
+func (s *Server) RegisterService(desc *grpc.ServiceDesc, impl any) {
+	s.srv.RegisterService(desc, impl)
+}
+
+// SetServingStatus sets the status the health service reports for the named
+// service. Use the empty string to set the status of the Server as a whole.
+func (s *Server) SetServingStatus(service string, status healthpb.HealthCheckResponse_ServingStatus) {
+	s.health.SetServingStatus(service, status)
+}
+
+// NeedLeaderElection returns false; a Server serves on every replica.
+func (s *Server) NeedLeaderElection() bool {
+	return false
+}
+
+// Start serving. Start blocks until the supplied context is done, then stops
+// the Server gracefully. It returns an error if the Server can't serve.
+func (s *Server) Start(ctx context.Context) error {
+	l := s.listener
+	if l == nil {
+		var err error
+		if l, err = net.Listen("tcp", s.address); err != nil {
+			return errors.Wrapf(err, errFmtListen, s.address)
+		}
+	}
+
+	s.health.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	for name := range s.srv.GetServiceInfo() {
+		s.health.SetServingStatus(name, healthpb.HealthCheckResponse_SERVING)
+	}
+
+	s.log.Info("Serving gRPC", "address", l.Addr().String(), "tls", s.tls != nil)
+
+	served := make(chan error, 1)
+	go func() {
+		served <- s.srv.Serve(l)
+	}()
+
+	select {
+	case err := <-served:
+		return errors.Wrap(err, errServe)
+	case <-ctx.Done():
+	}
+
+	s.stop()
+
+	return errors.Wrap(<-served, errServe)
+}
+
+// stop the Server, giving in-flight RPCs the grace period to finish.
+func (s *Server) stop() {
+	// Tell clients we're going away before we stop accepting RPCs.
+	s.health.Shutdown()
+
+	stopped := make(chan struct{})
+	go func() {
+		s.srv.GracefulStop()
+		close(stopped)
+	}()
+
+	t := time.NewTimer(s.gracePeriod)
+	defer t.Stop()
+
+	select {
+	case <-stopped:
+		s.log.Debug("Stopped serving gRPC")
+	case <-t.C:
+		s.log.Info("Grace period expired; forcibly stopping gRPC server", "grace-period", s.gracePeriod.String())
+		s.srv.Stop()
+	}
+}
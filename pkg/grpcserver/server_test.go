@@ -0,0 +1,157 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcserver
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/certificates"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+)
+
+const certs = "../certificates/test-data/certs/"
+
+func TestNew(t *testing.T) {
+	cases := map[string]struct {
+		reason         string
+		o              []Option
+		wantErr        error
+		wantReflection bool
+	}{
+		"NoTLS": {
+			reason:  "A server that isn't configured to use TLS, or explicitly configured not to, should be an error.",
+			wantErr: errors.New(errNoTLS),
+		},
+		"TLSFilesError": {
+			reason:  "An error loading TLS certificates should be returned.",
+			o:       []Option{WithTLSFiles("invalid/ca.crt", "invalid/tls.crt", "invalid/tls.key")},
+			wantErr: errors.Wrap(errors.Wrap(errors.New("open invalid/tls.crt: no such file or directory"), "cannot load certificate"), errLoadTLS),
+		},
+		"TLSFiles": {
+			reason: "A server should be created using the supplied TLS certificates.",
+			o:      []Option{WithTLSFiles(certs+"ca.crt", certs+"tls.crt", certs+"tls.key")},
+		},
+		"InsecureWithReflection": {
+			reason:         "A server explicitly configured not to use TLS should serve the reflection service if enabled.",
+			o:              []Option{WithInsecure(), WithReflection(true)},
+			wantReflection: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s, err := New(tc.o...)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("\n%s\nNew(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+
+			if s == nil {
+				return
+			}
+
+			_, got := s.srv.GetServiceInfo()["grpc.reflection.v1.ServerReflection"]
+			if got != tc.wantReflection {
+				t.Errorf("\n%s\nNew(...): want reflection %t, got %t", tc.reason, tc.wantReflection, got)
+			}
+		})
+	}
+}
+
+func TestServer(t *testing.T) {
+	l := bufconn.Listen(1024 * 1024)
+
+	s, err := New(WithInsecure(), WithListener(l))
+	if err != nil {
+		t.Fatalf("New(...): %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+
+	go func() { done <- s.Start(ctx) }()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return l.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("grpc.NewClient(...): %v", err)
+	}
+	defer conn.Close() //nolint:errcheck // Only a test.
+
+	rsp, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{Service: healthpb.Health_ServiceDesc.ServiceName}, grpc.WaitForReady(true))
+	if err != nil {
+		t.Fatalf("Check(...): %v", err)
+	}
+
+	if diff := cmp.Diff(healthpb.HealthCheckResponse_SERVING, rsp.GetStatus()); diff != "" {
+		t.Errorf("Check(...): -want, +got:\n%s", diff)
+	}
+
+	cancel()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("Start(...): %v", err)
+		}
+	case <-time.After(DefaultGracePeriod):
+		t.Errorf("Start(...): server did not stop when its context was cancelled")
+	}
+}
+
+func TestServerTLS(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("net.Listen(...): %v", err)
+	}
+
+	s, err := New(WithTLSFiles(certs+"ca.crt", certs+"tls.crt", certs+"tls.key"), WithListener(l))
+	if err != nil {
+		t.Fatalf("New(...): %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() { _ = s.Start(ctx) }()
+
+	cfg, err := certificates.LoadMTLSConfig(certs+"ca.crt", certs+"tls.crt", certs+"tls.key", false)
+	if err != nil {
+		t.Fatalf("LoadMTLSConfig(...): %v", err)
+	}
+
+	conn, err := grpc.NewClient(l.Addr().String(), grpc.WithTransportCredentials(credentials.NewTLS(cfg)))
+	if err != nil {
+		t.Fatalf("grpc.NewClient(...): %v", err)
+	}
+	defer conn.Close() //nolint:errcheck // Only a test.
+
+	if _, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{}, grpc.WaitForReady(true)); err != nil {
+		t.Errorf("Check(...): %v", err)
+	}
+}
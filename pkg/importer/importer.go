@@ -0,0 +1,174 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package importer builds managed resources that import existing external
+// resources, for example to power an import command line tool:
+//
+//	mg, err := importer.New(gvk, "my-bucket", importer.WithNamespace("default"), importer.WithProviderConfig("ProviderConfig", "default"))
+//	b, err := importer.Manifest(mg)
+package importer
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/fieldpath"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/unstructured/managed"
+)
+
+// Error strings.
+const (
+	errNoExternalName  = "external name is required"
+	errNoKind          = "group, version, and kind are required"
+	errSetForProvider  = "cannot set spec.forProvider"
+	errMarshalManifest = "cannot marshal manifest"
+)
+
+type options struct {
+	name        string
+	namespace   string
+	pcKind      string
+	pcName      string
+	forProvider map[string]any
+	observeOnly bool
+}
+
+// An Option configures the managed resource built by New.
+type Option func(o *options)
+
+// WithName sets the name of the managed resource. The external name is used
+// if no name is supplied.
+func WithName(name string) Option {
+	return func(o *options) {
+		o.name = name
+	}
+}
+
+// WithNamespace builds a namespaced managed resource in the supplied
+// namespace. A cluster scoped, legacy managed resource is built if no
+// namespace is supplied.
+func WithNamespace(namespace string) Option {
+	return func(o *options) {
+		o.namespace = namespace
+	}
+}
+
+// WithProviderConfig sets the ProviderConfig the managed resource uses. The
+// kind is ignored for legacy managed resources, which reference a
+// ProviderConfig by name only.
+func WithProviderConfig(kind, name string) Option {
+	return func(o *options) {
+		o.pcKind = kind
+		o.pcName = name
+	}
+}
+
+// WithForProvider sets the spec.forProvider fields of the managed resource,
+// for example those the provider needs to find the external resource, like
+// its region.
+func WithForProvider(fp map[string]any) Option {
+	return func(o *options) {
+		o.forProvider = fp
+	}
+}
+
+// WithObserveOnly builds a managed resource that only observes the external
+// resource, instead of one that is paused. Use it to populate the managed
+// resource's status.atProvider before deciding what to manage.
+func WithObserveOnly() Option {
+	return func(o *options) {
+		o.observeOnly = true
+	}
+}
+
+// New returns a managed resource of the supplied kind that imports the
+// external resource with the supplied external name. By default the managed
+// resource is paused, so it won't change or delete the external resource
+// until it's reviewed and unpaused. Legacy managed resources also orphan the
+// external resource when they're deleted.
+func New(gvk schema.GroupVersionKind, externalName string, o ...Option) (resource.Managed, error) {
+	if externalName == "" {
+		return nil, errors.New(errNoExternalName)
+	}
+
+	if gvk.Version == "" || gvk.Kind == "" {
+		return nil, errors.New(errNoKind)
+	}
+
+	opts := &options{}
+	for _, fn := range o {
+		fn(opts)
+	}
+
+	var (
+		mg resource.Managed
+		u  *managed.Unstructured
+	)
+
+	switch {
+	case opts.namespace == "":
+		l := managed.NewLegacy(managed.WithGroupVersionKind(gvk))
+		l.SetDeletionPolicy(xpv1.DeletionOrphan)
+
+		if opts.pcName != "" {
+			l.SetProviderConfigReference(&xpv1.Reference{Name: opts.pcName})
+		}
+
+		mg, u = l, &l.Unstructured
+	default:
+		u = managed.New(managed.WithGroupVersionKind(gvk))
+		u.SetNamespace(opts.namespace)
+
+		if opts.pcName != "" {
+			u.SetProviderConfigReference(&xpv1.ProviderConfigReference{Kind: opts.pcKind, Name: opts.pcName})
+		}
+
+		mg = u
+	}
+
+	mg.SetName(opts.name)
+	if opts.name == "" {
+		mg.SetName(externalName)
+	}
+
+	meta.SetExternalName(mg, externalName)
+
+	switch {
+	case opts.observeOnly:
+		mg.SetManagementPolicies(xpv1.ManagementPolicies{xpv1.ManagementActionObserve})
+	default:
+		meta.AddAnnotations(mg, map[string]string{meta.AnnotationKeyReconciliationPaused: "true"})
+	}
+
+	if opts.forProvider != nil {
+		if err := fieldpath.Pave(u.Object).SetValue("spec.forProvider", opts.forProvider); err != nil {
+			return nil, errors.Wrap(err, errSetForProvider)
+		}
+	}
+
+	return mg, nil
+}
+
+// Manifest returns the supplied managed resource as a YAML manifest that is
+// ready to apply.
+func Manifest(mg resource.Managed) ([]byte, error) {
+	b, err := yaml.Marshal(mg)
+	return b, errors.Wrap(err, errMarshalManifest)
+}
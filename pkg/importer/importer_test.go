@@ -0,0 +1,191 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package importer
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+)
+
+var gvk = schema.GroupVersionKind{Group: "example.org", Version: "v1", Kind: "Cool"}
+
+func TestNew(t *testing.T) {
+	type args struct {
+		gvk          schema.GroupVersionKind
+		externalName string
+		o            []Option
+	}
+
+	type want struct {
+		obj map[string]any
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"NoExternalName": {
+			reason: "We should return an error if no external name is supplied.",
+			args: args{
+				gvk: gvk,
+			},
+			want: want{
+				err: errors.New(errNoExternalName),
+			},
+		},
+		"NoKind": {
+			reason: "We should return an error if no kind is supplied.",
+			args: args{
+				gvk:          schema.GroupVersionKind{Group: "example.org", Version: "v1"},
+				externalName: "cool-external",
+			},
+			want: want{
+				err: errors.New(errNoKind),
+			},
+		},
+		"Legacy": {
+			reason: "We should build a paused, cluster scoped managed resource that orphans its external resource if no namespace is supplied.",
+			args: args{
+				gvk:          gvk,
+				externalName: "cool-external",
+				o:            []Option{WithProviderConfig("ProviderConfig", "default")},
+			},
+			want: want{
+				obj: map[string]any{
+					"apiVersion": "example.org/v1",
+					"kind":       "Cool",
+					"metadata": map[string]any{
+						"name": "cool-external",
+						"annotations": map[string]any{
+							"crossplane.io/external-name": "cool-external",
+							"crossplane.io/paused":        "true",
+						},
+					},
+					"spec": map[string]any{
+						"deletionPolicy":    "Orphan",
+						"providerConfigRef": map[string]any{"name": "default"},
+					},
+				},
+			},
+		},
+		"Namespaced": {
+			reason: "We should build a paused, namespaced managed resource with a typed ProviderConfig reference if a namespace is supplied.",
+			args: args{
+				gvk:          gvk,
+				externalName: "cool-external",
+				o: []Option{
+					WithName("cool"),
+					WithNamespace("default"),
+					WithProviderConfig("ClusterProviderConfig", "default"),
+					WithForProvider(map[string]any{"region": "us-east-1"}),
+				},
+			},
+			want: want{
+				obj: map[string]any{
+					"apiVersion": "example.org/v1",
+					"kind":       "Cool",
+					"metadata": map[string]any{
+						"namespace": "default",
+						"name":      "cool",
+						"annotations": map[string]any{
+							"crossplane.io/external-name": "cool-external",
+							"crossplane.io/paused":        "true",
+						},
+					},
+					"spec": map[string]any{
+						"providerConfigRef": map[string]any{"kind": "ClusterProviderConfig", "name": "default"},
+						"forProvider":       map[string]any{"region": "us-east-1"},
+					},
+				},
+			},
+		},
+		"ObserveOnly": {
+			reason: "We should build a managed resource that only observes its external resource, instead of a paused one, if asked.",
+			args: args{
+				gvk:          gvk,
+				externalName: "cool-external",
+				o:            []Option{WithNamespace("default"), WithObserveOnly()},
+			},
+			want: want{
+				obj: map[string]any{
+					"apiVersion": "example.org/v1",
+					"kind":       "Cool",
+					"metadata": map[string]any{
+						"namespace": "default",
+						"name":      "cool-external",
+						"annotations": map[string]any{
+							"crossplane.io/external-name": "cool-external",
+						},
+					},
+					"spec": map[string]any{
+						"managementPolicies": []any{"Observe"},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg, err := New(tc.args.gvk, tc.args.externalName, tc.args.o...)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nNew(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+
+			var got map[string]any
+			if u, ok := mg.(interface{ UnstructuredContent() map[string]any }); ok {
+				got = u.UnstructuredContent()
+			}
+
+			if diff := cmp.Diff(tc.want.obj, got); diff != "" {
+				t.Errorf("\n%s\nNew(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestManifest(t *testing.T) {
+	mg, err := New(gvk, "cool-external", WithNamespace("default"))
+	if err != nil {
+		t.Fatalf("New(...): %s", err)
+	}
+
+	got, err := Manifest(mg)
+	if err != nil {
+		t.Fatalf("Manifest(...): %s", err)
+	}
+
+	want := `apiVersion: example.org/v1
+kind: Cool
+metadata:
+  annotations:
+    crossplane.io/external-name: cool-external
+    crossplane.io/paused: "true"
+  name: cool-external
+  namespace: default
+`
+	if diff := cmp.Diff(want, string(got)); diff != "" {
+		t.Errorf("Manifest(...): -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package index provides field indexes commonly used to look up managed
+// resources, for example by the ProviderConfig they use. Register an index
+// with a manager's field indexer, then list managed resources using the
+// matching lookup helper:
+//
+//	index.Register(ctx, mgr.GetFieldIndexer(), &v1.Bucket{}, index.ProviderConfig)
+//	mgr.GetClient().List(ctx, l, index.MatchingProviderConfig("ProviderConfig", "default"))
+package index
+
+import (
+	"context"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+)
+
+// Indexed fields.
+const (
+	FieldProviderConfig   = "index.crossplane.io/provider-config"
+	FieldExternalName     = "index.crossplane.io/external-name"
+	FieldClaim            = "index.crossplane.io/claim"
+	FieldConnectionSecret = "index.crossplane.io/connection-secret"
+)
+
+const errFmtIndexField = "cannot index field %q"
+
+// An Index is a field index of managed resources.
+type Index struct {
+	// Field that is indexed.
+	Field string
+
+	// Extract the indexed values of an object.
+	Extract client.IndexerFunc
+}
+
+var (
+	// ProviderConfig indexes managed resources by the ProviderConfig they
+	// use. See MatchingProviderConfig.
+	ProviderConfig = Index{Field: FieldProviderConfig, Extract: ProviderConfigValues}
+
+	// ExternalName indexes managed resources by their external name. See
+	// MatchingExternalName.
+	ExternalName = Index{Field: FieldExternalName, Extract: ExternalNameValues}
+
+	// Claim indexes resources by the claim that references them. See
+	// MatchingClaim.
+	Claim = Index{Field: FieldClaim, Extract: ClaimValues}
+
+	// ConnectionSecret indexes managed resources by the Secret they write
+	// their connection details to. See MatchingConnectionSecret.
+	ConnectionSecret = Index{Field: FieldConnectionSecret, Extract: ConnectionSecretValues}
+)
+
+// Register the supplied indexes of the supplied kind of object.
+func Register(ctx context.Context, fi client.FieldIndexer, o client.Object, idx ...Index) error {
+	for _, i := range idx {
+		if err := fi.IndexField(ctx, o, i.Field, i.Extract); err != nil {
+			return errors.Wrapf(err, errFmtIndexField, i.Field)
+		}
+	}
+
+	return nil
+}
+
+// ProviderConfigKey returns the value a reference to the supplied kind and
+// name of ProviderConfig is indexed by. Legacy managed resources reference a
+// ProviderConfig by name only; use an empty kind to look them up.
+func ProviderConfigKey(kind, name string) string {
+	if kind == "" {
+		return name
+	}
+
+	return kind + "/" + name
+}
+
+// ProviderConfigValues returns the ProviderConfig the supplied object uses.
+func ProviderConfigValues(o client.Object) []string {
+	switch r := o.(type) {
+	case resource.TypedProviderConfigReferencer:
+		if ref := r.GetProviderConfigReference(); ref != nil && ref.Name != "" {
+			return []string{ProviderConfigKey(ref.Kind, ref.Name)}
+		}
+	case resource.ProviderConfigReferencer:
+		if ref := r.GetProviderConfigReference(); ref != nil && ref.Name != "" {
+			return []string{ProviderConfigKey("", ref.Name)}
+		}
+	}
+
+	return nil
+}
+
+// MatchingProviderConfig selects managed resources that use the supplied kind
+// and name of ProviderConfig.
+func MatchingProviderConfig(kind, name string) client.MatchingFields {
+	return client.MatchingFields{FieldProviderConfig: ProviderConfigKey(kind, name)}
+}
+
+// ExternalNameValues returns the external name of the supplied object.
+func ExternalNameValues(o client.Object) []string {
+	if en := meta.GetExternalName(o); en != "" {
+		return []string{en}
+	}
+
+	return nil
+}
+
+// MatchingExternalName selects managed resources with the supplied external
+// name.
+func MatchingExternalName(name string) client.MatchingFields {
+	return client.MatchingFields{FieldExternalName: name}
+}
+
+// ClaimKey returns the value a reference to the supplied kind, namespace, and
+// name of claim is indexed by.
+func ClaimKey(kind, namespace, name string) string {
+	return strings.Join([]string{kind, namespace, name}, "/")
+}
+
+// ClaimValues returns the claim that references the supplied object.
+func ClaimValues(o client.Object) []string {
+	r, ok := o.(resource.ClaimReferencer)
+	if !ok {
+		return nil
+	}
+
+	ref := r.GetClaimReference()
+	if ref == nil || ref.Name == "" {
+		return nil
+	}
+
+	return []string{ClaimKey(ref.Kind, ref.Namespace, ref.Name)}
+}
+
+// MatchingClaim selects resources referenced by the supplied kind, namespace,
+// and name of claim.
+func MatchingClaim(kind, namespace, name string) client.MatchingFields {
+	return client.MatchingFields{FieldClaim: ClaimKey(kind, namespace, name)}
+}
+
+// ConnectionSecretKey returns the value a reference to the supplied namespace
+// and name of Secret is indexed by.
+func ConnectionSecretKey(namespace, name string) string {
+	return namespace + "/" + name
+}
+
+// ConnectionSecretValues returns the Secret the supplied object writes its
+// connection details to. A local Secret reference refers to a Secret in the
+// object's namespace.
+func ConnectionSecretValues(o client.Object) []string {
+	switch r := o.(type) {
+	case resource.LocalConnectionSecretWriterTo:
+		if ref := r.GetWriteConnectionSecretToReference(); ref != nil && ref.Name != "" {
+			return []string{ConnectionSecretKey(o.GetNamespace(), ref.Name)}
+		}
+	case resource.ConnectionSecretWriterTo:
+		if ref := r.GetWriteConnectionSecretToReference(); ref != nil && ref.Name != "" {
+			return []string{ConnectionSecretKey(ref.Namespace, ref.Name)}
+		}
+	}
+
+	return nil
+}
+
+// MatchingConnectionSecret selects managed resources that write their
+// connection details to the supplied namespace and name of Secret.
+func MatchingConnectionSecret(namespace, name string) client.MatchingFields {
+	return client.MatchingFields{FieldConnectionSecret: ConnectionSecretKey(namespace, name)}
+}
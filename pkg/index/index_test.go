@@ -0,0 +1,182 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package index
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/unstructured/reference"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+)
+
+type fieldIndexerFn func(ctx context.Context, obj client.Object, field string, fn client.IndexerFunc) error
+
+func (fn fieldIndexerFn) IndexField(ctx context.Context, obj client.Object, field string, extract client.IndexerFunc) error {
+	return fn(ctx, obj, field, extract)
+}
+
+func TestRegister(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		fields []string
+		err    error
+	}
+
+	cases := map[string]struct {
+		reason string
+		err    error
+		idx    []Index
+		want   want
+	}{
+		"Success": {
+			reason: "We should register every supplied index.",
+			idx:    []Index{ProviderConfig, ExternalName},
+			want: want{
+				fields: []string{FieldProviderConfig, FieldExternalName},
+			},
+		},
+		"IndexFieldError": {
+			reason: "We should return any error registering an index.",
+			err:    errBoom,
+			idx:    []Index{ProviderConfig, ExternalName},
+			want: want{
+				fields: []string{FieldProviderConfig},
+				err:    errors.Wrapf(errBoom, errFmtIndexField, FieldProviderConfig),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var fields []string
+
+			fi := fieldIndexerFn(func(_ context.Context, _ client.Object, field string, _ client.IndexerFunc) error {
+				fields = append(fields, field)
+				return tc.err
+			})
+
+			err := Register(context.Background(), fi, &fake.ModernManaged{}, tc.idx...)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nRegister(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.want.fields, fields); diff != "" {
+				t.Errorf("\n%s\nRegister(...): -want fields, +got fields:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestValues(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		idx    Index
+		o      client.Object
+		match  client.MatchingFields
+	}{
+		"TypedProviderConfig": {
+			reason: "A managed resource should be indexed by the kind and name of its ProviderConfig.",
+			idx:    ProviderConfig,
+			o: &fake.ModernManaged{
+				TypedProviderConfigReferencer: fake.TypedProviderConfigReferencer{Ref: &xpv1.ProviderConfigReference{Kind: "ClusterProviderConfig", Name: "default"}},
+			},
+			match: MatchingProviderConfig("ClusterProviderConfig", "default"),
+		},
+		"LegacyProviderConfig": {
+			reason: "A legacy managed resource should be indexed by the name of its ProviderConfig.",
+			idx:    ProviderConfig,
+			o: &fake.LegacyManaged{
+				LegacyProviderConfigReferencer: fake.LegacyProviderConfigReferencer{Ref: &xpv1.Reference{Name: "default"}},
+			},
+			match: MatchingProviderConfig("", "default"),
+		},
+		"NoProviderConfig": {
+			reason: "A managed resource without a ProviderConfig shouldn't be indexed.",
+			idx:    ProviderConfig,
+			o:      &fake.ModernManaged{},
+		},
+		"ExternalName": {
+			reason: "A managed resource should be indexed by its external name.",
+			idx:    ExternalName,
+			o: func() client.Object {
+				mg := &fake.ModernManaged{}
+				meta.SetExternalName(mg, "cool")
+				return mg
+			}(),
+			match: MatchingExternalName("cool"),
+		},
+		"NoExternalName": {
+			reason: "A managed resource without an external name shouldn't be indexed.",
+			idx:    ExternalName,
+			o:      &fake.ModernManaged{},
+		},
+		"Claim": {
+			reason: "A resource should be indexed by the claim that references it.",
+			idx:    Claim,
+			o: &fake.Composite{
+				ClaimReferencer: fake.ClaimReferencer{Ref: &reference.Claim{Kind: "Bucket", Namespace: "default", Name: "cool"}},
+			},
+			match: MatchingClaim("Bucket", "default", "cool"),
+		},
+		"NotClaimReferencer": {
+			reason: "A resource that can't reference a claim shouldn't be indexed.",
+			idx:    Claim,
+			o:      &fake.ModernManaged{},
+		},
+		"LocalConnectionSecret": {
+			reason: "A managed resource should be indexed by the Secret in its namespace it writes connection details to.",
+			idx:    ConnectionSecret,
+			o: &fake.ModernManaged{
+				ObjectMeta:                    metav1.ObjectMeta{Namespace: "default"},
+				LocalConnectionSecretWriterTo: fake.LocalConnectionSecretWriterTo{Ref: &xpv1.LocalSecretReference{Name: "cool"}},
+			},
+			match: MatchingConnectionSecret("default", "cool"),
+		},
+		"ConnectionSecret": {
+			reason: "A legacy managed resource should be indexed by the Secret it writes connection details to.",
+			idx:    ConnectionSecret,
+			o: &fake.LegacyManaged{
+				ConnectionSecretWriterTo: fake.ConnectionSecretWriterTo{Ref: &xpv1.SecretReference{Namespace: "default", Name: "cool"}},
+			},
+			match: MatchingConnectionSecret("default", "cool"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var want []string
+			if v, ok := tc.match[tc.idx.Field]; ok {
+				want = []string{v}
+			}
+
+			got := tc.idx.Extract(tc.o)
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("\n%s\n%s: -want, +got:\n%s", tc.reason, tc.idx.Field, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logging
+
+// Keys of structured data that Crossplane logs. These keys are stable; log
+// processors may rely on them to correlate messages.
+const (
+	// KeyExternalName is the external name of a managed resource.
+	KeyExternalName = "external-name"
+
+	// KeyGVK is the group, version, and kind of a resource.
+	KeyGVK = "gvk"
+
+	// KeyReconcileID uniquely identifies a single reconcile. Every message
+	// logged during a reconcile has the same reconcile ID.
+	KeyReconcileID = "reconcile-id"
+
+	// KeyOperation is the operation performed on an external resource, for
+	// example OPERATION_TYPE_CREATE.
+	KeyOperation = "operation"
+)
@@ -0,0 +1,166 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package meta
+
+import (
+	"strconv"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Annotations understood by GitOps tools.
+const (
+	// AnnotationKeyArgoCDSyncWave orders the resources Argo CD syncs. Lower
+	// waves are synced first.
+	AnnotationKeyArgoCDSyncWave = "argocd.argoproj.io/sync-wave"
+
+	// AnnotationKeyArgoCDSyncOptions is a comma separated list of options
+	// that configure how Argo CD syncs a resource, e.g. Prune=false.
+	AnnotationKeyArgoCDSyncOptions = "argocd.argoproj.io/sync-options"
+
+	// AnnotationKeyArgoCDCompareOptions is a comma separated list of options
+	// that configure how Argo CD compares a resource to its desired state,
+	// e.g. IgnoreExtraneous.
+	AnnotationKeyArgoCDCompareOptions = "argocd.argoproj.io/compare-options"
+
+	// AnnotationKeyFluxPrune configures whether Flux prunes a resource. Flux
+	// doesn't prune a resource with this annotation set to 'disabled'.
+	AnnotationKeyFluxPrune = "kustomize.toolkit.fluxcd.io/prune"
+
+	// AnnotationKeyFluxSSA configures how Flux applies a resource, e.g.
+	// IfNotPresent.
+	AnnotationKeyFluxSSA = "kustomize.toolkit.fluxcd.io/ssa"
+)
+
+// Argo CD sync and compare options.
+const (
+	ArgoCDSyncOptionPruneDisabled       = "Prune=false"
+	ArgoCDSyncOptionDeleteDisabled      = "Delete=false"
+	ArgoCDCompareOptionIgnoreExtraneous = "IgnoreExtraneous"
+)
+
+// Values of Flux annotations.
+const (
+	FluxPruneDisabled   = "disabled"
+	FluxSSAIfNotPresent = "IfNotPresent"
+	FluxSSAIgnore       = "Ignore"
+	FluxSSAMerge        = "Merge"
+)
+
+// GetArgoCDSyncWave returns the Argo CD sync wave of the supplied object, and
+// whether it has a valid one.
+func GetArgoCDSyncWave(o metav1.Object) (int, bool) {
+	w, err := strconv.Atoi(o.GetAnnotations()[AnnotationKeyArgoCDSyncWave])
+	return w, err == nil
+}
+
+// SetArgoCDSyncWave sets the Argo CD sync wave of the supplied object.
+func SetArgoCDSyncWave(o metav1.Object, wave int) {
+	setAnnotation(o, AnnotationKeyArgoCDSyncWave, strconv.Itoa(wave))
+}
+
+// GetArgoCDSyncOptions returns the Argo CD sync options of the supplied object.
+func GetArgoCDSyncOptions(o metav1.Object) []string {
+	return splitOptions(o.GetAnnotations()[AnnotationKeyArgoCDSyncOptions])
+}
+
+// AddArgoCDSyncOptions adds the supplied Argo CD sync options to the supplied
+// object. An option of the form key=value replaces any existing option with
+// the same key.
+func AddArgoCDSyncOptions(o metav1.Object, opts ...string) {
+	setAnnotation(o, AnnotationKeyArgoCDSyncOptions, strings.Join(mergeOptions(GetArgoCDSyncOptions(o), opts), ","))
+}
+
+// GetArgoCDCompareOptions returns the Argo CD compare options of the supplied
+// object.
+func GetArgoCDCompareOptions(o metav1.Object) []string {
+	return splitOptions(o.GetAnnotations()[AnnotationKeyArgoCDCompareOptions])
+}
+
+// AddArgoCDCompareOptions adds the supplied Argo CD compare options to the
+// supplied object. An option of the form key=value replaces any existing
+// option with the same key.
+func AddArgoCDCompareOptions(o metav1.Object, opts ...string) {
+	setAnnotation(o, AnnotationKeyArgoCDCompareOptions, strings.Join(mergeOptions(GetArgoCDCompareOptions(o), opts), ","))
+}
+
+// IsPruneDisabled returns true if the supplied object asks Argo CD or Flux not
+// to prune it.
+func IsPruneDisabled(o metav1.Object) bool {
+	if o.GetAnnotations()[AnnotationKeyFluxPrune] == FluxPruneDisabled {
+		return true
+	}
+
+	for _, opt := range GetArgoCDSyncOptions(o) {
+		if opt == ArgoCDSyncOptionPruneDisabled {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SetPruneDisabled asks Argo CD and Flux not to prune the supplied object,
+// for example because deleting it would delete an external resource.
+func SetPruneDisabled(o metav1.Object) {
+	AddArgoCDSyncOptions(o, ArgoCDSyncOptionPruneDisabled)
+	setAnnotation(o, AnnotationKeyFluxPrune, FluxPruneDisabled)
+}
+
+func splitOptions(s string) []string {
+	if s == "" {
+		return nil
+	}
+
+	out := make([]string, 0, strings.Count(s, ",")+1)
+
+	for opt := range strings.SplitSeq(s, ",") {
+		if opt = strings.TrimSpace(opt); opt != "" {
+			out = append(out, opt)
+		}
+	}
+
+	return out
+}
+
+// mergeOptions adds the supplied options to the existing options. Options of
+// the form key=value replace existing options with the same key.
+func mergeOptions(existing, add []string) []string {
+	key := func(opt string) string {
+		k, _, _ := strings.Cut(opt, "=")
+		return k
+	}
+
+	out := existing
+	for _, opt := range add {
+		replaced := false
+
+		for i := range out {
+			if key(out[i]) == key(opt) {
+				out[i] = opt
+				replaced = true
+			}
+		}
+
+		if !replaced {
+			out = append(out, opt)
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package meta
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAddArgoCDSyncOptions(t *testing.T) {
+	cases := map[string]struct {
+		reason   string
+		existing string
+		add      []string
+		want     string
+	}{
+		"NoExistingOptions": {
+			reason: "Options should be added to an object without options.",
+			add:    []string{"Prune=false", "ServerSideApply=true"},
+			want:   "Prune=false,ServerSideApply=true",
+		},
+		"ReplaceOption": {
+			reason:   "An option should replace an existing option with the same key.",
+			existing: "Prune=true, Validate=false",
+			add:      []string{"Prune=false"},
+			want:     "Prune=false,Validate=false",
+		},
+		"AppendOption": {
+			reason:   "An option should be appended to the existing options.",
+			existing: "Validate=false",
+			add:      []string{"Prune=false"},
+			want:     "Validate=false,Prune=false",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o := &corev1.ConfigMap{}
+			if tc.existing != "" {
+				o.SetAnnotations(map[string]string{AnnotationKeyArgoCDSyncOptions: tc.existing})
+			}
+
+			AddArgoCDSyncOptions(o, tc.add...)
+
+			if diff := cmp.Diff(tc.want, o.GetAnnotations()[AnnotationKeyArgoCDSyncOptions]); diff != "" {
+				t.Errorf("\n%s\nAddArgoCDSyncOptions(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestIsPruneDisabled(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		a      map[string]string
+		want   bool
+	}{
+		"NoAnnotations": {
+			reason: "An object without annotations may be pruned.",
+			want:   false,
+		},
+		"ArgoCD": {
+			reason: "An object with the Argo CD Prune=false sync option may not be pruned.",
+			a:      map[string]string{AnnotationKeyArgoCDSyncOptions: "Validate=false,Prune=false"},
+			want:   true,
+		},
+		"Flux": {
+			reason: "An object with the Flux prune annotation set to disabled may not be pruned.",
+			a:      map[string]string{AnnotationKeyFluxPrune: FluxPruneDisabled},
+			want:   true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Annotations: tc.a}}
+
+			if diff := cmp.Diff(tc.want, IsPruneDisabled(o)); diff != "" {
+				t.Errorf("\n%s\nIsPruneDisabled(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestArgoCDSyncWave(t *testing.T) {
+	o := &corev1.ConfigMap{}
+
+	if _, ok := GetArgoCDSyncWave(o); ok {
+		t.Errorf("GetArgoCDSyncWave(...): want no sync wave")
+	}
+
+	SetArgoCDSyncWave(o, -1)
+
+	w, ok := GetArgoCDSyncWave(o)
+	if diff := cmp.Diff(-1, w); diff != "" || !ok {
+		t.Errorf("GetArgoCDSyncWave(...): -want, +got:\n%s", diff)
+	}
+}
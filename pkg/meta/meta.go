@@ -61,6 +61,71 @@ const (
 	// the resource will be filtered and thus no further reconcile requests
 	// will be queued for the resource.
 	AnnotationKeyReconciliationPaused = "crossplane.io/paused"
+
+	// AnnotationKeyLastCreateTime is the key in the annotations map of a
+	// resource that records the last time creation of the external resource
+	// was successfully requested. Its value is an RFC3339 timestamp.
+	AnnotationKeyLastCreateTime = "crossplane.io/last-create-time"
+
+	// AnnotationKeyLastUpdateTime is the key in the annotations map of a
+	// resource that records the last time an update of the external resource
+	// was successfully requested. Its value is an RFC3339 timestamp.
+	AnnotationKeyLastUpdateTime = "crossplane.io/last-update-time"
+
+	// AnnotationKeyLastObservedTime is the key in the annotations map of a
+	// resource that records the last time the external resource was
+	// successfully observed. Its value is an RFC3339 timestamp.
+	AnnotationKeyLastObservedTime = "crossplane.io/last-observed-time"
+
+	// AnnotationKeyLastOperationError is the key in the annotations map of a
+	// resource that records the error returned by the last failed operation
+	// on the external resource. It's removed when an operation succeeds.
+	AnnotationKeyLastOperationError = "crossplane.io/last-operation-error"
+
+	// AnnotationKeyConsecutiveDriftUpdates is the key in the annotations map
+	// of a resource that records how many consecutive times its external
+	// resource was updated without being observed as up to date.
+	AnnotationKeyConsecutiveDriftUpdates = "crossplane.io/consecutive-drift-updates"
+
+	// AnnotationKeyPlan is the key in the annotations map of a managed
+	// resource that, when set to `true`, asks its reconciler to plan what it
+	// would do to the external resource rather than doing it.
+	AnnotationKeyPlan = "crossplane.io/plan"
+
+	// AnnotationKeyDeletionProtection is the key in the annotations map of a
+	// managed resource that, when set to `true`, stops its reconciler from
+	// deleting the external resource until the annotation is removed.
+	AnnotationKeyDeletionProtection = "crossplane.io/deletion-protection"
+
+	// AnnotationKeyDebug is the key in the annotations map of a managed
+	// resource that, when set to `true`, asks its reconciler to log verbosely
+	// and emit additional events while reconciling it, for a limited time.
+	AnnotationKeyDebug = "crossplane.io/debug"
+
+	// AnnotationKeyDebugExpires is the key in the annotations map of a
+	// managed resource that records when debugging requested using
+	// AnnotationKeyDebug stops. Its value is an RFC3339 timestamp.
+	AnnotationKeyDebugExpires = "crossplane.io/debug-expires"
+
+	// AnnotationKeyIgnoreChanges is the key in the annotations map of a
+	// managed resource whose value is a comma separated list of field paths,
+	// for example spec.forProvider.desiredCapacity. Its reconciler doesn't
+	// update the external resource when only those fields differ from the
+	// desired state.
+	AnnotationKeyIgnoreChanges = "crossplane.io/ignore-changes"
+
+	// LabelKeyComposite is the key in the labels map of a composed resource
+	// for the name of the composite resource that owns it.
+	LabelKeyComposite = "crossplane.io/composite"
+
+	// LabelKeyClaimName is the key in the labels map of a composed resource
+	// for the name of the claim that owns its composite resource.
+	LabelKeyClaimName = "crossplane.io/claim-name"
+
+	// LabelKeyClaimNamespace is the key in the labels map of a composed
+	// resource for the namespace of the claim that owns its composite
+	// resource.
+	LabelKeyClaimNamespace = "crossplane.io/claim-namespace"
 )
 
 // ReferenceTo returns an object reference to the supplied object, presumed to
@@ -93,6 +158,20 @@ func TypedReferenceTo(o metav1.Object, of schema.GroupVersionKind) *xpv1.TypedRe
 	}
 }
 
+// NamespacedTypedReferenceTo returns a namespaced typed object reference to
+// the supplied object, presumed to be of the supplied group, version, and
+// kind.
+func NamespacedTypedReferenceTo(o metav1.Object, of schema.GroupVersionKind) *xpv1.NamespacedTypedReference {
+	v, k := of.ToAPIVersionAndKind()
+
+	return &xpv1.NamespacedTypedReference{
+		APIVersion: v,
+		Kind:       k,
+		Name:       o.GetName(),
+		Namespace:  o.GetNamespace(),
+	}
+}
+
 // AsOwner converts the supplied object reference to an owner reference.
 func AsOwner(r *xpv1.TypedReference) metav1.OwnerReference {
 	return metav1.OwnerReference{
@@ -134,6 +213,17 @@ func NamespacedNameOf(r *corev1.ObjectReference) types.NamespacedName {
 	return types.NamespacedName{Namespace: r.Namespace, Name: r.Name}
 }
 
+// NamespacedNameOfTyped returns the referenced object's namespaced name. The
+// supplied default namespace is used if the reference doesn't specify one.
+func NamespacedNameOfTyped(r *xpv1.NamespacedTypedReference, defaultNamespace string) types.NamespacedName {
+	ns := r.Namespace
+	if ns == "" {
+		ns = defaultNamespace
+	}
+
+	return types.NamespacedName{Namespace: ns, Name: r.Name}
+}
+
 // AddOwnerReference to the supplied object' metadata. Any existing owner with
 // the same UID as the supplied reference will be replaced.
 func AddOwnerReference(o metav1.Object, r metav1.OwnerReference) {
@@ -199,19 +289,22 @@ func FinalizerExists(o metav1.Object, finalizer string) bool {
 	return false
 }
 
-// AddLabels to the supplied object.
+// AddLabels to the supplied object. The object's labels are only set if they
+// change, because setting them can be expensive; e.g. for an unstructured
+// object it copies the labels.
 func AddLabels(o metav1.Object, labels map[string]string) {
 	l := o.GetLabels()
 	if l == nil {
-		o.SetLabels(labels)
+		if len(labels) > 0 {
+			o.SetLabels(labels)
+		}
+
 		return
 	}
 
-	for k, v := range labels {
-		l[k] = v
+	if merge(l, labels) {
+		o.SetLabels(l)
 	}
-
-	o.SetLabels(l)
 }
 
 // RemoveLabels with the supplied keys from the supplied object.
@@ -221,26 +314,26 @@ func RemoveLabels(o metav1.Object, labels ...string) {
 		return
 	}
 
-	for _, k := range labels {
-		delete(l, k)
+	if remove(l, labels...) {
+		o.SetLabels(l)
 	}
-
-	o.SetLabels(l)
 }
 
-// AddAnnotations to the supplied object.
+// AddAnnotations to the supplied object. The object's annotations are only set
+// if they change.
 func AddAnnotations(o metav1.Object, annotations map[string]string) {
 	a := o.GetAnnotations()
 	if a == nil {
-		o.SetAnnotations(annotations)
+		if len(annotations) > 0 {
+			o.SetAnnotations(annotations)
+		}
+
 		return
 	}
 
-	for k, v := range annotations {
-		a[k] = v
+	if merge(a, annotations) {
+		o.SetAnnotations(a)
 	}
-
-	o.SetAnnotations(a)
 }
 
 // RemoveAnnotations with the supplied keys from the supplied object.
@@ -250,13 +343,62 @@ func RemoveAnnotations(o metav1.Object, annotations ...string) {
 		return
 	}
 
-	for _, k := range annotations {
-		delete(a, k)
+	if remove(a, annotations...) {
+		o.SetAnnotations(a)
 	}
+}
 
+// setAnnotation sets a single annotation of the supplied object. Unlike
+// AddAnnotations it doesn't allocate a map unless the object has no
+// annotations.
+func setAnnotation(o metav1.Object, k, v string) {
+	a := o.GetAnnotations()
+	if a == nil {
+		o.SetAnnotations(map[string]string{k: v})
+		return
+	}
+
+	if cur, ok := a[k]; ok && cur == v {
+		return
+	}
+
+	a[k] = v
 	o.SetAnnotations(a)
 }
 
+// merge the supplied src map into dst, returning true if dst changed.
+func merge(dst, src map[string]string) bool {
+	changed := false
+
+	for k, v := range src {
+		if cur, ok := dst[k]; ok && cur == v {
+			continue
+		}
+
+		dst[k] = v
+		changed = true
+	}
+
+	return changed
+}
+
+// remove the supplied keys from m, returning true if m changed.
+func remove(m map[string]string, keys ...string) bool {
+	changed := false
+
+	for _, k := range keys {
+		if _, ok := m[k]; !ok {
+			continue
+		}
+
+		delete(m, k)
+
+		changed = true
+	}
+
+	return changed
+}
+
 // WasDeleted returns true if the supplied object was deleted from the API server.
 func WasDeleted(o metav1.Object) bool {
 	return !o.GetDeletionTimestamp().IsZero()
@@ -277,7 +419,7 @@ func GetExternalName(o metav1.Object) string {
 
 // SetExternalName sets the external name annotation of the resource.
 func SetExternalName(o metav1.Object, name string) {
-	AddAnnotations(o, map[string]string{AnnotationKeyExternalName: name})
+	setAnnotation(o, AnnotationKeyExternalName, name)
 }
 
 // GetExternalCreatePending returns the time at which the external resource
@@ -296,7 +438,7 @@ func GetExternalCreatePending(o metav1.Object) time.Time {
 // SetExternalCreatePending sets the time at which the external resource was
 // most recently pending creation to the supplied time.
 func SetExternalCreatePending(o metav1.Object, t time.Time) {
-	AddAnnotations(o, map[string]string{AnnotationKeyExternalCreatePending: t.Format(time.RFC3339)})
+	setAnnotation(o, AnnotationKeyExternalCreatePending, t.Format(time.RFC3339))
 }
 
 // GetExternalCreateSucceeded returns the time at which the external resource
@@ -315,7 +457,7 @@ func GetExternalCreateSucceeded(o metav1.Object) time.Time {
 // SetExternalCreateSucceeded sets the time at which the external resource was
 // most recently created to the supplied time.
 func SetExternalCreateSucceeded(o metav1.Object, t time.Time) {
-	AddAnnotations(o, map[string]string{AnnotationKeyExternalCreateSucceeded: t.Format(time.RFC3339)})
+	setAnnotation(o, AnnotationKeyExternalCreateSucceeded, t.Format(time.RFC3339))
 }
 
 // GetExternalCreateFailed returns the time at which the external resource
@@ -334,7 +476,7 @@ func GetExternalCreateFailed(o metav1.Object) time.Time {
 // SetExternalCreateFailed sets the time at which the external resource most
 // recently failed to create.
 func SetExternalCreateFailed(o metav1.Object, t time.Time) {
-	AddAnnotations(o, map[string]string{AnnotationKeyExternalCreateFailed: t.Format(time.RFC3339)})
+	setAnnotation(o, AnnotationKeyExternalCreateFailed, t.Format(time.RFC3339))
 }
 
 // ExternalCreateIncomplete returns true if creation of the external resource
@@ -363,12 +505,63 @@ func ExternalCreateIncomplete(o metav1.Object) bool {
 // resource that corresponds to the supplied managed resource succeeded within
 // the supplied duration.
 func ExternalCreateSucceededDuring(o metav1.Object, d time.Duration) bool {
+	return ExternalCreateSucceededWithin(o, time.Now(), d)
+}
+
+// ExternalCreateSucceededWithin returns true if creation of the external
+// resource that corresponds to the supplied managed resource succeeded within
+// the supplied duration before the supplied time. It is useful when the
+// current time is read from an injected clock.
+func ExternalCreateSucceededWithin(o metav1.Object, now time.Time, d time.Duration) bool {
 	t := GetExternalCreateSucceeded(o)
 	if t.IsZero() {
 		return false
 	}
 
-	return time.Since(t) < d
+	return now.Sub(t) < d
+}
+
+// IsPlanRequested returns true if the object has the AnnotationKeyPlan
+// annotation set to `true`.
+func IsPlanRequested(o metav1.Object) bool {
+	return o.GetAnnotations()[AnnotationKeyPlan] == "true"
+}
+
+// IsDeletionProtected returns true if the object has the
+// AnnotationKeyDeletionProtection annotation set to `true`.
+func IsDeletionProtected(o metav1.Object) bool {
+	return o.GetAnnotations()[AnnotationKeyDeletionProtection] == "true"
+}
+
+// IsDebugRequested returns true if the object has the AnnotationKeyDebug
+// annotation set to `true`.
+func IsDebugRequested(o metav1.Object) bool {
+	return o.GetAnnotations()[AnnotationKeyDebug] == "true"
+}
+
+// GetDebugExpires returns the time at which debugging of the object stops, or
+// the zero time if it's not set.
+func GetDebugExpires(o metav1.Object) time.Time {
+	a := o.GetAnnotations()[AnnotationKeyDebugExpires]
+
+	t, err := time.Parse(time.RFC3339, a)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return t
+}
+
+// SetDebugExpires sets the time at which debugging of the object stops to the
+// supplied time.
+func SetDebugExpires(o metav1.Object, t time.Time) {
+	setAnnotation(o, AnnotationKeyDebugExpires, t.Format(time.RFC3339))
+}
+
+// GetIgnoreChanges returns the field paths listed by the object's
+// AnnotationKeyIgnoreChanges annotation.
+func GetIgnoreChanges(o metav1.Object) []string {
+	return splitOptions(o.GetAnnotations()[AnnotationKeyIgnoreChanges])
 }
 
 // IsPaused returns true if the object has the AnnotationKeyReconciliationPaused
@@ -23,6 +23,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 
@@ -130,6 +131,23 @@ func TestTypedReferenceTo(t *testing.T) {
 	}
 }
 
+func TestNamespacedTypedReferenceTo(t *testing.T) {
+	o := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, UID: uid}}
+	of := schema.GroupVersionKind{Group: group, Version: version, Kind: kind}
+
+	want := &xpv1.NamespacedTypedReference{
+		APIVersion: groupVersion,
+		Kind:       kind,
+		Name:       name,
+		Namespace:  namespace,
+	}
+
+	got := NamespacedTypedReferenceTo(o, of)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("NamespacedTypedReferenceTo(): -want, +got:\n%s", diff)
+	}
+}
+
 func TestAsOwner(t *testing.T) {
 	tests := map[string]struct {
 		r    *xpv1.TypedReference
@@ -291,6 +309,34 @@ func TestNamespacedNameOf(t *testing.T) {
 	}
 }
 
+func TestNamespacedNameOfTyped(t *testing.T) {
+	cases := map[string]struct {
+		r    *xpv1.NamespacedTypedReference
+		ns   string
+		want types.NamespacedName
+	}{
+		"WithNamespace": {
+			r:    &xpv1.NamespacedTypedReference{Namespace: namespace, Name: name},
+			ns:   "default",
+			want: types.NamespacedName{Namespace: namespace, Name: name},
+		},
+		"DefaultNamespace": {
+			r:    &xpv1.NamespacedTypedReference{Name: name},
+			ns:   "default",
+			want: types.NamespacedName{Namespace: "default", Name: name},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := NamespacedNameOfTyped(tc.r, tc.ns)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("NamespacedNameOfTyped(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestAddOwnerReference(t *testing.T) {
 	owner := metav1.OwnerReference{UID: uid}
 	other := metav1.OwnerReference{UID: "a-different-uuid"}
@@ -1117,6 +1163,63 @@ func TestExternalCreateSucceededDuring(t *testing.T) {
 	}
 }
 
+func TestExternalCreateSucceededWithin(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type args struct {
+		o   metav1.Object
+		now time.Time
+		d   time.Duration
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"NotYetSuccessfullyCreated": {
+			args: args{
+				o:   &corev1.Pod{},
+				now: now,
+				d:   1 * time.Minute,
+			},
+			want: false,
+		},
+		"SuccessfullyCreatedTooLongAgo": {
+			args: args{
+				o: func() metav1.Object {
+					o := &corev1.Pod{}
+					SetExternalCreateSucceeded(o, now.Add(-2*time.Minute))
+					return o
+				}(),
+				now: now,
+				d:   1 * time.Minute,
+			},
+			want: false,
+		},
+		"SuccessfullyCreatedWithinDuration": {
+			args: args{
+				o: func() metav1.Object {
+					o := &corev1.Pod{}
+					SetExternalCreateSucceeded(o, now.Add(-30*time.Second))
+					return o
+				}(),
+				now: now,
+				d:   1 * time.Minute,
+			},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ExternalCreateSucceededWithin(tc.args.o, tc.args.now, tc.args.d)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ExternalCreateSucceededWithin(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestExternalCreateIncomplete(t *testing.T) {
 	now := time.Now().Format(time.RFC3339)
 	earlier := time.Now().Add(-1 * time.Second).Format(time.RFC3339)
@@ -1224,3 +1327,64 @@ func TestIsPaused(t *testing.T) {
 		})
 	}
 }
+
+func BenchmarkSetExternalName(b *testing.B) {
+	b.ReportAllocs()
+
+	for b.Loop() {
+		o := &corev1.Pod{}
+		SetExternalName(o, "cool")
+		SetExternalName(o, "cool")
+	}
+}
+
+func BenchmarkSetExternalNameUnstructured(b *testing.B) {
+	b.ReportAllocs()
+
+	for b.Loop() {
+		o := &unstructured.Unstructured{Object: map[string]any{}}
+		SetExternalName(o, "cool")
+		SetExternalName(o, "cool")
+	}
+}
+
+func BenchmarkRemoveAnnotationsUnstructured(b *testing.B) {
+	b.ReportAllocs()
+
+	for b.Loop() {
+		o := &unstructured.Unstructured{Object: map[string]any{}}
+		o.SetAnnotations(map[string]string{"cool": "very"})
+		RemoveAnnotations(o, AnnotationKeyExternalCreatePending)
+	}
+}
+
+func TestGetDebugExpires(t *testing.T) {
+	now := time.Now().Round(time.Second)
+
+	cases := map[string]struct {
+		o    metav1.Object
+		want time.Time
+	}{
+		"DebugExpiresExists": {
+			o:    &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{AnnotationKeyDebugExpires: now.Format(time.RFC3339)}}},
+			want: now,
+		},
+		"InvalidDebugExpires": {
+			o:    &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{AnnotationKeyDebugExpires: "soon"}}},
+			want: time.Time{},
+		},
+		"NoDebugExpires": {
+			o:    &corev1.Pod{},
+			want: time.Time{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GetDebugExpires(tc.o)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GetDebugExpires(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,305 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package migration converts legacy cluster scoped managed resources to their
+// namespaced equivalents.
+package migration
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/crossplane/crossplane-runtime/v2/apis/common"
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/fieldpath"
+)
+
+// DefaultProviderConfigKind is the kind of provider config a converted
+// managed resource references, unless configured otherwise. Legacy managed
+// resources reference cluster scoped provider configs.
+const DefaultProviderConfigKind = "ClusterProviderConfig"
+
+// Error strings.
+const (
+	errNoNamespace         = "a namespace is required to convert a cluster scoped managed resource"
+	errFmtGetField         = "cannot get field %q"
+	errFmtSetField         = "cannot set field %q"
+	errFmtDeleteField      = "cannot delete field %q"
+	errFmtSecretNamespace  = "connection secret namespace %q differs from managed resource namespace %q"
+	errFmtDeletionPolicy   = "unknown deletion policy %q"
+	errFmtManagementPolicy = "cannot convert deletion policy %q with management policies %v"
+)
+
+// Legacy managed resource fields.
+const (
+	fieldSecretNamespace   = "spec.writeConnectionSecretToRef.namespace"
+	fieldDeletionPolicy    = "spec.deletionPolicy"
+	fieldManagementPolicy  = "spec.managementPolicies"
+	fieldProviderConfigRef = "spec.providerConfigRef"
+	fieldPublishTo         = "spec.publishConnectionDetailsTo"
+	fieldConditions        = "status.conditions"
+)
+
+// An Option configures a Converter.
+type Option func(c *Converter)
+
+// WithNamespace configures the namespace of converted managed resources.
+func WithNamespace(ns string) Option {
+	return func(c *Converter) {
+		c.namespace = ns
+	}
+}
+
+// WithGroupVersionKind configures the group, version, and kind of converted
+// managed resources. Namespaced managed resources are often served by a
+// different API group than their legacy equivalents. By default the legacy
+// group, version, and kind is preserved.
+func WithGroupVersionKind(gvk schema.GroupVersionKind) Option {
+	return func(c *Converter) {
+		c.gvk = &gvk
+	}
+}
+
+// WithProviderConfigKind configures the kind of provider config converted
+// managed resources reference, if they don't specify one.
+func WithProviderConfigKind(kind string) Option {
+	return func(c *Converter) {
+		c.providerConfigKind = kind
+	}
+}
+
+// WithAnnotationMapping configures the converter to rename annotations. The
+// supplied map is keyed by legacy annotation key. Annotations mapped to an
+// empty key are removed.
+func WithAnnotationMapping(m map[string]string) Option {
+	return func(c *Converter) {
+		for k, v := range m {
+			c.annotations[k] = v
+		}
+	}
+}
+
+// A Converter converts legacy cluster scoped managed resources to their
+// namespaced equivalents. It operates on unstructured data, so it can be used
+// by conversion webhooks and one-shot migration jobs alike.
+type Converter struct {
+	namespace          string
+	gvk                *schema.GroupVersionKind
+	providerConfigKind string
+	annotations        map[string]string
+}
+
+// NewConverter returns a new Converter.
+func NewConverter(o ...Option) *Converter {
+	c := &Converter{
+		providerConfigKind: DefaultProviderConfigKind,
+		annotations:        map[string]string{},
+	}
+
+	for _, fn := range o {
+		fn(c)
+	}
+
+	return c
+}
+
+// Convert the supplied legacy cluster scoped managed resource to its
+// namespaced equivalent. The supplied managed resource is not modified.
+//
+// Convert:
+//   - Sets the managed resource's namespace.
+//   - Removes server populated metadata, like its UID and resource version.
+//   - Removes the namespace of its connection secret reference, which must
+//     match the managed resource's namespace.
+//   - Replaces its deletion policy with equivalent management policies.
+//   - Sets the kind of its provider config reference.
+//   - Removes its publishConnectionDetailsTo, which is not supported.
+//   - Renames or removes annotations per WithAnnotationMapping.
+//   - Preserves its status, but clears the observed generation of its
+//     conditions, which is not meaningful for the new resource.
+func (c *Converter) Convert(legacy *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	if c.namespace == "" {
+		return nil, errors.New(errNoNamespace)
+	}
+
+	u := legacy.DeepCopy()
+	if c.gvk != nil {
+		u.SetGroupVersionKind(*c.gvk)
+	}
+
+	c.convertMetadata(u)
+
+	p := fieldpath.Pave(u.Object)
+
+	for _, fn := range []func(p *fieldpath.Paved) error{
+		c.convertConnectionSecretRef,
+		convertDeletionPolicy,
+		c.convertProviderConfigRef,
+		convertStatus,
+	} {
+		if err := fn(p); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.DeleteField(fieldPublishTo); err != nil {
+		return nil, errors.Wrapf(err, errFmtDeleteField, fieldPublishTo)
+	}
+
+	u.SetUnstructuredContent(p.UnstructuredContent())
+
+	return u, nil
+}
+
+func (c *Converter) convertMetadata(u *unstructured.Unstructured) {
+	u.SetNamespace(c.namespace)
+
+	for _, f := range []string{"uid", "resourceVersion", "generation", "creationTimestamp", "managedFields", "selfLink"} {
+		unstructured.RemoveNestedField(u.Object, "metadata", f)
+	}
+
+	a := u.GetAnnotations()
+	if len(a) == 0 {
+		return
+	}
+
+	for from, to := range c.annotations {
+		v, ok := a[from]
+		if !ok {
+			continue
+		}
+
+		delete(a, from)
+
+		if to != "" {
+			a[to] = v
+		}
+	}
+
+	u.SetAnnotations(a)
+}
+
+func (c *Converter) convertConnectionSecretRef(p *fieldpath.Paved) error {
+	ns, err := p.GetString(fieldSecretNamespace)
+	if fieldpath.IsNotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return errors.Wrapf(err, errFmtGetField, fieldSecretNamespace)
+	}
+
+	if ns != "" && ns != c.namespace {
+		return errors.Errorf(errFmtSecretNamespace, ns, c.namespace)
+	}
+
+	return errors.Wrapf(p.DeleteField(fieldSecretNamespace), errFmtDeleteField, fieldSecretNamespace)
+}
+
+func convertDeletionPolicy(p *fieldpath.Paved) error {
+	dp, err := p.GetString(fieldDeletionPolicy)
+	if fieldpath.IsNotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return errors.Wrapf(err, errFmtGetField, fieldDeletionPolicy)
+	}
+
+	if err := p.DeleteField(fieldDeletionPolicy); err != nil {
+		return errors.Wrapf(err, errFmtDeleteField, fieldDeletionPolicy)
+	}
+
+	switch xpv1.DeletionPolicy(dp) {
+	case xpv1.DeletionDelete:
+		return nil
+	case xpv1.DeletionOrphan:
+	default:
+		return errors.Errorf(errFmtDeletionPolicy, dp)
+	}
+
+	// Orphaning is expressed by omitting the Delete management action.
+	mp := common.ManagementPolicies{}
+	if err := p.GetValueInto(fieldManagementPolicy, &mp); err != nil && !fieldpath.IsNotFound(err) {
+		return errors.Wrapf(err, errFmtGetField, fieldManagementPolicy)
+	}
+
+	if len(mp) == 0 {
+		mp = common.ManagementPolicies{common.ManagementActionAll}
+	}
+
+	orphan := common.ManagementPolicies{}
+
+	for _, a := range mp {
+		switch a {
+		case common.ManagementActionAll:
+			orphan = append(orphan,
+				common.ManagementActionObserve,
+				common.ManagementActionCreate,
+				common.ManagementActionUpdate,
+				common.ManagementActionLateInitialize,
+			)
+		case common.ManagementActionDelete:
+		default:
+			orphan = append(orphan, a)
+		}
+	}
+
+	if len(orphan) == 0 {
+		return errors.Errorf(errFmtManagementPolicy, dp, mp)
+	}
+
+	return errors.Wrapf(p.SetValue(fieldManagementPolicy, orphan), errFmtSetField, fieldManagementPolicy)
+}
+
+func (c *Converter) convertProviderConfigRef(p *fieldpath.Paved) error {
+	ref := map[string]any{}
+	if err := p.GetValueInto(fieldProviderConfigRef, &ref); err != nil {
+		if fieldpath.IsNotFound(err) {
+			return nil
+		}
+
+		return errors.Wrapf(err, errFmtGetField, fieldProviderConfigRef)
+	}
+
+	// Legacy provider config references may specify a resolution policy,
+	// which is not supported by namespaced managed resources.
+	delete(ref, "policy")
+
+	if _, ok := ref["kind"]; !ok {
+		ref["kind"] = c.providerConfigKind
+	}
+
+	return errors.Wrapf(p.SetValue(fieldProviderConfigRef, ref), errFmtSetField, fieldProviderConfigRef)
+}
+
+func convertStatus(p *fieldpath.Paved) error {
+	cs := []xpv1.Condition{}
+	if err := p.GetValueInto(fieldConditions, &cs); err != nil {
+		if fieldpath.IsNotFound(err) {
+			return nil
+		}
+
+		return errors.Wrapf(err, errFmtGetField, fieldConditions)
+	}
+
+	for i := range cs {
+		cs[i].ObservedGeneration = 0
+	}
+
+	return errors.Wrapf(p.SetValue(fieldConditions, cs), errFmtSetField, fieldConditions)
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migration
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+)
+
+func TestConvert(t *testing.T) {
+	legacy := func(spec map[string]any) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "example.org/v1",
+			"kind":       "Cool",
+			"metadata": map[string]any{
+				"name":              "cool",
+				"uid":               "some-uid",
+				"resourceVersion":   "42",
+				"generation":        int64(3),
+				"creationTimestamp": "2026-01-01T00:00:00Z",
+				"annotations": map[string]any{
+					"crossplane.io/external-name": "cool-external",
+					"example.org/legacy":          "value",
+				},
+			},
+			"spec": spec,
+			"status": map[string]any{
+				"atProvider": map[string]any{"id": "cool-id"},
+				"conditions": []any{
+					map[string]any{
+						"type":               "Ready",
+						"status":             "True",
+						"reason":             "Available",
+						"lastTransitionTime": "2026-01-01T00:00:00Z",
+						"observedGeneration": int64(3),
+					},
+				},
+			},
+		}}
+	}
+
+	namespaced := func(apiVersion string, spec map[string]any) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": apiVersion,
+			"kind":       "Cool",
+			"metadata": map[string]any{
+				"name":      "cool",
+				"namespace": "default",
+				"annotations": map[string]any{
+					"crossplane.io/external-name": "cool-external",
+					"example.org/namespaced":      "value",
+				},
+			},
+			"spec": spec,
+			"status": map[string]any{
+				"atProvider": map[string]any{"id": "cool-id"},
+				"conditions": []any{
+					map[string]any{
+						"type":               "Ready",
+						"status":             "True",
+						"reason":             "Available",
+						"lastTransitionTime": "2026-01-01T00:00:00Z",
+					},
+				},
+			},
+		}}
+	}
+
+	defaultOptions := []Option{
+		WithNamespace("default"),
+		WithAnnotationMapping(map[string]string{"example.org/legacy": "example.org/namespaced"}),
+	}
+
+	type args struct {
+		o      []Option
+		legacy *unstructured.Unstructured
+	}
+
+	type want struct {
+		u   *unstructured.Unstructured
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"NoNamespace": {
+			reason: "We should return an error if no namespace was configured.",
+			args: args{
+				legacy: legacy(map[string]any{}),
+			},
+			want: want{
+				err: errors.New(errNoNamespace),
+			},
+		},
+		"DeletionPolicyDelete": {
+			reason: "We should convert a legacy managed resource with the default deletion policy.",
+			args: args{
+				o: append(defaultOptions, WithGroupVersionKind(schema.GroupVersionKind{Group: "m.example.org", Version: "v1", Kind: "Cool"})),
+				legacy: legacy(map[string]any{
+					"deletionPolicy":             "Delete",
+					"providerConfigRef":          map[string]any{"name": "default", "policy": map[string]any{"resolve": "Always"}},
+					"writeConnectionSecretToRef": map[string]any{"name": "cool-secret", "namespace": "default"},
+					"publishConnectionDetailsTo": map[string]any{"name": "cool-secret"},
+					"forProvider":                map[string]any{"region": "us-east-1"},
+				}),
+			},
+			want: want{
+				u: namespaced("m.example.org/v1", map[string]any{
+					"providerConfigRef":          map[string]any{"name": "default", "kind": "ClusterProviderConfig"},
+					"writeConnectionSecretToRef": map[string]any{"name": "cool-secret"},
+					"forProvider":                map[string]any{"region": "us-east-1"},
+				}),
+			},
+		},
+		"DeletionPolicyOrphan": {
+			reason: "We should express an orphan deletion policy as management policies.",
+			args: args{
+				o: defaultOptions,
+				legacy: legacy(map[string]any{
+					"deletionPolicy": "Orphan",
+				}),
+			},
+			want: want{
+				u: namespaced("example.org/v1", map[string]any{
+					"managementPolicies": []any{"Observe", "Create", "Update", "LateInitialize"},
+				}),
+			},
+		},
+		"DeletionPolicyOrphanWithManagementPolicies": {
+			reason: "We should remove the Delete action from existing management policies when orphaning.",
+			args: args{
+				o: defaultOptions,
+				legacy: legacy(map[string]any{
+					"deletionPolicy":     "Orphan",
+					"managementPolicies": []any{"Observe", "Delete"},
+				}),
+			},
+			want: want{
+				u: namespaced("example.org/v1", map[string]any{
+					"managementPolicies": []any{"Observe"},
+				}),
+			},
+		},
+		"SecretInOtherNamespace": {
+			reason: "We should return an error if the connection secret is in another namespace.",
+			args: args{
+				o: defaultOptions,
+				legacy: legacy(map[string]any{
+					"writeConnectionSecretToRef": map[string]any{"name": "cool-secret", "namespace": "other"},
+				}),
+			},
+			want: want{
+				err: errors.Errorf(errFmtSecretNamespace, "other", "default"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := NewConverter(tc.args.o...).Convert(tc.args.legacy)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nConvert(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.want.u, got); diff != "" {
+				t.Errorf("\n%s\nConvert(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,247 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package providerconfig caches sessions built from ProviderConfig
+// credentials.
+package providerconfig
+
+import (
+	"context"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+)
+
+// Error strings.
+const (
+	errExtractCredentials = "cannot extract credentials"
+	errNewSession         = "cannot create session from credentials"
+	errGetSecretInformer  = "cannot get Secret informer"
+	errAddSecretHandler   = "cannot add Secret event handler"
+)
+
+// A NewSessionFn builds a session, for example a cloud SDK client config,
+// from the supplied credentials. Building a session may be expensive, for
+// example because it exchanges the credentials for a token.
+type NewSessionFn[T any] func(ctx context.Context, creds []byte) (T, error)
+
+// Credentials a ProviderConfig uses to build a session.
+type Credentials struct {
+	// Source of the credentials.
+	Source xpv1.CredentialsSource
+
+	// Selectors used to extract the credentials from their source.
+	Selectors xpv1.CommonCredentialSelectors
+}
+
+// A SessionCache returns sessions built from a ProviderConfig's credentials.
+// Provider Connect implementations should use a SessionCache rather than
+// extracting credentials and building a session each time they connect.
+type SessionCache[T any] interface {
+	Session(ctx context.Context, pc resource.ProviderConfig, creds Credentials, fn NewSessionFn[T]) (T, error)
+}
+
+// A NopSessionCache doesn't cache sessions. It extracts credentials and
+// builds a new session each time one is requested.
+type NopSessionCache[T any] struct {
+	client client.Client
+}
+
+// NewNopSessionCache returns a SessionCache that doesn't cache sessions.
+func NewNopSessionCache[T any](c client.Client) *NopSessionCache[T] {
+	return &NopSessionCache[T]{client: c}
+}
+
+// Session extracts credentials and builds a new session.
+func (c *NopSessionCache[T]) Session(ctx context.Context, _ resource.ProviderConfig, creds Credentials, fn NewSessionFn[T]) (T, error) {
+	return newSession(ctx, c.client, creds, fn)
+}
+
+type entry[T any] struct {
+	session    T
+	generation int64
+	secret     types.NamespacedName
+}
+
+// A CredentialCache caches sessions built from a ProviderConfig's
+// credentials. A cached session is used until the ProviderConfig's generation
+// changes, or until the Secret its credentials were read from changes.
+type CredentialCache[T any] struct {
+	client client.Client
+
+	mu      sync.Mutex
+	entries map[types.UID]entry[T]
+
+	// building tracks Secrets from which sessions are being built, so that
+	// a session isn't cached if its Secret changes while it's being built.
+	building map[types.NamespacedName]*build
+}
+
+// A build tracks the sessions being built from a Secret.
+type build struct {
+	// sessions being built.
+	sessions int
+
+	// epoch is incremented each time the Secret is invalidated.
+	epoch uint64
+}
+
+// NewCredentialCache returns a SessionCache that caches sessions. Use Watch
+// to invalidate sessions when their credentials Secret changes.
+func NewCredentialCache[T any](c client.Client) *CredentialCache[T] {
+	return &CredentialCache[T]{client: c, entries: make(map[types.UID]entry[T]), building: make(map[types.NamespacedName]*build)}
+}
+
+// Session returns the cached session for the supplied ProviderConfig, if any.
+// Otherwise it extracts the supplied credentials, builds a session, and caches
+// it. A session is returned but not cached if the Secret its credentials were
+// read from changes while it's being built.
+func (c *CredentialCache[T]) Session(ctx context.Context, pc resource.ProviderConfig, creds Credentials, fn NewSessionFn[T]) (T, error) {
+	e := entry[T]{generation: pc.GetGeneration()}
+	if creds.Source == xpv1.CredentialsSourceSecret && creds.Selectors.SecretRef != nil {
+		e.secret = types.NamespacedName{Namespace: creds.Selectors.SecretRef.Namespace, Name: creds.Selectors.SecretRef.Name}
+	}
+
+	c.mu.Lock()
+	cached, ok := c.entries[pc.GetUID()]
+	if ok && cached.generation == pc.GetGeneration() {
+		c.mu.Unlock()
+		return cached.session, nil
+	}
+
+	b, ok := c.building[e.secret]
+	if !ok {
+		b = &build{}
+		c.building[e.secret] = b
+	}
+	b.sessions++
+	epoch := b.epoch
+	c.mu.Unlock()
+
+	s, err := newSession(ctx, c.client, creds, fn)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	b.sessions--
+	if b.sessions == 0 {
+		delete(c.building, e.secret)
+	}
+
+	if err != nil {
+		return s, err
+	}
+
+	// Don't cache a session built from credentials that may be stale.
+	if b.epoch != epoch {
+		return s, nil
+	}
+
+	e.session = s
+	c.entries[pc.GetUID()] = e
+
+	return s, nil
+}
+
+// Invalidate any cached sessions built from credentials read from the supplied
+// Secret.
+func (c *CredentialCache[T]) Invalidate(secret types.NamespacedName) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if b, ok := c.building[secret]; ok {
+		b.epoch++
+	}
+
+	for uid, e := range c.entries {
+		if e.secret == secret {
+			delete(c.entries, uid)
+		}
+	}
+}
+
+// Forget the cached session for the supplied ProviderConfig, for example
+// because it was deleted.
+func (c *CredentialCache[T]) Forget(pc resource.ProviderConfig) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, pc.GetUID())
+}
+
+// Watch Secrets using the supplied informers, and invalidate cached sessions
+// when the Secret their credentials were read from is updated or deleted.
+func (c *CredentialCache[T]) Watch(ctx context.Context, i cache.Informers) error {
+	inf, err := i.GetInformer(ctx, &corev1.Secret{})
+	if err != nil {
+		return errors.Wrap(err, errGetSecretInformer)
+	}
+
+	_, err = inf.AddEventHandler(toolscache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(oldObj, newObj any) {
+			// Informers periodically resync, sending updates for unchanged
+			// Secrets.
+			o, ook := oldObj.(*corev1.Secret)
+			n, nok := newObj.(*corev1.Secret)
+			if ook && nok && o.GetResourceVersion() == n.GetResourceVersion() {
+				return
+			}
+			c.invalidate(newObj)
+		},
+		DeleteFunc: c.invalidate,
+	})
+
+	return errors.Wrap(err, errAddSecretHandler)
+}
+
+func (c *CredentialCache[T]) invalidate(obj any) {
+	// Deleted objects may be wrapped in a tombstone if the informer missed
+	// their deletion.
+	if t, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+		obj = t.Obj
+	}
+
+	s, ok := obj.(*corev1.Secret)
+	if !ok {
+		return
+	}
+
+	c.Invalidate(types.NamespacedName{Namespace: s.GetNamespace(), Name: s.GetName()})
+}
+
+func newSession[T any](ctx context.Context, c client.Client, creds Credentials, fn NewSessionFn[T]) (T, error) {
+	var zero T
+
+	data, err := resource.CommonCredentialExtractor(ctx, creds.Source, c, creds.Selectors)
+	if err != nil {
+		return zero, errors.Wrap(err, errExtractCredentials)
+	}
+
+	s, err := fn(ctx, data)
+	if err != nil {
+		return zero, errors.Wrap(err, errNewSession)
+	}
+
+	return s, nil
+}
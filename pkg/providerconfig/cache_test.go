@@ -0,0 +1,255 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package providerconfig
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+)
+
+func TestCredentialCacheSession(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	creds := Credentials{
+		Source: xpv1.CredentialsSourceSecret,
+		Selectors: xpv1.CommonCredentialSelectors{
+			SecretRef: &xpv1.SecretKeySelector{
+				SecretReference: xpv1.SecretReference{Namespace: "crossplane-system", Name: "creds"},
+				Key:             "key",
+			},
+		},
+	}
+	secret := types.NamespacedName{Namespace: "crossplane-system", Name: "creds"}
+
+	pc := func(gen int64) *fake.ProviderConfig {
+		return &fake.ProviderConfig{ObjectMeta: metav1.ObjectMeta{UID: "cool-pc", Generation: gen}}
+	}
+
+	// Reading the credentials Secret returns how many times it has been read.
+	newClient := func(err error) (client.Client, *int) {
+		reads := 0
+		return &test.MockClient{
+			MockGet: test.NewMockGetFn(err, func(obj client.Object) error {
+				reads++
+				//nolint:forcetypeassert // This is always a Secret.
+				obj.(*corev1.Secret).Data = map[string][]byte{"key": []byte("secret")}
+
+				return nil
+			}),
+		}, &reads
+	}
+
+	newSession := func(_ context.Context, creds []byte) (string, error) {
+		return "session-for-" + string(creds), nil
+	}
+
+	type args struct {
+		getErr error
+		prime  func(c *CredentialCache[string])
+		pc     *fake.ProviderConfig
+		fn     NewSessionFn[string]
+	}
+
+	type want struct {
+		session string
+		reads   int
+		err     error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"Miss": {
+			reason: "We should extract credentials and build a session if none is cached.",
+			args: args{
+				pc: pc(1),
+				fn: newSession,
+			},
+			want: want{
+				session: "session-for-secret",
+				reads:   1,
+			},
+		},
+		"Hit": {
+			reason: "We should return the cached session without reading credentials.",
+			args: args{
+				prime: func(c *CredentialCache[string]) {
+					_, _ = c.Session(context.Background(), pc(1), creds, newSession)
+				},
+				pc: pc(1),
+				fn: func(_ context.Context, _ []byte) (string, error) { return "", errBoom },
+			},
+			want: want{
+				session: "session-for-secret",
+				reads:   1,
+			},
+		},
+		"NewGeneration": {
+			reason: "We should build a new session if the ProviderConfig changed.",
+			args: args{
+				prime: func(c *CredentialCache[string]) {
+					_, _ = c.Session(context.Background(), pc(1), creds, newSession)
+				},
+				pc: pc(2),
+				fn: newSession,
+			},
+			want: want{
+				session: "session-for-secret",
+				reads:   2,
+			},
+		},
+		"SecretChanged": {
+			reason: "We should build a new session if the credentials Secret changed.",
+			args: args{
+				prime: func(c *CredentialCache[string]) {
+					_, _ = c.Session(context.Background(), pc(1), creds, newSession)
+					c.Invalidate(secret)
+				},
+				pc: pc(1),
+				fn: newSession,
+			},
+			want: want{
+				session: "session-for-secret",
+				reads:   2,
+			},
+		},
+		"SecretChangedDuringBuild": {
+			reason: "We should not cache a session if its credentials Secret changed while it was being built.",
+			args: args{
+				prime: func(c *CredentialCache[string]) {
+					_, _ = c.Session(context.Background(), pc(1), creds, func(_ context.Context, _ []byte) (string, error) {
+						c.Invalidate(secret)
+						return "stale", nil
+					})
+				},
+				pc: pc(1),
+				fn: newSession,
+			},
+			want: want{
+				session: "session-for-secret",
+				reads:   2,
+			},
+		},
+		"OtherSecretChanged": {
+			reason: "We should return the cached session if an unrelated Secret changed.",
+			args: args{
+				prime: func(c *CredentialCache[string]) {
+					_, _ = c.Session(context.Background(), pc(1), creds, newSession)
+					c.Invalidate(types.NamespacedName{Namespace: "crossplane-system", Name: "other"})
+				},
+				pc: pc(1),
+				fn: newSession,
+			},
+			want: want{
+				session: "session-for-secret",
+				reads:   1,
+			},
+		},
+		"ExtractError": {
+			reason: "We should return any error encountered extracting credentials.",
+			args: args{
+				getErr: errBoom,
+				pc:     pc(1),
+				fn:     newSession,
+			},
+			want: want{
+				reads: 1,
+				err:   errors.Wrap(errors.Wrap(errBoom, "cannot get credentials secret"), errExtractCredentials),
+			},
+		},
+		"NewSessionError": {
+			reason: "We should return any error encountered building a session.",
+			args: args{
+				pc: pc(1),
+				fn: func(_ context.Context, _ []byte) (string, error) { return "", errBoom },
+			},
+			want: want{
+				reads: 1,
+				err:   errors.Wrap(errBoom, errNewSession),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c, reads := newClient(tc.args.getErr)
+			cc := NewCredentialCache[string](c)
+
+			if tc.args.prime != nil {
+				tc.args.prime(cc)
+			}
+
+			got, err := cc.Session(context.Background(), tc.args.pc, creds, tc.args.fn)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nSession(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.want.session, got); diff != "" {
+				t.Errorf("\n%s\nSession(...): -want, +got:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.want.reads, *reads); diff != "" {
+				t.Errorf("\n%s\nSession(...): -want Secret reads, +got Secret reads:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCredentialCacheInvalidate(t *testing.T) {
+	s := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "crossplane-system", Name: "creds"}}
+
+	cases := map[string]struct {
+		reason string
+		obj    any
+	}{
+		"Secret": {
+			reason: "We should invalidate sessions built from a deleted Secret.",
+			obj:    s,
+		},
+		"Tombstone": {
+			reason: "We should invalidate sessions built from a Secret whose deletion the informer missed.",
+			obj:    toolscache.DeletedFinalStateUnknown{Key: "crossplane-system/creds", Obj: s},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cc := NewCredentialCache[string](nil)
+			cc.entries["cool-pc"] = entry[string]{secret: types.NamespacedName{Namespace: "crossplane-system", Name: "creds"}}
+
+			cc.invalidate(tc.obj)
+
+			if diff := cmp.Diff(0, len(cc.entries)); diff != "" {
+				t.Errorf("\n%s\ninvalidate(...): -want entries, +got entries:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
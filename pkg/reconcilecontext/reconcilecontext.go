@@ -0,0 +1,98 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package reconcilecontext propagates information about a reconcile through
+// the context passed to the code it calls, for example an ExternalClient. It
+// allows providers to correlate their own logs, such as those of a cloud SDK,
+// with the logs, events, and change logs of the reconcile.
+package reconcilecontext
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+)
+
+// Info about a reconcile.
+type Info struct {
+	// ID uniquely identifies the reconcile.
+	ID string
+
+	// GVK of the resource being reconciled.
+	GVK schema.GroupVersionKind
+
+	// Namespace, name, and UID of the resource being reconciled.
+	Namespace string
+	Name      string
+	UID       types.UID
+
+	// Deadline by which calls made by the reconcile must complete. It's zero
+	// if there is no deadline.
+	Deadline time.Time
+}
+
+// KeysAndValues returns the structured log data that describes the reconcile.
+func (i Info) KeysAndValues() []any {
+	kv := []any{
+		logging.KeyReconcileID, i.ID,
+		logging.KeyGVK, i.GVK.String(),
+		"name", i.Name,
+		"uid", string(i.UID),
+	}
+
+	if i.Namespace != "" {
+		kv = append(kv, "namespace", i.Namespace)
+	}
+
+	return kv
+}
+
+type infoKey struct{}
+
+// WithInfo returns a context that carries the supplied reconcile information.
+func WithInfo(ctx context.Context, i Info) context.Context {
+	return context.WithValue(ctx, infoKey{}, i)
+}
+
+// InfoFrom returns the reconcile information carried by the supplied context,
+// if any.
+func InfoFrom(ctx context.Context) (Info, bool) {
+	i, ok := ctx.Value(infoKey{}).(Info)
+	return i, ok
+}
+
+// IDFrom returns the ID of the reconcile carried by the supplied context, if
+// any.
+func IDFrom(ctx context.Context) (string, bool) {
+	i, ok := InfoFrom(ctx)
+	return i.ID, ok && i.ID != ""
+}
+
+// Logger returns a logger that includes the reconcile information carried by
+// the supplied context with any messages it logs. It returns the supplied
+// logger if the context carries no reconcile information.
+func Logger(ctx context.Context, log logging.Logger) logging.Logger {
+	i, ok := InfoFrom(ctx)
+	if !ok {
+		return log
+	}
+
+	return log.WithValues(i.KeysAndValues()...)
+}
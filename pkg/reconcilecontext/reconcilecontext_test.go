@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconcilecontext
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestIDFrom(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		ctx    context.Context
+		wantID string
+		wantOK bool
+	}{
+		"NoInfo": {
+			reason: "A context without reconcile information should carry no ID.",
+			ctx:    context.Background(),
+		},
+		"NoID": {
+			reason: "A context with reconcile information that has no ID should carry no ID.",
+			ctx:    WithInfo(context.Background(), Info{Name: "cool"}),
+		},
+		"ID": {
+			reason: "A context with reconcile information should carry its ID.",
+			ctx:    WithInfo(context.Background(), Info{ID: "cool-id"}),
+			wantID: "cool-id",
+			wantOK: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			id, ok := IDFrom(tc.ctx)
+			if diff := cmp.Diff(tc.wantID, id); diff != "" {
+				t.Errorf("\n%s\nIDFrom(...): -want ID, +got ID:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.wantOK, ok); diff != "" {
+				t.Errorf("\n%s\nIDFrom(...): -want ok, +got ok:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestKeysAndValues(t *testing.T) {
+	gvk := schema.GroupVersionKind{Group: "example.org", Version: "v1", Kind: "Cool"}
+
+	cases := map[string]struct {
+		reason string
+		i      Info
+		want   []any
+	}{
+		"ClusterScoped": {
+			reason: "A cluster scoped resource should not have a namespace.",
+			i:      Info{ID: "cool-id", GVK: gvk, Name: "cool", UID: "cool-uid"},
+			want:   []any{"reconcile-id", "cool-id", "gvk", gvk.String(), "name", "cool", "uid", "cool-uid"},
+		},
+		"Namespaced": {
+			reason: "A namespaced resource should have a namespace.",
+			i:      Info{ID: "cool-id", GVK: gvk, Namespace: "default", Name: "cool", UID: "cool-uid"},
+			want:   []any{"reconcile-id", "cool-id", "gvk", gvk.String(), "name", "cool", "uid", "cool-uid", "namespace", "default"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := tc.i.KeysAndValues()
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nKeysAndValues(): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
@@ -40,11 +41,18 @@ const (
 	// fieldOwnerAPISimpleRefResolver owns the reference fields
 	// the managed reconciler resolves.
 	fieldOwnerAPISimpleRefResolver = "managed.crossplane.io/api-simple-reference-resolver"
+
+	// fieldOwnerCriticalAnnotationUpdater owns the critical annotations
+	// the managed reconciler applies.
+	fieldOwnerCriticalAnnotationUpdater = "managed.crossplane.io/critical-annotation-updater"
 )
 
 // Error strings.
 const (
 	errCreateOrUpdateSecret      = "cannot create or update connection secret"
+	errGetSecret                 = "cannot get connection secret"
+	errEncryptConnection         = "cannot encrypt connection details"
+	errDecryptConnection         = "cannot decrypt connection details"
 	errUpdateManaged             = "cannot update managed resource"
 	errPatchManaged              = "cannot patch the managed resource via server-side apply"
 	errMarshalExisting           = "cannot marshal the existing object into JSON"
@@ -76,21 +84,192 @@ func (a *NameAsExternalName) Initialize(ctx context.Context, mg resource.Managed
 	return errors.Wrap(a.client.Update(ctx, mg), errUpdateManaged)
 }
 
+// A ConnectionSecretOption configures how connection secrets are written and
+// read.
+type ConnectionSecretOption func(o *connectionSecretOptions)
+
+type connectionSecretOptions struct {
+	templates *ConnectionDetailsTemplates
+	encryptor ConnectionDetailsEncryptor
+	ownership ConnectionSecretOwnership
+}
+
+// ConnectionSecretOwnership determines which object owns a managed resource's
+// connection secret, and thus when Kubernetes garbage collects it.
+type ConnectionSecretOwnership string
+
+// Connection secret ownerships.
+const (
+	// ConnectionSecretOwnedByManaged connection secrets are controlled by
+	// their managed resource, and deleted when it's deleted. This is the
+	// default.
+	ConnectionSecretOwnedByManaged ConnectionSecretOwnership = "Managed"
+
+	// ConnectionSecretOwnedByComposite connection secrets are controlled by
+	// the controller of their managed resource, typically the composite
+	// resource that composed it, and deleted when it's deleted. Connection
+	// secrets of managed resources that have no controller are controlled
+	// by their managed resource.
+	ConnectionSecretOwnedByComposite ConnectionSecretOwnership = "Composite"
+
+	// ConnectionSecretNotOwned connection secrets have no owner, and aren't
+	// garbage collected. Existing owner references aren't removed.
+	ConnectionSecretNotOwned ConnectionSecretOwnership = "None"
+)
+
+// WithConnectionDetailsTemplates derives additional connection details from
+// the raw connection details before they're written to a connection secret,
+// so that consumers get ready to use values. Derived connection details are
+// written before they're encrypted.
+func WithConnectionDetailsTemplates(t *ConnectionDetailsTemplates) ConnectionSecretOption {
+	return func(o *connectionSecretOptions) {
+		o.templates = t
+	}
+}
+
+// WithConnectionDetailsEncryptor encrypts connection details before they're
+// written to a connection secret, and decrypts them after they're read. Use
+// it, for example with an EnvelopeEncryptor, when connection secrets must not
+// contain plaintext credentials but etcd encryption at rest isn't available.
+// Consumers of the connection secret must decrypt it too.
+func WithConnectionDetailsEncryptor(e ConnectionDetailsEncryptor) ConnectionSecretOption {
+	return func(o *connectionSecretOptions) {
+		o.encryptor = e
+	}
+}
+
+// WithConnectionSecretOwnership configures which object owns the connection
+// secrets that are written. By default they're owned by their managed
+// resource.
+func WithConnectionSecretOwnership(o ConnectionSecretOwnership) ConnectionSecretOption {
+	return func(opts *connectionSecretOptions) {
+		opts.ownership = o
+	}
+}
+
+func newConnectionSecretOptions(o ...ConnectionSecretOption) connectionSecretOptions {
+	opts := connectionSecretOptions{}
+	for _, fn := range o {
+		fn(&opts)
+	}
+
+	return opts
+}
+
+// derive connection details from the supplied connection details, if
+// templates are configured.
+func (o connectionSecretOptions) derive(c ConnectionDetails) (ConnectionDetails, error) {
+	if o.templates == nil {
+		return c, nil
+	}
+
+	return o.templates.Derive(c)
+}
+
+// encrypt the supplied connection details, if an encryptor is configured.
+func (o connectionSecretOptions) encrypt(ctx context.Context, c ConnectionDetails) (ConnectionDetails, error) {
+	if o.encryptor == nil {
+		return c, nil
+	}
+
+	e, err := o.encryptor.Encrypt(ctx, c)
+
+	return e, errors.Wrap(err, errEncryptConnection)
+}
+
+// owners returns the owner references of the connection secret of the
+// supplied managed resource, and the UIDs that may control an existing
+// connection secret. A connection secret owned by its composite may also be
+// controlled by its managed resource, so that ownership can be handed over.
+func (o connectionSecretOptions) owners(mg metav1.Object, kind schema.GroupVersionKind) ([]metav1.OwnerReference, []types.UID) {
+	switch o.ownership {
+	case ConnectionSecretOwnedByComposite:
+		if c := metav1.GetControllerOf(mg); c != nil {
+			return []metav1.OwnerReference{*c}, []types.UID{c.UID, mg.GetUID()}
+		}
+	case ConnectionSecretNotOwned:
+		return nil, []types.UID{mg.GetUID()}
+	case ConnectionSecretOwnedByManaged:
+	}
+
+	return []metav1.OwnerReference{meta.AsController(meta.TypedReferenceTo(mg, kind))}, []types.UID{mg.GetUID()}
+}
+
+// decrypt the supplied connection details, if an encryptor is configured.
+func (o connectionSecretOptions) decrypt(ctx context.Context, c ConnectionDetails) (ConnectionDetails, error) {
+	if o.encryptor == nil {
+		return c, nil
+	}
+
+	d, err := o.encryptor.Decrypt(ctx, c)
+
+	return d, errors.Wrap(err, errDecryptConnection)
+}
+
+// upToDate returns true if the supplied connection secret exists, may be
+// controlled by any of the supplied UIDs, and its decrypted data matches the
+// supplied connection details. Encrypting connection details takes a round
+// trip to a KMS, so we check whether an encrypted connection secret is up to
+// date before encrypting them. It returns false if no encryptor is configured,
+// or if the check fails, in which case the connection secret is applied as
+// usual.
+func (o connectionSecretOptions) upToDate(ctx context.Context, c client.Reader, s *corev1.Secret, controllers []types.UID, desired ConnectionDetails) bool {
+	if o.encryptor == nil {
+		return false
+	}
+
+	current := &corev1.Secret{}
+	if err := c.Get(ctx, types.NamespacedName{Namespace: s.GetNamespace(), Name: s.GetName()}, current); err != nil {
+		return false
+	}
+
+	if err := resource.ConnectionSecretMustBeControllableByAny(controllers...)(ctx, current, s); err != nil {
+		return false
+	}
+
+	return !o.changed(ctx, desired)(current, s)
+}
+
+// changed returns a function that returns true if the data of the current
+// Secret differs from the supplied desired connection details. Encrypted data
+// differs each time it's encrypted, so the plaintext is compared when an
+// encryptor is configured.
+func (o connectionSecretOptions) changed(ctx context.Context, desired ConnectionDetails) func(current, _ runtime.Object) bool {
+	return func(current, _ runtime.Object) bool {
+		//nolint:forcetypeassert // Will always be a secret.
+		data := ConnectionDetails(current.(*corev1.Secret).Data)
+
+		data, err := o.decrypt(ctx, data)
+		if err != nil {
+			// The current Secret isn't encrypted, or can't be decrypted.
+			// Either way it should be replaced.
+			return true
+		}
+
+		// NOTE(erhancagirici): cmp package is not recommended for production use
+		return !cmp.Equal(data, desired, cmpopts.EquateEmpty())
+	}
+}
+
 // An APISecretPublisher publishes ConnectionDetails by submitting a Secret to a
 // Kubernetes API server.
 type APISecretPublisher struct {
+	client client.Reader
 	secret resource.Applicator
 	typer  runtime.ObjectTyper
+	opts   connectionSecretOptions
 }
 
 // NewAPISecretPublisher returns a new APISecretPublisher.
-func NewAPISecretPublisher(c client.Client, ot runtime.ObjectTyper) *APISecretPublisher {
+func NewAPISecretPublisher(c client.Client, ot runtime.ObjectTyper, o ...ConnectionSecretOption) *APISecretPublisher {
 	// NOTE(negz): We transparently inject an APIPatchingApplicator in order to maintain
 	// backward compatibility with the original API of this function.
 	return &APISecretPublisher{
+		client: c,
 		secret: resource.NewApplicatorWithRetry(resource.NewAPIPatchingApplicator(c),
 			resource.IsAPIErrorWrapped, nil),
 		typer: ot,
+		opts:  newConnectionSecretOptions(o...),
 	}
 }
 
@@ -103,17 +282,31 @@ func (a *APISecretPublisher) PublishConnection(ctx context.Context, o resource.C
 		return false, nil
 	}
 
-	s := resource.ConnectionSecretFor(o, resource.MustGetKind(o, a.typer))
-	s.Data = c
+	c, err := a.opts.derive(c)
+	if err != nil {
+		return false, err
+	}
+
+	kind := resource.MustGetKind(o, a.typer)
+	owners, controllers := a.opts.owners(o, kind)
+
+	s := resource.ConnectionSecretFor(o, kind)
+	s.SetOwnerReferences(owners)
+
+	if a.opts.upToDate(ctx, a.client, s, controllers, c) {
+		return false, nil
+	}
+
+	s.Data, err = a.opts.encrypt(ctx, c)
+	if err != nil {
+		return false, err
+	}
 
-	err := a.secret.Apply(ctx, s,
-		resource.ConnectionSecretMustBeControllableBy(o.GetUID()),
-		resource.AllowUpdateIf(func(current, desired runtime.Object) bool {
-			// We consider the update to be a no-op and don't allow it if the
-			// current and existing secret data are identical.
-			//nolint:forcetypeassert // Will always be a secret.
-			return !cmp.Equal(current.(*corev1.Secret).Data, desired.(*corev1.Secret).Data, cmpopts.EquateEmpty())
-		}),
+	// We consider the update to be a no-op and don't allow it if the current
+	// and existing secret data are identical.
+	err = a.secret.Apply(ctx, s,
+		resource.ConnectionSecretMustBeControllableByAny(controllers...),
+		resource.AllowUpdateIf(a.opts.changed(ctx, c)),
 	)
 	if resource.IsNotAllowed(err) {
 		// The update was not allowed because it was a no-op.
@@ -137,18 +330,22 @@ func (a *APISecretPublisher) UnpublishConnection(_ context.Context, _ resource.C
 // An APILocalSecretPublisher publishes ConnectionDetails by submitting a Secret to a
 // Kubernetes API server.
 type APILocalSecretPublisher struct {
+	client client.Reader
 	secret resource.Applicator
 	typer  runtime.ObjectTyper
+	opts   connectionSecretOptions
 }
 
 // NewAPILocalSecretPublisher returns a new APILocalSecretPublisher.
-func NewAPILocalSecretPublisher(c client.Client, ot runtime.ObjectTyper) *APILocalSecretPublisher {
+func NewAPILocalSecretPublisher(c client.Client, ot runtime.ObjectTyper, o ...ConnectionSecretOption) *APILocalSecretPublisher {
 	// NOTE(negz): We transparently inject an APIPatchingApplicator in order to maintain
 	// backward compatibility with the original API of this function.
 	return &APILocalSecretPublisher{
+		client: c,
 		secret: resource.NewApplicatorWithRetry(resource.NewAPIPatchingApplicator(c),
 			resource.IsAPIErrorWrapped, nil),
 		typer: ot,
+		opts:  newConnectionSecretOptions(o...),
 	}
 }
 
@@ -161,18 +358,31 @@ func (a *APILocalSecretPublisher) PublishConnection(ctx context.Context, o resou
 		return false, nil
 	}
 
-	s := resource.LocalConnectionSecretFor(o, resource.MustGetKind(o, a.typer))
-	s.Data = c
+	c, err := a.opts.derive(c)
+	if err != nil {
+		return false, err
+	}
+
+	kind := resource.MustGetKind(o, a.typer)
+	owners, controllers := a.opts.owners(o, kind)
+
+	s := resource.LocalConnectionSecretFor(o, kind)
+	s.SetOwnerReferences(owners)
+
+	if a.opts.upToDate(ctx, a.client, s, controllers, c) {
+		return false, nil
+	}
+
+	s.Data, err = a.opts.encrypt(ctx, c)
+	if err != nil {
+		return false, err
+	}
 
-	err := a.secret.Apply(ctx, s,
-		resource.ConnectionSecretMustBeControllableBy(o.GetUID()),
-		resource.AllowUpdateIf(func(current, desired runtime.Object) bool {
-			// We consider the update to be a no-op and don't allow it if the
-			// current and existing secret data are identical.
-			//nolint:forcetypeassert // Will always be a secret.
-			// NOTE(erhancagirici): cmp package is not recommended for production use
-			return !cmp.Equal(current.(*corev1.Secret).Data, desired.(*corev1.Secret).Data, cmpopts.EquateEmpty())
-		}),
+	// We consider the update to be a no-op and don't allow it if the current
+	// and existing secret data are identical.
+	err = a.secret.Apply(ctx, s,
+		resource.ConnectionSecretMustBeControllableByAny(controllers...),
+		resource.AllowUpdateIf(a.opts.changed(ctx, c)),
 	)
 	if resource.IsNotAllowed(err) {
 		// The update was not allowed because it was a no-op.
@@ -193,6 +403,37 @@ func (a *APILocalSecretPublisher) UnpublishConnection(_ context.Context, _ resou
 	return nil
 }
 
+// An APISecretFetcher fetches ConnectionDetails from a Secret written by an
+// APISecretPublisher.
+type APISecretFetcher struct {
+	client client.Reader
+	opts   connectionSecretOptions
+}
+
+// NewAPISecretFetcher returns a new APISecretFetcher. It should be configured
+// with the same ConnectionSecretOptions as the APISecretPublisher that wrote
+// the Secret.
+func NewAPISecretFetcher(c client.Reader, o ...ConnectionSecretOption) *APISecretFetcher {
+	return &APISecretFetcher{client: c, opts: newConnectionSecretOptions(o...)}
+}
+
+// FetchConnection fetches the ConnectionDetails of the supplied connection
+// secret owner. It returns no ConnectionDetails if the owner doesn't write a
+// connection secret.
+func (a *APISecretFetcher) FetchConnection(ctx context.Context, so resource.ConnectionSecretOwner) (ConnectionDetails, error) {
+	ref := so.GetWriteConnectionSecretToReference()
+	if ref == nil {
+		return nil, nil
+	}
+
+	s := &corev1.Secret{}
+	if err := a.client.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return nil, errors.Wrap(err, errGetSecret)
+	}
+
+	return a.opts.decrypt(ctx, s.Data)
+}
+
 // An APISimpleReferenceResolver resolves references from one managed resource
 // to others by calling the referencing resource's ResolveReferences method, if
 // any.
@@ -235,43 +476,123 @@ func prepareJSONMerge(existing, resolved runtime.Object) ([]byte, error) {
 // ResolveReferences of the supplied managed resource by calling its
 // ResolveReferences method, if any.
 func (a *APISimpleReferenceResolver) ResolveReferences(ctx context.Context, mg resource.Managed) error {
+	_, err := a.ResolveReferencesWithResult(ctx, mg)
+	return err
+}
+
+// ResolveReferencesWithResult resolves the references of the supplied managed
+// resource by calling its ResolveReferences method, if any, and reports the
+// resources they resolved to.
+func (a *APISimpleReferenceResolver) ResolveReferencesWithResult(ctx context.Context, mg resource.Managed) (ReferenceResolution, error) {
 	rr, ok := mg.(interface {
 		ResolveReferences(ctx context.Context, r client.Reader) error
 	})
 	if !ok {
 		// This managed resource doesn't have any references to resolve.
-		return nil
+		return ReferenceResolution{}, nil
 	}
 
 	existing := mg.DeepCopyObject()
+	reader := &recordingReader{Reader: a.client}
 
-	if err := rr.ResolveReferences(ctx, a.client); err != nil {
-		return errors.Wrap(err, errResolveReferences)
+	if err := rr.ResolveReferences(ctx, reader); err != nil {
+		return ReferenceResolution{}, errors.Wrap(err, errResolveReferences)
 	}
 
+	res := ReferenceResolution{Targets: reader.targets}
+
 	if cmp.Equal(existing, mg, cmpopts.EquateEmpty()) {
 		// The resource didn't change during reference resolution.
-		return nil
+		return res, nil
 	}
 
+	res.Changed = true
+
 	patch, err := prepareJSONMerge(existing, mg)
 	if err != nil {
-		return err
+		return ReferenceResolution{}, err
+	}
+
+	if err := a.client.Patch(ctx, mg, client.RawPatch(types.ApplyPatchType, patch), client.FieldOwner(fieldOwnerAPISimpleRefResolver), client.ForceOwnership); err != nil {
+		return ReferenceResolution{}, errors.Wrap(err, errPatchManaged)
+	}
+
+	return res, nil
+}
+
+// A CriticalAnnotationUpdaterOption configures a CriticalAnnotationUpdater.
+type CriticalAnnotationUpdaterOption func(o *criticalAnnotationOptions)
+
+type criticalAnnotationOptions struct {
+	keys []string
+}
+
+func newCriticalAnnotationOptions(o ...CriticalAnnotationUpdaterOption) *criticalAnnotationOptions {
+	opts := &criticalAnnotationOptions{keys: []string{
+		meta.AnnotationKeyExternalName,
+		meta.AnnotationKeyExternalCreatePending,
+		meta.AnnotationKeyExternalCreateSucceeded,
+		meta.AnnotationKeyExternalCreateFailed,
+	}}
+
+	for _, fn := range o {
+		fn(opts)
 	}
 
-	return errors.Wrap(a.client.Patch(ctx, mg, client.RawPatch(types.ApplyPatchType, patch), client.FieldOwner(fieldOwnerAPISimpleRefResolver), client.ForceOwnership), errPatchManaged)
+	return opts
+}
+
+// criticalAnnotations returns the critical annotations of the supplied object.
+func (o *criticalAnnotationOptions) criticalAnnotations(obj client.Object) map[string]string {
+	a := make(map[string]string, len(o.keys))
+	for _, k := range o.keys {
+		if v, ok := obj.GetAnnotations()[k]; ok {
+			a[k] = v
+		}
+	}
+
+	return a
+}
+
+// WithCriticalAnnotationKeys configures a CriticalAnnotationUpdater to treat
+// the supplied annotation keys as critical, in addition to the external name
+// and external create annotations. Providers may use this to ensure
+// annotations their ExternalClients set during Create, for example the ID of
+// the external resource, are persisted.
+func WithCriticalAnnotationKeys(keys ...string) CriticalAnnotationUpdaterOption {
+	return func(o *criticalAnnotationOptions) {
+		o.keys = append(o.keys, keys...)
+	}
+}
+
+// An APISpecPersister persists the spec of a managed resource by updating it.
+type APISpecPersister struct {
+	client client.Client
+}
+
+// NewAPISpecPersister returns a SpecPersister that persists the spec of a
+// managed resource by updating it.
+func NewAPISpecPersister(c client.Client) *APISpecPersister {
+	return &APISpecPersister{client: c}
+}
+
+// PersistSpec of the supplied managed resource by updating it. The update
+// fails if the managed resource was changed since it was read.
+func (p *APISpecPersister) PersistSpec(ctx context.Context, mg resource.Managed) error {
+	return p.client.Update(ctx, mg)
 }
 
 // A RetryingCriticalAnnotationUpdater is a CriticalAnnotationUpdater that
 // retries annotation updates in the face of API server errors.
 type RetryingCriticalAnnotationUpdater struct {
 	client client.Client
+	opts   *criticalAnnotationOptions
 }
 
 // NewRetryingCriticalAnnotationUpdater returns a CriticalAnnotationUpdater that
 // retries annotation updates in the face of API server errors.
-func NewRetryingCriticalAnnotationUpdater(c client.Client) *RetryingCriticalAnnotationUpdater {
-	return &RetryingCriticalAnnotationUpdater{client: c}
+func NewRetryingCriticalAnnotationUpdater(c client.Client, o ...CriticalAnnotationUpdaterOption) *RetryingCriticalAnnotationUpdater {
+	return &RetryingCriticalAnnotationUpdater{client: c, opts: newCriticalAnnotationOptions(o...)}
 }
 
 // UpdateCriticalAnnotations updates (i.e. persists) the annotations of the
@@ -279,9 +600,10 @@ func NewRetryingCriticalAnnotationUpdater(c client.Client) *RetryingCriticalAnno
 // in order to ensure annotations that contain critical state are persisted.
 // Pending changes to the supplied Object's spec, status, or other metadata
 // might get reset to their current state according to the API server, e.g. in
-// case of a conflict error.
+// case of a conflict error. This includes pending changes to annotations that
+// aren't critical.
 func (u *RetryingCriticalAnnotationUpdater) UpdateCriticalAnnotations(ctx context.Context, o client.Object) error {
-	a := o.GetAnnotations()
+	a := u.opts.criticalAnnotations(o)
 	err := retry.OnError(retry.DefaultRetry, func(err error) bool {
 		return !errors.Is(err, context.Canceled)
 	}, func() error {
@@ -299,3 +621,50 @@ func (u *RetryingCriticalAnnotationUpdater) UpdateCriticalAnnotations(ctx contex
 
 	return errors.Wrap(err, errUpdateCriticalAnnotations)
 }
+
+// An ApplyingCriticalAnnotationUpdater is a CriticalAnnotationUpdater that
+// persists critical annotations using server-side apply.
+type ApplyingCriticalAnnotationUpdater struct {
+	client client.Client
+	opts   *criticalAnnotationOptions
+}
+
+// NewApplyingCriticalAnnotationUpdater returns a CriticalAnnotationUpdater
+// that persists critical annotations using server-side apply.
+func NewApplyingCriticalAnnotationUpdater(c client.Client, o ...CriticalAnnotationUpdaterOption) *ApplyingCriticalAnnotationUpdater {
+	return &ApplyingCriticalAnnotationUpdater{client: c, opts: newCriticalAnnotationOptions(o...)}
+}
+
+// UpdateCriticalAnnotations persists the critical annotations of the supplied
+// Object. Only its critical annotations are applied, using a dedicated field
+// manager, so the update can't conflict with or reset concurrent changes to
+// the Object's spec or other metadata. A critical annotation that was
+// previously applied but is no longer set on the supplied Object is removed,
+// unless another field manager also set it.
+// The supplied Object is updated to reflect its state according to the API
+// server, so pending changes to its spec or status will be reset.
+func (u *ApplyingCriticalAnnotationUpdater) UpdateCriticalAnnotations(ctx context.Context, o client.Object) error {
+	gvk, err := u.client.GroupVersionKindFor(o)
+	if err != nil {
+		return errors.Wrap(err, errUpdateCriticalAnnotations)
+	}
+
+	md := map[string]any{
+		"name":        o.GetName(),
+		"annotations": u.opts.criticalAnnotations(o),
+	}
+	if o.GetNamespace() != "" {
+		md["namespace"] = o.GetNamespace()
+	}
+
+	patch, err := json.Marshal(map[string]any{
+		"apiVersion": gvk.GroupVersion().String(),
+		"kind":       gvk.Kind,
+		"metadata":   md,
+	})
+	if err != nil {
+		return errors.Wrap(err, errUpdateCriticalAnnotations)
+	}
+
+	return errors.Wrap(u.client.Patch(ctx, o, client.RawPatch(types.ApplyPatchType, patch), client.FieldOwner(fieldOwnerCriticalAnnotationUpdater), client.ForceOwnership), errUpdateCriticalAnnotations)
+}
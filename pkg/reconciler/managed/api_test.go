@@ -21,10 +21,13 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
@@ -39,6 +42,8 @@ var (
 	_ Initializer              = &NameAsExternalName{}
 	_ ConnectionPublisher      = &APISecretPublisher{}
 	_ LocalConnectionPublisher = &APILocalSecretPublisher{}
+	_ ConnectionDetailsFetcher = &APISecretFetcher{}
+	_ SpecPersister            = &APISpecPersister{}
 )
 
 func TestNameAsExternalName(t *testing.T) {
@@ -135,9 +140,26 @@ func TestAPISecretPublisher(t *testing.T) {
 
 	cd := ConnectionDetails{"cool": {42}}
 
+	enc := NewEnvelopeEncryptor(&identityKMS{})
+
+	encrypted, err := enc.Encrypt(context.Background(), cd)
+	if err != nil {
+		t.Fatalf("enc.Encrypt(...): %v", err)
+	}
+
+	templates, err := NewConnectionDetailsTemplates(map[string]string{"cooler": "{{ .cool }}"})
+	if err != nil {
+		t.Fatalf("NewConnectionDetailsTemplates(...): %v", err)
+	}
+
+	current := resource.ConnectionSecretFor(mg, fake.GVK(mg))
+	current.Data = encrypted
+
 	type fields struct {
+		client client.Reader
 		secret resource.Applicator
 		typer  runtime.ObjectTyper
+		opts   []ConnectionSecretOption
 	}
 
 	type args struct {
@@ -225,11 +247,98 @@ func TestAPISecretPublisher(t *testing.T) {
 				published: true,
 			},
 		},
+		"SuccessDerived": {
+			reason: "Derived connection details should be published alongside the raw connection details",
+			fields: fields{
+				secret: resource.ApplyFn(func(_ context.Context, o client.Object, _ ...resource.ApplyOption) error {
+					want := resource.ConnectionSecretFor(mg, fake.GVK(mg))
+					want.Data = ConnectionDetails{"cool": {42}, "cooler": []byte("*")}
+					if diff := cmp.Diff(want, o); diff != "" {
+						t.Errorf("-want, +got:\n%s", diff)
+					}
+					return nil
+				}),
+				typer: fake.SchemeWith(&fake.LegacyManaged{}),
+				opts:  []ConnectionSecretOption{WithConnectionDetailsTemplates(templates)},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  mg,
+				c:   cd,
+			},
+			want: want{
+				published: true,
+			},
+		},
+		"EncryptError": {
+			reason: "An error encrypting the connection details should be returned",
+			fields: fields{
+				client: &test.MockClient{MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, ""))},
+				typer:  fake.SchemeWith(&fake.LegacyManaged{}),
+				opts:   []ConnectionSecretOption{WithConnectionDetailsEncryptor(NewEnvelopeEncryptor(&identityKMS{err: errBoom}))},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  mg,
+				c:   cd,
+			},
+			want: want{
+				err: errors.Wrap(errors.Wrap(errBoom, errEncryptDataKey), errEncryptConnection),
+			},
+		},
+		"AlreadyPublishedEncrypted": {
+			reason: "An encrypted connection secret whose plaintext is up to date should not be encrypted or applied",
+			fields: fields{
+				client: &test.MockClient{MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					current.DeepCopyInto(obj.(*corev1.Secret))
+					return nil
+				})},
+				secret: resource.ApplyFn(func(_ context.Context, _ client.Object, _ ...resource.ApplyOption) error {
+					return errBoom
+				}),
+				typer: fake.SchemeWith(&fake.LegacyManaged{}),
+				opts:  []ConnectionSecretOption{WithConnectionDetailsEncryptor(enc)},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  mg,
+				c:   cd,
+			},
+			want: want{
+				published: false,
+			},
+		},
+		"SuccessEncrypted": {
+			reason: "Connection details should be encrypted before they're published",
+			fields: fields{
+				client: &test.MockClient{MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, ""))},
+				secret: resource.ApplyFn(func(ctx context.Context, o client.Object, _ ...resource.ApplyOption) error {
+					got, err := enc.Decrypt(ctx, o.(*corev1.Secret).Data)
+					if err != nil {
+						t.Errorf("enc.Decrypt(...): %v", err)
+					}
+					if diff := cmp.Diff(cd, got); diff != "" {
+						t.Errorf("-want, +got:\n%s", diff)
+					}
+					return nil
+				}),
+				typer: fake.SchemeWith(&fake.LegacyManaged{}),
+				opts:  []ConnectionSecretOption{WithConnectionDetailsEncryptor(enc)},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  mg,
+				c:   cd,
+			},
+			want: want{
+				published: true,
+			},
+		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			a := &APISecretPublisher{tc.fields.secret, tc.fields.typer}
+			a := &APISecretPublisher{client: tc.fields.client, secret: tc.fields.secret, typer: tc.fields.typer, opts: newConnectionSecretOptions(tc.fields.opts...)}
 
 			got, gotErr := a.PublishConnection(tc.args.ctx, tc.args.mg, tc.args.c)
 			if diff := cmp.Diff(tc.want.err, gotErr, test.EquateErrors()); diff != "" {
@@ -243,6 +352,58 @@ func TestAPISecretPublisher(t *testing.T) {
 	}
 }
 
+func TestAPISecretPublisherUnchangedEncrypted(t *testing.T) {
+	mg := &fake.LegacyManaged{
+		ConnectionSecretWriterTo: fake.ConnectionSecretWriterTo{Ref: &xpv1.SecretReference{
+			Namespace: "coolnamespace",
+			Name:      "coolsecret",
+		}},
+	}
+
+	cd := ConnectionDetails{"cool": {42}}
+
+	kms := &identityKMS{}
+	enc := NewEnvelopeEncryptor(kms)
+
+	encrypted, err := enc.Encrypt(context.Background(), cd)
+	if err != nil {
+		t.Fatalf("enc.Encrypt(...): %v", err)
+	}
+
+	current := resource.ConnectionSecretFor(mg, fake.GVK(mg))
+	current.Data = encrypted
+
+	kms.calls = 0
+
+	a := &APISecretPublisher{
+		client: &test.MockClient{MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+			current.DeepCopyInto(obj.(*corev1.Secret))
+			return nil
+		})},
+		secret: resource.ApplyFn(func(_ context.Context, _ client.Object, _ ...resource.ApplyOption) error {
+			t.Error("a.secret.Apply(...): an unchanged connection secret should not be applied")
+			return nil
+		}),
+		typer: fake.SchemeWith(&fake.LegacyManaged{}),
+		opts:  newConnectionSecretOptions(WithConnectionDetailsEncryptor(enc)),
+	}
+
+	for range 3 {
+		published, err := a.PublishConnection(context.Background(), mg, cd)
+		if err != nil {
+			t.Fatalf("a.PublishConnection(...): %v", err)
+		}
+
+		if published {
+			t.Error("a.PublishConnection(...): want an unchanged connection secret not to be published")
+		}
+	}
+
+	if kms.calls != 0 {
+		t.Errorf("a.PublishConnection(...): want no KMS calls for an unchanged connection secret, got %d", kms.calls)
+	}
+}
+
 func TestAPILocalSecretPublisher(t *testing.T) {
 	errBoom := errors.New("boom")
 
@@ -348,7 +509,7 @@ func TestAPILocalSecretPublisher(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			a := &APILocalSecretPublisher{tc.fields.secret, tc.fields.typer}
+			a := &APILocalSecretPublisher{secret: tc.fields.secret, typer: tc.fields.typer}
 
 			got, gotErr := a.PublishConnection(tc.args.ctx, tc.args.mg, tc.args.c)
 			if diff := cmp.Diff(tc.want.err, gotErr, test.EquateErrors()); diff != "" {
@@ -362,6 +523,141 @@ func TestAPILocalSecretPublisher(t *testing.T) {
 	}
 }
 
+func TestConnectionSecretOwnership(t *testing.T) {
+	xr := metav1.OwnerReference{APIVersion: "example.org/v1", Kind: "XBucket", Name: "cool-xr", UID: "xr-uid", Controller: ptr.To(true)}
+
+	mg := &fake.ModernManaged{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "cool-mr", UID: "mr-uid", OwnerReferences: []metav1.OwnerReference{xr}},
+		LocalConnectionSecretWriterTo: fake.LocalConnectionSecretWriterTo{Ref: &xpv1.LocalSecretReference{
+			Name: "coolsecret",
+		}},
+	}
+	orphan := &fake.ModernManaged{
+		ObjectMeta:                    metav1.ObjectMeta{Namespace: "default", Name: "cool-mr", UID: "mr-uid"},
+		LocalConnectionSecretWriterTo: mg.LocalConnectionSecretWriterTo,
+	}
+
+	controlledBy := func(uid types.UID) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{OwnerReferences: []metav1.OwnerReference{{UID: uid, Controller: ptr.To(true)}}},
+			Type:       resource.SecretTypeConnection,
+		}
+	}
+
+	type want struct {
+		owners []metav1.OwnerReference
+		err    error
+	}
+
+	cases := map[string]struct {
+		reason    string
+		ownership ConnectionSecretOwnership
+		mg        resource.ModernManaged
+		current   *corev1.Secret
+		want      want
+	}{
+		"Default": {
+			reason:  "Connection secrets should be controlled by their managed resource by default.",
+			mg:      mg,
+			current: controlledBy("mr-uid"),
+			want:    want{owners: []metav1.OwnerReference{meta.AsController(meta.TypedReferenceTo(mg, fake.GVK(mg)))}},
+		},
+		"Composite": {
+			reason:    "Connection secrets should be controlled by their managed resource's controller if asked.",
+			ownership: ConnectionSecretOwnedByComposite,
+			mg:        mg,
+			current:   controlledBy("xr-uid"),
+			want:      want{owners: []metav1.OwnerReference{xr}},
+		},
+		"CompositeHandover": {
+			reason:    "A connection secret controlled by its managed resource should be handed over to its composite.",
+			ownership: ConnectionSecretOwnedByComposite,
+			mg:        mg,
+			current:   controlledBy("mr-uid"),
+			want:      want{owners: []metav1.OwnerReference{xr}},
+		},
+		"CompositeNoController": {
+			reason:    "Connection secrets of managed resources without a controller should be controlled by the managed resource.",
+			ownership: ConnectionSecretOwnedByComposite,
+			mg:        orphan,
+			current:   controlledBy("mr-uid"),
+			want:      want{owners: []metav1.OwnerReference{meta.AsController(meta.TypedReferenceTo(orphan, fake.GVK(orphan)))}},
+		},
+		"CompositeControlledBySomeoneElse": {
+			reason:    "We shouldn't write a connection secret controlled by neither the managed resource nor its composite.",
+			ownership: ConnectionSecretOwnedByComposite,
+			mg:        mg,
+			current:   controlledBy("other-uid"),
+			want: want{
+				owners: []metav1.OwnerReference{xr},
+				err:    errors.Wrap(errors.Errorf("existing secret is not controlled by any of UIDs %q", []types.UID{"xr-uid", "mr-uid"}), errCreateOrUpdateSecret),
+			},
+		},
+		"None": {
+			reason:    "Connection secrets should have no owner if asked.",
+			ownership: ConnectionSecretNotOwned,
+			mg:        mg,
+			current:   &corev1.Secret{Type: resource.SecretTypeConnection},
+			want:      want{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got []metav1.OwnerReference
+
+			a := NewAPILocalSecretPublisher(nil, fake.SchemeWith(&fake.ModernManaged{}), WithConnectionSecretOwnership(tc.ownership))
+			a.secret = resource.ApplyFn(func(ctx context.Context, o client.Object, ao ...resource.ApplyOption) error {
+				got = o.GetOwnerReferences()
+				for _, fn := range ao {
+					if err := fn(ctx, tc.current, o); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+
+			_, err := a.PublishConnection(context.Background(), tc.mg, ConnectionDetails{"cool": {42}})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nPublish(...): -wantErr, +gotErr:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.want.owners, got); diff != "" {
+				t.Errorf("\n%s\nPublish(...): -want owners, +got owners:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestAPISpecPersister(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		reason string
+		c      client.Client
+		want   error
+	}{
+		"UpdateError": {
+			reason: "Errors updating the managed resource should be returned.",
+			c:      &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			want:   errBoom,
+		},
+		"Success": {
+			reason: "Persisting the spec should update the managed resource.",
+			c:      &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := NewAPISpecPersister(tc.c).PersistSpec(context.Background(), &fake.ModernManaged{})
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nPersistSpec(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
 type mockSimpleReferencer struct {
 	resource.Managed
 
@@ -380,6 +676,114 @@ func (r *mockSimpleReferencer) Equal(s *mockSimpleReferencer) bool {
 	return cmp.Equal(r.Managed, s.Managed)
 }
 
+func TestAPISecretFetcher(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	mg := &fake.LegacyManaged{
+		ConnectionSecretWriterTo: fake.ConnectionSecretWriterTo{Ref: &xpv1.SecretReference{
+			Namespace: "coolnamespace",
+			Name:      "coolsecret",
+		}},
+	}
+
+	cd := ConnectionDetails{"cool": {42}}
+
+	enc := NewEnvelopeEncryptor(&identityKMS{})
+
+	encrypted, err := enc.Encrypt(context.Background(), cd)
+	if err != nil {
+		t.Fatalf("enc.Encrypt(...): %v", err)
+	}
+
+	secret := func(data map[string][]byte) test.MockGetFn {
+		return test.NewMockGetFn(nil, func(obj client.Object) error {
+			obj.(*corev1.Secret).Data = data
+			return nil
+		})
+	}
+
+	type args struct {
+		c    client.Reader
+		opts []ConnectionSecretOption
+		so   resource.ConnectionSecretOwner
+	}
+
+	type want struct {
+		cd  ConnectionDetails
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"ResourceDoesNotPublishSecret": {
+			reason: "A managed resource with a nil GetWriteConnectionSecretToReference should have no connection details",
+			args: args{
+				so: &fake.LegacyManaged{},
+			},
+		},
+		"GetError": {
+			reason: "An error getting the connection secret should be returned",
+			args: args{
+				c:  &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+				so: mg,
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetSecret),
+			},
+		},
+		"Plaintext": {
+			reason: "Connection details should be returned as is if no encryptor is configured",
+			args: args{
+				c:  &test.MockClient{MockGet: secret(cd)},
+				so: mg,
+			},
+			want: want{
+				cd: cd,
+			},
+		},
+		"Encrypted": {
+			reason: "Connection details should be decrypted if an encryptor is configured",
+			args: args{
+				c:    &test.MockClient{MockGet: secret(encrypted)},
+				opts: []ConnectionSecretOption{WithConnectionDetailsEncryptor(enc)},
+				so:   mg,
+			},
+			want: want{
+				cd: cd,
+			},
+		},
+		"DecryptError": {
+			reason: "An error decrypting the connection details should be returned",
+			args: args{
+				c:    &test.MockClient{MockGet: secret(cd)},
+				opts: []ConnectionSecretOption{WithConnectionDetailsEncryptor(enc)},
+				so:   mg,
+			},
+			want: want{
+				err: errors.Wrap(errors.New(errNoDataKey), errDecryptConnection),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			f := NewAPISecretFetcher(tc.args.c, tc.args.opts...)
+
+			got, err := f.FetchConnection(context.Background(), tc.args.so)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nFetchConnection(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.want.cd, got); diff != "" {
+				t.Errorf("\n%s\nFetchConnection(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
 func TestResolveReferences(t *testing.T) {
 	errBoom := errors.New("boom")
 
@@ -484,6 +888,81 @@ func TestResolveReferences(t *testing.T) {
 	}
 }
 
+func TestResolveReferencesWithResult(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	different := &fake.LegacyManaged{}
+
+	type want struct {
+		res ReferenceResolution
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		c      client.Client
+		mg     resource.Managed
+		want   want
+	}{
+		"NoReferencersFound": {
+			reason: "Should report nothing when the managed resource has no references.",
+			mg:     &fake.LegacyManaged{},
+		},
+		"ResolveReferencesError": {
+			reason: "Should return errors encountered while resolving references.",
+			c:      &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			mg: &mockSimpleReferencer{
+				Managed: &fake.LegacyManaged{},
+				MockResolveReferences: func(ctx context.Context, r client.Reader) error {
+					return r.Get(ctx, types.NamespacedName{Name: "cool"}, &fake.LegacyManaged{})
+				},
+			},
+			want: want{err: errors.Wrap(errBoom, errResolveReferences)},
+		},
+		"Unchanged": {
+			reason: "Should report the targets of references that resolved without changing the managed resource.",
+			c:      &test.MockClient{MockGet: test.NewMockGetFn(nil)},
+			mg: &mockSimpleReferencer{
+				Managed: &fake.LegacyManaged{},
+				MockResolveReferences: func(ctx context.Context, r client.Reader) error {
+					_ = r.Get(ctx, types.NamespacedName{Name: "cool"}, &fake.LegacyManaged{})
+					_ = r.Get(ctx, types.NamespacedName{Name: "cool"}, &fake.LegacyManaged{})
+					return r.Get(ctx, types.NamespacedName{Namespace: "default", Name: "cooler"}, &fake.ModernManaged{})
+				},
+			},
+			want: want{res: ReferenceResolution{Targets: []string{"LegacyManaged/cool", "ModernManaged/default/cooler"}}},
+		},
+		"Changed": {
+			reason: "Should report that resolving references changed the managed resource.",
+			c: &test.MockClient{
+				MockList:  test.NewMockListFn(nil),
+				MockPatch: test.NewMockPatchFn(nil),
+			},
+			mg: &mockSimpleReferencer{
+				Managed: different,
+				MockResolveReferences: func(ctx context.Context, r client.Reader) error {
+					different.SetName("I'm different!")
+					return r.List(ctx, &corev1.SecretList{}, client.MatchingLabels{"cool": "true"})
+				},
+			},
+			want: want{res: ReferenceResolution{Targets: []string{"Secret matching labels cool=true"}, Changed: true}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			res, err := NewAPISimpleReferenceResolver(tc.c).ResolveReferencesWithResult(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nr.ResolveReferencesWithResult(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.want.res, res); diff != "" {
+				t.Errorf("\n%s\nr.ResolveReferencesWithResult(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
 func TestPrepareJSONMerge(t *testing.T) {
 	type args struct {
 		existing runtime.Object
@@ -534,8 +1013,9 @@ func TestRetryingCriticalAnnotationUpdater(t *testing.T) {
 	errBoom := errors.New("boom")
 
 	type args struct {
-		ctx context.Context
-		o   client.Object
+		ctx  context.Context
+		o    client.Object
+		opts []CriticalAnnotationUpdaterOption
 	}
 
 	type want struct {
@@ -607,6 +1087,43 @@ func TestRetryingCriticalAnnotationUpdater(t *testing.T) {
 				o: objectReturnedByGet,
 			},
 		},
+		"ConflictReappliesCriticalAnnotations": {
+			reason: "After a conflict we should reapply only critical annotations, including any additional critical annotations, to the latest version of the object",
+			c: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					obj.SetAnnotations(map[string]string{"cool": "latest"})
+					return nil
+				}),
+				MockUpdate: func() test.MockUpdateFn {
+					calls := 0
+					return func(_ context.Context, _ client.Object, _ ...client.UpdateOption) error {
+						calls++
+						if calls == 1 {
+							return kerrors.NewConflict(schema.GroupResource{Group: "foo.com", Resource: "bars"}, "abc", errBoom)
+						}
+
+						return nil
+					}
+				}(),
+			},
+			args: args{
+				o: &fake.LegacyManaged{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+					meta.AnnotationKeyExternalName:            "name",
+					meta.AnnotationKeyExternalCreateSucceeded: "now",
+					"example.org/id":                          "id",
+					"cool":                                    "stale",
+				}}},
+				opts: []CriticalAnnotationUpdaterOption{WithCriticalAnnotationKeys("example.org/id")},
+			},
+			want: want{
+				o: &fake.LegacyManaged{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+					meta.AnnotationKeyExternalName:            "name",
+					meta.AnnotationKeyExternalCreateSucceeded: "now",
+					"example.org/id":                          "id",
+					"cool":                                    "latest",
+				}}},
+			},
+		},
 		"Success": {
 			reason: "We should return without error if we successfully update our annotations",
 			c: &test.MockClient{
@@ -625,7 +1142,7 @@ func TestRetryingCriticalAnnotationUpdater(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			u := NewRetryingCriticalAnnotationUpdater(tc.c)
+			u := NewRetryingCriticalAnnotationUpdater(tc.c, tc.args.opts...)
 
 			got := u.UpdateCriticalAnnotations(tc.args.ctx, tc.args.o)
 			if diff := cmp.Diff(tc.want.err, got, test.EquateErrors()); diff != "" {
@@ -638,3 +1155,109 @@ func TestRetryingCriticalAnnotationUpdater(t *testing.T) {
 		})
 	}
 }
+
+func TestApplyingCriticalAnnotationUpdater(t *testing.T) {
+	errBoom := errors.New("boom")
+	gvk := schema.GroupVersionKind{Group: "example.org", Version: "v1", Kind: "Cool"}
+
+	type args struct {
+		o    client.Object
+		opts []CriticalAnnotationUpdaterOption
+	}
+
+	type want struct {
+		patch string
+		err   error
+	}
+
+	cases := map[string]struct {
+		reason string
+		gvkErr error
+		patch  error
+		args   args
+		want   want
+	}{
+		"GroupVersionKindForError": {
+			reason: "We should return any error we encounter determining the object's kind",
+			gvkErr: errBoom,
+			args: args{
+				o: &fake.ModernManaged{},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdateCriticalAnnotations),
+			},
+		},
+		"PatchError": {
+			reason: "We should return any error we encounter applying the critical annotations",
+			patch:  errBoom,
+			args: args{
+				o: &fake.ModernManaged{ObjectMeta: metav1.ObjectMeta{Name: "cool"}},
+			},
+			want: want{
+				patch: `{"apiVersion":"example.org/v1","kind":"Cool","metadata":{"annotations":{},"name":"cool"}}`,
+				err:   errors.Wrap(errBoom, errUpdateCriticalAnnotations),
+			},
+		},
+		"Success": {
+			reason: "We should apply only the critical annotations, including any additional critical annotations",
+			args: args{
+				o: &fake.ModernManaged{ObjectMeta: metav1.ObjectMeta{
+					Name:      "cool",
+					Namespace: "default",
+					Annotations: map[string]string{
+						meta.AnnotationKeyExternalName:            "name",
+						meta.AnnotationKeyExternalCreateSucceeded: "now",
+						"example.org/id":                          "id",
+						"cool":                                    "very",
+					},
+				}},
+				opts: []CriticalAnnotationUpdaterOption{WithCriticalAnnotationKeys("example.org/id")},
+			},
+			want: want{
+				patch: `{"apiVersion":"example.org/v1","kind":"Cool","metadata":{"annotations":{"crossplane.io/external-create-succeeded":"now","crossplane.io/external-name":"name","example.org/id":"id"},"name":"cool","namespace":"default"}}`,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var patch string
+
+			c := &test.MockClient{
+				MockGroupVersionKindFor: test.NewMockGroupVersionKindForFn(tc.gvkErr, gvk),
+				MockPatch: func(_ context.Context, obj client.Object, p client.Patch, opts ...client.PatchOption) error {
+					if p.Type() != types.ApplyPatchType {
+						t.Errorf("\n%s\nu.UpdateCriticalAnnotations(...): want patch type %q, got %q", tc.reason, types.ApplyPatchType, p.Type())
+					}
+
+					po := &client.PatchOptions{}
+					po.ApplyOptions(opts)
+
+					if po.FieldManager != fieldOwnerCriticalAnnotationUpdater || po.Force == nil || !*po.Force {
+						t.Errorf("\n%s\nu.UpdateCriticalAnnotations(...): want forced apply as %q, got %+v", tc.reason, fieldOwnerCriticalAnnotationUpdater, po)
+					}
+
+					b, err := p.Data(obj)
+					if err != nil {
+						return err
+					}
+
+					patch = string(b)
+
+					return tc.patch
+				},
+			}
+
+			u := NewApplyingCriticalAnnotationUpdater(c, tc.args.opts...)
+
+			err := u.UpdateCriticalAnnotations(context.Background(), tc.args.o)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nu.UpdateCriticalAnnotations(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.want.patch, patch); diff != "" {
+				t.Errorf("\n%s\nu.UpdateCriticalAnnotations(...): -want patch, +got patch:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
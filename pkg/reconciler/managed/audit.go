@@ -0,0 +1,150 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+)
+
+// DefaultMaxOperationErrorLength is the default maximum length of the
+// last-operation-error annotation.
+const DefaultMaxOperationErrorLength = 256
+
+// An AuditAnnotatorOption configures an AuditAnnotator.
+type AuditAnnotatorOption func(a *AuditAnnotator)
+
+// WithMaxOperationErrorLength configures the maximum length of the
+// last-operation-error annotation. Longer errors are truncated.
+func WithMaxOperationErrorLength(n int) AuditAnnotatorOption {
+	return func(a *AuditAnnotator) {
+		a.maxErrorLength = n
+	}
+}
+
+// WithAuditClock configures the clock used to timestamp audit annotations.
+func WithAuditClock(c clock.PassiveClock) AuditAnnotatorOption {
+	return func(a *AuditAnnotator) {
+		a.clock = c
+	}
+}
+
+// WithAuditLogger configures the logger used to report annotations that
+// could not be persisted.
+func WithAuditLogger(l logging.Logger) AuditAnnotatorOption {
+	return func(a *AuditAnnotator) {
+		a.log = l
+	}
+}
+
+// An AuditAnnotator is a ReconcileOutcomeObserver that records the time of
+// the last successful create, update, and observation of an external resource,
+// and the error returned by the last failed operation, as annotations on its
+// managed resource.
+type AuditAnnotator struct {
+	client         client.Client
+	clock          clock.PassiveClock
+	log            logging.Logger
+	maxErrorLength int
+}
+
+// NewAuditAnnotator returns an AuditAnnotator that uses the supplied client
+// to persist annotations.
+func NewAuditAnnotator(c client.Client, o ...AuditAnnotatorOption) *AuditAnnotator {
+	a := &AuditAnnotator{
+		client:         c,
+		clock:          clock.RealClock{},
+		log:            logging.NewNopLogger(),
+		maxErrorLength: DefaultMaxOperationErrorLength,
+	}
+
+	for _, fn := range o {
+		fn(a)
+	}
+
+	return a
+}
+
+// ObserveOutcome annotates the supplied managed resource according to the
+// supplied outcome. Annotations are persisted using a merge patch of a copy
+// of the managed resource, so pending changes to the managed resource are not
+// reset. Failure to persist annotations is logged, but otherwise ignored.
+func (a *AuditAnnotator) ObserveOutcome(ctx context.Context, mg resource.Managed, o ReconcileOutcome) {
+	add, remove := a.annotations(o)
+	if len(add) == 0 && len(remove) == 0 {
+		return
+	}
+
+	//nolint:forcetypeassert // A deep copy of a managed resource is a managed resource.
+	cp := mg.DeepCopyObject().(resource.Managed)
+	patch := client.MergeFrom(cp.DeepCopyObject().(resource.Managed)) //nolint:forcetypeassert // See above.
+
+	meta.AddAnnotations(cp, add)
+	meta.RemoveAnnotations(cp, remove...)
+
+	if err := a.client.Patch(ctx, cp, patch); err != nil {
+		a.log.Debug("Cannot persist audit annotations", "error", err)
+	}
+}
+
+func (a *AuditAnnotator) annotations(o ReconcileOutcome) (map[string]string, []string) {
+	now := a.clock.Now().Format(time.RFC3339)
+
+	switch o.Type {
+	case OutcomeCreated:
+		return map[string]string{meta.AnnotationKeyLastObservedTime: now, meta.AnnotationKeyLastCreateTime: now}, []string{meta.AnnotationKeyLastOperationError}
+	case OutcomeUpdated:
+		return map[string]string{meta.AnnotationKeyLastObservedTime: now, meta.AnnotationKeyLastUpdateTime: now}, []string{meta.AnnotationKeyLastOperationError}
+	case OutcomeUpToDate, OutcomeDeletionRequested, OutcomePending, OutcomePolicySkip, OutcomeUpdateSkipped, OutcomeUpdateCooldown, OutcomeNotModified, OutcomeExternalDeleting:
+		return map[string]string{meta.AnnotationKeyLastObservedTime: now}, []string{meta.AnnotationKeyLastOperationError}
+	case OutcomeError:
+		switch o.Stage { //nolint:exhaustive // Only operations on the external resource are audited.
+		case StageObserve:
+			return map[string]string{meta.AnnotationKeyLastOperationError: a.truncate(o.Err)}, nil
+		case StageCreate, StageUpdate, StageDelete:
+			// We observed the external resource before we failed to operate
+			// on it.
+			return map[string]string{meta.AnnotationKeyLastObservedTime: now, meta.AnnotationKeyLastOperationError: a.truncate(o.Err)}, nil
+		}
+	case OutcomeDeleted, OutcomeAlreadyFinalized, OutcomePausedSkip:
+		// The managed resource is gone, or we were asked to leave it alone.
+	}
+
+	return nil, nil
+}
+
+func (a *AuditAnnotator) truncate(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	msg := err.Error()
+	if len(msg) <= a.maxErrorLength {
+		return msg
+	}
+
+	// Don't split a multi-byte character.
+	return strings.ToValidUTF8(msg[:a.maxErrorLength], "")
+}
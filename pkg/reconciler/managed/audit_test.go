@@ -0,0 +1,143 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+)
+
+func TestAuditAnnotator(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	ts := now.Format(time.RFC3339)
+
+	type args struct {
+		annotations map[string]string
+		o           ReconcileOutcome
+	}
+	type want struct {
+		patched     bool
+		annotations map[string]string
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"Created": {
+			reason: "We should record the create and observation times, and clear any previous error.",
+			args: args{
+				annotations: map[string]string{meta.AnnotationKeyLastOperationError: "boom"},
+				o:           outcome(OutcomeCreated),
+			},
+			want: want{
+				patched: true,
+				annotations: map[string]string{
+					meta.AnnotationKeyLastCreateTime:   ts,
+					meta.AnnotationKeyLastObservedTime: ts,
+				},
+			},
+		},
+		"Updated": {
+			reason: "We should record the update and observation times.",
+			args: args{
+				o: outcome(OutcomeUpdated),
+			},
+			want: want{
+				patched: true,
+				annotations: map[string]string{
+					meta.AnnotationKeyLastUpdateTime:   ts,
+					meta.AnnotationKeyLastObservedTime: ts,
+				},
+			},
+		},
+		"UpToDate": {
+			reason: "We should record the observation time.",
+			args: args{
+				o: outcome(OutcomeUpToDate),
+			},
+			want: want{
+				patched:     true,
+				annotations: map[string]string{meta.AnnotationKeyLastObservedTime: ts},
+			},
+		},
+		"UpdateError": {
+			reason: "We should record a truncated error when an operation fails.",
+			args: args{
+				o: outcomeError(StageUpdate, errors.New("this error is far too long")),
+			},
+			want: want{
+				patched: true,
+				annotations: map[string]string{
+					meta.AnnotationKeyLastObservedTime:   ts,
+					meta.AnnotationKeyLastOperationError: "this error",
+				},
+			},
+		},
+		"InitializeError": {
+			reason: "We should not record errors that did not occur operating on the external resource.",
+			args: args{
+				o: outcomeError(StageInitialize, errors.New("boom")),
+			},
+			want: want{},
+		},
+		"Paused": {
+			reason: "We should not annotate a paused managed resource.",
+			args: args{
+				o: outcome(OutcomePausedSkip),
+			},
+			want: want{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got want
+			c := &test.MockClient{
+				MockPatch: func(_ context.Context, obj client.Object, _ client.Patch, _ ...client.PatchOption) error {
+					got.patched = true
+					got.annotations = obj.GetAnnotations()
+					return nil
+				},
+			}
+
+			mg := &fake.Managed{ObjectMeta: metav1.ObjectMeta{Annotations: tc.args.annotations}}
+			a := NewAuditAnnotator(c, WithAuditClock(clocktesting.NewFakePassiveClock(now)), WithMaxOperationErrorLength(10))
+			a.ObserveOutcome(context.Background(), mg, tc.args.o)
+
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\na.ObserveOutcome(...): -want, +got:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.args.annotations, mg.GetAnnotations()); diff != "" {
+				t.Errorf("\n%s\na.ObserveOutcome(...): should not modify the supplied managed resource: -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,322 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+)
+
+const defaultCacheIdleTTL = 10 * time.Minute
+
+// Error strings.
+const (
+	errCacheKey         = "cannot determine external client cache key"
+	errDisconnectCached = "cannot disconnect cached external client"
+)
+
+// A Pinger can cheaply check whether it is still healthy. An ExternalClient
+// may implement Pinger to allow a CachingConnector to check whether a cached
+// client may be reused.
+type Pinger interface {
+	Ping(ctx context.Context) error
+}
+
+// An Invalidator can be invalidated, so that it's not reused. ExternalClients
+// returned by a CachingConnector are Invalidators. Invalidating one evicts it
+// from the cache and disconnects it, so that the next call to Connect
+// connects anew, for example with fresh credentials.
+type Invalidator interface {
+	Invalidate(ctx context.Context)
+}
+
+// A CacheKeyFn returns the key under which the ExternalClient for the
+// supplied managed resource should be cached. Managed resources with the same
+// key share an ExternalClient.
+type CacheKeyFn[managed resource.Managed] func(ctx context.Context, mg managed) (string, error)
+
+// ProviderConfigCacheKey returns a cache key derived from the supplied managed
+// resource's kind and provider config reference. Managed resources of the
+// same kind that use the same provider config share an ExternalClient.
+func ProviderConfigCacheKey[managed resource.Managed](_ context.Context, mg managed) (string, error) {
+	t := resource.GetExternalTags(mg)
+
+	ns := ""
+	if t[resource.ExternalResourceTagKeyProviderConfigKind] != "" && !strings.HasPrefix(t[resource.ExternalResourceTagKeyProviderConfigKind], "Cluster") {
+		// Namespaced provider configs are resolved in the managed
+		// resource's namespace.
+		ns = mg.GetNamespace()
+	}
+
+	return strings.Join([]string{
+		t[resource.ExternalResourceTagKeyKind],
+		t[resource.ExternalResourceTagKeyProviderConfigKind],
+		ns,
+		t[resource.ExternalResourceTagKeyProvider],
+	}, "/"), nil
+}
+
+// A CachingConnectorOption configures a CachingConnector.
+type CachingConnectorOption func(o *cachingConnectorOptions)
+
+type cachingConnectorOptions struct {
+	ttl   time.Duration
+	clock clock.PassiveClock
+}
+
+// WithCacheIdleTTL configures how long a cached ExternalClient may go unused
+// before it is disconnected and evicted.
+func WithCacheIdleTTL(d time.Duration) CachingConnectorOption {
+	return func(o *cachingConnectorOptions) {
+		o.ttl = d
+	}
+}
+
+// WithCacheClock configures the clock a CachingConnector uses to determine
+// whether a cached ExternalClient has gone unused for too long.
+func WithCacheClock(c clock.PassiveClock) CachingConnectorOption {
+	return func(o *cachingConnectorOptions) {
+		o.clock = c
+	}
+}
+
+// A CachingConnector caches ExternalClients produced by an ExternalConnector.
+type CachingConnector = TypedCachingConnector[resource.Managed]
+
+// NewCachingConnector returns a CachingConnector that caches the
+// ExternalClients produced by the supplied ExternalConnector.
+func NewCachingConnector(c ExternalConnector, key CacheKeyFn[resource.Managed], o ...CachingConnectorOption) *CachingConnector {
+	return NewTypedCachingConnector(c, key, o...)
+}
+
+type cachedExternalClient[managed resource.Managed] struct {
+	client   TypedExternalClient[managed]
+	lastUsed time.Time
+}
+
+// A TypedCachingConnector caches the ExternalClients produced by a
+// TypedExternalConnector, so that they may be reused across reconciles. This
+// is useful when connecting is expensive, for example because it involves
+// establishing an SDK session.
+//
+// Cached clients are shared by concurrent reconciles, and must therefore be
+// safe for concurrent use. A cached client that implements Pinger is pinged
+// before it is reused, and replaced if the ping fails. Cached clients are
+// disconnected and evicted once they have gone unused for the idle TTL.
+// Eviction happens lazily, when Connect is called.
+//
+// The reconciler calls Disconnect on the client it is returned at the end of
+// every reconcile. Clients returned by a TypedCachingConnector ignore these
+// calls. Call Close to disconnect all cached clients.
+type TypedCachingConnector[managed resource.Managed] struct {
+	connector TypedExternalConnector[managed]
+	key       CacheKeyFn[managed]
+	ttl       time.Duration
+	clock     clock.PassiveClock
+
+	mu      sync.Mutex
+	clients map[string]*cachedExternalClient[managed]
+}
+
+// NewTypedCachingConnector returns a TypedCachingConnector that caches the
+// ExternalClients produced by the supplied TypedExternalConnector.
+func NewTypedCachingConnector[managed resource.Managed](c TypedExternalConnector[managed], key CacheKeyFn[managed], o ...CachingConnectorOption) *TypedCachingConnector[managed] {
+	opts := &cachingConnectorOptions{ttl: defaultCacheIdleTTL, clock: clock.RealClock{}}
+	for _, fn := range o {
+		fn(opts)
+	}
+
+	return &TypedCachingConnector[managed]{
+		connector: c,
+		key:       key,
+		ttl:       opts.ttl,
+		clock:     opts.clock,
+		clients:   make(map[string]*cachedExternalClient[managed]),
+	}
+}
+
+// Connect returns a cached ExternalClient for the supplied managed resource,
+// or connects and caches a new one.
+func (c *TypedCachingConnector[managed]) Connect(ctx context.Context, mg managed) (TypedExternalClient[managed], error) {
+	key, err := c.key(ctx, mg)
+	if err != nil {
+		return nil, errors.Wrap(err, errCacheKey)
+	}
+
+	c.evictIdle(ctx)
+
+	if ec := c.cached(ctx, key); ec != nil {
+		return ec, nil
+	}
+
+	// We don't hold the lock while connecting, so that a slow connection
+	// doesn't block reconciles of resources with other cache keys.
+	ec, err := c.connector.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if existing, ok := c.clients[key]; ok {
+		// Another reconcile connected while we were connecting. Use its
+		// client, and discard ours.
+		existing.lastUsed = c.clock.Now()
+		_ = ec.Disconnect(ctx) //nolint:errcheck // Best effort. Our client was never used.
+
+		return &sharedExternalClient[managed]{TypedExternalClient: existing.client, cache: c, key: key}, nil
+	}
+
+	c.clients[key] = &cachedExternalClient[managed]{client: ec, lastUsed: c.clock.Now()}
+
+	return &sharedExternalClient[managed]{TypedExternalClient: ec, cache: c, key: key}, nil
+}
+
+// cached returns the cached client for the supplied key, if any. It returns
+// nil if there is no cached client, or if the cached client is unhealthy.
+func (c *TypedCachingConnector[managed]) cached(ctx context.Context, key string) TypedExternalClient[managed] {
+	c.mu.Lock()
+	cc, ok := c.clients[key]
+	c.mu.Unlock()
+
+	if !ok {
+		return nil
+	}
+
+	if ping(ctx, cc.client) == nil {
+		c.mu.Lock()
+		cc.lastUsed = c.clock.Now()
+		c.mu.Unlock()
+
+		return &sharedExternalClient[managed]{TypedExternalClient: cc.client, cache: c, key: key}
+	}
+
+	c.mu.Lock()
+	if c.clients[key] == cc {
+		delete(c.clients, key)
+	}
+	c.mu.Unlock()
+
+	_ = cc.client.Disconnect(ctx) //nolint:errcheck // Best effort. The client is unhealthy.
+
+	return nil
+}
+
+// evictIdle disconnects and evicts cached clients that have gone unused for
+// the idle TTL. Disconnecting is best effort; a client that can't be
+// disconnected is evicted regardless.
+func (c *TypedCachingConnector[managed]) evictIdle(ctx context.Context) {
+	c.mu.Lock()
+
+	idle := make([]TypedExternalClient[managed], 0)
+
+	for key, cc := range c.clients {
+		if c.clock.Since(cc.lastUsed) < c.ttl {
+			continue
+		}
+
+		idle = append(idle, cc.client)
+		delete(c.clients, key)
+	}
+
+	c.mu.Unlock()
+
+	for _, ec := range idle {
+		_ = ec.Disconnect(ctx) //nolint:errcheck // Best effort. See above.
+	}
+}
+
+// Close disconnects and evicts all cached ExternalClients.
+func (c *TypedCachingConnector[managed]) Close(ctx context.Context) error {
+	c.mu.Lock()
+	clients := c.clients
+	c.clients = make(map[string]*cachedExternalClient[managed])
+	c.mu.Unlock()
+
+	errs := make([]error, 0, len(clients))
+	for _, cc := range clients {
+		errs = append(errs, cc.client.Disconnect(ctx))
+	}
+
+	return errors.Wrap(errors.Join(errs...), errDisconnectCached)
+}
+
+// A sharedExternalClient is a cached ExternalClient. It ignores calls to
+// Disconnect, which the reconciler makes at the end of every reconcile.
+type sharedExternalClient[managed resource.Managed] struct {
+	TypedExternalClient[managed]
+
+	cache *TypedCachingConnector[managed]
+	key   string
+}
+
+// Disconnect does nothing. The TypedCachingConnector disconnects the
+// underlying client when it is evicted.
+func (c *sharedExternalClient[managed]) Disconnect(_ context.Context) error {
+	return nil
+}
+
+// ExternalNameInUse checks whether the supplied external name is in use, if
+// the shared client is a TypedExternalNameCollisionChecker. Names are never in
+// use otherwise.
+func (c *sharedExternalClient[managed]) ExternalNameInUse(ctx context.Context, mg managed, name string) (bool, error) {
+	if cc, ok := c.TypedExternalClient.(TypedExternalNameCollisionChecker[managed]); ok {
+		return cc.ExternalNameInUse(ctx, mg, name)
+	}
+
+	return false, nil
+}
+
+// Ping the shared client, if it's a Pinger.
+func (c *sharedExternalClient[managed]) Ping(ctx context.Context) error {
+	return ping(ctx, c.TypedExternalClient)
+}
+
+// Invalidate evicts the client from the cache, if it's still cached, and
+// disconnects it. Disconnecting is best effort.
+func (c *sharedExternalClient[managed]) Invalidate(ctx context.Context) {
+	c.cache.mu.Lock()
+	cc, ok := c.cache.clients[c.key]
+	if !ok || cc.client != c.TypedExternalClient {
+		// Another reconcile already invalidated the client.
+		c.cache.mu.Unlock()
+		return
+	}
+
+	delete(c.cache.clients, c.key)
+	c.cache.mu.Unlock()
+
+	_ = c.TypedExternalClient.Disconnect(ctx) //nolint:errcheck // Best effort. The client is invalid.
+}
+
+// ping the supplied client, if it's a Pinger. Clients that aren't Pingers are
+// assumed to be healthy.
+func ping(ctx context.Context, c any) error {
+	if p, ok := c.(Pinger); ok {
+		return p.Ping(ctx)
+	}
+
+	return nil
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+)
+
+type pingingClient struct {
+	ExternalClientFns
+
+	ping func(ctx context.Context) error
+}
+
+func (c *pingingClient) Ping(ctx context.Context) error {
+	return c.ping(ctx)
+}
+
+func TestCachingConnector(t *testing.T) {
+	now := time.Now()
+	key := func(_ context.Context, mg resource.Managed) (string, error) { return mg.GetName(), nil }
+
+	type counts struct {
+		Connects    int
+		Disconnects int
+	}
+
+	// connector returns a connector that records how many clients it created
+	// and how many were disconnected. Clients are healthy unless healthy
+	// returns false.
+	connector := func(c *counts, healthy func() bool) ExternalConnector {
+		return ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+			c.Connects++
+			return &pingingClient{
+				ExternalClientFns: ExternalClientFns{DisconnectFn: func(_ context.Context) error {
+					c.Disconnects++
+					return nil
+				}},
+				ping: func(_ context.Context) error {
+					if healthy() {
+						return nil
+					}
+					return errors.New("unhealthy")
+				},
+			}, nil
+		})
+	}
+
+	cases := map[string]struct {
+		reason string
+		// run connects to the supplied CachingConnector, advancing the
+		// supplied clock and toggling health as needed.
+		run  func(cc *CachingConnector, clk *clocktesting.FakePassiveClock, healthy *bool)
+		want counts
+	}{
+		"ReuseCachedClient": {
+			reason: "Managed resources with the same cache key should share a client, which should ignore Disconnect.",
+			run: func(cc *CachingConnector, _ *clocktesting.FakePassiveClock, _ *bool) {
+				for range 3 {
+					ec, _ := cc.Connect(context.Background(), &fake.ModernManaged{})
+					_ = ec.Disconnect(context.Background())
+				}
+			},
+			want: counts{Connects: 1},
+		},
+		"EvictIdleClient": {
+			reason: "A client that went unused for the idle TTL should be disconnected and replaced.",
+			run: func(cc *CachingConnector, clk *clocktesting.FakePassiveClock, _ *bool) {
+				_, _ = cc.Connect(context.Background(), &fake.ModernManaged{})
+				clk.SetTime(now.Add(2 * time.Minute))
+				_, _ = cc.Connect(context.Background(), &fake.ModernManaged{})
+			},
+			want: counts{Connects: 2, Disconnects: 1},
+		},
+		"ReplaceUnhealthyClient": {
+			reason: "A client that fails its ping should be disconnected and replaced.",
+			run: func(cc *CachingConnector, _ *clocktesting.FakePassiveClock, healthy *bool) {
+				_, _ = cc.Connect(context.Background(), &fake.ModernManaged{})
+				*healthy = false
+				_, _ = cc.Connect(context.Background(), &fake.ModernManaged{})
+			},
+			want: counts{Connects: 2, Disconnects: 1},
+		},
+		"InvalidateClient": {
+			reason: "An invalidated client should be disconnected and replaced.",
+			run: func(cc *CachingConnector, _ *clocktesting.FakePassiveClock, _ *bool) {
+				ec, _ := cc.Connect(context.Background(), &fake.ModernManaged{})
+				ec.(Invalidator).Invalidate(context.Background())
+				_, _ = cc.Connect(context.Background(), &fake.ModernManaged{})
+			},
+			want: counts{Connects: 2, Disconnects: 1},
+		},
+		"InvalidateReplacedClient": {
+			reason: "Invalidating a client that was already replaced shouldn't evict its replacement.",
+			run: func(cc *CachingConnector, _ *clocktesting.FakePassiveClock, _ *bool) {
+				first, _ := cc.Connect(context.Background(), &fake.ModernManaged{})
+				second, _ := cc.Connect(context.Background(), &fake.ModernManaged{})
+				first.(Invalidator).Invalidate(context.Background())
+				second.(Invalidator).Invalidate(context.Background())
+				_, _ = cc.Connect(context.Background(), &fake.ModernManaged{})
+				_, _ = cc.Connect(context.Background(), &fake.ModernManaged{})
+			},
+			want: counts{Connects: 2, Disconnects: 1},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := &counts{}
+			healthy := true
+			clk := clocktesting.NewFakePassiveClock(now)
+
+			cc := NewCachingConnector(connector(got, func() bool { return healthy }), key, WithCacheIdleTTL(time.Minute), WithCacheClock(clk))
+			tc.run(cc, clk, &healthy)
+
+			if diff := cmp.Diff(tc.want, *got); diff != "" {
+				t.Errorf("\n%s\nConnect(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+// An optionalClient implements all of the optional interfaces an
+// ExternalClient may implement.
+type optionalClient struct {
+	ExternalClientFns
+
+	inUse func(name string) (bool, error)
+	ping  func(ctx context.Context) error
+}
+
+func (c *optionalClient) ExternalNameInUse(_ context.Context, _ resource.Managed, name string) (bool, error) {
+	return c.inUse(name)
+}
+
+func (c *optionalClient) Ping(ctx context.Context) error {
+	return c.ping(ctx)
+}
+
+func TestSharedExternalClientForwards(t *testing.T) {
+	errUnhealthy := errors.New("unhealthy")
+
+	cc := NewCachingConnector(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+		return &optionalClient{
+			inUse: func(name string) (bool, error) { return name == "taken", nil },
+			ping:  func(_ context.Context) error { return errUnhealthy },
+		}, nil
+	}), func(_ context.Context, mg resource.Managed) (string, error) { return mg.GetName(), nil })
+
+	ec, err := cc.Connect(context.Background(), &fake.ModernManaged{})
+	if err != nil {
+		t.Fatalf("cc.Connect(...): %v", err)
+	}
+
+	checker, ok := ec.(ExternalNameCollisionChecker)
+	if !ok {
+		t.Fatalf("cc.Connect(...): want a client that forwards ExternalNameCollisionChecker")
+	}
+
+	if inUse, _ := checker.ExternalNameInUse(context.Background(), &fake.ModernManaged{}, "taken"); !inUse {
+		t.Errorf("ExternalNameInUse(...): want the cached client's answer")
+	}
+
+	if err := ec.(Pinger).Ping(context.Background()); !errors.Is(err, errUnhealthy) {
+		t.Errorf("Ping(...): want the cached client's error, got %v", err)
+	}
+}
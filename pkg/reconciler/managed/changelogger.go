@@ -84,6 +84,26 @@ func WithSendTimeout(timeout time.Duration) GRPCChangeLoggerOption {
 
 // Log sends the given change log entry to the change log service.
 func (g *GRPCChangeLogger) Log(ctx context.Context, managed resource.Managed, opType v1alpha1.OperationType, changeErr error, ad AdditionalDetails) error {
+	entry, err := newChangeLogEntry(managed, g.providerVersion, opType, changeErr, ad)
+	if err != nil {
+		return err
+	}
+
+	// create a specific context and timeout for sending the change log entry
+	// that is different than the parent context that is for the entire
+	// reconciliation
+	sendCtx, sendCancel := context.WithTimeout(ctx, g.sendTimeout)
+	defer sendCancel()
+
+	// send everything we've got to the change log service
+	_, err = g.client.SendChangeLog(sendCtx, &v1alpha1.SendChangeLogRequest{Entry: entry}, grpc.WaitForReady(true))
+
+	return errors.Wrap(err, "cannot send change log entry")
+}
+
+// newChangeLogEntry returns a change log entry for the supplied change to the
+// supplied managed resource.
+func newChangeLogEntry(managed resource.Managed, providerVersion string, opType v1alpha1.OperationType, changeErr error, ad AdditionalDetails) (*v1alpha1.ChangeLogEntry, error) {
 	// get an error message from the error if it exists
 	var changeErrMessage *string
 	if changeErr != nil {
@@ -93,14 +113,14 @@ func (g *GRPCChangeLogger) Log(ctx context.Context, managed resource.Managed, op
 	// capture the full state of the managed resource from before we performed the change
 	snapshot, err := resource.AsProtobufStruct(managed)
 	if err != nil {
-		return errors.Wrap(err, "cannot snapshot managed resource")
+		return nil, errors.Wrap(err, "cannot snapshot managed resource")
 	}
 
 	gvk := managed.GetObjectKind().GroupVersionKind()
 
-	entry := &v1alpha1.ChangeLogEntry{
+	return &v1alpha1.ChangeLogEntry{
 		Timestamp:         timestamppb.Now(),
-		Provider:          g.providerVersion,
+		Provider:          providerVersion,
 		ApiVersion:        gvk.GroupVersion().String(),
 		Kind:              gvk.Kind,
 		Name:              managed.GetName(),
@@ -109,18 +129,7 @@ func (g *GRPCChangeLogger) Log(ctx context.Context, managed resource.Managed, op
 		Snapshot:          snapshot,
 		ErrorMessage:      changeErrMessage,
 		AdditionalDetails: ad,
-	}
-
-	// create a specific context and timeout for sending the change log entry
-	// that is different than the parent context that is for the entire
-	// reconciliation
-	sendCtx, sendCancel := context.WithTimeout(ctx, g.sendTimeout)
-	defer sendCancel()
-
-	// send everything we've got to the change log service
-	_, err = g.client.SendChangeLog(sendCtx, &v1alpha1.SendChangeLogRequest{Entry: entry}, grpc.WaitForReady(true))
-
-	return errors.Wrap(err, "cannot send change log entry")
+	}, nil
 }
 
 // nopChangeLogger does nothing for recording change logs, this is the default
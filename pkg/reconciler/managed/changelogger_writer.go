@@ -0,0 +1,221 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/crossplane/crossplane-runtime/v2/apis/changelogs/proto/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+)
+
+const (
+	defaultMaxFileSize    = 100 * 1024 * 1024
+	defaultMaxFileBackups = 3
+)
+
+// A WriterChangeLogger writes change log entries to an io.Writer as JSON
+// lines. It allows change logs to be recorded without running the change
+// logs service, for example to a file or to stdout.
+type WriterChangeLogger struct {
+	w               io.Writer
+	providerVersion string
+
+	mu sync.Mutex
+}
+
+// A WriterChangeLoggerOption configures a WriterChangeLogger.
+type WriterChangeLoggerOption func(*WriterChangeLogger)
+
+// WithWriterProviderVersion sets the provider version to be included in each
+// change log entry.
+func WithWriterProviderVersion(version string) WriterChangeLoggerOption {
+	return func(l *WriterChangeLogger) {
+		l.providerVersion = version
+	}
+}
+
+// NewWriterChangeLogger returns a ChangeLogger that writes change log entries
+// to the supplied io.Writer.
+func NewWriterChangeLogger(w io.Writer, o ...WriterChangeLoggerOption) *WriterChangeLogger {
+	l := &WriterChangeLogger{w: w}
+	for _, fn := range o {
+		fn(l)
+	}
+
+	return l
+}
+
+// NewStdoutChangeLogger returns a ChangeLogger that writes change log entries
+// to stdout.
+func NewStdoutChangeLogger(o ...WriterChangeLoggerOption) *WriterChangeLogger {
+	return NewWriterChangeLogger(os.Stdout, o...)
+}
+
+// Log writes the given change log entry as a line of JSON.
+func (l *WriterChangeLogger) Log(_ context.Context, managed resource.Managed, opType v1alpha1.OperationType, changeErr error, ad AdditionalDetails) error {
+	entry, err := newChangeLogEntry(managed, l.providerVersion, opType, changeErr, ad)
+	if err != nil {
+		return err
+	}
+
+	b, err := protojson.Marshal(entry)
+	if err != nil {
+		return errors.Wrap(err, "cannot marshal change log entry")
+	}
+
+	// Serialize writes so concurrent reconciles don't interleave lines.
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	_, err = l.w.Write(append(b, '\n'))
+
+	return errors.Wrap(err, "cannot write change log entry")
+}
+
+// A RotatingFileOption configures a RotatingFile.
+type RotatingFileOption func(*RotatingFile)
+
+// WithMaxFileSize configures the size, in bytes, at which a RotatingFile is
+// rotated.
+func WithMaxFileSize(bytes int64) RotatingFileOption {
+	return func(f *RotatingFile) {
+		f.maxSize = bytes
+	}
+}
+
+// WithMaxFileBackups configures how many rotated files a RotatingFile keeps.
+func WithMaxFileBackups(n int) RotatingFileOption {
+	return func(f *RotatingFile) {
+		f.maxBackups = n
+	}
+}
+
+// A RotatingFile is an io.WriteCloser that writes to a file, rotating it when
+// a write would make it exceed a maximum size. Rotated files are renamed with
+// a numeric suffix, e.g. changes.log.1, with higher numbers being older. It's
+// intended to be used with a WriterChangeLogger.
+type RotatingFile struct {
+	path       string
+	maxSize    int64
+	maxBackups int
+
+	mu   sync.Mutex
+	f    *os.File
+	size int64
+}
+
+// NewRotatingFile opens or creates the file at the supplied path for
+// appending.
+func NewRotatingFile(path string, o ...RotatingFileOption) (*RotatingFile, error) {
+	f := &RotatingFile{
+		path:       path,
+		maxSize:    defaultMaxFileSize,
+		maxBackups: defaultMaxFileBackups,
+	}
+
+	for _, fn := range o {
+		fn(f)
+	}
+
+	return f, f.open()
+}
+
+func (f *RotatingFile) open() error {
+	file, err := os.OpenFile(f.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return errors.Wrap(err, "cannot open change log file")
+	}
+
+	fi, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return errors.Wrap(err, "cannot stat change log file")
+	}
+
+	f.f = file
+	f.size = fi.Size()
+
+	return nil
+}
+
+func (f *RotatingFile) rotate() (err error) {
+	if err := f.f.Close(); err != nil {
+		return errors.Wrap(err, "cannot close change log file")
+	}
+
+	// Always reopen the file, even if we fail to rotate it. Otherwise every
+	// subsequent write would fail because the file is closed.
+	defer func() {
+		if oerr := f.open(); err == nil {
+			err = oerr
+		}
+	}()
+
+	// Shift each backup up by one, dropping the oldest.
+	for i := f.maxBackups; i > 0; i-- {
+		from := f.path
+		if i > 1 {
+			from = fmt.Sprintf("%s.%d", f.path, i-1)
+		}
+
+		if err := os.Rename(from, fmt.Sprintf("%s.%d", f.path, i)); err != nil && !os.IsNotExist(err) {
+			return errors.Wrap(err, "cannot rotate change log file")
+		}
+	}
+
+	if f.maxBackups < 1 {
+		if err := os.Remove(f.path); err != nil && !os.IsNotExist(err) {
+			return errors.Wrap(err, "cannot rotate change log file")
+		}
+	}
+
+	return nil
+}
+
+// Write the supplied bytes to the file, first rotating it if the write would
+// make it exceed its maximum size.
+func (f *RotatingFile) Write(p []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.size > 0 && f.size+int64(len(p)) > f.maxSize {
+		if err := f.rotate(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := f.f.Write(p)
+	f.size += int64(n)
+
+	return n, err
+}
+
+// Close the file.
+func (f *RotatingFile) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.f.Close()
+}
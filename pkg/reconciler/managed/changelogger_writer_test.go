@@ -0,0 +1,171 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/v2/apis/changelogs/proto/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+)
+
+func TestWriterChangeLogger(t *testing.T) {
+	mg := &fake.Managed{ObjectMeta: metav1.ObjectMeta{
+		Name:        "cool-managed",
+		Annotations: map[string]string{meta.AnnotationKeyExternalName: "cool-external"},
+	}}
+
+	b := &bytes.Buffer{}
+	l := NewWriterChangeLogger(b, WithWriterProviderVersion("provider-cool:v9.99.999"))
+
+	for range 2 {
+		if err := l.Log(context.Background(), mg, v1alpha1.OperationType_OPERATION_TYPE_CREATE, nil, AdditionalDetails{"key": "value"}); err != nil {
+			t.Fatalf("l.Log(...): %s", err)
+		}
+	}
+
+	lines := strings.Split(strings.TrimSuffix(b.String(), "\n"), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("l.Log(...): want 2 lines, got %d", len(lines))
+	}
+
+	want := &v1alpha1.ChangeLogEntry{
+		Timestamp:         timestamppb.Now(),
+		Provider:          "provider-cool:v9.99.999",
+		ApiVersion:        (&fake.Managed{}).GetObjectKind().GroupVersionKind().GroupVersion().String(),
+		Kind:              (&fake.Managed{}).GetObjectKind().GroupVersionKind().Kind,
+		Name:              "cool-managed",
+		ExternalName:      "cool-external",
+		Operation:         v1alpha1.OperationType_OPERATION_TYPE_CREATE,
+		Snapshot:          mustObjectAsProtobufStruct(mg),
+		AdditionalDetails: AdditionalDetails{"key": "value"},
+	}
+
+	for _, line := range lines {
+		got := &v1alpha1.ChangeLogEntry{}
+		if err := protojson.Unmarshal([]byte(line), got); err != nil {
+			t.Fatalf("protojson.Unmarshal(...): %s", err)
+		}
+
+		if diff := cmp.Diff(want, got, equateApproxTimepb(time.Second)...); diff != "" {
+			t.Errorf("l.Log(...): -want entry, +got entry:\n%s", diff)
+		}
+	}
+}
+
+func TestRotatingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "changes.log")
+
+	f, err := NewRotatingFile(path, WithMaxFileSize(10), WithMaxFileBackups(2))
+	if err != nil {
+		t.Fatalf("NewRotatingFile(...): %s", err)
+	}
+	defer f.Close() //nolint:errcheck // Only a test.
+
+	// Each write fills the file, so every subsequent write rotates it.
+	for _, line := range []string{"first....\n", "second...\n", "third....\n", "fourth...\n"} {
+		if _, err := f.Write([]byte(line)); err != nil {
+			t.Fatalf("f.Write(...): %s", err)
+		}
+	}
+
+	want := map[string]string{
+		path:        "fourth...\n",
+		path + ".1": "third....\n",
+		path + ".2": "second...\n",
+	}
+
+	got := map[string]string{}
+	for p := range want {
+		b, err := os.ReadFile(p)
+		if err != nil {
+			t.Fatalf("os.ReadFile(%q): %s", p, err)
+		}
+		got[p] = string(b)
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("f.Write(...): -want files, +got files:\n%s", diff)
+	}
+
+	if _, err := os.Stat(path + ".3"); !os.IsNotExist(err) {
+		t.Errorf("f.Write(...): want at most 2 backups")
+	}
+}
+
+func TestRotatingFileRotateError(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "changes.log")
+
+	f, err := NewRotatingFile(path, WithMaxFileSize(10), WithMaxFileBackups(1))
+	if err != nil {
+		t.Fatalf("NewRotatingFile(...): %s", err)
+	}
+	defer f.Close() //nolint:errcheck // Only a test.
+
+	if _, err := f.Write([]byte("first....\n")); err != nil {
+		t.Fatalf("f.Write(...): %s", err)
+	}
+
+	// A non-empty directory where the backup should go makes rotation fail.
+	if err := os.MkdirAll(filepath.Join(path+".1", "blocked"), 0o700); err != nil {
+		t.Fatalf("os.MkdirAll(...): %s", err)
+	}
+
+	if _, err := f.Write([]byte("second...\n")); err == nil {
+		t.Fatalf("f.Write(...): want error rotating file")
+	}
+
+	if err := os.RemoveAll(path + ".1"); err != nil {
+		t.Fatalf("os.RemoveAll(...): %s", err)
+	}
+
+	// The file should have been reopened, so writes succeed once rotation
+	// does.
+	if _, err := f.Write([]byte("third....\n")); err != nil {
+		t.Fatalf("f.Write(...): %s", err)
+	}
+
+	want := map[string]string{
+		path:        "third....\n",
+		path + ".1": "first....\n",
+	}
+
+	got := map[string]string{}
+	for p := range want {
+		b, err := os.ReadFile(p)
+		if err != nil {
+			t.Fatalf("os.ReadFile(%q): %s", p, err)
+		}
+		got[p] = string(b)
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("f.Write(...): -want files, +got files:\n%s", diff)
+	}
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+)
+
+// Error strings.
+const (
+	errFmtMarshalConnectionDetailKeys = "cannot marshal connection detail keys of %s"
+	errApplyConnectionDetailKeys      = "cannot apply connection detail keys ConfigMap"
+)
+
+// A ConnectionDetailKey describes a connection detail an ExternalClient may
+// publish to its managed resource's connection secret.
+type ConnectionDetailKey struct {
+	// Name of the connection detail, i.e. its key in the connection secret.
+	Name string `json:"name"`
+
+	// Description of the connection detail.
+	Description string `json:"description,omitempty"`
+
+	// Sensitive is true if the connection detail is a credential, such as a
+	// password or private key, rather than e.g. an endpoint or port.
+	Sensitive bool `json:"sensitive"`
+}
+
+// A ConnectionDetailKeysRegistry records the connection detail keys each kind
+// of managed resource may publish, so that documentation and UIs can show what
+// a managed resource's connection secret will contain. It's safe for
+// concurrent use.
+type ConnectionDetailKeysRegistry struct {
+	mu   sync.RWMutex
+	keys map[schema.GroupVersionKind][]ConnectionDetailKey
+}
+
+// NewConnectionDetailKeysRegistry returns an empty registry.
+func NewConnectionDetailKeysRegistry() *ConnectionDetailKeysRegistry {
+	return &ConnectionDetailKeysRegistry{keys: make(map[schema.GroupVersionKind][]ConnectionDetailKey)}
+}
+
+// Register the connection detail keys the supplied kind of managed resource
+// may publish, replacing any that were previously registered.
+func (r *ConnectionDetailKeysRegistry) Register(gvk schema.GroupVersionKind, keys ...ConnectionDetailKey) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.keys[gvk] = slices.Clone(keys)
+}
+
+// Get the connection detail keys the supplied kind of managed resource may
+// publish. It returns false if none were registered.
+func (r *ConnectionDetailKeysRegistry) Get(gvk schema.GroupVersionKind) ([]ConnectionDetailKey, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	keys, ok := r.keys[gvk]
+
+	return slices.Clone(keys), ok
+}
+
+// All returns the connection detail keys of every registered kind of managed
+// resource.
+func (r *ConnectionDetailKeysRegistry) All() map[schema.GroupVersionKind][]ConnectionDetailKey {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	out := make(map[schema.GroupVersionKind][]ConnectionDetailKey, len(r.keys))
+	for gvk, keys := range r.keys {
+		out[gvk] = slices.Clone(keys)
+	}
+
+	return out
+}
+
+// WithConnectionDetailKeys declares the connection detail keys the
+// Reconciler's ExternalClients may publish, and registers them for the
+// Reconciler's kind of managed resource with the supplied registry.
+func WithConnectionDetailKeys(reg *ConnectionDetailKeysRegistry, keys ...ConnectionDetailKey) ReconcilerOption {
+	return func(r *Reconciler) {
+		reg.Register(r.kind, keys...)
+	}
+}
+
+// ConnectionDetailKeysConfigMapKey returns the key of the ConfigMap data a
+// ConfigMapConnectionDetailKeysPublisher writes the supplied kind of managed
+// resource's connection detail keys to, e.g. bucket.v1.s3.example.org.
+func ConnectionDetailKeysConfigMapKey(gvk schema.GroupVersionKind) string {
+	return strings.ToLower(gvk.Kind) + "." + gvk.Version + "." + gvk.Group
+}
+
+// A ConfigMapConnectionDetailKeysPublisher publishes the connection detail
+// keys in a registry as JSON in a well-known ConfigMap, with one data key per
+// kind of managed resource. See ConnectionDetailKeysConfigMapKey.
+//
+// It's a controller-runtime Runnable. Add it to a controller manager after
+// setting up the controllers that register connection detail keys, and it
+// publishes them when the manager starts.
+type ConfigMapConnectionDetailKeysPublisher struct {
+	applier  resource.Applicator
+	name     types.NamespacedName
+	registry *ConnectionDetailKeysRegistry
+}
+
+// NewConfigMapConnectionDetailKeysPublisher returns a publisher that writes
+// the connection detail keys in the supplied registry to the named ConfigMap.
+func NewConfigMapConnectionDetailKeysPublisher(c client.Client, nn types.NamespacedName, reg *ConnectionDetailKeysRegistry) *ConfigMapConnectionDetailKeysPublisher {
+	return &ConfigMapConnectionDetailKeysPublisher{applier: resource.NewAPIPatchingApplicator(c), name: nn, registry: reg}
+}
+
+// Publish the registered connection detail keys.
+func (p *ConfigMapConnectionDetailKeysPublisher) Publish(ctx context.Context) error {
+	all := p.registry.All()
+
+	data := make(map[string]string, len(all))
+
+	for gvk, keys := range all {
+		j, err := json.Marshal(keys)
+		if err != nil {
+			return errors.Wrapf(err, errFmtMarshalConnectionDetailKeys, gvk)
+		}
+
+		data[ConnectionDetailKeysConfigMapKey(gvk)] = string(j)
+	}
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: p.name.Namespace, Name: p.name.Name},
+		Data:       data,
+	}
+
+	return errors.Wrap(p.applier.Apply(ctx, cm), errApplyConnectionDetailKeys)
+}
+
+// Start publishes the registered connection detail keys. It satisfies
+// controller-runtime's Runnable interface.
+func (p *ConfigMapConnectionDetailKeysPublisher) Start(ctx context.Context) error {
+	return p.Publish(ctx)
+}
+
+// NeedLeaderElection returns true; only the leader publishes connection
+// detail keys.
+func (p *ConfigMapConnectionDetailKeysPublisher) NeedLeaderElection() bool {
+	return true
+}
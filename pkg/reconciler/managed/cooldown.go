@@ -0,0 +1,87 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+)
+
+// An updateCooldown tracks when each managed resource's external resource was
+// last updated, in memory, in order to enforce a minimum interval between
+// updates.
+type updateCooldown struct {
+	period time.Duration
+
+	mu   sync.Mutex
+	last map[types.UID]lastUpdate
+}
+
+// A lastUpdate records when an external resource was last updated, and the
+// generation of the managed resource's desired state at the time.
+type lastUpdate struct {
+	time       time.Time
+	generation int64
+}
+
+func newUpdateCooldown(period time.Duration) *updateCooldown {
+	return &updateCooldown{period: period, last: make(map[types.UID]lastUpdate)}
+}
+
+// Remaining returns how long remains before the supplied managed resource's
+// external resource may be updated again. The cooldown doesn't apply if the
+// managed resource's desired state changed since the last update, so that
+// changes made by users aren't deferred like changes made by other systems.
+func (c *updateCooldown) Remaining(mg resource.Managed, now time.Time) time.Duration {
+	if c.period <= 0 {
+		return 0
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	last, ok := c.last[mg.GetUID()]
+	if !ok || last.generation != mg.GetGeneration() {
+		return 0
+	}
+
+	return max(last.time.Add(c.period).Sub(now), 0)
+}
+
+// Record that the supplied managed resource's external resource was updated.
+func (c *updateCooldown) Record(mg resource.Managed, now time.Time) {
+	if c.period <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.last[mg.GetUID()] = lastUpdate{time: now, generation: mg.GetGeneration()}
+}
+
+// Forget the supplied managed resource.
+func (c *updateCooldown) Forget(mg resource.Managed) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.last, mg.GetUID())
+}
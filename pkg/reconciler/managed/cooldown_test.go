@@ -0,0 +1,135 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+)
+
+func TestUpdateCooldownRemaining(t *testing.T) {
+	now := time.Now()
+	mg := &fake.Managed{ObjectMeta: metav1.ObjectMeta{UID: "cool-uid", Generation: 1}}
+	edited := &fake.Managed{ObjectMeta: metav1.ObjectMeta{UID: "cool-uid", Generation: 2}}
+
+	type args struct {
+		period time.Duration
+		record *time.Time
+		forget bool
+		now    time.Time
+		mg     *fake.Managed
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   time.Duration
+	}{
+		"Disabled": {
+			reason: "A zero cooldown period should never defer updates.",
+			args: args{
+				record: &now,
+				now:    now,
+			},
+			want: 0,
+		},
+		"NeverUpdated": {
+			reason: "A resource that was never updated should not be deferred.",
+			args: args{
+				period: time.Minute,
+				now:    now,
+			},
+			want: 0,
+		},
+		"WithinCooldown": {
+			reason: "A recently updated resource should be deferred for the rest of the period.",
+			args: args{
+				period: time.Minute,
+				record: &now,
+				now:    now.Add(20 * time.Second),
+			},
+			want: 40 * time.Second,
+		},
+		"WithinCooldownSameGeneration": {
+			reason: "A recently updated resource whose desired state hasn't changed should be deferred.",
+			args: args{
+				period: time.Minute,
+				record: &now,
+				now:    now.Add(20 * time.Second),
+				mg:     mg,
+			},
+			want: 40 * time.Second,
+		},
+		"WithinCooldownNewGeneration": {
+			reason: "A recently updated resource whose desired state has changed should not be deferred.",
+			args: args{
+				period: time.Minute,
+				record: &now,
+				now:    now.Add(20 * time.Second),
+				mg:     edited,
+			},
+			want: 0,
+		},
+		"CooldownElapsed": {
+			reason: "A resource updated longer ago than the period should not be deferred.",
+			args: args{
+				period: time.Minute,
+				record: &now,
+				now:    now.Add(2 * time.Minute),
+			},
+			want: 0,
+		},
+		"Forgotten": {
+			reason: "A forgotten resource should not be deferred.",
+			args: args{
+				period: time.Minute,
+				record: &now,
+				forget: true,
+				now:    now,
+			},
+			want: 0,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := newUpdateCooldown(tc.args.period)
+			if tc.args.record != nil {
+				c.Record(mg, *tc.args.record)
+			}
+
+			if tc.args.forget {
+				c.Forget(mg)
+			}
+
+			check := mg
+			if tc.args.mg != nil {
+				check = tc.args.mg
+			}
+
+			got := c.Remaining(check, tc.args.now)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nc.Remaining(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+)
+
+const errCheckCreationGate = "cannot check whether the external resource may be created"
+
+// Condition reasons.
+const (
+	// ReasonCreationDeferred indicates creation of the external resource was
+	// deferred by a CreationGate, and will be retried.
+	ReasonCreationDeferred xpv1.ConditionReason = "CreationDeferred"
+
+	// ReasonCreationDenied indicates creation of the external resource was
+	// denied by a CreationGate.
+	ReasonCreationDenied xpv1.ConditionReason = "CreationDenied"
+)
+
+// CreationDeferred returns a condition that indicates creation of the external
+// resource was deferred for the supplied reason.
+func CreationDeferred(msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               xpv1.TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonCreationDeferred,
+		Message:            msg,
+	}
+}
+
+// CreationDenied returns a condition that indicates creation of the external
+// resource was denied for the supplied reason.
+func CreationDenied(msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               xpv1.TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonCreationDenied,
+		Message:            msg,
+	}
+}
+
+// A CreationVerdict is a CreationGate's verdict on whether an external
+// resource may be created.
+type CreationVerdict string
+
+// Creation verdicts.
+const (
+	// CreateAllowed external resources are created.
+	CreateAllowed CreationVerdict = "Allowed"
+
+	// CreateDeferred external resources aren't created yet. The Reconciler
+	// asks the CreationGate again later.
+	CreateDeferred CreationVerdict = "Deferred"
+
+	// CreateDenied external resources aren't created. The Reconciler only
+	// asks the CreationGate again when the managed resource changes.
+	CreateDenied CreationVerdict = "Denied"
+)
+
+// A CreationDecision is a CreationGate's decision on whether an external
+// resource may be created.
+type CreationDecision struct {
+	// Verdict of the CreationGate. External resources with an empty verdict
+	// are created.
+	Verdict CreationVerdict
+
+	// RequeueAfter is how long to wait before asking the CreationGate again
+	// about a deferred creation. The Reconciler's poll interval is used if
+	// it's zero.
+	RequeueAfter time.Duration
+
+	// Message explains why creation was deferred or denied, for example
+	// because it would exceed a budget.
+	Message string
+}
+
+// AllowCreation returns a decision that allows an external resource to be
+// created.
+func AllowCreation() CreationDecision {
+	return CreationDecision{Verdict: CreateAllowed}
+}
+
+// DeferCreation returns a decision that defers creation of an external
+// resource for the supplied duration, for the supplied reason.
+func DeferCreation(after time.Duration, msg string) CreationDecision {
+	return CreationDecision{Verdict: CreateDeferred, RequeueAfter: after, Message: msg}
+}
+
+// DenyCreation returns a decision that denies creation of an external
+// resource, for the supplied reason.
+func DenyCreation(msg string) CreationDecision {
+	return CreationDecision{Verdict: CreateDenied, Message: msg}
+}
+
+// A CreationGate is consulted before the Reconciler creates an external
+// resource. It may allow, defer, or deny creation, for example to enforce a
+// budget or to reserve quota. It's called with the context used to connect to
+// the external system.
+type CreationGate interface {
+	AllowCreate(ctx context.Context, mg resource.Managed) (CreationDecision, error)
+}
+
+// A CreationGateFn is a function that satisfies the CreationGate interface.
+type CreationGateFn func(ctx context.Context, mg resource.Managed) (CreationDecision, error)
+
+// AllowCreate calls CreationGateFn.
+func (fn CreationGateFn) AllowCreate(ctx context.Context, mg resource.Managed) (CreationDecision, error) {
+	return fn(ctx, mg)
+}
+
+// A NopCreationGate always allows creation.
+type NopCreationGate struct{}
+
+// AllowCreate always allows creation.
+func (NopCreationGate) AllowCreate(_ context.Context, _ resource.Managed) (CreationDecision, error) {
+	return AllowCreation(), nil
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+)
+
+func TestReconcileCreationGate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		result  reconcile.Result
+		created bool
+		ready   xpv1.Condition
+		synced  xpv1.Condition
+	}
+
+	cases := map[string]struct {
+		reason string
+		gate   CreationGate
+		want   want
+	}{
+		"Allowed": {
+			reason: "We should create the external resource if the gate allows it.",
+			gate:   NopCreationGate{},
+			want: want{
+				result:  reconcile.Result{Requeue: true},
+				created: true,
+				ready:   xpv1.Creating().WithObservedGeneration(42),
+				synced:  xpv1.ReconcileSuccess().WithObservedGeneration(42),
+			},
+		},
+		"Deferred": {
+			reason: "We should not create the external resource if the gate defers creation, and should ask again after the requested duration.",
+			gate: CreationGateFn(func(_ context.Context, _ resource.Managed) (CreationDecision, error) {
+				return DeferCreation(time.Minute, "waiting for quota"), nil
+			}),
+			want: want{
+				result: reconcile.Result{RequeueAfter: time.Minute},
+				ready:  CreationDeferred("waiting for quota").WithObservedGeneration(42),
+				synced: xpv1.ReconcileSuccess().WithObservedGeneration(42),
+			},
+		},
+		"DeferredDefaultInterval": {
+			reason: "We should ask the gate again after the poll interval if it defers creation without saying for how long.",
+			gate: CreationGateFn(func(_ context.Context, _ resource.Managed) (CreationDecision, error) {
+				return DeferCreation(0, "waiting for quota"), nil
+			}),
+			want: want{
+				result: reconcile.Result{RequeueAfter: defaultPollInterval},
+				ready:  CreationDeferred("waiting for quota").WithObservedGeneration(42),
+				synced: xpv1.ReconcileSuccess().WithObservedGeneration(42),
+			},
+		},
+		"Denied": {
+			reason: "We should not create the external resource, or requeue, if the gate denies creation.",
+			gate: CreationGateFn(func(_ context.Context, _ resource.Managed) (CreationDecision, error) {
+				return DenyCreation("over budget"), nil
+			}),
+			want: want{
+				result: reconcile.Result{},
+				ready:  CreationDenied("over budget").WithObservedGeneration(42),
+				synced: xpv1.ReconcileError(errors.New("over budget")).WithObservedGeneration(42),
+			},
+		},
+		"GateError": {
+			reason: "We should not create the external resource if we can't ask the gate whether to.",
+			gate: CreationGateFn(func(_ context.Context, _ resource.Managed) (CreationDecision, error) {
+				return CreationDecision{}, errBoom
+			}),
+			want: want{
+				result: reconcile.Result{Requeue: true},
+				ready:  xpv1.Creating().WithObservedGeneration(42),
+				synced: xpv1.ReconcileError(errors.Wrap(errBoom, errCheckCreationGate)).WithObservedGeneration(42),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var ready, synced xpv1.Condition
+
+			c := &test.MockClient{
+				MockGet:    test.NewMockGetFn(nil, func(obj client.Object) error { asModernManaged(obj, 42); return nil }),
+				MockUpdate: test.NewMockUpdateFn(nil),
+				MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil, func(obj client.Object) error {
+					//nolint:forcetypeassert // This is always a managed resource.
+					mg := obj.(resource.Managed)
+					ready, synced = mg.GetCondition(xpv1.TypeReady), mg.GetCondition(xpv1.TypeSynced)
+					return nil
+				}),
+			}
+			m := &fake.Manager{Client: c, Scheme: fake.SchemeWith(&fake.ModernManaged{})}
+
+			created := false
+
+			r := NewReconciler(m, resource.ManagedKind(fake.GVK(&fake.ModernManaged{})),
+				WithInitializers(),
+				WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+				WithExternalConnector(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+					return &ExternalClientFns{
+						ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+							return ExternalObservation{ResourceExists: false}, nil
+						},
+						CreateFn: func(_ context.Context, _ resource.Managed) (ExternalCreation, error) {
+							created = true
+							return ExternalCreation{}, nil
+						},
+						DisconnectFn: func(_ context.Context) error { return nil },
+					}, nil
+				})),
+				WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+				WithCreationGate(tc.gate),
+			)
+
+			result, err := r.Reconcile(context.Background(), reconcile.Request{})
+			if err != nil {
+				t.Errorf("\n%s\nr.Reconcile(...): %v", tc.reason, err)
+			}
+
+			if diff := cmp.Diff(tc.want.result, result); diff != "" {
+				t.Errorf("\n%s\nr.Reconcile(...): -want result, +got result:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.want.created, created); diff != "" {
+				t.Errorf("\n%s\nr.Reconcile(...): -want created, +got created:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.want.ready, ready, test.EquateConditions()); diff != "" {
+				t.Errorf("\n%s\nr.Reconcile(...): -want Ready condition, +got Ready condition:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.want.synced, synced, test.EquateConditions()); diff != "" {
+				t.Errorf("\n%s\nr.Reconcile(...): -want Synced condition, +got Synced condition:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
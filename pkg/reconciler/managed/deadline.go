@@ -0,0 +1,135 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/clock"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+)
+
+// Error strings.
+const (
+	errFmtSlowExternalCall = "%s took %s, more than %d%% of the reconcile timeout of %s"
+	errFmtDeadlineExceeded = "reconcile exceeded its timeout of %s"
+)
+
+// Event reasons.
+const (
+	reasonSlowExternalCall event.Reason = "SlowExternalCall"
+	reasonDeadlineExceeded event.Reason = "ReconcileDeadlineExceeded"
+)
+
+// A deadlineTracker detects calls to the external system that take longer
+// than a percentage of the reconcile timeout, and reconciles that exceed it.
+type deadlineTracker struct {
+	kind    schema.GroupVersionKind
+	timeout time.Duration
+	percent int
+	clock   clock.PassiveClock
+	record  event.Recorder
+	metrics MetricRecorder
+}
+
+// threshold returns how long a call to the external system may take before
+// it's considered slow.
+func (t *deadlineTracker) threshold() time.Duration {
+	return t.timeout * time.Duration(t.percent) / 100
+}
+
+// track a call to the external system that started at the supplied time. It
+// must be called when the call returns.
+func (t *deadlineTracker) track(mg resource.Managed, op string, start time.Time) {
+	took := t.clock.Since(start)
+	if took <= t.threshold() {
+		return
+	}
+
+	t.metrics.recordSlowExternalCall(t.kind, op)
+	t.record.Event(mg, event.Warning(reasonSlowExternalCall, errors.Errorf(errFmtSlowExternalCall, op, took.Round(time.Millisecond), t.percent, t.timeout)))
+}
+
+// done checks whether the supplied reconcile exceeded its deadline. It must
+// be called when the reconcile is done.
+func (t *deadlineTracker) done(s *ReconcileState) {
+	if s.Managed == nil || !errors.Is(s.externalCtx.Err(), context.DeadlineExceeded) {
+		return
+	}
+
+	t.metrics.recordDeadlineExceeded(t.kind)
+	s.Record.Event(s.Managed, event.Warning(reasonDeadlineExceeded, errors.Errorf(errFmtDeadlineExceeded, t.timeout)))
+}
+
+// A deadlineTrackingConnector tracks how long calls to an ExternalConnector
+// and the ExternalClients it produces take.
+type deadlineTrackingConnector struct {
+	ExternalConnectDisconnector
+
+	tracker *deadlineTracker
+}
+
+func (c *deadlineTrackingConnector) Connect(ctx context.Context, mg resource.Managed) (ExternalClient, error) {
+	defer c.tracker.track(mg, opConnect, c.tracker.clock.Now())
+
+	ec, err := c.ExternalConnectDisconnector.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &deadlineTrackingClient{ExternalClient: ec, tracker: c.tracker}, nil
+}
+
+type deadlineTrackingClient struct {
+	ExternalClient
+
+	tracker *deadlineTracker
+}
+
+func (c *deadlineTrackingClient) Observe(ctx context.Context, mg resource.Managed) (ExternalObservation, error) {
+	defer c.tracker.track(mg, opObserve, c.tracker.clock.Now())
+	return c.ExternalClient.Observe(ctx, mg)
+}
+
+func (c *deadlineTrackingClient) Create(ctx context.Context, mg resource.Managed) (ExternalCreation, error) {
+	defer c.tracker.track(mg, opCreate, c.tracker.clock.Now())
+	return c.ExternalClient.Create(ctx, mg)
+}
+
+func (c *deadlineTrackingClient) Update(ctx context.Context, mg resource.Managed) (ExternalUpdate, error) {
+	defer c.tracker.track(mg, opUpdate, c.tracker.clock.Now())
+	return c.ExternalClient.Update(ctx, mg)
+}
+
+func (c *deadlineTrackingClient) Delete(ctx context.Context, mg resource.Managed) (ExternalDelete, error) {
+	defer c.tracker.track(mg, opDelete, c.tracker.clock.Now())
+	return c.ExternalClient.Delete(ctx, mg)
+}
+
+func (c *deadlineTrackingClient) ExternalNameInUse(ctx context.Context, mg resource.Managed, name string) (bool, error) {
+	defer c.tracker.track(mg, opExternalNameInUse, c.tracker.clock.Now())
+	return externalNameInUse(ctx, c.ExternalClient, mg, name)
+}
+
+func (c *deadlineTrackingClient) Ping(ctx context.Context) error {
+	return ping(ctx, c.ExternalClient)
+}
@@ -0,0 +1,207 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+)
+
+type deadlineCountingMetricRecorder struct {
+	*NopMetricRecorder
+
+	slow     []string
+	exceeded int
+}
+
+func (r *deadlineCountingMetricRecorder) recordSlowExternalCall(_ schema.GroupVersionKind, op string) {
+	r.slow = append(r.slow, op)
+}
+
+func (r *deadlineCountingMetricRecorder) recordDeadlineExceeded(_ schema.GroupVersionKind) {
+	r.exceeded++
+}
+
+func TestDeadlineTrackingConnector(t *testing.T) {
+	type want struct {
+		slow    []string
+		reasons []event.Reason
+	}
+
+	cases := map[string]struct {
+		reason string
+		took   time.Duration
+		want   want
+	}{
+		"Fast": {
+			reason: "Calls that take less than the percentage of the timeout shouldn't be counted.",
+			took:   5 * time.Second,
+			want:   want{},
+		},
+		"Slow": {
+			reason: "Calls that take more than the percentage of the timeout should be counted, and emitted as events.",
+			took:   6 * time.Second,
+			want: want{
+				slow:    []string{opConnect, opObserve, opCreate, opUpdate, opDelete},
+				reasons: []event.Reason{reasonSlowExternalCall, reasonSlowExternalCall, reasonSlowExternalCall, reasonSlowExternalCall, reasonSlowExternalCall},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			clk := clocktesting.NewFakePassiveClock(time.Now())
+			slow := func() { clk.SetTime(clk.Now().Add(tc.took)) }
+
+			m := &deadlineCountingMetricRecorder{NopMetricRecorder: NewNopMetricRecorder()}
+			rec := &reasonRecorder{}
+
+			c := &deadlineTrackingConnector{
+				ExternalConnectDisconnector: &ExternalConnectDisconnectorFns{
+					ConnectFn: func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+						slow()
+						return &ExternalClientFns{
+							ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+								slow()
+								return ExternalObservation{}, nil
+							},
+							CreateFn: func(_ context.Context, _ resource.Managed) (ExternalCreation, error) {
+								slow()
+								return ExternalCreation{}, nil
+							},
+							UpdateFn: func(_ context.Context, _ resource.Managed) (ExternalUpdate, error) {
+								slow()
+								return ExternalUpdate{}, nil
+							},
+							DeleteFn: func(_ context.Context, _ resource.Managed) (ExternalDelete, error) {
+								slow()
+								return ExternalDelete{}, nil
+							},
+						}, nil
+					},
+				},
+				tracker: &deadlineTracker{timeout: 10 * time.Second, percent: 50, clock: clk, record: rec, metrics: m},
+			}
+
+			ctx := context.Background()
+			mg := &fake.ModernManaged{}
+
+			ec, _ := c.Connect(ctx, mg)
+			_, _ = ec.Observe(ctx, mg)
+			_, _ = ec.Create(ctx, mg)
+			_, _ = ec.Update(ctx, mg)
+			_, _ = ec.Delete(ctx, mg)
+
+			if diff := cmp.Diff(tc.want.slow, m.slow); diff != "" {
+				t.Errorf("\n%s\nrecordSlowExternalCall(...): -want, +got:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.want.reasons, rec.reasons); diff != "" {
+				t.Errorf("\n%s\nEvent(...): -want reasons, +got reasons:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDeadlineTrackerDone(t *testing.T) {
+	exceeded, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+
+	type want struct {
+		exceeded int
+		reasons  []event.Reason
+	}
+
+	cases := map[string]struct {
+		reason string
+		s      *ReconcileState
+		want   want
+	}{
+		"NoManagedResource": {
+			reason: "A reconcile that didn't get the managed resource shouldn't be counted.",
+			s:      &ReconcileState{externalCtx: exceeded},
+			want:   want{},
+		},
+		"WithinDeadline": {
+			reason: "A reconcile that didn't exceed its deadline shouldn't be counted.",
+			s:      &ReconcileState{Managed: &fake.ModernManaged{}, externalCtx: context.Background()},
+			want:   want{},
+		},
+		"DeadlineExceeded": {
+			reason: "A reconcile that exceeded its deadline should be counted, and emitted as an event.",
+			s:      &ReconcileState{Managed: &fake.ModernManaged{}, externalCtx: exceeded},
+			want: want{
+				exceeded: 1,
+				reasons:  []event.Reason{reasonDeadlineExceeded},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			m := &deadlineCountingMetricRecorder{NopMetricRecorder: NewNopMetricRecorder()}
+			rec := &reasonRecorder{}
+			tc.s.Record = rec
+
+			d := &deadlineTracker{timeout: 10 * time.Second, percent: 50, metrics: m}
+			d.done(tc.s)
+
+			if diff := cmp.Diff(tc.want.exceeded, m.exceeded); diff != "" {
+				t.Errorf("\n%s\nrecordDeadlineExceeded(...): -want, +got:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.want.reasons, rec.reasons); diff != "" {
+				t.Errorf("\n%s\nEvent(...): -want reasons, +got reasons:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDeadlineTrackingClientForwards(t *testing.T) {
+	errUnhealthy := errors.New("unhealthy")
+
+	var ec ExternalClient = &deadlineTrackingClient{
+		ExternalClient: &optionalClient{
+			inUse: func(name string) (bool, error) { return name == "taken", nil },
+			ping:  func(_ context.Context) error { return errUnhealthy },
+		},
+		tracker: &deadlineTracker{clock: clocktesting.NewFakeClock(time.Now())},
+	}
+
+	checker, ok := ec.(ExternalNameCollisionChecker)
+	if !ok {
+		t.Fatalf("deadlineTrackingClient: want a client that forwards ExternalNameCollisionChecker")
+	}
+
+	if inUse, _ := checker.ExternalNameInUse(context.Background(), &fake.ModernManaged{}, "taken"); !inUse {
+		t.Errorf("ExternalNameInUse(...): want the wrapped client's answer")
+	}
+
+	if err := ec.(Pinger).Ping(context.Background()); !errors.Is(err, errUnhealthy) {
+		t.Errorf("Ping(...): want the wrapped client's error, got %v", err)
+	}
+}
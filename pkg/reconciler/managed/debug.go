@@ -0,0 +1,115 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+)
+
+const (
+	defaultDebugDuration = 1 * time.Hour
+
+	// Events have a maximum length. Longer diffs are truncated.
+	maxDebugDiffLength = 1024
+)
+
+// Error strings.
+const (
+	errPatchDebugAnnotations = "cannot update debug annotations of managed resource"
+)
+
+// debug elevates the log level of, and emits additional events for, a managed
+// resource annotated with meta.AnnotationKeyDebug. Debugging expires after the
+// Reconciler's debug duration. The first reconcile after it expires removes
+// the debug annotations.
+func (r *Reconciler) debug(ctx context.Context, s *ReconcileState) {
+	managed := s.Managed
+	if r.debugDuration <= 0 || !meta.IsDebugRequested(managed) {
+		return
+	}
+
+	now := r.clock.Now()
+	expires := meta.GetDebugExpires(managed)
+
+	//nolint:forcetypeassert // A deep copy of a managed resource is a managed resource.
+	patch := client.MergeFrom(managed.DeepCopyObject().(resource.Managed))
+
+	if !expires.IsZero() && !now.Before(expires) {
+		meta.RemoveAnnotations(managed, meta.AnnotationKeyDebug, meta.AnnotationKeyDebugExpires)
+
+		if err := r.client.Patch(ctx, managed, patch); err != nil {
+			s.Log.Info(errPatchDebugAnnotations, "error", err)
+		}
+
+		s.Log.Info("Debugging expired", "debug-expired", expires.Format(time.RFC3339))
+		s.Record.Event(managed, event.Normal(reasonDebugging, "Debugging expired"))
+
+		return
+	}
+
+	if expires.IsZero() {
+		expires = now.Add(r.debugDuration)
+		meta.SetDebugExpires(managed, expires)
+
+		// We debug this reconcile even if we can't record when debugging
+		// expires. We'll try again next reconcile.
+		if err := r.client.Patch(ctx, managed, patch); err != nil {
+			s.Log.Info(errPatchDebugAnnotations, "error", err)
+		}
+
+		s.Record.Event(managed, event.Normal(reasonDebugging, "Debugging until "+expires.Format(time.RFC3339)))
+	}
+
+	s.Debug = true
+	s.Log = debugLogger{Logger: s.Log.WithValues("debug-expires", expires.Format(time.RFC3339))}
+}
+
+// recordDiff emits an event with the supplied diff if the managed resource is
+// being debugged.
+func recordDiff(s *ReconcileState, diff string) {
+	if !s.Debug || diff == "" {
+		return
+	}
+
+	if len(diff) > maxDebugDiffLength {
+		diff = diff[:maxDebugDiffLength]
+	}
+
+	s.Record.Event(s.Managed, event.Normal(reasonDebugDiff, diff))
+}
+
+// A debugLogger logs debug messages at info level, so they're logged even if
+// debug logging is disabled.
+type debugLogger struct {
+	logging.Logger
+}
+
+func (l debugLogger) Debug(msg string, keysAndValues ...any) {
+	l.Info(msg, keysAndValues...)
+}
+
+func (l debugLogger) WithValues(keysAndValues ...any) logging.Logger {
+	return debugLogger{Logger: l.Logger.WithValues(keysAndValues...)}
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/runtime"
+	clocktesting "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+)
+
+type reasonRecorder struct {
+	event.NopRecorder
+
+	reasons []event.Reason
+}
+
+func (r *reasonRecorder) Event(_ runtime.Object, e event.Event) {
+	r.reasons = append(r.reasons, e.Reason)
+}
+
+func TestDebug(t *testing.T) {
+	errBoom := errors.New("boom")
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type args struct {
+		annotations map[string]string
+		patchErr    error
+		o           []ReconcilerOption
+	}
+
+	type want struct {
+		annotations map[string]string
+		debug       bool
+		reasons     []event.Reason
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"NotRequested": {
+			reason: "A managed resource that isn't annotated for debugging shouldn't be debugged.",
+			want:   want{},
+		},
+		"Disabled": {
+			reason: "A managed resource shouldn't be debugged if debugging is disabled.",
+			args: args{
+				annotations: map[string]string{meta.AnnotationKeyDebug: "true"},
+				o:           []ReconcilerOption{WithDebugDuration(0)},
+			},
+			want: want{
+				annotations: map[string]string{meta.AnnotationKeyDebug: "true"},
+			},
+		},
+		"Start": {
+			reason: "A managed resource that's newly annotated for debugging should be debugged, and annotated with when debugging expires.",
+			args: args{
+				annotations: map[string]string{meta.AnnotationKeyDebug: "true"},
+			},
+			want: want{
+				annotations: map[string]string{
+					meta.AnnotationKeyDebug:        "true",
+					meta.AnnotationKeyDebugExpires: now.Add(defaultDebugDuration).Format(time.RFC3339),
+				},
+				debug:   true,
+				reasons: []event.Reason{reasonDebugging},
+			},
+		},
+		"StartPatchError": {
+			reason: "A managed resource should be debugged even if we can't record when debugging expires.",
+			args: args{
+				annotations: map[string]string{meta.AnnotationKeyDebug: "true"},
+				patchErr:    errBoom,
+			},
+			want: want{
+				annotations: map[string]string{
+					meta.AnnotationKeyDebug:        "true",
+					meta.AnnotationKeyDebugExpires: now.Add(defaultDebugDuration).Format(time.RFC3339),
+				},
+				debug:   true,
+				reasons: []event.Reason{reasonDebugging},
+			},
+		},
+		"Active": {
+			reason: "A managed resource whose debugging hasn't expired should be debugged.",
+			args: args{
+				annotations: map[string]string{
+					meta.AnnotationKeyDebug:        "true",
+					meta.AnnotationKeyDebugExpires: now.Add(time.Minute).Format(time.RFC3339),
+				},
+			},
+			want: want{
+				annotations: map[string]string{
+					meta.AnnotationKeyDebug:        "true",
+					meta.AnnotationKeyDebugExpires: now.Add(time.Minute).Format(time.RFC3339),
+				},
+				debug: true,
+			},
+		},
+		"Expired": {
+			reason: "A managed resource whose debugging has expired shouldn't be debugged, and its debug annotations should be removed.",
+			args: args{
+				annotations: map[string]string{
+					meta.AnnotationKeyDebug:        "true",
+					meta.AnnotationKeyDebugExpires: now.Add(-time.Minute).Format(time.RFC3339),
+					"cool":                         "very",
+				},
+			},
+			want: want{
+				annotations: map[string]string{"cool": "very"},
+				reasons:     []event.Reason{reasonDebugging},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg := &fake.ModernManaged{}
+			mg.SetAnnotations(tc.args.annotations)
+
+			var patched map[string]string
+
+			m := &fake.Manager{
+				Client: &test.MockClient{
+					MockPatch: test.NewMockPatchFn(tc.args.patchErr, func(obj client.Object) error {
+						patched = obj.GetAnnotations()
+						return nil
+					}),
+				},
+				Scheme: fake.SchemeWith(&fake.ModernManaged{}),
+			}
+
+			o := append([]ReconcilerOption{WithClock(clocktesting.NewFakePassiveClock(now))}, tc.args.o...)
+			r := NewReconciler(m, resource.ManagedKind(fake.GVK(&fake.ModernManaged{})), o...)
+
+			rec := &reasonRecorder{}
+			s := &ReconcileState{Managed: mg, Log: logging.NewNopLogger(), Record: rec}
+
+			r.debug(context.Background(), s)
+
+			if diff := cmp.Diff(tc.want.annotations, mg.GetAnnotations()); diff != "" {
+				t.Errorf("\n%s\nr.debug(...): -want annotations, +got annotations:\n%s", tc.reason, diff)
+			}
+
+			if tc.want.reasons != nil && tc.args.patchErr == nil {
+				if diff := cmp.Diff(tc.want.annotations, patched); diff != "" {
+					t.Errorf("\n%s\nr.debug(...): -want patched annotations, +got patched annotations:\n%s", tc.reason, diff)
+				}
+			}
+
+			if diff := cmp.Diff(tc.want.debug, s.Debug); diff != "" {
+				t.Errorf("\n%s\nr.debug(...): -want debug, +got debug:\n%s", tc.reason, diff)
+			}
+
+			if _, got := s.Log.(debugLogger); got != tc.want.debug {
+				t.Errorf("\n%s\nr.debug(...): want elevated logger %t, got %t", tc.reason, tc.want.debug, got)
+			}
+
+			if diff := cmp.Diff(tc.want.reasons, rec.reasons); diff != "" {
+				t.Errorf("\n%s\nr.debug(...): -want events, +got events:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
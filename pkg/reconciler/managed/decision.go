@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+)
+
+// A PolicyDecision is a decision the Reconciler made about whether the
+// management policies of a managed resource allow an action.
+type PolicyDecision struct {
+	// Action the Reconciler considered taking.
+	Action xpv1.ManagementAction
+
+	// Allowed is true if the Reconciler may take the action.
+	Allowed bool
+
+	// Policies are the management policies of the managed resource.
+	Policies xpv1.ManagementPolicies
+
+	// Degraded is true if the managed resource was degraded to observe only,
+	// regardless of its management policies, because its external client
+	// lacks access to the external resource.
+	Degraded bool
+}
+
+// A PolicyDecisionObserver is notified of each decision the Reconciler makes
+// about whether the management policies of a managed resource allow an
+// action. It's useful to debug why the Reconciler did or did not take an
+// action.
+type PolicyDecisionObserver interface {
+	ObservePolicyDecision(ctx context.Context, mg resource.Managed, d PolicyDecision)
+}
+
+// A PolicyDecisionObserverFn is a function that satisfies the
+// PolicyDecisionObserver interface.
+type PolicyDecisionObserverFn func(ctx context.Context, mg resource.Managed, d PolicyDecision)
+
+// ObservePolicyDecision calls PolicyDecisionObserverFn function.
+func (fn PolicyDecisionObserverFn) ObservePolicyDecision(ctx context.Context, mg resource.Managed, d PolicyDecision) {
+	fn(ctx, mg, d)
+}
+
+// A NopPolicyDecisionObserver does nothing.
+type NopPolicyDecisionObserver struct{}
+
+// ObservePolicyDecision does nothing.
+func (NopPolicyDecisionObserver) ObservePolicyDecision(_ context.Context, _ resource.Managed, _ PolicyDecision) {
+}
+
+// An observedPolicy notifies the Reconciler's log, metrics, and policy
+// decision observer of each decision made by the wrapped checker.
+type observedPolicy struct {
+	ManagementPoliciesChecker
+
+	ctx      context.Context //nolint:containedctx // Only lives for the duration of a reconcile.
+	mg       resource.Managed
+	log      logging.Logger
+	metrics  MetricRecorder
+	observer PolicyDecisionObserver
+	degraded bool
+}
+
+func (p *observedPolicy) observe(a xpv1.ManagementAction, allowed bool) bool {
+	d := PolicyDecision{
+		Action:   a,
+		Allowed:  allowed,
+		Policies: p.mg.GetManagementPolicies(),
+		Degraded: p.degraded,
+	}
+
+	p.log.Debug("Management policy decision", "action", d.Action, "allowed", d.Allowed, "policies", d.Policies, "degraded", d.Degraded)
+	p.metrics.recordPolicyDecision(p.mg, d)
+	p.observer.ObservePolicyDecision(p.ctx, p.mg, d)
+
+	return allowed
+}
+
+// ShouldCreate returns true if the Create action is allowed.
+func (p *observedPolicy) ShouldCreate() bool {
+	return p.observe(xpv1.ManagementActionCreate, p.ManagementPoliciesChecker.ShouldCreate())
+}
+
+// ShouldUpdate returns true if the Update action is allowed.
+func (p *observedPolicy) ShouldUpdate() bool {
+	return p.observe(xpv1.ManagementActionUpdate, p.ManagementPoliciesChecker.ShouldUpdate())
+}
+
+// ShouldLateInitialize returns true if the LateInitialize action is allowed.
+func (p *observedPolicy) ShouldLateInitialize() bool {
+	return p.observe(xpv1.ManagementActionLateInitialize, p.ManagementPoliciesChecker.ShouldLateInitialize())
+}
+
+// ShouldDelete returns true if the Delete action is allowed.
+func (p *observedPolicy) ShouldDelete() bool {
+	return p.observe(xpv1.ManagementActionDelete, p.ManagementPoliciesChecker.ShouldDelete())
+}
+
+// degradeToObserveOnly returns a checker that only allows the Observe and
+// Delete actions. Decisions made by an observed checker remain observed.
+func degradeToObserveOnly(p ManagementPoliciesChecker) ManagementPoliciesChecker {
+	op, ok := p.(*observedPolicy)
+	if !ok {
+		return observeOnlyPolicy{p}
+	}
+
+	cp := *op
+	cp.ManagementPoliciesChecker = observeOnlyPolicy{op.ManagementPoliciesChecker}
+	cp.degraded = true
+
+	return &cp
+}
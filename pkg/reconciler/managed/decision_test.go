@@ -0,0 +1,165 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+)
+
+func TestPolicyDecisionObserver(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	mockClient := func(fn test.ObjectFn) *test.MockClient {
+		return &test.MockClient{
+			MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+				asModernManaged(obj, 42)
+				return fn(obj)
+			}),
+			MockUpdate:       test.NewMockUpdateFn(nil),
+			MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+		}
+	}
+
+	connector := func(obs ExternalObservation) ExternalConnector {
+		return ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+			return &ExternalClientFns{
+				ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+					return obs, nil
+				},
+				CreateFn: func(_ context.Context, _ resource.Managed) (ExternalCreation, error) {
+					return ExternalCreation{}, nil
+				},
+				UpdateFn: func(_ context.Context, _ resource.Managed) (ExternalUpdate, error) {
+					return ExternalUpdate{}, nil
+				},
+				DeleteFn: func(_ context.Context, _ resource.Managed) (ExternalDelete, error) {
+					return ExternalDelete{}, nil
+				},
+				DisconnectFn: func(_ context.Context) error { return nil },
+			}, nil
+		})
+	}
+
+	type args struct {
+		c   client.Client
+		obs ExternalObservation
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   []PolicyDecision
+	}{
+		"Create": {
+			reason: "The observer should be notified when the Reconciler decides whether to create an external resource.",
+			args: args{
+				c:   mockClient(func(_ client.Object) error { return nil }),
+				obs: ExternalObservation{ResourceExists: false},
+			},
+			want: []PolicyDecision{
+				{Action: xpv1.ManagementActionCreate, Allowed: true},
+			},
+		},
+		"LateInitializeAndUpdate": {
+			reason: "The observer should be notified when the Reconciler decides whether to late initialize and update an external resource.",
+			args: args{
+				c:   mockClient(func(_ client.Object) error { return nil }),
+				obs: ExternalObservation{ResourceExists: true, ResourceLateInitialized: true},
+			},
+			want: []PolicyDecision{
+				{Action: xpv1.ManagementActionLateInitialize, Allowed: true},
+				{Action: xpv1.ManagementActionUpdate, Allowed: true},
+			},
+		},
+		"Delete": {
+			reason: "The observer should be notified when the Reconciler decides whether to delete an external resource.",
+			args: args{
+				c: mockClient(func(obj client.Object) error {
+					now := metav1.Now()
+					obj.SetDeletionTimestamp(&now)
+					return nil
+				}),
+				obs: ExternalObservation{ResourceExists: true},
+			},
+			want: []PolicyDecision{
+				{Action: xpv1.ManagementActionDelete, Allowed: true},
+				{Action: xpv1.ManagementActionDelete, Allowed: true},
+			},
+		},
+		"Degraded": {
+			reason: "Decisions made after the managed resource was degraded to observe only should be reported as degraded.",
+			args: args{
+				c:   mockClient(func(_ client.Object) error { return nil }),
+				obs: ExternalObservation{ResourceExists: true, AccessDenied: errBoom},
+			},
+			want: []PolicyDecision{
+				{Action: xpv1.ManagementActionUpdate, Allowed: false, Degraded: true},
+			},
+		},
+		"Paused": {
+			reason: "The observer should not be notified if the Reconciler doesn't consider taking any action.",
+			args: args{
+				c: mockClient(func(obj client.Object) error {
+					meta.AddAnnotations(obj, map[string]string{meta.AnnotationKeyReconciliationPaused: "true"})
+					return nil
+				}),
+			},
+			want: nil,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got []PolicyDecision
+
+			m := &fake.Manager{Client: tc.args.c, Scheme: fake.SchemeWith(&fake.ModernManaged{})}
+			r := NewReconciler(m, resource.ManagedKind(fake.GVK(&fake.ModernManaged{})),
+				WithPolicyDecisionObserver(PolicyDecisionObserverFn(func(_ context.Context, _ resource.Managed, d PolicyDecision) {
+					got = append(got, d)
+				})),
+				WithInitializers(),
+				WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+				WithExternalConnector(connector(tc.args.obs)),
+				WithFinalizer(resource.FinalizerFns{
+					AddFinalizerFn:    func(_ context.Context, _ resource.Object) error { return nil },
+					RemoveFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil },
+				}),
+			)
+
+			if _, err := r.Reconcile(context.Background(), reconcile.Request{}); err != nil {
+				t.Fatalf("\n%s\nr.Reconcile(...): %v", tc.reason, err)
+			}
+
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nr.Reconcile(...): -want decisions, +got decisions:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+// An observeOnlyPolicy degrades the supplied management policies to observe
+// only. It doesn't prevent deletion, so that a managed resource whose
+// external resource would otherwise be deleted doesn't silently orphan it.
+type observeOnlyPolicy struct {
+	ManagementPoliciesChecker
+}
+
+func (observeOnlyPolicy) ShouldOnlyObserve() bool    { return true }
+func (observeOnlyPolicy) ShouldCreate() bool         { return false }
+func (observeOnlyPolicy) ShouldLateInitialize() bool { return false }
+func (observeOnlyPolicy) ShouldUpdate() bool         { return false }
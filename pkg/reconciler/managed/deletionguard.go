@@ -0,0 +1,149 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/fieldpath"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+)
+
+const (
+	errCheckDependents   = "cannot check for dependents"
+	errFmtListDependents = "cannot list dependents of kind %s"
+)
+
+// A DeletionGuard is consulted before the Reconciler deletes an external
+// resource. It returns the managed resources that must be deleted first, for
+// example the subnets of a VPC. The Reconciler won't delete the external
+// resource while any are returned.
+type DeletionGuard interface {
+	Dependents(ctx context.Context, mg resource.Managed) ([]xpv1.TypedReference, error)
+}
+
+// A DeletionGuardFn is a function that satisfies the DeletionGuard interface.
+type DeletionGuardFn func(ctx context.Context, mg resource.Managed) ([]xpv1.TypedReference, error)
+
+// Dependents calls DeletionGuardFn.
+func (fn DeletionGuardFn) Dependents(ctx context.Context, mg resource.Managed) ([]xpv1.TypedReference, error) {
+	return fn(ctx, mg)
+}
+
+// A NopDeletionGuard never blocks deletion.
+type NopDeletionGuard struct{}
+
+// Dependents returns no dependents.
+func (NopDeletionGuard) Dependents(_ context.Context, _ resource.Managed) ([]xpv1.TypedReference, error) {
+	return nil, nil
+}
+
+// A DependentIndex identifies a kind of managed resource that depends on
+// another, and the field index that maps a managed resource to its
+// dependents. The index must return the name of the managed resource each
+// dependent references. See IndexReference.
+type DependentIndex struct {
+	// Kind of the dependent managed resources.
+	Kind resource.ManagedKind
+
+	// Field the dependents are indexed by.
+	Field string
+}
+
+type dependentList struct {
+	DependentIndex
+
+	of resource.ManagedList
+}
+
+// An IndexedDeletionGuard uses field indexes to find the managed resources that
+// depend on a managed resource. Dependents are assumed to be in the same
+// namespace as the managed resource they depend on.
+type IndexedDeletionGuard struct {
+	client client.Reader
+	lists  []dependentList
+}
+
+// NewIndexedDeletionGuard returns a DeletionGuard that blocks deletion while
+// any dependents are found using the supplied indexes. It panics if a
+// dependent kind's list kind isn't registered with the client's scheme.
+func NewIndexedDeletionGuard(c client.Client, idx ...DependentIndex) *IndexedDeletionGuard {
+	g := &IndexedDeletionGuard{client: c, lists: make([]dependentList, len(idx))}
+
+	for i, di := range idx {
+		lk := schema.GroupVersionKind(di.Kind)
+		lk.Kind += "List"
+		//nolint:forcetypeassert // If this isn't a list of managed resources it's a programming error and we want to panic.
+		g.lists[i] = dependentList{DependentIndex: di, of: resource.MustCreateObject(lk, c.Scheme()).(resource.ManagedList)}
+	}
+
+	return g
+}
+
+// Dependents returns the managed resources that depend on the supplied one.
+func (g *IndexedDeletionGuard) Dependents(ctx context.Context, mg resource.Managed) ([]xpv1.TypedReference, error) {
+	var out []xpv1.TypedReference
+
+	for _, dl := range g.lists {
+		//nolint:forcetypeassert // Will always be a list of managed resources.
+		l := dl.of.DeepCopyObject().(resource.ManagedList)
+		if err := g.client.List(ctx, l, client.InNamespace(mg.GetNamespace()), client.MatchingFields{dl.Field: mg.GetName()}); err != nil {
+			return nil, errors.Wrapf(err, errFmtListDependents, dl.Kind.Kind)
+		}
+
+		for _, d := range l.GetItems() {
+			if d.GetUID() == mg.GetUID() {
+				continue
+			}
+
+			out = append(out, xpv1.TypedReference{
+				APIVersion: schema.GroupVersionKind(dl.Kind).GroupVersion().String(),
+				Kind:       dl.Kind.Kind,
+				Name:       d.GetName(),
+			})
+		}
+	}
+
+	return out, nil
+}
+
+// IndexReference returns an IndexerFunc that indexes managed resources by the
+// string at the supplied field path, for example the name of the managed
+// resource a reference resolves to:
+//
+//	mgr.GetFieldIndexer().IndexField(ctx, &v1.Subnet{}, "vpcRef", managed.IndexReference("spec.forProvider.vpcIdRef.name"))
+func IndexReference(path string) client.IndexerFunc {
+	return func(o client.Object) []string {
+		u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(o)
+		if err != nil {
+			return nil
+		}
+
+		v, err := fieldpath.Pave(u).GetString(path)
+		if err != nil || v == "" {
+			return nil
+		}
+
+		return []string{v}
+	}
+}
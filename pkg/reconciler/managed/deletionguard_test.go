@@ -0,0 +1,293 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+)
+
+var _ DeletionGuard = &IndexedDeletionGuard{}
+
+type ModernManagedList struct {
+	client.ObjectList
+
+	Items []resource.Managed
+}
+
+func (l *ModernManagedList) GetObjectKind() schema.ObjectKind {
+	return schema.EmptyObjectKind
+}
+
+func (l *ModernManagedList) DeepCopyObject() runtime.Object {
+	return &ModernManagedList{Items: append([]resource.Managed{}, l.Items...)}
+}
+
+func (l *ModernManagedList) GetItems() []resource.Managed {
+	return l.Items
+}
+
+func TestReconcileDeletionGuard(t *testing.T) {
+	errBoom := errors.New("boom")
+	now := metav1.Now()
+	dependents := []xpv1.TypedReference{{APIVersion: "example.org/v1", Kind: "Subnet", Name: "cool-subnet"}}
+
+	type args struct {
+		protected bool
+		guard     DeletionGuard
+	}
+
+	type want struct {
+		result  reconcile.Result
+		deleted bool
+		status  xpv1.Condition
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"NoDependents": {
+			reason: "We should delete the external resource if it has no dependents.",
+			args: args{
+				guard: NopDeletionGuard{},
+			},
+			want: want{
+				result:  reconcile.Result{Requeue: true},
+				deleted: true,
+				status:  xpv1.Deleting().WithObservedGeneration(42),
+			},
+		},
+		"Dependents": {
+			reason: "We should not delete the external resource while it has dependents, and should poll until they're gone.",
+			args: args{
+				guard: DeletionGuardFn(func(_ context.Context, _ resource.Managed) ([]xpv1.TypedReference, error) {
+					return dependents, nil
+				}),
+			},
+			want: want{
+				result: reconcile.Result{RequeueAfter: defaultPollInterval},
+				status: xpv1.DeletionBlocked(dependents).WithObservedGeneration(42),
+			},
+		},
+		"DeletionProtected": {
+			reason: "We should not delete the external resource while the managed resource is deletion protected.",
+			args: args{
+				protected: true,
+				guard:     NopDeletionGuard{},
+			},
+			want: want{
+				result: reconcile.Result{RequeueAfter: defaultPollInterval},
+				status: xpv1.DeletionProtected().WithObservedGeneration(42),
+			},
+		},
+		"GuardError": {
+			reason: "We should not delete the external resource if we can't check for dependents.",
+			args: args{
+				guard: DeletionGuardFn(func(_ context.Context, _ resource.Managed) ([]xpv1.TypedReference, error) {
+					return nil, errBoom
+				}),
+			},
+			want: want{
+				result: reconcile.Result{Requeue: true},
+				status: xpv1.Deleting().WithObservedGeneration(42),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var status xpv1.Condition
+
+			c := &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					mg := asModernManaged(obj, 42)
+					mg.SetDeletionTimestamp(&now)
+					if tc.args.protected {
+						mg.SetAnnotations(map[string]string{meta.AnnotationKeyDeletionProtection: "true"})
+					}
+					return nil
+				}),
+				MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil, func(obj client.Object) error {
+					//nolint:forcetypeassert // This is always a managed resource.
+					status = obj.(resource.Managed).GetCondition(xpv1.TypeReady)
+					return nil
+				}),
+			}
+			m := &fake.Manager{Client: c, Scheme: fake.SchemeWith(&fake.ModernManaged{})}
+
+			deleted := false
+
+			r := NewReconciler(m, resource.ManagedKind(fake.GVK(&fake.ModernManaged{})),
+				WithInitializers(),
+				WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+				WithExternalConnector(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+					return &ExternalClientFns{
+						ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+							return ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+						},
+						DeleteFn: func(_ context.Context, _ resource.Managed) (ExternalDelete, error) {
+							deleted = true
+							return ExternalDelete{}, nil
+						},
+						DisconnectFn: func(_ context.Context) error { return nil },
+					}, nil
+				})),
+				WithDeletionGuard(tc.args.guard),
+			)
+
+			result, err := r.Reconcile(context.Background(), reconcile.Request{})
+			if err != nil {
+				t.Errorf("\n%s\nr.Reconcile(...): %v", tc.reason, err)
+			}
+
+			if diff := cmp.Diff(tc.want.result, result); diff != "" {
+				t.Errorf("\n%s\nr.Reconcile(...): -want result, +got result:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.want.deleted, deleted); diff != "" {
+				t.Errorf("\n%s\nr.Reconcile(...): -want deleted, +got deleted:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.want.status, status, test.EquateConditions()); diff != "" {
+				t.Errorf("\n%s\nr.Reconcile(...): -want Ready condition, +got Ready condition:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestIndexedDeletionGuard(t *testing.T) {
+	errBoom := errors.New("boom")
+	kind := resource.ManagedKind(fake.GVK(&fake.ModernManaged{}))
+	s := fake.SchemeWith(&fake.ModernManaged{}, &ModernManagedList{})
+
+	mg := &fake.ModernManaged{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "cool-vpc", UID: "cool-vpc"}}
+	subnet := &fake.ModernManaged{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "cool-subnet", UID: "cool-subnet"}}
+
+	type args struct {
+		list test.MockListFn
+	}
+
+	type want struct {
+		dependents []xpv1.TypedReference
+		err        error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"ListError": {
+			reason: "We should return any error encountered listing dependents.",
+			args: args{
+				list: test.NewMockListFn(errBoom),
+			},
+			want: want{
+				err: errors.Wrapf(errBoom, errFmtListDependents, kind.Kind),
+			},
+		},
+		"Dependents": {
+			reason: "We should return the dependents found in the managed resource's namespace using the index, excluding itself.",
+			args: args{
+				list: func(_ context.Context, obj client.ObjectList, opts ...client.ListOption) error {
+					lo := &client.ListOptions{}
+					lo.ApplyOptions(opts)
+
+					if lo.Namespace != "default" || lo.FieldSelector.String() != "vpcRef=cool-vpc" {
+						return errors.Errorf("unexpected list options: namespace %q, fields %q", lo.Namespace, lo.FieldSelector)
+					}
+
+					//nolint:forcetypeassert // This is always a ModernManagedList.
+					obj.(*ModernManagedList).Items = []resource.Managed{mg, subnet}
+
+					return nil
+				},
+			},
+			want: want{
+				dependents: []xpv1.TypedReference{{APIVersion: fake.GV.String(), Kind: kind.Kind, Name: "cool-subnet"}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &test.MockClient{MockList: tc.args.list, MockScheme: test.NewMockSchemeFn(s)}
+			g := NewIndexedDeletionGuard(c, DependentIndex{Kind: kind, Field: "vpcRef"})
+
+			got, err := g.Dependents(context.Background(), mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nDependents(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.want.dependents, got); diff != "" {
+				t.Errorf("\n%s\nDependents(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestIndexReference(t *testing.T) {
+	withRef := func(ref string) *taggedManaged {
+		mg := &taggedManaged{}
+		if ref != "" {
+			mg.Spec.ForProvider.Tags = map[string]string{"vpc": ref}
+		}
+
+		return mg
+	}
+
+	cases := map[string]struct {
+		reason string
+		obj    client.Object
+		want   []string
+	}{
+		"Referenced": {
+			reason: "We should index a managed resource by the name at the field path.",
+			obj:    withRef("cool-vpc"),
+			want:   []string{"cool-vpc"},
+		},
+		"NotReferenced": {
+			reason: "We should not index a managed resource without a value at the field path.",
+			obj:    withRef(""),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IndexReference("spec.forProvider.tags.vpc")(tc.obj)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nIndexReference(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
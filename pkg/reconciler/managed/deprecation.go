@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/fieldpath"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/unstructured"
+)
+
+const reasonDeprecatedField event.Reason = "DeprecatedField"
+
+// Error strings.
+const (
+	errFmtDeprecatedField = "field %s is deprecated"
+	errFmtDeprecatedUse   = "field %s is deprecated: %s"
+)
+
+// A DeprecationNotifier notifies users when a managed resource uses deprecated
+// fields. It's consulted each time a managed resource is initialized.
+type DeprecationNotifier interface {
+	// Notify users if the supplied managed resource uses deprecated fields.
+	Notify(ctx context.Context, mg resource.Managed, record event.Recorder)
+
+	// Forget the supplied managed resource, because it was deleted.
+	Forget(mg resource.Managed)
+}
+
+// A NopDeprecationNotifier does nothing.
+type NopDeprecationNotifier struct{}
+
+// Notify does nothing.
+func (NopDeprecationNotifier) Notify(_ context.Context, _ resource.Managed, _ event.Recorder) {}
+
+// Forget does nothing.
+func (NopDeprecationNotifier) Forget(_ resource.Managed) {}
+
+// A DeprecatedField is a deprecated field of a managed resource.
+type DeprecatedField struct {
+	// Path to the field, e.g. spec.forProvider.legacyName. The path may
+	// include wildcards, e.g. spec.forProvider.rules[*].legacyName.
+	Path string
+
+	// Message telling users what to do instead, e.g. "use
+	// spec.forProvider.name instead".
+	Message string
+}
+
+// A FieldPathDeprecationNotifier emits a warning event when a managed resource
+// uses a deprecated field. It emits each warning once per managed resource,
+// for as long as the managed resource uses the deprecated field. Which warnings
+// were emitted is tracked in memory, so warnings are emitted again when the
+// controller restarts.
+type FieldPathDeprecationNotifier struct {
+	fields []DeprecatedField
+
+	mu      sync.Mutex
+	emitted map[types.UID]sets.Set[string]
+}
+
+// NewFieldPathDeprecationNotifier returns a FieldPathDeprecationNotifier that
+// notifies users when a managed resource uses any of the supplied fields.
+func NewFieldPathDeprecationNotifier(f ...DeprecatedField) *FieldPathDeprecationNotifier {
+	return &FieldPathDeprecationNotifier{fields: f, emitted: make(map[types.UID]sets.Set[string])}
+}
+
+// Notify users if the supplied managed resource uses deprecated fields.
+func (n *FieldPathDeprecationNotifier) Notify(_ context.Context, mg resource.Managed, record event.Recorder) {
+	p, err := paveManaged(mg)
+	if err != nil {
+		// We'd rather not warn than block the reconcile.
+		return
+	}
+
+	used := sets.New[string]()
+
+	for _, f := range n.fields {
+		paths, err := p.ExpandWildcards(f.Path)
+		if err != nil || len(paths) == 0 {
+			continue
+		}
+
+		used.Insert(f.Path)
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	// Forget fields that are no longer used, so we warn again if they're used
+	// again.
+	emitted := n.emitted[mg.GetUID()].Intersection(used)
+	if emitted.Len() == 0 {
+		delete(n.emitted, mg.GetUID())
+	}
+
+	for _, f := range n.fields {
+		if !used.Has(f.Path) || emitted.Has(f.Path) {
+			continue
+		}
+
+		err := errors.Errorf(errFmtDeprecatedField, f.Path)
+		if f.Message != "" {
+			err = errors.Errorf(errFmtDeprecatedUse, f.Path, f.Message)
+		}
+
+		record.Event(mg, event.Warning(reasonDeprecatedField, err))
+		emitted.Insert(f.Path)
+		n.emitted[mg.GetUID()] = emitted
+	}
+}
+
+// Forget the supplied managed resource.
+func (n *FieldPathDeprecationNotifier) Forget(mg resource.Managed) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	delete(n.emitted, mg.GetUID())
+}
+
+func paveManaged(mg resource.Managed) (*fieldpath.Paved, error) {
+	if w, ok := mg.(unstructured.Wrapper); ok {
+		return fieldpath.Pave(w.GetUnstructured().UnstructuredContent()), nil
+	}
+
+	return fieldpath.PaveObject(mg)
+}
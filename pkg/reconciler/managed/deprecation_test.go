@@ -0,0 +1,111 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	umanaged "github.com/crossplane/crossplane-runtime/v2/pkg/resource/unstructured/managed"
+)
+
+func TestFieldPathDeprecationNotifier(t *testing.T) {
+	withSpec := func(spec map[string]any) *umanaged.Unstructured {
+		mg := umanaged.New()
+		mg.SetUID(types.UID("cool-uid"))
+		mg.Object["spec"] = spec
+
+		return mg
+	}
+
+	fields := []DeprecatedField{
+		{Path: "spec.forProvider.legacyName", Message: "use spec.forProvider.name instead"},
+		{Path: "spec.forProvider.rules[*].legacy"},
+	}
+
+	cases := map[string]struct {
+		reason string
+		specs  []map[string]any
+		want   []event.Reason
+	}{
+		"Unused": {
+			reason: "We shouldn't warn about deprecated fields that aren't used.",
+			specs:  []map[string]any{{"forProvider": map[string]any{"name": "cool"}}},
+		},
+		"UsedOnce": {
+			reason: "We should warn about each deprecated field that's used.",
+			specs: []map[string]any{
+				{"forProvider": map[string]any{"legacyName": "cool", "rules": []any{map[string]any{"legacy": true}}}},
+			},
+			want: []event.Reason{reasonDeprecatedField, reasonDeprecatedField},
+		},
+		"UsedRepeatedly": {
+			reason: "We should only warn once about a deprecated field that's used repeatedly.",
+			specs: []map[string]any{
+				{"forProvider": map[string]any{"legacyName": "cool"}},
+				{"forProvider": map[string]any{"legacyName": "cool"}},
+				{"forProvider": map[string]any{"legacyName": "cooler"}},
+			},
+			want: []event.Reason{reasonDeprecatedField},
+		},
+		"UsedAgain": {
+			reason: "We should warn again about a deprecated field that's used again after it stopped being used.",
+			specs: []map[string]any{
+				{"forProvider": map[string]any{"legacyName": "cool"}},
+				{"forProvider": map[string]any{"name": "cool"}},
+				{"forProvider": map[string]any{"legacyName": "cool"}},
+			},
+			want: []event.Reason{reasonDeprecatedField, reasonDeprecatedField},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			n := NewFieldPathDeprecationNotifier(fields...)
+			rec := &reasonRecorder{}
+
+			for _, spec := range tc.specs {
+				n.Notify(context.Background(), withSpec(spec), rec)
+			}
+
+			if diff := cmp.Diff(tc.want, rec.reasons); diff != "" {
+				t.Errorf("\n%s\nNotify(...): -want events, +got events:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestFieldPathDeprecationNotifierForget(t *testing.T) {
+	n := NewFieldPathDeprecationNotifier(DeprecatedField{Path: "spec.legacy"})
+	rec := &reasonRecorder{}
+
+	mg := umanaged.New()
+	mg.SetUID(types.UID("cool-uid"))
+	mg.Object["spec"] = map[string]any{"legacy": true}
+
+	n.Notify(context.Background(), mg, rec)
+	n.Forget(mg)
+	n.Notify(context.Background(), mg, rec)
+
+	if diff := cmp.Diff([]event.Reason{reasonDeprecatedField, reasonDeprecatedField}, rec.reasons); diff != "" {
+		t.Errorf("Notify(...): a forgotten managed resource should be warned again: -want events, +got events:\n%s", diff)
+	}
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+)
+
+// A driftLoopDetector counts how many consecutive times each managed
+// resource's external resource was updated without being observed as up to
+// date. Counts are tracked in memory, and persisted as an annotation so that
+// they survive controller restarts.
+type driftLoopDetector struct {
+	threshold    int
+	pollInterval time.Duration
+
+	mu      sync.Mutex
+	updates map[types.UID]int
+}
+
+func newDriftLoopDetector(threshold int, pollInterval time.Duration) *driftLoopDetector {
+	return &driftLoopDetector{threshold: threshold, pollInterval: pollInterval, updates: make(map[types.UID]int)}
+}
+
+// Updated records that the supplied managed resource's external resource was
+// updated, and returns how many consecutive times it has now been updated.
+func (d *driftLoopDetector) Updated(mg resource.Managed) int {
+	if d.threshold <= 0 {
+		return 0
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	n, ok := d.updates[mg.GetUID()]
+	if !ok {
+		// We may have been restarted. Pick up where we left off.
+		n, _ = strconv.Atoi(mg.GetAnnotations()[meta.AnnotationKeyConsecutiveDriftUpdates])
+	}
+
+	n++
+	d.updates[mg.GetUID()] = n
+
+	return n
+}
+
+// Detected returns true if the supplied number of consecutive updates
+// indicates a drift loop.
+func (d *driftLoopDetector) Detected(updates int) bool {
+	return d.threshold > 0 && updates >= d.threshold
+}
+
+// PollInterval returns the interval at which to poll an external resource
+// that is in a drift loop, given the interval at which it would otherwise be
+// polled.
+func (d *driftLoopDetector) PollInterval(def time.Duration) time.Duration {
+	return max(d.pollInterval, def)
+}
+
+// Forget the supplied managed resource, for example because its external
+// resource was observed to be up to date.
+func (d *driftLoopDetector) Forget(mg resource.Managed) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	delete(d.updates, mg.GetUID())
+}
+
+// Persist the supplied managed resource's count of consecutive updates as an
+// annotation, if the supplied outcome changed it. The annotation is persisted
+// using a merge patch of a copy of the managed resource, so pending changes to
+// the managed resource are not reset.
+func (d *driftLoopDetector) Persist(ctx context.Context, c client.Client, mg resource.Managed, o ReconcileOutcome) error {
+	if d.threshold <= 0 {
+		return nil
+	}
+
+	switch o.Type { //nolint:exhaustive // Only these outcomes change the count.
+	case OutcomeUpdated, OutcomeUpToDate:
+	default:
+		return nil
+	}
+
+	d.mu.Lock()
+	n := d.updates[mg.GetUID()]
+	d.mu.Unlock()
+
+	want := ""
+	if n > 0 {
+		want = strconv.Itoa(n)
+	}
+
+	if mg.GetAnnotations()[meta.AnnotationKeyConsecutiveDriftUpdates] == want {
+		return nil
+	}
+
+	//nolint:forcetypeassert // A deep copy of a managed resource is a managed resource.
+	cp := mg.DeepCopyObject().(resource.Managed)
+	patch := client.MergeFrom(cp.DeepCopyObject().(resource.Managed)) //nolint:forcetypeassert // See above.
+
+	if n > 0 {
+		meta.AddAnnotations(cp, map[string]string{meta.AnnotationKeyConsecutiveDriftUpdates: want})
+	} else {
+		meta.RemoveAnnotations(cp, meta.AnnotationKeyConsecutiveDriftUpdates)
+	}
+
+	return c.Patch(ctx, cp, patch)
+}
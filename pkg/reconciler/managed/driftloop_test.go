@@ -0,0 +1,168 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+)
+
+func TestDriftLoopDetector(t *testing.T) {
+	type args struct {
+		threshold   int
+		annotations map[string]string
+		updates     int
+		forget      bool
+		o           ReconcileOutcome
+	}
+	type want struct {
+		updates     int
+		detected    bool
+		patched     bool
+		annotations map[string]string
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"Disabled": {
+			reason: "A zero threshold should disable drift loop detection.",
+			args: args{
+				updates: 10,
+				o:       outcome(OutcomeUpdated),
+			},
+			want: want{},
+		},
+		"BelowThreshold": {
+			reason: "Fewer consecutive updates than the threshold should not be a drift loop.",
+			args: args{
+				threshold: 3,
+				updates:   2,
+				o:         outcome(OutcomeUpdated),
+			},
+			want: want{
+				updates:     2,
+				patched:     true,
+				annotations: map[string]string{meta.AnnotationKeyConsecutiveDriftUpdates: "2"},
+			},
+		},
+		"AtThreshold": {
+			reason: "As many consecutive updates as the threshold should be a drift loop.",
+			args: args{
+				threshold: 3,
+				updates:   3,
+				o:         outcome(OutcomeUpdated),
+			},
+			want: want{
+				updates:     3,
+				detected:    true,
+				patched:     true,
+				annotations: map[string]string{meta.AnnotationKeyConsecutiveDriftUpdates: "3"},
+			},
+		},
+		"ResumeFromAnnotation": {
+			reason: "We should resume counting from the annotation when we're not tracking a resource in memory.",
+			args: args{
+				threshold:   3,
+				annotations: map[string]string{meta.AnnotationKeyConsecutiveDriftUpdates: "4"},
+				updates:     1,
+				o:           outcome(OutcomeUpdated),
+			},
+			want: want{
+				updates:     5,
+				detected:    true,
+				patched:     true,
+				annotations: map[string]string{meta.AnnotationKeyConsecutiveDriftUpdates: "5"},
+			},
+		},
+		"UpToDate": {
+			reason: "We should remove the annotation once the external resource is observed to be up to date.",
+			args: args{
+				threshold:   3,
+				annotations: map[string]string{meta.AnnotationKeyConsecutiveDriftUpdates: "4"},
+				forget:      true,
+				o:           outcome(OutcomeUpToDate),
+			},
+			want: want{
+				patched:     true,
+				annotations: map[string]string{},
+			},
+		},
+		"UpToDateWithoutAnnotation": {
+			reason: "We should not patch a resource that has no annotation to remove.",
+			args: args{
+				threshold: 3,
+				forget:    true,
+				o:         outcome(OutcomeUpToDate),
+			},
+			want: want{},
+		},
+		"OtherOutcome": {
+			reason: "We should not persist the count for outcomes that don't change it.",
+			args: args{
+				threshold:   3,
+				annotations: map[string]string{meta.AnnotationKeyConsecutiveDriftUpdates: "4"},
+				o:           outcome(OutcomeUpdateCooldown),
+			},
+			want: want{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got want
+			c := &test.MockClient{
+				MockPatch: func(_ context.Context, obj client.Object, _ client.Patch, _ ...client.PatchOption) error {
+					got.patched = true
+					got.annotations = obj.GetAnnotations()
+					return nil
+				},
+			}
+
+			mg := &fake.Managed{ObjectMeta: metav1.ObjectMeta{UID: "drift-uid", Annotations: tc.args.annotations}}
+			d := newDriftLoopDetector(tc.args.threshold, 0)
+
+			for range tc.args.updates {
+				got.updates = d.Updated(mg)
+			}
+
+			got.detected = d.Detected(got.updates)
+
+			if tc.args.forget {
+				d.Forget(mg)
+			}
+
+			if err := d.Persist(context.Background(), c, mg, tc.args.o); err != nil {
+				t.Fatalf("\n%s\nd.Persist(...): %v", tc.reason, err)
+			}
+
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\ndriftLoopDetector: -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,217 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"sync"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+)
+
+// EncryptedDataKeyKey is the key of the connection secret data that stores the
+// encrypted data key of connection details encrypted by an EnvelopeEncryptor.
+const EncryptedDataKeyKey = "crossplane.io-encrypted-data-key"
+
+const dataKeySize = 32 // AES-256
+
+// The maximum number of decrypted data keys an EnvelopeEncryptor caches.
+const maxCachedDataKeys = 4096
+
+// Error strings.
+const (
+	errGenerateDataKey   = "cannot generate data key"
+	errEncryptDataKey    = "cannot encrypt data key"
+	errDecryptDataKey    = "cannot decrypt data key"
+	errNewCipher         = "cannot create cipher"
+	errGenerateNonce     = "cannot generate nonce"
+	errNoDataKey         = "connection details have no encrypted data key"
+	errFmtDecryptDetail  = "cannot decrypt connection detail %q"
+	errFmtReservedDetail = "connection detail key %q is reserved"
+)
+
+// A KeyManagementService encrypts and decrypts small amounts of data, such as
+// data keys, using a key it manages. It's typically backed by a cloud KMS or
+// an HSM.
+type KeyManagementService interface {
+	// Encrypt the supplied plaintext.
+	Encrypt(ctx context.Context, plaintext []byte) ([]byte, error)
+
+	// Decrypt the supplied ciphertext.
+	Decrypt(ctx context.Context, ciphertext []byte) ([]byte, error)
+}
+
+// A ConnectionDetailsEncryptor encrypts connection details before they're
+// written to a connection secret, and decrypts them after they're read.
+type ConnectionDetailsEncryptor interface {
+	// Encrypt the supplied connection details.
+	Encrypt(ctx context.Context, c ConnectionDetails) (ConnectionDetails, error)
+
+	// Decrypt the supplied connection details.
+	Decrypt(ctx context.Context, c ConnectionDetails) (ConnectionDetails, error)
+}
+
+// An EnvelopeEncryptor encrypts connection details using envelope encryption.
+// Each set of connection details is encrypted using AES-256-GCM with a new,
+// random data key. The data key is encrypted by a KeyManagementService and
+// stored alongside the connection details under EncryptedDataKeyKey. Only
+// the KeyManagementService can decrypt the data key, so connection secrets
+// don't contain plaintext credentials at rest.
+//
+// Decrypted data keys are cached in memory, keyed by their encrypted data key,
+// so that checking whether a connection secret is up to date doesn't require
+// a round trip to the KeyManagementService.
+type EnvelopeEncryptor struct {
+	kms KeyManagementService
+
+	mu   sync.Mutex
+	keys map[string][]byte
+}
+
+// NewEnvelopeEncryptor returns an EnvelopeEncryptor that encrypts data keys
+// using the supplied KeyManagementService.
+func NewEnvelopeEncryptor(kms KeyManagementService) *EnvelopeEncryptor {
+	return &EnvelopeEncryptor{kms: kms, keys: make(map[string][]byte)}
+}
+
+// Encrypt the supplied connection details.
+func (e *EnvelopeEncryptor) Encrypt(ctx context.Context, c ConnectionDetails) (ConnectionDetails, error) {
+	if _, ok := c[EncryptedDataKeyKey]; ok {
+		return nil, errors.Errorf(errFmtReservedDetail, EncryptedDataKeyKey)
+	}
+
+	dk := make([]byte, dataKeySize)
+	if _, err := rand.Read(dk); err != nil {
+		return nil, errors.Wrap(err, errGenerateDataKey)
+	}
+
+	edk, err := e.kms.Encrypt(ctx, dk)
+	if err != nil {
+		return nil, errors.Wrap(err, errEncryptDataKey)
+	}
+
+	e.cache(edk, dk)
+
+	aead, err := newAEAD(dk)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(ConnectionDetails, len(c)+1)
+	for k, v := range c {
+		nonce := make([]byte, aead.NonceSize())
+		if _, err := rand.Read(nonce); err != nil {
+			return nil, errors.Wrap(err, errGenerateNonce)
+		}
+
+		// The key is authenticated, so encrypted values can't be swapped
+		// between keys.
+		out[k] = aead.Seal(nonce, nonce, v, []byte(k))
+	}
+
+	out[EncryptedDataKeyKey] = edk
+
+	return out, nil
+}
+
+// Decrypt the supplied connection details.
+func (e *EnvelopeEncryptor) Decrypt(ctx context.Context, c ConnectionDetails) (ConnectionDetails, error) {
+	edk, ok := c[EncryptedDataKeyKey]
+	if !ok {
+		return nil, errors.New(errNoDataKey)
+	}
+
+	dk, err := e.dataKey(ctx, edk)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := newAEAD(dk)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(ConnectionDetails, len(c)-1)
+	for k, v := range c {
+		if k == EncryptedDataKeyKey {
+			continue
+		}
+
+		if len(v) < aead.NonceSize() {
+			return nil, errors.Errorf(errFmtDecryptDetail, k)
+		}
+
+		pt, err := aead.Open(nil, v[:aead.NonceSize()], v[aead.NonceSize():], []byte(k))
+		if err != nil {
+			return nil, errors.Wrapf(err, errFmtDecryptDetail, k)
+		}
+
+		out[k] = pt
+	}
+
+	return out, nil
+}
+
+// dataKey returns the supplied encrypted data key, decrypted.
+func (e *EnvelopeEncryptor) dataKey(ctx context.Context, edk []byte) ([]byte, error) {
+	e.mu.Lock()
+	dk, ok := e.keys[string(edk)]
+	e.mu.Unlock()
+
+	if ok {
+		return dk, nil
+	}
+
+	dk, err := e.kms.Decrypt(ctx, edk)
+	if err != nil {
+		return nil, errors.Wrap(err, errDecryptDataKey)
+	}
+
+	e.cache(edk, dk)
+
+	return dk, nil
+}
+
+// cache the supplied decrypted data key.
+func (e *EnvelopeEncryptor) cache(edk, dk []byte) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if len(e.keys) >= maxCachedDataKeys {
+		// Evict an arbitrary data key. Map iteration order is random.
+		for k := range e.keys {
+			delete(e.keys, k)
+			break
+		}
+	}
+
+	e.keys[string(edk)] = dk
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	b, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewCipher)
+	}
+
+	aead, err := cipher.NewGCM(b)
+
+	return aead, errors.Wrap(err, errNewCipher)
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+)
+
+var _ ConnectionDetailsEncryptor = &EnvelopeEncryptor{}
+
+// An identityKMS "encrypts" data by returning it as is. It counts how many
+// times it's called.
+type identityKMS struct {
+	err   error
+	calls int
+}
+
+func (k *identityKMS) Encrypt(_ context.Context, plaintext []byte) ([]byte, error) {
+	k.calls++
+	return bytes.Clone(plaintext), k.err
+}
+
+func (k *identityKMS) Decrypt(_ context.Context, ciphertext []byte) ([]byte, error) {
+	k.calls++
+	return bytes.Clone(ciphertext), k.err
+}
+
+func TestEnvelopeEncryptor(t *testing.T) {
+	errBoom := errors.New("boom")
+	cd := ConnectionDetails{"username": []byte("cool"), "password": []byte("very-secret")}
+
+	type args struct {
+		kms KeyManagementService
+		cd  ConnectionDetails
+
+		// tamper with the encrypted connection details.
+		tamper func(ConnectionDetails)
+	}
+
+	type want struct {
+		encryptErr error
+		decryptErr error
+		cd         ConnectionDetails
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"RoundTrip": {
+			reason: "Encrypted connection details should decrypt to the original connection details.",
+			args: args{
+				kms: &identityKMS{},
+				cd:  cd,
+			},
+			want: want{
+				cd: cd,
+			},
+		},
+		"Empty": {
+			reason: "Empty connection details should round trip.",
+			args: args{
+				kms: &identityKMS{},
+				cd:  ConnectionDetails{},
+			},
+			want: want{
+				cd: ConnectionDetails{},
+			},
+		},
+		"ReservedKey": {
+			reason: "Connection details that use the reserved data key key should not be encrypted.",
+			args: args{
+				kms: &identityKMS{},
+				cd:  ConnectionDetails{EncryptedDataKeyKey: []byte("cool")},
+			},
+			want: want{
+				encryptErr: errors.Errorf(errFmtReservedDetail, EncryptedDataKeyKey),
+			},
+		},
+		"EncryptDataKeyError": {
+			reason: "An error encrypting the data key should be returned.",
+			args: args{
+				kms: &identityKMS{err: errBoom},
+				cd:  cd,
+			},
+			want: want{
+				encryptErr: errors.Wrap(errBoom, errEncryptDataKey),
+			},
+		},
+		"NoDataKey": {
+			reason: "Connection details without a data key should not be decrypted.",
+			args: args{
+				kms:    &identityKMS{},
+				cd:     cd,
+				tamper: func(c ConnectionDetails) { delete(c, EncryptedDataKeyKey) },
+			},
+			want: want{
+				decryptErr: errors.New(errNoDataKey),
+			},
+		},
+		"SwappedValues": {
+			reason: "Encrypted values that were swapped between keys should not be decrypted.",
+			args: args{
+				kms: &identityKMS{},
+				cd:  ConnectionDetails{"username": []byte("cool")},
+				tamper: func(c ConnectionDetails) {
+					c["password"] = c["username"]
+					delete(c, "username")
+				},
+			},
+			want: want{
+				decryptErr: errors.Wrapf(errors.New("cipher: message authentication failed"), errFmtDecryptDetail, "password"),
+			},
+		},
+		"Truncated": {
+			reason: "Encrypted values that are too short should not be decrypted.",
+			args: args{
+				kms:    &identityKMS{},
+				cd:     ConnectionDetails{"username": []byte("cool")},
+				tamper: func(c ConnectionDetails) { c["username"] = []byte("x") },
+			},
+			want: want{
+				decryptErr: errors.Errorf(errFmtDecryptDetail, "username"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := NewEnvelopeEncryptor(tc.args.kms)
+
+			encrypted, err := e.Encrypt(context.Background(), tc.args.cd)
+			if diff := cmp.Diff(tc.want.encryptErr, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("\n%s\ne.Encrypt(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+
+			if err != nil {
+				return
+			}
+
+			for k, v := range tc.args.cd {
+				if bytes.Contains(encrypted[k], v) {
+					t.Errorf("\n%s\ne.Encrypt(...): connection detail %q was not encrypted", tc.reason, k)
+				}
+			}
+
+			if tc.args.tamper != nil {
+				tc.args.tamper(encrypted)
+			}
+
+			got, err := e.Decrypt(context.Background(), encrypted)
+			if diff := cmp.Diff(tc.want.decryptErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Decrypt(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.want.cd, got); diff != "" {
+				t.Errorf("\n%s\ne.Decrypt(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
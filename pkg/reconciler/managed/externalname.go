@@ -0,0 +1,291 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+
+	"github.com/google/uuid"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/fieldpath"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+)
+
+const defaultExternalNameAttempts = 3
+
+// Error strings.
+const (
+	errGenerateExternalName      = "cannot generate external name"
+	errCheckExternalName         = "cannot check whether external name is in use"
+	errFmtExternalNameCollisions = "cannot generate an unused external name after %d attempts"
+	errFmtTemplateField          = "cannot get string at field path %q"
+	errFmtExternalNameTooLong    = "external name %q is %d characters long, but must be at most %d"
+	errFmtExternalNameMismatch   = "external name %q does not match pattern %q"
+	errEmptyExternalName         = "external name is empty"
+)
+
+// ReasonInvalidExternalName indicates the external resource can't be created
+// because its external name doesn't satisfy the external system's
+// constraints.
+const ReasonInvalidExternalName xpv1.ConditionReason = "InvalidExternalName"
+
+// InvalidExternalName returns a condition that indicates the external resource
+// can't be created because its external name is invalid. The Reconciler won't
+// retry until the managed resource changes.
+func InvalidExternalName(err error) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               xpv1.TypeSynced,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonInvalidExternalName,
+		Message:            err.Error(),
+	}
+}
+
+// ExternalNameConstraints are the constraints an external system places on the
+// names of a kind of external resource.
+type ExternalNameConstraints struct {
+	// MaxLength of an external name. Zero means unlimited.
+	MaxLength int
+
+	// Pattern an external name must match. Nil matches any name.
+	Pattern *regexp.Regexp
+}
+
+// Validate returns an error if the supplied external name doesn't satisfy the
+// constraints.
+func (c ExternalNameConstraints) Validate(name string) error {
+	if name == "" {
+		return errors.New(errEmptyExternalName)
+	}
+
+	if c.MaxLength > 0 && len(name) > c.MaxLength {
+		return errors.Errorf(errFmtExternalNameTooLong, name, len(name), c.MaxLength)
+	}
+
+	if c.Pattern != nil && !c.Pattern.MatchString(name) {
+		return errors.Errorf(errFmtExternalNameMismatch, name, c.Pattern.String())
+	}
+
+	return nil
+}
+
+// An ExternalNameGenerator generates an external name for a managed resource.
+type ExternalNameGenerator interface {
+	GenerateExternalName(ctx context.Context, mg resource.Managed) (string, error)
+}
+
+// An ExternalNameGeneratorFn is a function that satisfies the
+// ExternalNameGenerator interface.
+type ExternalNameGeneratorFn func(ctx context.Context, mg resource.Managed) (string, error)
+
+// GenerateExternalName calls ExternalNameGeneratorFn function.
+func (fn ExternalNameGeneratorFn) GenerateExternalName(ctx context.Context, mg resource.Managed) (string, error) {
+	return fn(ctx, mg)
+}
+
+// An ExternalNameCollisionChecker checks whether an external name is already
+// in use by an external resource. An ExternalClient may implement this
+// interface to allow a GeneratedExternalName to avoid collisions.
+type ExternalNameCollisionChecker = TypedExternalNameCollisionChecker[resource.Managed]
+
+// A TypedExternalNameCollisionChecker checks whether an external name is
+// already in use by an external resource. A TypedExternalClient may implement
+// this interface to allow a GeneratedExternalName to avoid collisions.
+type TypedExternalNameCollisionChecker[managed resource.Managed] interface {
+	ExternalNameInUse(ctx context.Context, mg managed, name string) (bool, error)
+}
+
+// NewUUIDExternalNameGenerator returns an ExternalNameGenerator that generates
+// a random UUID.
+func NewUUIDExternalNameGenerator() ExternalNameGeneratorFn {
+	return func(_ context.Context, _ resource.Managed) (string, error) {
+		return uuid.NewString(), nil
+	}
+}
+
+// NewHashExternalNameGenerator returns an ExternalNameGenerator that generates
+// a hex encoded SHA-256 hash of the managed resource's namespace and name,
+// truncated to the supplied length. A length of zero or less does not
+// truncate the hash. The generated name is deterministic.
+func NewHashExternalNameGenerator(length int) ExternalNameGeneratorFn {
+	return func(_ context.Context, mg resource.Managed) (string, error) {
+		sum := sha256.Sum256([]byte(mg.GetNamespace() + "/" + mg.GetName()))
+
+		h := hex.EncodeToString(sum[:])
+		if length > 0 && length < len(h) {
+			h = h[:length]
+		}
+
+		return h, nil
+	}
+}
+
+var templateField = regexp.MustCompile(`\{([^{}]+)\}`)
+
+// NewTemplateExternalNameGenerator returns an ExternalNameGenerator that
+// generates an external name by replacing each {field.path} in the supplied
+// template with the string value at that field path of the managed resource.
+// For example {metadata.namespace}-{spec.forProvider.region}.
+func NewTemplateExternalNameGenerator(template string) ExternalNameGeneratorFn {
+	return func(_ context.Context, mg resource.Managed) (string, error) {
+		p, err := fieldpath.PaveObject(mg)
+		if err != nil {
+			return "", err
+		}
+
+		var rerr error
+
+		name := templateField.ReplaceAllStringFunc(template, func(m string) string {
+			path := templateField.FindStringSubmatch(m)[1]
+
+			s, err := p.GetString(path)
+			if err != nil && rerr == nil {
+				rerr = errors.Wrapf(err, errFmtTemplateField, path)
+			}
+
+			return s
+		})
+
+		return name, rerr
+	}
+}
+
+// A GeneratedExternalNameOption configures a GeneratedExternalName.
+type GeneratedExternalNameOption func(n *GeneratedExternalName)
+
+// WithExternalNameAttempts configures how many external names a
+// GeneratedExternalName may generate when each generated name is found to be
+// in use. Only generators that are not deterministic, like
+// NewUUIDExternalNameGenerator, benefit from more than one attempt.
+func WithExternalNameAttempts(attempts int) GeneratedExternalNameOption {
+	return func(n *GeneratedExternalName) {
+		n.attempts = attempts
+	}
+}
+
+// GeneratedExternalName sets the external name of a managed resource using an
+// ExternalNameGenerator, if it does not already have an external name. It may
+// be used as an Initializer, or by the Reconciler once it has connected to the
+// external system so that generated names can be checked for collisions. See
+// WithExternalNameGenerator.
+type GeneratedExternalName struct {
+	client    client.Client
+	generator ExternalNameGenerator
+	attempts  int
+}
+
+// NewGeneratedExternalName returns a new GeneratedExternalName.
+func NewGeneratedExternalName(c client.Client, g ExternalNameGenerator, o ...GeneratedExternalNameOption) *GeneratedExternalName {
+	n := &GeneratedExternalName{client: c, generator: g, attempts: 1}
+	for _, fn := range o {
+		fn(n)
+	}
+
+	return n
+}
+
+// Initialize the given managed resource. Generated names are not checked for
+// collisions.
+func (n *GeneratedExternalName) Initialize(ctx context.Context, mg resource.Managed) error {
+	return n.SetExternalName(ctx, mg, nil)
+}
+
+// SetExternalName sets the external name of the supplied managed resource, if
+// it does not already have one. If the supplied ExternalClient implements
+// ExternalNameCollisionChecker, a generated name that is in use is
+// regenerated.
+func (n *GeneratedExternalName) SetExternalName(ctx context.Context, mg resource.Managed, ec ExternalClient) error {
+	if meta.GetExternalName(mg) != "" {
+		return nil
+	}
+
+	name, err := n.generate(ctx, mg, ec)
+	if err != nil {
+		return err
+	}
+
+	meta.SetExternalName(mg, name)
+
+	return errors.Wrap(n.client.Update(ctx, mg), errUpdateManaged)
+}
+
+// externalNameInUse checks whether the supplied external name is in use, if
+// the supplied client is an ExternalNameCollisionChecker. Names are never in
+// use otherwise.
+func externalNameInUse(ctx context.Context, c any, mg resource.Managed, name string) (bool, error) {
+	if cc, ok := c.(ExternalNameCollisionChecker); ok {
+		return cc.ExternalNameInUse(ctx, mg, name)
+	}
+
+	return false, nil
+}
+
+func (n *GeneratedExternalName) generate(ctx context.Context, mg resource.Managed, ec ExternalClient) (string, error) {
+	cc, ok := ec.(ExternalNameCollisionChecker)
+	if !ok {
+		name, err := n.generator.GenerateExternalName(ctx, mg)
+		return name, errors.Wrap(err, errGenerateExternalName)
+	}
+
+	for range max(n.attempts, 1) {
+		name, err := n.generator.GenerateExternalName(ctx, mg)
+		if err != nil {
+			return "", errors.Wrap(err, errGenerateExternalName)
+		}
+
+		inUse, err := cc.ExternalNameInUse(ctx, mg, name)
+		if err != nil {
+			return "", errors.Wrap(err, errCheckExternalName)
+		}
+
+		if !inUse {
+			return name, nil
+		}
+	}
+
+	return "", errors.Errorf(errFmtExternalNameCollisions, max(n.attempts, 1))
+}
+
+// withoutNameAsExternalName returns the supplied Initializer without any
+// NameAsExternalName initializers.
+func withoutNameAsExternalName(i Initializer) Initializer {
+	switch i := i.(type) {
+	case *NameAsExternalName:
+		return InitializerChain{}
+	case InitializerChain:
+		out := make(InitializerChain, 0, len(i))
+		for _, ii := range i {
+			if _, ok := ii.(*NameAsExternalName); !ok {
+				out = append(out, ii)
+			}
+		}
+
+		return out
+	default:
+		return i
+	}
+}
@@ -0,0 +1,479 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+)
+
+type collisionCheckingClient struct {
+	ExternalClientFns
+
+	inUse func(name string) (bool, error)
+}
+
+func (c *collisionCheckingClient) ExternalNameInUse(_ context.Context, _ resource.Managed, name string) (bool, error) {
+	return c.inUse(name)
+}
+
+func TestExternalNameGenerators(t *testing.T) {
+	tagged := &taggedManaged{}
+	tagged.Spec.ForProvider.Tags = map[string]string{"region": "us-east-1"}
+	tagged.SetName("cool")
+
+	type want struct {
+		name string
+		err  error
+	}
+
+	cases := map[string]struct {
+		reason string
+		g      ExternalNameGenerator
+		mg     resource.Managed
+		want   want
+	}{
+		"Hash": {
+			reason: "The hash generator should return a truncated hash of the namespace and name.",
+			g:      NewHashExternalNameGenerator(8),
+			mg:     &fake.ModernManaged{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "cool"}},
+			want: want{
+				// echo -n default/cool | sha256sum
+				name: "a9b82c55",
+			},
+		},
+		"Template": {
+			reason: "The template generator should replace field paths with their values.",
+			g:      NewTemplateExternalNameGenerator("cool-{spec.forProvider.tags.region}"),
+			mg:     tagged,
+			want: want{
+				name: "cool-us-east-1",
+			},
+		},
+		"TemplateMissingField": {
+			reason: "The template generator should return an error if a field path does not exist.",
+			g:      NewTemplateExternalNameGenerator("cool-{spec.forProvider.tags.zone}"),
+			mg:     tagged,
+			want: want{
+				name: "cool-",
+				err:  errors.Wrapf(errors.New("spec.forProvider.tags.zone: no such field"), errFmtTemplateField, "spec.forProvider.tags.zone"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.g.GenerateExternalName(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nGenerateExternalName(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.want.name, got); diff != "" {
+				t.Errorf("\n%s\nGenerateExternalName(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+type typedCollisionCheckingClient struct {
+	TypedExternalClientFns[*fake.ModernManaged]
+
+	inUse func(name string) (bool, error)
+}
+
+func (c *typedCollisionCheckingClient) ExternalNameInUse(_ context.Context, _ *fake.ModernManaged, name string) (bool, error) {
+	return c.inUse(name)
+}
+
+func TestGeneratedExternalNameSetExternalName(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	// Generates cool-1, cool-2, etc.
+	sequence := func() ExternalNameGeneratorFn {
+		i := 0
+		return func(_ context.Context, _ resource.Managed) (string, error) {
+			i++
+			return "cool-" + string(rune('0'+i)), nil
+		}
+	}
+
+	checker := func(inUse func(name string) (bool, error)) ExternalClient {
+		return &collisionCheckingClient{inUse: inUse}
+	}
+
+	type args struct {
+		c  client.Client
+		g  ExternalNameGenerator
+		o  []GeneratedExternalNameOption
+		ec ExternalClient
+		mg resource.Managed
+	}
+
+	type want struct {
+		err  error
+		name string
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"ExistingExternalName": {
+			reason: "We should not generate an external name if one is already set.",
+			args: args{
+				g: sequence(),
+				mg: &fake.ModernManaged{ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{meta.AnnotationKeyExternalName: "existing"},
+				}},
+			},
+			want: want{
+				name: "existing",
+			},
+		},
+		"NoCollisionCheck": {
+			reason: "We should set the generated external name.",
+			args: args{
+				c:  &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				g:  sequence(),
+				mg: &fake.ModernManaged{},
+			},
+			want: want{
+				name: "cool-1",
+			},
+		},
+		"Collision": {
+			reason: "We should regenerate an external name that is already in use.",
+			args: args{
+				c: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				g: sequence(),
+				o: []GeneratedExternalNameOption{WithExternalNameAttempts(3)},
+				ec: checker(func(name string) (bool, error) {
+					return name == "cool-1", nil
+				}),
+				mg: &fake.ModernManaged{},
+			},
+			want: want{
+				name: "cool-2",
+			},
+		},
+		"TypedCollision": {
+			reason: "We should regenerate an external name that a typed client reports is already in use.",
+			args: args{
+				c: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				g: sequence(),
+				o: []GeneratedExternalNameOption{WithExternalNameAttempts(3)},
+				ec: &typedExternalClientWrapper[*fake.ModernManaged]{c: &typedCollisionCheckingClient{
+					inUse: func(name string) (bool, error) {
+						return name == "cool-1", nil
+					},
+				}},
+				mg: &fake.ModernManaged{},
+			},
+			want: want{
+				name: "cool-2",
+			},
+		},
+		"TooManyCollisions": {
+			reason: "We should return an error if every generated external name is in use.",
+			args: args{
+				g: sequence(),
+				o: []GeneratedExternalNameOption{WithExternalNameAttempts(3)},
+				ec: checker(func(_ string) (bool, error) {
+					return true, nil
+				}),
+				mg: &fake.ModernManaged{},
+			},
+			want: want{
+				err: errors.Errorf(errFmtExternalNameCollisions, 3),
+			},
+		},
+		"CheckError": {
+			reason: "We should return any error encountered checking for collisions.",
+			args: args{
+				g: sequence(),
+				o: []GeneratedExternalNameOption{WithExternalNameAttempts(3)},
+				ec: checker(func(_ string) (bool, error) {
+					return false, errBoom
+				}),
+				mg: &fake.ModernManaged{},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errCheckExternalName),
+			},
+		},
+		"UpdateError": {
+			reason: "We should return any error encountered updating the managed resource.",
+			args: args{
+				c:  &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				g:  sequence(),
+				mg: &fake.ModernManaged{},
+			},
+			want: want{
+				err:  errors.Wrap(errBoom, errUpdateManaged),
+				name: "cool-1",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := NewGeneratedExternalName(tc.args.c, tc.args.g, tc.args.o...).SetExternalName(context.Background(), tc.args.mg, tc.args.ec)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nSetExternalName(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.want.name, meta.GetExternalName(tc.args.mg)); diff != "" {
+				t.Errorf("\n%s\nSetExternalName(...): -want external name, +got external name:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestWithExternalNameGenerator(t *testing.T) {
+	var (
+		connects    int
+		initialized bool
+		resolved    bool
+		generatedAt string
+	)
+
+	c := &test.MockClient{
+		MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+			asModernManaged(obj, 42)
+			return nil
+		}),
+		MockUpdate:       test.NewMockUpdateFn(nil),
+		MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+	}
+	m := &fake.Manager{Client: c, Scheme: fake.SchemeWith(&fake.ModernManaged{})}
+
+	g := ExternalNameGeneratorFn(func(_ context.Context, _ resource.Managed) (string, error) {
+		if resolved {
+			generatedAt = "resolved"
+		}
+
+		return "cool-1", nil
+	})
+
+	var observed string
+
+	r := NewReconciler(m, resource.ManagedKind(fake.GVK(&fake.ModernManaged{})),
+		WithInitializers(InitializerFn(func(_ context.Context, _ resource.Managed) error {
+			initialized = true
+			return nil
+		}), NewNameAsExternalName(c)),
+		WithExternalNameGenerator(g),
+		WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error {
+			resolved = true
+			return nil
+		})),
+		WithExternalConnector(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+			connects++
+			return &collisionCheckingClient{
+				ExternalClientFns: ExternalClientFns{
+					ObserveFn: func(_ context.Context, mg resource.Managed) (ExternalObservation, error) {
+						observed = meta.GetExternalName(mg)
+						return ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+					},
+					DisconnectFn: func(_ context.Context) error { return nil },
+				},
+				inUse: func(_ string) (bool, error) { return false, nil },
+			}, nil
+		})),
+		WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+	)
+
+	if _, err := r.Reconcile(context.Background(), reconcile.Request{}); err != nil {
+		t.Fatalf("r.Reconcile(...): unexpected error: %v", err)
+	}
+
+	if !initialized {
+		t.Errorf("WithExternalNameGenerator(...): want other initializers to be kept")
+	}
+
+	if generatedAt != "resolved" {
+		t.Errorf("WithExternalNameGenerator(...): want external name to be generated after references are resolved")
+	}
+
+	if diff := cmp.Diff(1, connects); diff != "" {
+		t.Errorf("WithExternalNameGenerator(...): -want connects, +got connects:\n%s", diff)
+	}
+
+	// NameAsExternalName would have set the external name to the managed
+	// resource's name, which is empty.
+	if diff := cmp.Diff("cool-1", observed); diff != "" {
+		t.Errorf("WithExternalNameGenerator(...): -want external name, +got external name:\n%s", diff)
+	}
+}
+
+func TestWithExternalNameGeneratorDecorated(t *testing.T) {
+	c := &test.MockClient{
+		MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+			asModernManaged(obj, 42)
+			return nil
+		}),
+		MockUpdate:       test.NewMockUpdateFn(nil),
+		MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+	}
+	m := &fake.Manager{Client: c, Scheme: fake.SchemeWith(&fake.ModernManaged{})}
+
+	i := 0
+	g := ExternalNameGeneratorFn(func(_ context.Context, _ resource.Managed) (string, error) {
+		i++
+		return "cool-" + string(rune('0'+i)), nil
+	})
+
+	var observed string
+
+	// The ExternalClient is wrapped by each of these options. The wrappers
+	// must not hide that it's an ExternalNameCollisionChecker.
+	r := NewReconciler(m, resource.ManagedKind(fake.GVK(&fake.ModernManaged{})),
+		WithPanicRecovery(),
+		WithDeadlineOverrunDetection(50),
+		WithFaultInjection(&feature.Flags{}),
+		WithReconnectOnUnauthorized(),
+		WithInitializers(),
+		WithExternalNameGenerator(g),
+		WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+		WithExternalConnector(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+			return &collisionCheckingClient{
+				ExternalClientFns: ExternalClientFns{
+					ObserveFn: func(_ context.Context, mg resource.Managed) (ExternalObservation, error) {
+						observed = meta.GetExternalName(mg)
+						return ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+					},
+					DisconnectFn: func(_ context.Context) error { return nil },
+				},
+				inUse: func(name string) (bool, error) { return name == "cool-1", nil },
+			}, nil
+		})),
+		WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+	)
+
+	if _, err := r.Reconcile(context.Background(), reconcile.Request{}); err != nil {
+		t.Fatalf("r.Reconcile(...): unexpected error: %v", err)
+	}
+
+	if diff := cmp.Diff("cool-2", observed); diff != "" {
+		t.Errorf("WithExternalNameGenerator(...): want a generated name that isn't in use: -want external name, +got external name:\n%s", diff)
+	}
+}
+
+func TestExternalNameConstraintsValidate(t *testing.T) {
+	c := ExternalNameConstraints{MaxLength: 8, Pattern: regexp.MustCompile(`^[a-z-]+$`)}
+
+	cases := map[string]struct {
+		reason string
+		name   string
+		want   error
+	}{
+		"Valid": {
+			reason: "A name that satisfies the constraints should be valid.",
+			name:   "cool-vpc",
+		},
+		"Empty": {
+			reason: "An empty name should be invalid.",
+			want:   errors.New(errEmptyExternalName),
+		},
+		"TooLong": {
+			reason: "A name longer than the max length should be invalid.",
+			name:   "very-cool-vpc",
+			want:   errors.Errorf(errFmtExternalNameTooLong, "very-cool-vpc", 13, 8),
+		},
+		"Mismatch": {
+			reason: "A name that doesn't match the pattern should be invalid.",
+			name:   "Cool_VPC",
+			want:   errors.Errorf(errFmtExternalNameMismatch, "Cool_VPC", `^[a-z-]+$`),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := c.Validate(tc.name)
+			if diff := cmp.Diff(tc.want, got, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nValidate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestWithExternalNameConstraints(t *testing.T) {
+	var status xpv1.Condition
+
+	c := &test.MockClient{
+		MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+			mg := asModernManaged(obj, 42)
+			meta.SetExternalName(mg, "Cool_VPC")
+			return nil
+		}),
+		MockUpdate: test.NewMockUpdateFn(nil),
+		MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil, func(obj client.Object) error {
+			//nolint:forcetypeassert // This is always a managed resource.
+			status = obj.(resource.Managed).GetCondition(xpv1.TypeSynced)
+			return nil
+		}),
+	}
+	m := &fake.Manager{Client: c, Scheme: fake.SchemeWith(&fake.ModernManaged{})}
+
+	r := NewReconciler(m, resource.ManagedKind(fake.GVK(&fake.ModernManaged{})),
+		WithInitializers(),
+		WithExternalNameConstraints(ExternalNameConstraints{Pattern: regexp.MustCompile(`^[a-z-]+$`)}),
+		WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+		WithExternalConnector(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+			return &ExternalClientFns{
+				ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+					return ExternalObservation{ResourceExists: false}, nil
+				},
+				CreateFn: func(_ context.Context, _ resource.Managed) (ExternalCreation, error) {
+					t.Errorf("Create(...): want no create with an invalid external name")
+					return ExternalCreation{}, nil
+				},
+				DisconnectFn: func(_ context.Context) error { return nil },
+			}, nil
+		})),
+		WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+	)
+
+	got, err := r.Reconcile(context.Background(), reconcile.Request{})
+	if err != nil {
+		t.Fatalf("r.Reconcile(...): unexpected error: %v", err)
+	}
+
+	if diff := cmp.Diff(reconcile.Result{Requeue: false}, got); diff != "" {
+		t.Errorf("r.Reconcile(...): want no requeue for an invalid external name: -want, +got:\n%s", diff)
+	}
+
+	want := InvalidExternalName(errors.Errorf(errFmtExternalNameMismatch, "Cool_VPC", `^[a-z-]+$`)).WithObservedGeneration(42)
+	if diff := cmp.Diff(want, status, test.EquateConditions()); diff != "" {
+		t.Errorf("r.Reconcile(...): -want Synced condition, +got Synced condition:\n%s", diff)
+	}
+}
@@ -0,0 +1,269 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fake provides fake implementations of the interfaces used by the
+// managed resource reconciler, for use in tests.
+package fake
+
+import (
+	"context"
+	"sync"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+)
+
+// Error strings.
+const (
+	errFmtUnexpectedCall = "unexpected call to %s: expected step %d to be %s"
+	errFmtNoMoreSteps    = "unexpected call to %s: all %d scripted steps have been consumed"
+	errFmtAssertion      = "step %d (%s) assertion failed"
+	errFmtStepsRemaining = "%d of %d scripted steps were not called, next expected step is %s"
+)
+
+// An Operation that may be performed by an ExternalClient.
+type Operation string
+
+// Operations that may be performed by an ExternalClient.
+const (
+	OperationObserve Operation = "Observe"
+	OperationCreate  Operation = "Create"
+	OperationUpdate  Operation = "Update"
+	OperationDelete  Operation = "Delete"
+)
+
+// A Step is a single call a scripted ExternalClient expects to receive.
+type Step struct {
+	// Operation the ExternalClient expects to be called.
+	Operation Operation
+
+	// Assert is called with the managed resource supplied to the call. A
+	// non-nil error fails the step, and is returned by the call.
+	Assert func(mg resource.Managed) error
+
+	// Mutate is called with the managed resource supplied to the call, after
+	// Assert. It may be used to simulate an ExternalClient that updates the
+	// managed resource, for example by setting its external name.
+	Mutate func(mg resource.Managed)
+
+	// Observation is returned by an Observe step.
+	Observation managed.ExternalObservation
+
+	// Creation is returned by a Create step.
+	Creation managed.ExternalCreation
+
+	// Update is returned by an Update step.
+	Update managed.ExternalUpdate
+
+	// Deletion is returned by a Delete step.
+	Deletion managed.ExternalDelete
+
+	// Err is returned by the step.
+	Err error
+}
+
+// A StepOption configures a Step.
+type StepOption func(s *Step)
+
+// WithAssertion configures a Step to assert the supplied managed resource
+// matches the caller's expectations.
+func WithAssertion(fn func(mg resource.Managed) error) StepOption {
+	return func(s *Step) {
+		s.Assert = fn
+	}
+}
+
+// WithMutation configures a Step to mutate the supplied managed resource.
+func WithMutation(fn func(mg resource.Managed)) StepOption {
+	return func(s *Step) {
+		s.Mutate = fn
+	}
+}
+
+// WithError configures a Step to return the supplied error.
+func WithError(err error) StepOption {
+	return func(s *Step) {
+		s.Err = err
+	}
+}
+
+// Observe returns a Step that expects a call to Observe, and returns the
+// supplied ExternalObservation.
+func Observe(o managed.ExternalObservation, opts ...StepOption) Step {
+	s := Step{Operation: OperationObserve, Observation: o}
+	for _, fn := range opts {
+		fn(&s)
+	}
+
+	return s
+}
+
+// Create returns a Step that expects a call to Create, and returns the
+// supplied ExternalCreation.
+func Create(c managed.ExternalCreation, opts ...StepOption) Step {
+	s := Step{Operation: OperationCreate, Creation: c}
+	for _, fn := range opts {
+		fn(&s)
+	}
+
+	return s
+}
+
+// Update returns a Step that expects a call to Update, and returns the
+// supplied ExternalUpdate.
+func Update(u managed.ExternalUpdate, opts ...StepOption) Step {
+	s := Step{Operation: OperationUpdate, Update: u}
+	for _, fn := range opts {
+		fn(&s)
+	}
+
+	return s
+}
+
+// Delete returns a Step that expects a call to Delete, and returns the
+// supplied ExternalDelete.
+func Delete(d managed.ExternalDelete, opts ...StepOption) Step {
+	s := Step{Operation: OperationDelete, Deletion: d}
+	for _, fn := range opts {
+		fn(&s)
+	}
+
+	return s
+}
+
+// An ExternalClient is a managed.ExternalClient that expects to be called in
+// the order described by its scripted steps. It is safe for concurrent use,
+// and may be shared by several reconciles of the same managed resource in
+// order to test a full reconcile loop.
+type ExternalClient struct {
+	mu    sync.Mutex
+	steps []Step
+	next  int
+	errs  []error
+}
+
+// NewExternalClient returns an ExternalClient that expects to be called in
+// the order described by the supplied steps.
+func NewExternalClient(steps ...Step) *ExternalClient {
+	return &ExternalClient{steps: steps}
+}
+
+// Connector returns an ExternalConnector that always connects to this
+// ExternalClient.
+func (c *ExternalClient) Connector() managed.ExternalConnector {
+	return managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+		return c, nil
+	})
+}
+
+// Observe consumes the next step, which must be an Observe step.
+func (c *ExternalClient) Observe(_ context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	s, err := c.consume(OperationObserve, mg)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	return s.Observation, s.Err
+}
+
+// Create consumes the next step, which must be a Create step.
+func (c *ExternalClient) Create(_ context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	s, err := c.consume(OperationCreate, mg)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	return s.Creation, s.Err
+}
+
+// Update consumes the next step, which must be an Update step.
+func (c *ExternalClient) Update(_ context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	s, err := c.consume(OperationUpdate, mg)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	return s.Update, s.Err
+}
+
+// Delete consumes the next step, which must be a Delete step.
+func (c *ExternalClient) Delete(_ context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	s, err := c.consume(OperationDelete, mg)
+	if err != nil {
+		return managed.ExternalDelete{}, err
+	}
+
+	return s.Deletion, s.Err
+}
+
+// Disconnect does nothing. It never returns an error.
+func (c *ExternalClient) Disconnect(_ context.Context) error {
+	return nil
+}
+
+// Verify returns an error if any scripted step was not called, if any call
+// was unexpected, or if any step's assertion failed.
+func (c *ExternalClient) Verify() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	errs := append([]error{}, c.errs...)
+	if c.next < len(c.steps) {
+		errs = append(errs, errors.Errorf(errFmtStepsRemaining, len(c.steps)-c.next, len(c.steps), c.steps[c.next].Operation))
+	}
+
+	return errors.Join(errs...)
+}
+
+func (c *ExternalClient) consume(op Operation, mg resource.Managed) (Step, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.next >= len(c.steps) {
+		err := errors.Errorf(errFmtNoMoreSteps, op, len(c.steps))
+		c.errs = append(c.errs, err)
+
+		return Step{}, err
+	}
+
+	i := c.next
+	s := c.steps[i]
+
+	if s.Operation != op {
+		err := errors.Errorf(errFmtUnexpectedCall, op, i, s.Operation)
+		c.errs = append(c.errs, err)
+
+		return Step{}, err
+	}
+
+	c.next++
+
+	if s.Assert != nil {
+		if err := s.Assert(mg); err != nil {
+			err = errors.Wrapf(err, errFmtAssertion, i, op)
+			c.errs = append(c.errs, err)
+
+			return Step{}, err
+		}
+	}
+
+	if s.Mutate != nil {
+		s.Mutate(mg)
+	}
+
+	return s, nil
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+)
+
+var _ managed.ExternalClient = &ExternalClient{}
+
+func TestExternalClient(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	// call invokes the ExternalClient method corresponding to the supplied
+	// operation and returns its error.
+	call := func(c *ExternalClient, op Operation, mg resource.Managed) error {
+		var err error
+
+		switch op {
+		case OperationObserve:
+			_, err = c.Observe(context.Background(), mg)
+		case OperationCreate:
+			_, err = c.Create(context.Background(), mg)
+		case OperationUpdate:
+			_, err = c.Update(context.Background(), mg)
+		case OperationDelete:
+			_, err = c.Delete(context.Background(), mg)
+		}
+
+		return err
+	}
+
+	type args struct {
+		steps []Step
+		calls []Operation
+	}
+
+	type want struct {
+		errs         []error
+		externalName string
+		verify       error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"AllStepsCalledInOrder": {
+			reason: "Calls that match the scripted steps should succeed and Verify should return no error.",
+			args: args{
+				steps: []Step{
+					Observe(managed.ExternalObservation{}),
+					Create(managed.ExternalCreation{}, WithMutation(func(mg resource.Managed) {
+						meta.SetExternalName(mg, "cool-external")
+					})),
+					Observe(managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}),
+				},
+				calls: []Operation{OperationObserve, OperationCreate, OperationObserve},
+			},
+			want: want{
+				errs:         []error{nil, nil, nil},
+				externalName: "cool-external",
+			},
+		},
+		"StepError": {
+			reason: "A scripted step error should be returned by the call without failing verification.",
+			args: args{
+				steps: []Step{Update(managed.ExternalUpdate{}, WithError(errBoom))},
+				calls: []Operation{OperationUpdate},
+			},
+			want: want{
+				errs: []error{errBoom},
+			},
+		},
+		"UnexpectedCall": {
+			reason: "A call that does not match the next scripted step should return an error and fail verification.",
+			args: args{
+				steps: []Step{Observe(managed.ExternalObservation{})},
+				calls: []Operation{OperationDelete},
+			},
+			want: want{
+				errs: []error{errors.Errorf(errFmtUnexpectedCall, OperationDelete, 0, OperationObserve)},
+				verify: errors.Join(
+					errors.Errorf(errFmtUnexpectedCall, OperationDelete, 0, OperationObserve),
+					errors.Errorf(errFmtStepsRemaining, 1, 1, OperationObserve),
+				),
+			},
+		},
+		"NoMoreSteps": {
+			reason: "A call made after all scripted steps were consumed should return an error and fail verification.",
+			args: args{
+				calls: []Operation{OperationObserve},
+			},
+			want: want{
+				errs:   []error{errors.Errorf(errFmtNoMoreSteps, OperationObserve, 0)},
+				verify: errors.Join(errors.Errorf(errFmtNoMoreSteps, OperationObserve, 0)),
+			},
+		},
+		"AssertionFailed": {
+			reason: "A failed assertion should be returned by the call and fail verification.",
+			args: args{
+				steps: []Step{Delete(managed.ExternalDelete{}, WithAssertion(func(_ resource.Managed) error {
+					return errBoom
+				}))},
+				calls: []Operation{OperationDelete},
+			},
+			want: want{
+				errs:   []error{errors.Wrapf(errBoom, errFmtAssertion, 0, OperationDelete)},
+				verify: errors.Join(errors.Wrapf(errBoom, errFmtAssertion, 0, OperationDelete)),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := NewExternalClient(tc.args.steps...)
+			mg := &fake.Managed{}
+
+			errs := make([]error, 0, len(tc.args.calls))
+			for _, op := range tc.args.calls {
+				errs = append(errs, call(c, op, mg))
+			}
+
+			if diff := cmp.Diff(tc.want.errs, errs, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCalls(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(mg)); diff != "" {
+				t.Errorf("\n%s\nCalls(...): -want external name, +got external name:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.want.verify, c.Verify(), test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nVerify(): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
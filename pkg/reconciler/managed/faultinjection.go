@@ -0,0 +1,269 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+)
+
+// Error strings.
+const (
+	errInjectedFault = "injected fault"
+)
+
+// A FaultInjectionOption configures the faults a FaultInjectingConnector
+// injects.
+type FaultInjectionOption func(f *faultInjector)
+
+// WithInjectedLatency delays the supplied fraction of calls to the external
+// system by the supplied duration, e.g. 0.1 delays one in ten. A delayed call
+// returns its context's error if the context is done before the delay ends.
+func WithInjectedLatency(fraction float64, d time.Duration) FaultInjectionOption {
+	return func(f *faultInjector) {
+		f.latency = d
+		f.latencyFraction = fraction
+	}
+}
+
+// WithInjectedErrors fails the supplied fraction of calls to the external
+// system with the supplied error, without making them. A generic error is
+// used if the supplied error is nil.
+func WithInjectedErrors(fraction float64, err error) FaultInjectionOption {
+	return func(f *faultInjector) {
+		f.err = err
+		f.errFraction = fraction
+	}
+}
+
+// WithInjectedPartialFailures fails the supplied fraction of calls that
+// create, update, or delete external resources with the supplied error, after
+// making them. This simulates calls that succeed, but whose response is lost.
+// A generic error is used if the supplied error is nil.
+func WithInjectedPartialFailures(fraction float64, err error) FaultInjectionOption {
+	return func(f *faultInjector) {
+		f.partialErr = err
+		f.partialFraction = fraction
+	}
+}
+
+// WithFaultInjectionSeed seeds the random number generator used to choose
+// which calls to inject faults into, so that faults are reproducible. A
+// random seed is used by default.
+func WithFaultInjectionSeed(seed uint64) FaultInjectionOption {
+	return func(f *faultInjector) {
+		f.rand = rand.New(rand.NewPCG(seed, seed)) //nolint:gosec // Doesn't need to be cryptographically secure.
+	}
+}
+
+// A faultInjector chooses which calls to the external system to inject faults
+// into.
+type faultInjector struct {
+	flags *feature.Flags
+
+	latency         time.Duration
+	latencyFraction float64
+	err             error
+	errFraction     float64
+	partialErr      error
+	partialFraction float64
+
+	mu   sync.Mutex
+	rand *rand.Rand
+}
+
+// roll returns true with the supplied probability.
+func (f *faultInjector) roll(fraction float64) bool {
+	if fraction <= 0 {
+		return false
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.rand.Float64() < fraction
+}
+
+// before is called before a call to the external system. It returns an error
+// if the call should fail without being made.
+func (f *faultInjector) before(ctx context.Context) error {
+	if !f.flags.Enabled(feature.EnableAlphaFaultInjection) {
+		return nil
+	}
+
+	if f.roll(f.latencyFraction) {
+		t := time.NewTimer(f.latency)
+		defer t.Stop()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+
+	if f.roll(f.errFraction) {
+		return faultOrDefault(f.err)
+	}
+
+	return nil
+}
+
+// after is called after a call to the external system that succeeded. It
+// returns an error if the call should fail anyway.
+func (f *faultInjector) after() error {
+	if !f.flags.Enabled(feature.EnableAlphaFaultInjection) {
+		return nil
+	}
+
+	if f.roll(f.partialFraction) {
+		return faultOrDefault(f.partialErr)
+	}
+
+	return nil
+}
+
+func faultOrDefault(err error) error {
+	if err != nil {
+		return err
+	}
+
+	return errors.New(errInjectedFault)
+}
+
+// A FaultInjectingConnector injects faults into calls to an ExternalConnector
+// and the ExternalClients it produces, while the EnableAlphaFaultInjection
+// feature flag is enabled. It lets provider authors test how their
+// controllers, and the alerts that watch them, behave when the external
+// system is slow or unreliable. It must never be used in production.
+type FaultInjectingConnector struct {
+	ExternalConnectDisconnector
+
+	faults *faultInjector
+}
+
+// NewFaultInjectingConnector returns an ExternalConnectDisconnector that
+// injects the configured faults into calls to the supplied one, while the
+// EnableAlphaFaultInjection flag is enabled in the supplied flags.
+func NewFaultInjectingConnector(c ExternalConnectDisconnector, f *feature.Flags, o ...FaultInjectionOption) *FaultInjectingConnector {
+	fi := &faultInjector{flags: f, rand: rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))} //nolint:gosec // Doesn't need to be cryptographically secure.
+	for _, fn := range o {
+		fn(fi)
+	}
+
+	return &FaultInjectingConnector{ExternalConnectDisconnector: c, faults: fi}
+}
+
+// Connect to the external system, possibly injecting a fault.
+func (c *FaultInjectingConnector) Connect(ctx context.Context, mg resource.Managed) (ExternalClient, error) {
+	if err := c.faults.before(ctx); err != nil {
+		return nil, err
+	}
+
+	ec, err := c.ExternalConnectDisconnector.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &faultInjectingClient{ExternalClient: ec, faults: c.faults}, nil
+}
+
+type faultInjectingClient struct {
+	ExternalClient
+
+	faults *faultInjector
+}
+
+func (c *faultInjectingClient) Observe(ctx context.Context, mg resource.Managed) (ExternalObservation, error) {
+	if err := c.faults.before(ctx); err != nil {
+		return ExternalObservation{}, err
+	}
+
+	return c.ExternalClient.Observe(ctx, mg)
+}
+
+func (c *faultInjectingClient) Create(ctx context.Context, mg resource.Managed) (ExternalCreation, error) {
+	if err := c.faults.before(ctx); err != nil {
+		return ExternalCreation{}, err
+	}
+
+	cr, err := c.ExternalClient.Create(ctx, mg)
+	if err != nil {
+		return cr, err
+	}
+
+	// The response to a partially failed call is lost.
+	if err := c.faults.after(); err != nil {
+		return ExternalCreation{}, err
+	}
+
+	return cr, nil
+}
+
+func (c *faultInjectingClient) Update(ctx context.Context, mg resource.Managed) (ExternalUpdate, error) {
+	if err := c.faults.before(ctx); err != nil {
+		return ExternalUpdate{}, err
+	}
+
+	u, err := c.ExternalClient.Update(ctx, mg)
+	if err != nil {
+		return u, err
+	}
+
+	// The response to a partially failed call is lost.
+	if err := c.faults.after(); err != nil {
+		return ExternalUpdate{}, err
+	}
+
+	return u, nil
+}
+
+func (c *faultInjectingClient) Delete(ctx context.Context, mg resource.Managed) (ExternalDelete, error) {
+	if err := c.faults.before(ctx); err != nil {
+		return ExternalDelete{}, err
+	}
+
+	d, err := c.ExternalClient.Delete(ctx, mg)
+	if err != nil {
+		return d, err
+	}
+
+	// The response to a partially failed call is lost.
+	if err := c.faults.after(); err != nil {
+		return ExternalDelete{}, err
+	}
+
+	return d, nil
+}
+
+func (c *faultInjectingClient) ExternalNameInUse(ctx context.Context, mg resource.Managed, name string) (bool, error) {
+	if err := c.faults.before(ctx); err != nil {
+		return false, err
+	}
+
+	return externalNameInUse(ctx, c.ExternalClient, mg, name)
+}
+
+func (c *faultInjectingClient) Ping(ctx context.Context) error {
+	return ping(ctx, c.ExternalClient)
+}
@@ -0,0 +1,203 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/feature"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+)
+
+var _ ExternalConnectDisconnector = &FaultInjectingConnector{}
+
+func TestFaultInjectingConnector(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	enabled := &feature.Flags{}
+	enabled.Enable(feature.EnableAlphaFaultInjection)
+
+	type args struct {
+		flags *feature.Flags
+		o     []FaultInjectionOption
+	}
+
+	type want struct {
+		err     error
+		created bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"FlagDisabled": {
+			reason: "No faults should be injected while the feature flag is disabled.",
+			args: args{
+				flags: &feature.Flags{},
+				o:     []FaultInjectionOption{WithInjectedErrors(1, errBoom)},
+			},
+			want: want{created: true},
+		},
+		"NoFaults": {
+			reason: "No faults should be injected if none are configured.",
+			args: args{
+				flags: enabled,
+			},
+			want: want{created: true},
+		},
+		"InjectedError": {
+			reason: "Injected errors should be returned without calling the external system.",
+			args: args{
+				flags: enabled,
+				o:     []FaultInjectionOption{WithInjectedErrors(1, errBoom)},
+			},
+			want: want{err: errBoom},
+		},
+		"InjectedDefaultError": {
+			reason: "A generic error should be injected if none is supplied.",
+			args: args{
+				flags: enabled,
+				o:     []FaultInjectionOption{WithInjectedErrors(1, nil)},
+			},
+			want: want{err: errors.New(errInjectedFault)},
+		},
+		"InjectedPartialFailure": {
+			reason: "Injected partial failures should be returned after calling the external system.",
+			args: args{
+				flags: enabled,
+				o:     []FaultInjectionOption{WithInjectedPartialFailures(1, errBoom)},
+			},
+			want: want{err: errBoom, created: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			created := false
+
+			// We build the client directly, because injected errors would
+			// also fail Connect.
+			c := NewFaultInjectingConnector(&ExternalConnectDisconnectorFns{}, tc.args.flags, tc.args.o...)
+
+			var ec ExternalClient = &faultInjectingClient{ExternalClient: &ExternalClientFns{
+				CreateFn: func(_ context.Context, _ resource.Managed) (ExternalCreation, error) {
+					created = true
+					return ExternalCreation{}, nil
+				},
+			}, faults: c.faults}
+
+			_, err := ec.Create(context.Background(), &fake.ModernManaged{})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.want.created, created); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want created, +got created:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestFaultInjectingConnectorConnect(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	enabled := &feature.Flags{}
+	enabled.Enable(feature.EnableAlphaFaultInjection)
+
+	c := NewFaultInjectingConnector(&ExternalConnectDisconnectorFns{
+		ConnectFn: func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+			return &ExternalClientFns{}, nil
+		},
+	}, enabled, WithInjectedErrors(1, errBoom))
+
+	if _, err := c.Connect(context.Background(), &fake.ModernManaged{}); !errors.Is(err, errBoom) {
+		t.Errorf("c.Connect(...): want injected error, got %v", err)
+	}
+}
+
+func TestFaultInjectionLatency(t *testing.T) {
+	enabled := &feature.Flags{}
+	enabled.Enable(feature.EnableAlphaFaultInjection)
+
+	c := NewFaultInjectingConnector(&ExternalConnectDisconnectorFns{}, enabled, WithInjectedLatency(1, time.Minute))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+
+	if _, err := c.Connect(ctx, &fake.ModernManaged{}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("c.Connect(...): want context.DeadlineExceeded when injected latency exceeds the deadline, got %v", err)
+	}
+}
+
+func TestFaultInjectionSeed(t *testing.T) {
+	enabled := &feature.Flags{}
+	enabled.Enable(feature.EnableAlphaFaultInjection)
+
+	failures := func() []bool {
+		c := NewFaultInjectingConnector(&ExternalConnectDisconnectorFns{
+			ConnectFn: func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+				return &ExternalClientFns{}, nil
+			},
+		}, enabled, WithInjectedErrors(0.5, nil), WithFaultInjectionSeed(42))
+
+		out := make([]bool, 100)
+		for i := range out {
+			_, err := c.Connect(context.Background(), &fake.ModernManaged{})
+			out[i] = err != nil
+		}
+
+		return out
+	}
+
+	if diff := cmp.Diff(failures(), failures()); diff != "" {
+		t.Errorf("WithFaultInjectionSeed(...): the same seed should inject the same faults: -first, +second:\n%s", diff)
+	}
+}
+
+func TestFaultInjectingClientForwards(t *testing.T) {
+	errUnhealthy := errors.New("unhealthy")
+
+	var ec ExternalClient = &faultInjectingClient{
+		ExternalClient: &optionalClient{
+			inUse: func(name string) (bool, error) { return name == "taken", nil },
+			ping:  func(_ context.Context) error { return errUnhealthy },
+		},
+		faults: &faultInjector{flags: &feature.Flags{}},
+	}
+
+	checker, ok := ec.(ExternalNameCollisionChecker)
+	if !ok {
+		t.Fatalf("faultInjectingClient: want a client that forwards ExternalNameCollisionChecker")
+	}
+
+	if inUse, _ := checker.ExternalNameInUse(context.Background(), &fake.ModernManaged{}, "taken"); !inUse {
+		t.Errorf("ExternalNameInUse(...): want the wrapped client's answer")
+	}
+
+	if err := ec.(Pinger).Ping(context.Background()); !errors.Is(err, errUnhealthy) {
+		t.Errorf("Ping(...): want the wrapped client's error, got %v", err)
+	}
+}
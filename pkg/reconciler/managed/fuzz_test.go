@@ -0,0 +1,61 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"encoding/json"
+	"testing"
+
+	kunstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func FuzzPrepareJSONMerge(f *testing.F) {
+	f.Add([]byte(`{}`), []byte(`{}`))
+	f.Add([]byte(`{"apiVersion":"example.org/v1","kind":"Cool","spec":{"forProvider":{"name":"a"}}}`), []byte(`{"apiVersion":"example.org/v1","kind":"Cool","spec":{"forProvider":{"name":"b"}}}`))
+	f.Add([]byte(`{"spec":{"forProvider":{"vpcIdRef":{"name":"cool"}}}}`), []byte(`{"spec":{"forProvider":{"vpcId":"vpc-1234","vpcIdRef":{"name":"cool"}}}}`))
+	f.Add([]byte(`{"spec":{"list":[1,2,3]}}`), []byte(`{"spec":{"list":null}}`))
+	f.Add([]byte(`{"spec":{"a":{"b":{"c":{}}}}}`), []byte(`{"spec":"replaced"}`))
+
+	f.Fuzz(func(t *testing.T, existing, resolved []byte) {
+		e := &kunstructured.Unstructured{Object: map[string]any{}}
+		if err := json.Unmarshal(existing, &e.Object); err != nil {
+			return
+		}
+
+		r := &kunstructured.Unstructured{Object: map[string]any{}}
+		if err := json.Unmarshal(resolved, &r.Object); err != nil {
+			return
+		}
+
+		gvk := e.GroupVersionKind()
+
+		patch, err := prepareJSONMerge(e, r)
+		if err != nil {
+			return
+		}
+
+		// The existing object must not be modified.
+		if got := e.GroupVersionKind(); got != gvk {
+			t.Errorf("prepareJSONMerge(...): existing object's GVK changed from %s to %s", gvk, got)
+		}
+
+		// The patch must be a valid JSON merge patch.
+		if !json.Valid(patch) {
+			t.Errorf("prepareJSONMerge(...): invalid JSON merge patch %q", patch)
+		}
+	})
+}
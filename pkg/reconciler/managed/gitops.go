@@ -0,0 +1,126 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"maps"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+)
+
+// A GitOpsAnnotatorOption configures a GitOpsAnnotator.
+type GitOpsAnnotatorOption func(a *GitOpsAnnotator)
+
+// WithArgoCDSyncWave configures a GitOpsAnnotator to set the supplied Argo CD
+// sync wave.
+func WithArgoCDSyncWave(wave int) GitOpsAnnotatorOption {
+	return func(a *GitOpsAnnotator) {
+		a.wave = &wave
+	}
+}
+
+// WithArgoCDSyncOptions configures a GitOpsAnnotator to add the supplied Argo
+// CD sync options, e.g. ServerSideApply=true.
+func WithArgoCDSyncOptions(opts ...string) GitOpsAnnotatorOption {
+	return func(a *GitOpsAnnotator) {
+		a.syncOptions = append(a.syncOptions, opts...)
+	}
+}
+
+// WithPruneDisabled configures a GitOpsAnnotator to ask Argo CD and Flux not
+// to prune managed resources, so that removing a managed resource from a Git
+// repository doesn't delete its external resource.
+func WithPruneDisabled() GitOpsAnnotatorOption {
+	return func(a *GitOpsAnnotator) {
+		a.noPrune = true
+	}
+}
+
+// A GitOpsAnnotator is an Initializer that sets annotations understood by
+// GitOps tools like Argo CD and Flux. It respects any such annotations that
+// are already set; it only adds those that are missing.
+type GitOpsAnnotator struct {
+	client client.Client
+
+	wave        *int
+	syncOptions []string
+	noPrune     bool
+}
+
+// NewGitOpsAnnotator returns a new GitOpsAnnotator.
+func NewGitOpsAnnotator(c client.Client, o ...GitOpsAnnotatorOption) *GitOpsAnnotator {
+	a := &GitOpsAnnotator{client: c}
+	for _, fn := range o {
+		fn(a)
+	}
+
+	return a
+}
+
+// Initialize the GitOps annotations of the supplied managed resource.
+func (a *GitOpsAnnotator) Initialize(ctx context.Context, mg resource.Managed) error {
+	existing := maps.Clone(mg.GetAnnotations())
+
+	if _, ok := mg.GetAnnotations()[meta.AnnotationKeyArgoCDSyncWave]; !ok && a.wave != nil {
+		meta.SetArgoCDSyncWave(mg, *a.wave)
+	}
+
+	if a.noPrune && !pruneConfigured(mg) {
+		meta.SetPruneDisabled(mg)
+	}
+
+	for _, opt := range a.syncOptions {
+		if !hasOptionKey(meta.GetArgoCDSyncOptions(mg), opt) {
+			meta.AddArgoCDSyncOptions(mg, opt)
+		}
+	}
+
+	if maps.Equal(existing, mg.GetAnnotations()) {
+		return nil
+	}
+
+	return errors.Wrap(a.client.Update(ctx, mg), errUpdateManaged)
+}
+
+// pruneConfigured returns true if the supplied managed resource already tells
+// Argo CD or Flux whether to prune it.
+func pruneConfigured(mg resource.Managed) bool {
+	if _, ok := mg.GetAnnotations()[meta.AnnotationKeyFluxPrune]; ok {
+		return true
+	}
+
+	return hasOptionKey(meta.GetArgoCDSyncOptions(mg), meta.ArgoCDSyncOptionPruneDisabled)
+}
+
+// hasOptionKey returns true if the supplied options include an option with
+// the same key as the supplied option.
+func hasOptionKey(opts []string, opt string) bool {
+	k, _, _ := strings.Cut(opt, "=")
+	for _, o := range opts {
+		if ok, _, _ := strings.Cut(o, "="); ok == k {
+			return true
+		}
+	}
+
+	return false
+}
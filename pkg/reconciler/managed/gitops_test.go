@@ -0,0 +1,125 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+)
+
+func TestGitOpsAnnotator(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type args struct {
+		opts []GitOpsAnnotatorOption
+		a    map[string]string
+		err  error
+	}
+
+	type want struct {
+		a       map[string]string
+		updated bool
+		err     error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"AddAnnotations": {
+			reason: "Missing GitOps annotations should be added and persisted.",
+			args: args{
+				opts: []GitOpsAnnotatorOption{WithArgoCDSyncWave(5), WithPruneDisabled(), WithArgoCDSyncOptions("ServerSideApply=true")},
+			},
+			want: want{
+				a: map[string]string{
+					meta.AnnotationKeyArgoCDSyncWave:    "5",
+					meta.AnnotationKeyArgoCDSyncOptions: "Prune=false,ServerSideApply=true",
+					meta.AnnotationKeyFluxPrune:         meta.FluxPruneDisabled,
+				},
+				updated: true,
+			},
+		},
+		"RespectExistingAnnotations": {
+			reason: "Existing GitOps annotations should not be changed.",
+			args: args{
+				opts: []GitOpsAnnotatorOption{WithArgoCDSyncWave(5), WithPruneDisabled(), WithArgoCDSyncOptions("ServerSideApply=true")},
+				a: map[string]string{
+					meta.AnnotationKeyArgoCDSyncWave:    "1",
+					meta.AnnotationKeyArgoCDSyncOptions: "ServerSideApply=false",
+					meta.AnnotationKeyFluxPrune:         "enabled",
+				},
+			},
+			want: want{
+				a: map[string]string{
+					meta.AnnotationKeyArgoCDSyncWave:    "1",
+					meta.AnnotationKeyArgoCDSyncOptions: "ServerSideApply=false",
+					meta.AnnotationKeyFluxPrune:         "enabled",
+				},
+			},
+		},
+		"UpdateError": {
+			reason: "Errors persisting annotations should be returned.",
+			args: args{
+				opts: []GitOpsAnnotatorOption{WithArgoCDSyncWave(5)},
+				err:  errBoom,
+			},
+			want: want{
+				a:       map[string]string{meta.AnnotationKeyArgoCDSyncWave: "5"},
+				updated: true,
+				err:     errors.Wrap(errBoom, errUpdateManaged),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			updated := false
+			c := &test.MockClient{
+				MockUpdate: func(_ context.Context, _ client.Object, _ ...client.UpdateOption) error {
+					updated = true
+					return tc.args.err
+				},
+			}
+
+			mg := &fake.ModernManaged{ObjectMeta: metav1.ObjectMeta{Annotations: tc.args.a}}
+
+			err := NewGitOpsAnnotator(c, tc.args.opts...).Initialize(context.Background(), mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nInitialize(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.want.a, mg.GetAnnotations()); diff != "" {
+				t.Errorf("\n%s\nInitialize(...): -want annotations, +got annotations:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.want.updated, updated); diff != "" {
+				t.Errorf("\n%s\nInitialize(...): -want updated, +got updated:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"github.com/crossplane/crossplane-runtime/v2/pkg/fieldpath"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+)
+
+// IsChangeIgnored returns true if changes to the supplied field path of the
+// supplied managed resource should be ignored, per its ignore changes
+// annotation. A field is ignored if the annotation lists it, or any of its
+// parents, for example spec.forProvider.scaling ignores changes to
+// spec.forProvider.scaling.desiredCapacity. A wildcard matches any field or
+// array index, for example spec.forProvider.nodeGroups[*].size.
+//
+// If some changes to a managed resource aren't ignored its external resource
+// is updated as usual. ExternalClients that support ignoring changes should
+// use IsChangeIgnored to avoid overwriting ignored fields when they do.
+func IsChangeIgnored(mg resource.Managed, path string) bool {
+	return changeIgnored(ignoredPaths(mg), path)
+}
+
+// allChangesIgnored returns true if the supplied observation reports which
+// fields differ, and all of them are ignored.
+func allChangesIgnored(mg resource.Managed, obs ExternalObservation) bool {
+	if len(obs.DiffPaths) == 0 {
+		return false
+	}
+
+	ignored := ignoredPaths(mg)
+	if len(ignored) == 0 {
+		return false
+	}
+
+	for _, p := range obs.DiffPaths {
+		if !changeIgnored(ignored, p) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ignoredPaths returns the parsed field paths whose changes the supplied
+// managed resource ignores. Field paths that can't be parsed are skipped.
+func ignoredPaths(mg resource.Managed) []fieldpath.Segments {
+	paths := meta.GetIgnoreChanges(mg)
+	out := make([]fieldpath.Segments, 0, len(paths))
+
+	for _, p := range paths {
+		s, err := fieldpath.Parse(p)
+		if err != nil {
+			continue
+		}
+
+		out = append(out, s)
+	}
+
+	return out
+}
+
+func changeIgnored(ignored []fieldpath.Segments, path string) bool {
+	s, err := fieldpath.Parse(path)
+	if err != nil {
+		return false
+	}
+
+	for _, i := range ignored {
+		if segmentsPrefix(i, s) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// segmentsPrefix returns true if prefix is a prefix of s. A wildcard segment
+// of prefix matches any segment of s.
+func segmentsPrefix(prefix, s fieldpath.Segments) bool {
+	if len(prefix) > len(s) {
+		return false
+	}
+
+	for i, p := range prefix {
+		if p.Type == fieldpath.SegmentField && p.Field == "*" {
+			continue
+		}
+
+		if p != s[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+)
+
+func TestAllChangesIgnored(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		ignored string
+		paths   []string
+		want    bool
+	}{
+		"NoDiffPaths": {
+			reason:  "Changes shouldn't be ignored if the observation doesn't report which fields differ.",
+			ignored: "spec.forProvider.desiredCapacity",
+			want:    false,
+		},
+		"NoIgnoredPaths": {
+			reason: "Changes shouldn't be ignored if the managed resource doesn't ignore any.",
+			paths:  []string{"spec.forProvider.desiredCapacity"},
+			want:   false,
+		},
+		"AllIgnored": {
+			reason:  "Changes should be ignored if every field that differs is ignored.",
+			ignored: "spec.forProvider.desiredCapacity, spec.forProvider.tags",
+			paths:   []string{"spec.forProvider.desiredCapacity", "spec.forProvider.tags.team"},
+			want:    true,
+		},
+		"SomeIgnored": {
+			reason:  "Changes shouldn't be ignored if any field that differs isn't ignored.",
+			ignored: "spec.forProvider.desiredCapacity",
+			paths:   []string{"spec.forProvider.desiredCapacity", "spec.forProvider.region"},
+			want:    false,
+		},
+		"Wildcard": {
+			reason:  "A wildcard should match any array index.",
+			ignored: "spec.forProvider.nodeGroups[*].size",
+			paths:   []string{"spec.forProvider.nodeGroups[0].size", "spec.forProvider.nodeGroups[3].size"},
+			want:    true,
+		},
+		"SiblingNotIgnored": {
+			reason:  "A field that merely shares a prefix with an ignored field shouldn't be ignored.",
+			ignored: "spec.forProvider.size",
+			paths:   []string{"spec.forProvider.sizeGB"},
+			want:    false,
+		},
+		"InvalidIgnoredPath": {
+			reason:  "An ignored field path that can't be parsed should be skipped.",
+			ignored: "spec.forProvider[, spec.forProvider.size",
+			paths:   []string{"spec.forProvider.size"},
+			want:    true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg := &fake.ModernManaged{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{meta.AnnotationKeyIgnoreChanges: tc.ignored}}}
+
+			got := allChangesIgnored(mg, ExternalObservation{DiffPaths: tc.paths})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nallChangesIgnored(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -17,11 +17,13 @@ limitations under the License.
 package managed
 
 import (
+	"strconv"
 	"sync"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	kmetrics "k8s.io/component-base/metrics"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
@@ -35,11 +37,18 @@ type MetricRecorder interface { //nolint:interfacebloat // The first two methods
 	Describe(ch chan<- *prometheus.Desc)
 	Collect(ch chan<- prometheus.Metric)
 
-	recordUnchanged(name string)
-	recordFirstTimeReconciled(managed resource.Managed)
-	recordFirstTimeReady(managed resource.Managed)
-	recordDrift(managed resource.Managed)
-	recordDeleted(managed resource.Managed)
+	recordUnchanged(name string, now time.Time)
+	recordFirstTimeReconciled(managed resource.Managed, now time.Time)
+	recordFirstTimeReady(managed resource.Managed, now time.Time)
+	recordDrift(managed resource.Managed, now time.Time)
+	recordDeleted(managed resource.Managed, now time.Time)
+	recordOutcome(managed resource.Managed, o ReconcileOutcome)
+	recordDriftLoop(managed resource.Managed)
+	recordPolicyDecision(managed resource.Managed, d PolicyDecision)
+	recordPanic(gvk schema.GroupVersionKind, operation string)
+	recordObservedStateChange(managed resource.Managed)
+	recordSlowExternalCall(gvk schema.GroupVersionKind, operation string)
+	recordDeadlineExceeded(gvk schema.GroupVersionKind)
 }
 
 // MRMetricRecorder records the lifecycle metrics of managed resources.
@@ -51,6 +60,13 @@ type MRMetricRecorder struct {
 	mrFirstTimeReady *prometheus.HistogramVec
 	mrDeletion       *prometheus.HistogramVec
 	mrDrift          *prometheus.HistogramVec
+	mrOutcome        *prometheus.CounterVec
+	mrDriftLoop      *prometheus.CounterVec
+	mrPolicyDecision *prometheus.CounterVec
+	mrPanic          *prometheus.CounterVec
+	mrStateChange    *prometheus.CounterVec
+	mrSlowCall       *prometheus.CounterVec
+	mrDeadline       *prometheus.CounterVec
 }
 
 // NewMRMetricRecorder returns a new MRMetricRecorder which records metrics for managed resources.
@@ -80,6 +96,41 @@ func NewMRMetricRecorder() *MRMetricRecorder {
 			Help:      "ALPHA: How long since the previous successful reconcile when a resource was found to be out of sync; excludes restart of the provider",
 			Buckets:   kmetrics.ExponentialBuckets(10e-9, 10, 10),
 		}, []string{"gvk"}),
+		mrOutcome: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Subsystem: subSystem,
+			Name:      "managed_resource_reconcile_outcomes_total",
+			Help:      "ALPHA: The number of reconciles of a managed resource, by the path the reconcile took",
+		}, []string{"gvk", "outcome", "stage"}),
+		mrDriftLoop: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Subsystem: subSystem,
+			Name:      "managed_resource_drift_loops_total",
+			Help:      "ALPHA: The number of times a managed resource was detected to be fighting another system that mutates its external resource",
+		}, []string{"gvk"}),
+		mrPolicyDecision: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Subsystem: subSystem,
+			Name:      "managed_resource_policy_decisions_total",
+			Help:      "ALPHA: The number of times the management policies of a managed resource allowed or denied an action",
+		}, []string{"gvk", "action", "allowed"}),
+		mrPanic: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Subsystem: subSystem,
+			Name:      "managed_resource_external_client_panics_total",
+			Help:      "ALPHA: The number of times a managed resource's external client panicked",
+		}, []string{"gvk", "operation"}),
+		mrStateChange: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Subsystem: subSystem,
+			Name:      "managed_resource_observed_state_changes_total",
+			Help:      "ALPHA: The number of times the hash of a managed resource's observed external state changed",
+		}, []string{"gvk"}),
+		mrSlowCall: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Subsystem: subSystem,
+			Name:      "managed_resource_slow_external_calls_total",
+			Help:      "ALPHA: The number of times a call to a managed resource's external system took longer than the configured percentage of the reconcile timeout",
+		}, []string{"gvk", "operation"}),
+		mrDeadline: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Subsystem: subSystem,
+			Name:      "managed_resource_reconcile_deadline_exceeded_total",
+			Help:      "ALPHA: The number of times a reconcile of a managed resource exceeded the reconcile timeout",
+		}, []string{"gvk"}),
 	}
 }
 
@@ -91,6 +142,13 @@ func (r *MRMetricRecorder) Describe(ch chan<- *prometheus.Desc) {
 	r.mrFirstTimeReady.Describe(ch)
 	r.mrDeletion.Describe(ch)
 	r.mrDrift.Describe(ch)
+	r.mrOutcome.Describe(ch)
+	r.mrDriftLoop.Describe(ch)
+	r.mrPolicyDecision.Describe(ch)
+	r.mrPanic.Describe(ch)
+	r.mrStateChange.Describe(ch)
+	r.mrSlowCall.Describe(ch)
+	r.mrDeadline.Describe(ch)
 }
 
 // Collect is called by the Prometheus registry when collecting
@@ -101,20 +159,27 @@ func (r *MRMetricRecorder) Collect(ch chan<- prometheus.Metric) {
 	r.mrFirstTimeReady.Collect(ch)
 	r.mrDeletion.Collect(ch)
 	r.mrDrift.Collect(ch)
+	r.mrOutcome.Collect(ch)
+	r.mrDriftLoop.Collect(ch)
+	r.mrPolicyDecision.Collect(ch)
+	r.mrPanic.Collect(ch)
+	r.mrStateChange.Collect(ch)
+	r.mrSlowCall.Collect(ch)
+	r.mrDeadline.Collect(ch)
 }
 
-func (r *MRMetricRecorder) recordUnchanged(name string) {
-	r.lastObservation.Store(name, time.Now())
+func (r *MRMetricRecorder) recordUnchanged(name string, now time.Time) {
+	r.lastObservation.Store(name, now)
 }
 
-func (r *MRMetricRecorder) recordFirstTimeReconciled(managed resource.Managed) {
+func (r *MRMetricRecorder) recordFirstTimeReconciled(managed resource.Managed, now time.Time) {
 	if managed.GetCondition(xpv1.TypeSynced).Status == corev1.ConditionUnknown {
-		r.mrDetected.With(getLabels(managed)).Observe(time.Since(managed.GetCreationTimestamp().Time).Seconds())
-		r.firstObservation.Store(managed.GetName(), time.Now()) // this is the first time we reconciled on this resource
+		r.mrDetected.With(getLabels(managed)).Observe(now.Sub(managed.GetCreationTimestamp().Time).Seconds())
+		r.firstObservation.Store(managed.GetName(), now) // this is the first time we reconciled on this resource
 	}
 }
 
-func (r *MRMetricRecorder) recordDrift(managed resource.Managed) {
+func (r *MRMetricRecorder) recordDrift(managed resource.Managed, now time.Time) {
 	name := managed.GetName()
 
 	last, ok := r.lastObservation.Load(name)
@@ -127,16 +192,52 @@ func (r *MRMetricRecorder) recordDrift(managed resource.Managed) {
 		return
 	}
 
-	r.mrDrift.With(getLabels(managed)).Observe(time.Since(lt).Seconds())
+	r.mrDrift.With(getLabels(managed)).Observe(now.Sub(lt).Seconds())
+
+	r.lastObservation.Store(name, now)
+}
+
+func (r *MRMetricRecorder) recordDeleted(managed resource.Managed, now time.Time) {
+	r.mrDeletion.With(getLabels(managed)).Observe(now.Sub(managed.GetDeletionTimestamp().Time).Seconds())
+}
+
+func (r *MRMetricRecorder) recordOutcome(managed resource.Managed, o ReconcileOutcome) {
+	r.mrOutcome.With(prometheus.Labels{
+		"gvk":     managed.GetObjectKind().GroupVersionKind().String(),
+		"outcome": string(o.Type),
+		"stage":   string(o.Stage),
+	}).Inc()
+}
 
-	r.lastObservation.Store(name, time.Now())
+func (r *MRMetricRecorder) recordDriftLoop(managed resource.Managed) {
+	r.mrDriftLoop.With(getLabels(managed)).Inc()
 }
 
-func (r *MRMetricRecorder) recordDeleted(managed resource.Managed) {
-	r.mrDeletion.With(getLabels(managed)).Observe(time.Since(managed.GetDeletionTimestamp().Time).Seconds())
+func (r *MRMetricRecorder) recordPolicyDecision(managed resource.Managed, d PolicyDecision) {
+	r.mrPolicyDecision.With(prometheus.Labels{
+		"gvk":     managed.GetObjectKind().GroupVersionKind().String(),
+		"action":  string(d.Action),
+		"allowed": strconv.FormatBool(d.Allowed),
+	}).Inc()
 }
 
-func (r *MRMetricRecorder) recordFirstTimeReady(managed resource.Managed) {
+func (r *MRMetricRecorder) recordPanic(gvk schema.GroupVersionKind, operation string) {
+	r.mrPanic.With(prometheus.Labels{"gvk": gvk.String(), "operation": operation}).Inc()
+}
+
+func (r *MRMetricRecorder) recordObservedStateChange(managed resource.Managed) {
+	r.mrStateChange.With(getLabels(managed)).Inc()
+}
+
+func (r *MRMetricRecorder) recordSlowExternalCall(gvk schema.GroupVersionKind, operation string) {
+	r.mrSlowCall.With(prometheus.Labels{"gvk": gvk.String(), "operation": operation}).Inc()
+}
+
+func (r *MRMetricRecorder) recordDeadlineExceeded(gvk schema.GroupVersionKind) {
+	r.mrDeadline.With(prometheus.Labels{"gvk": gvk.String()}).Inc()
+}
+
+func (r *MRMetricRecorder) recordFirstTimeReady(managed resource.Managed, now time.Time) {
 	// Note that providers may set the ready condition to "True", so we need
 	// to check the value here to send the ready metric
 	if managed.GetCondition(xpv1.TypeReady).Status == corev1.ConditionTrue {
@@ -145,7 +246,7 @@ func (r *MRMetricRecorder) recordFirstTimeReady(managed resource.Managed) {
 			return
 		}
 
-		r.mrFirstTimeReady.With(getLabels(managed)).Observe(time.Since(managed.GetCreationTimestamp().Time).Seconds())
+		r.mrFirstTimeReady.With(getLabels(managed)).Observe(now.Sub(managed.GetCreationTimestamp().Time).Seconds())
 		r.firstObservation.Delete(managed.GetName())
 	}
 }
@@ -164,15 +265,29 @@ func (r *NopMetricRecorder) Describe(_ chan<- *prometheus.Desc) {}
 // Collect does nothing.
 func (r *NopMetricRecorder) Collect(_ chan<- prometheus.Metric) {}
 
-func (r *NopMetricRecorder) recordUnchanged(_ string) {}
+func (r *NopMetricRecorder) recordUnchanged(_ string, _ time.Time) {}
+
+func (r *NopMetricRecorder) recordFirstTimeReconciled(_ resource.Managed, _ time.Time) {}
+
+func (r *NopMetricRecorder) recordDrift(_ resource.Managed, _ time.Time) {}
+
+func (r *NopMetricRecorder) recordDeleted(_ resource.Managed, _ time.Time) {}
+
+func (r *NopMetricRecorder) recordFirstTimeReady(_ resource.Managed, _ time.Time) {}
+
+func (r *NopMetricRecorder) recordOutcome(_ resource.Managed, _ ReconcileOutcome) {}
+
+func (r *NopMetricRecorder) recordDriftLoop(_ resource.Managed) {}
+
+func (r *NopMetricRecorder) recordPolicyDecision(_ resource.Managed, _ PolicyDecision) {}
 
-func (r *NopMetricRecorder) recordFirstTimeReconciled(_ resource.Managed) {}
+func (r *NopMetricRecorder) recordPanic(_ schema.GroupVersionKind, _ string) {}
 
-func (r *NopMetricRecorder) recordDrift(_ resource.Managed) {}
+func (r *NopMetricRecorder) recordObservedStateChange(_ resource.Managed) {}
 
-func (r *NopMetricRecorder) recordDeleted(_ resource.Managed) {}
+func (r *NopMetricRecorder) recordSlowExternalCall(_ schema.GroupVersionKind, _ string) {}
 
-func (r *NopMetricRecorder) recordFirstTimeReady(_ resource.Managed) {}
+func (r *NopMetricRecorder) recordDeadlineExceeded(_ schema.GroupVersionKind) {}
 
 func getLabels(r resource.Managed) prometheus.Labels {
 	return prometheus.Labels{
@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+)
+
+type contentHashKey struct{}
+
+// withContentHash returns a context that carries the supplied content hash.
+func withContentHash(ctx context.Context, hash string) context.Context {
+	return context.WithValue(ctx, contentHashKey{}, hash)
+}
+
+// ContentHashFrom returns the content hash of the previous observation of the
+// managed resource being observed, if any. An ExternalClient may use it to
+// make a conditional request, for example by sending it as an HTTP
+// If-None-Match header, and return an ExternalObservation with NotModified
+// set if the external resource has not changed. The content hash is only
+// supplied if the reconciler is configured with an ObservationCache, and the
+// managed resource's desired state has not changed since the previous
+// observation.
+func ContentHashFrom(ctx context.Context) (string, bool) {
+	h, ok := ctx.Value(contentHashKey{}).(string)
+	return h, ok
+}
+
+// An ObservationCache stores the content hash an ExternalClient returned when
+// it last observed a managed resource.
+type ObservationCache interface {
+	// Get the content hash stored for the supplied managed resource. A content
+	// hash is only returned if the managed resource's desired state has not
+	// changed since it was stored.
+	Get(mg resource.Managed) (string, bool)
+
+	// Set the content hash of the supplied managed resource.
+	Set(mg resource.Managed, hash string)
+
+	// Delete the content hash of the supplied managed resource.
+	Delete(mg resource.Managed)
+}
+
+// A NopObservationCache does not store content hashes.
+type NopObservationCache struct{}
+
+// Get always returns false.
+func (NopObservationCache) Get(_ resource.Managed) (string, bool) { return "", false }
+
+// Set does nothing.
+func (NopObservationCache) Set(_ resource.Managed, _ string) {}
+
+// Delete does nothing.
+func (NopObservationCache) Delete(_ resource.Managed) {}
+
+type cachedObservation struct {
+	hash       string
+	generation int64
+}
+
+// A MemoryObservationCache stores content hashes in memory, keyed by managed
+// resource UID. Each content hash is stored with the generation of the managed
+// resource, so that a change to the desired state invalidates it.
+type MemoryObservationCache struct {
+	mu           sync.RWMutex
+	observations map[types.UID]cachedObservation
+}
+
+// NewMemoryObservationCache returns a new, empty MemoryObservationCache.
+func NewMemoryObservationCache() *MemoryObservationCache {
+	return &MemoryObservationCache{observations: make(map[types.UID]cachedObservation)}
+}
+
+// Get the content hash stored for the supplied managed resource.
+func (c *MemoryObservationCache) Get(mg resource.Managed) (string, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	o, ok := c.observations[mg.GetUID()]
+	if !ok || o.generation != mg.GetGeneration() {
+		return "", false
+	}
+
+	return o.hash, true
+}
+
+// Set the content hash of the supplied managed resource.
+func (c *MemoryObservationCache) Set(mg resource.Managed, hash string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.observations[mg.GetUID()] = cachedObservation{hash: hash, generation: mg.GetGeneration()}
+}
+
+// Delete the content hash of the supplied managed resource.
+func (c *MemoryObservationCache) Delete(mg resource.Managed) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.observations, mg.GetUID())
+}
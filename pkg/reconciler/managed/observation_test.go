@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+)
+
+var _ ObservationCache = &MemoryObservationCache{}
+
+func TestMemoryObservationCache(t *testing.T) {
+	stored := &fake.Managed{ObjectMeta: metav1.ObjectMeta{UID: "cool", Generation: 1}}
+
+	type want struct {
+		hash string
+		ok   bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     resource.Managed
+		want   want
+	}{
+		"Hit": {
+			reason: "We should return the content hash stored for a managed resource.",
+			mg:     &fake.Managed{ObjectMeta: metav1.ObjectMeta{UID: "cool", Generation: 1}},
+			want:   want{hash: "etag", ok: true},
+		},
+		"DesiredStateChanged": {
+			reason: "We should not return a content hash stored for an older generation of a managed resource.",
+			mg:     &fake.Managed{ObjectMeta: metav1.ObjectMeta{UID: "cool", Generation: 2}},
+			want:   want{},
+		},
+		"Miss": {
+			reason: "We should not return a content hash for a managed resource we haven't stored.",
+			mg:     &fake.Managed{ObjectMeta: metav1.ObjectMeta{UID: "other", Generation: 1}},
+			want:   want{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := NewMemoryObservationCache()
+			c.Set(stored, "etag")
+
+			hash, ok := c.Get(tc.mg)
+			if diff := cmp.Diff(tc.want, want{hash: hash, ok: ok}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nc.Get(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestContentHashFrom(t *testing.T) {
+	if _, ok := ContentHashFrom(context.Background()); ok {
+		t.Errorf("ContentHashFrom(...): want no content hash")
+	}
+
+	got, ok := ContentHashFrom(withContentHash(context.Background(), "etag"))
+	if diff := cmp.Diff("etag", got); diff != "" || !ok {
+		t.Errorf("ContentHashFrom(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestReconcileObservationCacheAfterUpdateError(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	c := &test.MockClient{
+		MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+			mg := asModernManaged(obj, 42)
+			mg.SetUID("cool")
+
+			return nil
+		}),
+		MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+	}
+	m := &fake.Manager{Client: c, Scheme: fake.SchemeWith(&fake.ModernManaged{})}
+
+	observed := []bool{}
+	updates := 0
+
+	r := NewReconciler(m, resource.ManagedKind(fake.GVK(&fake.ModernManaged{})),
+		WithObservationCache(NewMemoryObservationCache()),
+		WithInitializers(),
+		WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+		WithExternalConnector(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+			return &ExternalClientFns{
+				ObserveFn: func(ctx context.Context, _ resource.Managed) (ExternalObservation, error) {
+					_, ok := ContentHashFrom(ctx)
+					observed = append(observed, ok)
+
+					// A conditional observe would report the external
+					// resource was not modified, though it needs an update.
+					return ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ContentHash: "etag", NotModified: ok}, nil
+				},
+				UpdateFn: func(_ context.Context, _ resource.Managed) (ExternalUpdate, error) {
+					updates++
+					if updates == 1 {
+						return ExternalUpdate{}, errBoom
+					}
+
+					return ExternalUpdate{}, nil
+				},
+				DisconnectFn: func(_ context.Context) error { return nil },
+			}, nil
+		})),
+		WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+	)
+
+	for range 2 {
+		if _, err := r.Reconcile(context.Background(), reconcile.Request{}); err != nil {
+			t.Fatalf("r.Reconcile(...): unexpected error: %v", err)
+		}
+	}
+
+	if diff := cmp.Diff([]bool{false, false}, observed); diff != "" {
+		t.Errorf("Observe(...): -want content hash supplied, +got content hash supplied:\n%s", diff)
+	}
+
+	if diff := cmp.Diff(2, updates); diff != "" {
+		t.Errorf("Update(...): -want calls, +got calls:\n%s", diff)
+	}
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+)
+
+// Error strings.
+const (
+	errHashObservedState = "cannot hash observed state"
+)
+
+// ObservedStateHash returns a hash of the supplied observed state of an
+// external resource. The state is hashed as JSON, which sorts the keys of
+// maps, so equivalent states produce the same hash.
+func ObservedStateHash(state any) (string, error) {
+	j, err := json.Marshal(state)
+	if err != nil {
+		return "", errors.Wrap(err, errHashObservedState)
+	}
+
+	sum := sha256.Sum256(j)
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// recordObservedStateHash records a hash of the supplied observed state in
+// the status of the supplied managed resource, if it satisfies
+// resource.ObservedStateHashed. It returns true if a previously recorded hash
+// changed.
+func recordObservedStateHash(mg resource.Managed, state any) (bool, error) {
+	sh, ok := mg.(resource.ObservedStateHashed)
+	if !ok {
+		return false, nil
+	}
+
+	h, err := ObservedStateHash(state)
+	if err != nil {
+		return false, err
+	}
+
+	prev := sh.GetObservedStateHash()
+	sh.SetObservedStateHash(h)
+
+	return prev != "" && prev != h, nil
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+)
+
+func TestObservedStateHash(t *testing.T) {
+	a, err := ObservedStateHash(map[string]any{"a": 1, "b": []string{"x"}})
+	if err != nil {
+		t.Fatalf("ObservedStateHash(...): %v", err)
+	}
+
+	b, err := ObservedStateHash(map[string]any{"b": []string{"x"}, "a": 1})
+	if err != nil {
+		t.Fatalf("ObservedStateHash(...): %v", err)
+	}
+
+	if a != b {
+		t.Errorf("ObservedStateHash(...): equivalent states should have the same hash, got %q and %q", a, b)
+	}
+
+	c, err := ObservedStateHash(map[string]any{"a": 2, "b": []string{"x"}})
+	if err != nil {
+		t.Fatalf("ObservedStateHash(...): %v", err)
+	}
+
+	if a == c {
+		t.Errorf("ObservedStateHash(...): different states should have different hashes, got %q", a)
+	}
+
+	if _, err := ObservedStateHash(make(chan int)); err == nil {
+		t.Errorf("ObservedStateHash(...): want an error hashing a state that can't be encoded as JSON")
+	}
+}
+
+func TestReconcileObservedStateHash(t *testing.T) {
+	state := map[string]string{"arn": "arn:aws:s3:::cool-bucket"}
+
+	hash, err := ObservedStateHash(state)
+	if err != nil {
+		t.Fatalf("ObservedStateHash(...): %v", err)
+	}
+
+	type want struct {
+		hash    string
+		changes int
+	}
+
+	cases := map[string]struct {
+		reason string
+		prev   string
+		o      []ReconcilerOption
+		want   want
+	}{
+		"Disabled": {
+			reason: "We shouldn't record the observed state hash unless asked to.",
+			want:   want{},
+		},
+		"FirstObservation": {
+			reason: "We should record the observed state hash, but not count a change, the first time we observe an external resource.",
+			o:      []ReconcilerOption{WithObservedStateHashes()},
+			want:   want{hash: hash},
+		},
+		"Unchanged": {
+			reason: "We shouldn't count a change if the observed state hash is unchanged.",
+			prev:   hash,
+			o:      []ReconcilerOption{WithObservedStateHashes()},
+			want:   want{hash: hash},
+		},
+		"Changed": {
+			reason: "We should record the new observed state hash, and count a change, if the observed state changed.",
+			prev:   "old",
+			o:      []ReconcilerOption{WithObservedStateHashes()},
+			want:   want{hash: hash, changes: 1},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got string
+
+			c := &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					asModernManaged(obj, 42)
+					obj.(*fake.ModernManaged).SetObservedStateHash(tc.prev) //nolint:forcetypeassert // This is always a fake.ModernManaged.
+
+					return nil
+				}),
+				MockUpdate: test.NewMockUpdateFn(nil),
+				MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil, func(obj client.Object) error {
+					got = obj.(*fake.ModernManaged).GetObservedStateHash() //nolint:forcetypeassert // This is always a fake.ModernManaged.
+					return nil
+				}),
+			}
+			m := &fake.Manager{Client: c, Scheme: fake.SchemeWith(&fake.ModernManaged{})}
+			mr := &countingMetricRecorder{}
+
+			o := append([]ReconcilerOption{
+				WithInitializers(),
+				WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+				WithExternalConnector(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+					return &ExternalClientFns{
+						ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+							return ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ObservedState: state}, nil
+						},
+						DisconnectFn: func(_ context.Context) error { return nil },
+					}, nil
+				})),
+				WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+				WithMetricRecorder(mr),
+			}, tc.o...)
+
+			r := NewReconciler(m, resource.ManagedKind(fake.GVK(&fake.ModernManaged{})), o...)
+
+			if _, err := r.Reconcile(context.Background(), reconcile.Request{}); err != nil {
+				t.Errorf("\n%s\nr.Reconcile(...): %v", tc.reason, err)
+			}
+
+			if diff := cmp.Diff(tc.want.hash, got); diff != "" {
+				t.Errorf("\n%s\nr.Reconcile(...): -want hash, +got hash:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.want.changes, mr.stateChanges); diff != "" {
+				t.Errorf("\n%s\nr.Reconcile(...): -want changes, +got changes:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+type countingMetricRecorder struct {
+	NopMetricRecorder
+
+	stateChanges int
+}
+
+func (r *countingMetricRecorder) recordObservedStateChange(_ resource.Managed) {
+	r.stateChanges++
+}
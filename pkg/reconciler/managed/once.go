@@ -0,0 +1,180 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+)
+
+// Error strings.
+const (
+	errNewOnceClient = "cannot create client"
+)
+
+// A ReconcileOnceOption configures ReconcileOnce.
+type ReconcileOnceOption func(o *reconcileOnceOptions)
+
+type reconcileOnceOptions struct {
+	scheme *runtime.Scheme
+	opts   []ReconcilerOption
+}
+
+// WithReconcileOnceScheme configures ReconcileOnce to reconcile the managed
+// resource as the Go type registered for its kind with the supplied scheme.
+// By default the managed resource is reconciled as unstructured data, and the
+// ExternalConnector is passed a *unstructured/managed.Unstructured.
+func WithReconcileOnceScheme(s *runtime.Scheme) ReconcileOnceOption {
+	return func(o *reconcileOnceOptions) {
+		o.scheme = s
+	}
+}
+
+// WithReconcileOnceReconcilerOptions configures the Reconciler ReconcileOnce
+// uses, for example to supply a logger or to enable management policies. The
+// ExternalConnector supplied to ReconcileOnce overrides any configured using
+// WithExternalConnector.
+func WithReconcileOnceReconcilerOptions(ro ...ReconcilerOption) ReconcileOnceOption {
+	return func(o *reconcileOnceOptions) {
+		o.opts = append(o.opts, ro...)
+	}
+}
+
+// A ReconcileOnceResult is the result of a single reconcile.
+type ReconcileOnceResult struct {
+	// Outcome of the reconcile.
+	Outcome ReconcileOutcome
+
+	// Diff between the desired and observed state of the external resource,
+	// as reported by the ExternalClient, if any.
+	Diff string
+
+	// Result of the reconcile, for example when it should be requeued.
+	Result reconcile.Result
+}
+
+// ReconcileOnce runs a single reconcile of the named managed resource of the
+// supplied kind, without a controller manager. It reads and writes the
+// managed resource directly from and to the API server at the supplied REST
+// config, and connects to its external resource using the supplied
+// ExternalConnector. It's intended for CLIs and debugging sessions; providers
+// should use a Reconciler.
+//
+// The reconcile is real; it may create, update, or delete the external
+// resource. Use management policies to limit what it may do.
+func ReconcileOnce(ctx context.Context, cfg *rest.Config, gvk schema.GroupVersionKind, nn types.NamespacedName, c ExternalConnector, o ...ReconcileOnceOption) (ReconcileOnceResult, error) {
+	opts := &reconcileOnceOptions{}
+	for _, fn := range o {
+		fn(opts)
+	}
+
+	kube, err := client.New(cfg, client.Options{Scheme: opts.scheme})
+	if err != nil {
+		return ReconcileOnceResult{}, errors.Wrap(err, errNewOnceClient)
+	}
+
+	return reconcileOnce(ctx, kube, opts.scheme, gvk, nn, c, opts.opts...)
+}
+
+func reconcileOnce(ctx context.Context, kube client.Client, s *runtime.Scheme, gvk schema.GroupVersionKind, nn types.NamespacedName, c ExternalConnector, o ...ReconcilerOption) (ReconcileOnceResult, error) {
+	res := ReconcileOnceResult{}
+
+	ro := make([]ReconcilerOption, 0, len(o)+2)
+	ro = append(ro, o...)
+	ro = append(ro, WithExternalConnector(&diffConnector{ExternalConnector: c, diff: &res.Diff}), func(r *Reconciler) {
+		// Preserve any outcome observer supplied by the caller.
+		prev := r.outcomeObserver
+		r.outcomeObserver = ReconcileOutcomeObserverFn(func(ctx context.Context, mg resource.Managed, o ReconcileOutcome) {
+			res.Outcome = o
+
+			prev.ObserveOutcome(ctx, mg, o)
+		})
+	})
+
+	m := &onceManager{client: kube, scheme: s}
+	if m.scheme == nil {
+		m.scheme = runtime.NewScheme()
+	}
+
+	nr := NewUnstructuredReconciler
+	if s != nil && s.Recognizes(gvk) {
+		nr = NewReconciler
+	}
+
+	r := nr(m, resource.ManagedKind(gvk), ro...)
+
+	var err error
+
+	res.Result, err = r.Reconcile(ctx, reconcile.Request{NamespacedName: nn})
+
+	return res, err
+}
+
+// An onceManager satisfies the parts of manager.Manager a Reconciler uses.
+type onceManager struct {
+	manager.Manager
+
+	client client.Client
+	scheme *runtime.Scheme
+}
+
+func (m *onceManager) GetClient() client.Client {
+	return m.client
+}
+
+func (m *onceManager) GetScheme() *runtime.Scheme {
+	return m.scheme
+}
+
+// A diffConnector records the diff its ExternalClients observe.
+type diffConnector struct {
+	ExternalConnector
+
+	diff *string
+}
+
+func (c *diffConnector) Connect(ctx context.Context, mg resource.Managed) (ExternalClient, error) {
+	ec, err := c.ExternalConnector.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &diffClient{ExternalClient: ec, diff: c.diff}, nil
+}
+
+type diffClient struct {
+	ExternalClient
+
+	diff *string
+}
+
+func (c *diffClient) Observe(ctx context.Context, mg resource.Managed) (ExternalObservation, error) {
+	o, err := c.ExternalClient.Observe(ctx, mg)
+	*c.diff = o.Diff
+
+	return o, err
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+	umanaged "github.com/crossplane/crossplane-runtime/v2/pkg/resource/unstructured/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+)
+
+func TestReconcileOnce(t *testing.T) {
+	errBoom := errors.New("boom")
+	gvk := fake.GVK(&fake.ModernManaged{})
+
+	connector := func(obs ExternalObservation, err error) ExternalConnector {
+		return ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+			return &ExternalClientFns{
+				ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+					return obs, err
+				},
+				UpdateFn: func(_ context.Context, _ resource.Managed) (ExternalUpdate, error) {
+					return ExternalUpdate{}, nil
+				},
+				DisconnectFn: func(_ context.Context) error { return nil },
+			}, nil
+		})
+	}
+
+	type args struct {
+		kube   client.Client
+		scheme *runtime.Scheme
+		c      ExternalConnector
+	}
+
+	type want struct {
+		res ReconcileOnceResult
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"Typed": {
+			reason: "A managed resource whose kind is registered with the scheme should be reconciled as its Go type.",
+			args: args{
+				kube: &test.MockClient{
+					MockGet:          test.NewMockGetFn(nil),
+					MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+				},
+				scheme: fake.SchemeWith(&fake.ModernManaged{}),
+				c: ExternalConnectorFn(func(_ context.Context, mg resource.Managed) (ExternalClient, error) {
+					if _, ok := mg.(*fake.ModernManaged); !ok {
+						return nil, errors.Errorf("want *fake.ModernManaged, got %T", mg)
+					}
+
+					return connector(ExternalObservation{ResourceExists: true, ResourceUpToDate: false, Diff: "-cool, +cooler"}, nil).Connect(context.Background(), mg)
+				}),
+			},
+			want: want{
+				res: ReconcileOnceResult{
+					Outcome: outcome(OutcomeUpdated),
+					Diff:    "-cool, +cooler",
+					Result:  reconcile.Result{RequeueAfter: defaultPollInterval},
+				},
+			},
+		},
+		"Unstructured": {
+			reason: "A managed resource whose kind isn't registered with a scheme should be reconciled as unstructured data.",
+			args: args{
+				kube: &test.MockClient{
+					MockGet:          test.NewMockGetFn(nil),
+					MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+				},
+				c: ExternalConnectorFn(func(_ context.Context, mg resource.Managed) (ExternalClient, error) {
+					if _, ok := mg.(*umanaged.Unstructured); !ok {
+						return nil, errors.Errorf("want *managed.Unstructured, got %T", mg)
+					}
+
+					return connector(ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil).Connect(context.Background(), mg)
+				}),
+			},
+			want: want{
+				res: ReconcileOnceResult{
+					Outcome: outcome(OutcomeUpToDate),
+					Result:  reconcile.Result{RequeueAfter: defaultPollInterval},
+				},
+			},
+		},
+		"ObserveError": {
+			reason: "An error observing the external resource should be reported as the outcome of the reconcile.",
+			args: args{
+				kube: &test.MockClient{
+					MockGet:          test.NewMockGetFn(nil),
+					MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+				},
+				scheme: fake.SchemeWith(&fake.ModernManaged{}),
+				c:      connector(ExternalObservation{}, errBoom),
+			},
+			want: want{
+				res: ReconcileOnceResult{
+					Outcome: outcomeError(StageObserve, errBoom),
+					Result:  reconcile.Result{Requeue: true},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o := []ReconcilerOption{
+				WithInitializers(),
+				WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+				WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+			}
+
+			got, err := reconcileOnce(context.Background(), tc.args.kube, tc.args.scheme, gvk, types.NamespacedName{Name: "cool"}, tc.args.c, o...)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nreconcileOnce(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.want.res, got, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nreconcileOnce(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
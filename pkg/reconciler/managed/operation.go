@@ -0,0 +1,159 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"slices"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/clock"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+)
+
+const (
+	// DefaultOperationDetailsLimit is the default maximum size, in bytes, of
+	// the operation details recorded in a managed resource's status.
+	DefaultOperationDetailsLimit = 1024
+
+	// RedactedValue replaces the value of redacted operation details.
+	RedactedValue = "REDACTED"
+)
+
+// An OperationDetailsRecorder records the AdditionalDetails returned by a
+// successful operation on an external resource.
+type OperationDetailsRecorder interface {
+	RecordOperationDetails(mg resource.Managed, op xpv1.OperationType, ad AdditionalDetails)
+}
+
+// An OperationDetailsRecorderFn is a function that satisfies the
+// OperationDetailsRecorder interface.
+type OperationDetailsRecorderFn func(mg resource.Managed, op xpv1.OperationType, ad AdditionalDetails)
+
+// RecordOperationDetails calls OperationDetailsRecorderFn.
+func (fn OperationDetailsRecorderFn) RecordOperationDetails(mg resource.Managed, op xpv1.OperationType, ad AdditionalDetails) {
+	fn(mg, op, ad)
+}
+
+// A NopOperationDetailsRecorder does nothing.
+type NopOperationDetailsRecorder struct{}
+
+// RecordOperationDetails does nothing.
+func (NopOperationDetailsRecorder) RecordOperationDetails(_ resource.Managed, _ xpv1.OperationType, _ AdditionalDetails) {
+}
+
+// A StatusOperationDetailsRecorderOption configures a
+// StatusOperationDetailsRecorder.
+type StatusOperationDetailsRecorderOption func(r *StatusOperationDetailsRecorder)
+
+// WithOperationDetailsLimit configures the maximum size, in bytes, of the
+// keys and values of the operation details recorded in a managed resource's
+// status. Details are recorded in order of key; the first detail that would
+// exceed the limit and all details after it are omitted.
+func WithOperationDetailsLimit(bytes int) StatusOperationDetailsRecorderOption {
+	return func(r *StatusOperationDetailsRecorder) {
+		r.limit = bytes
+	}
+}
+
+// WithRedactedOperationDetails configures keys whose values are redacted
+// before they're recorded in a managed resource's status.
+func WithRedactedOperationDetails(keys ...string) StatusOperationDetailsRecorderOption {
+	return func(r *StatusOperationDetailsRecorder) {
+		r.redact.Insert(keys...)
+	}
+}
+
+// WithOperationDetailsClock configures the clock used to record the time of
+// an operation.
+func WithOperationDetailsClock(c clock.PassiveClock) StatusOperationDetailsRecorderOption {
+	return func(r *StatusOperationDetailsRecorder) {
+		r.clock = c
+	}
+}
+
+// A StatusOperationDetailsRecorder records operation details in the
+// status.operationDetails field of managed resources that satisfy
+// resource.OperationDetailed. Other managed resources are ignored. The
+// details are persisted with the rest of the managed resource's status.
+type StatusOperationDetailsRecorder struct {
+	limit  int
+	redact sets.Set[string]
+	clock  clock.PassiveClock
+}
+
+// NewStatusOperationDetailsRecorder returns a new
+// StatusOperationDetailsRecorder.
+func NewStatusOperationDetailsRecorder(o ...StatusOperationDetailsRecorderOption) *StatusOperationDetailsRecorder {
+	r := &StatusOperationDetailsRecorder{
+		limit:  DefaultOperationDetailsLimit,
+		redact: sets.New[string](),
+		clock:  clock.RealClock{},
+	}
+
+	for _, fn := range o {
+		fn(r)
+	}
+
+	return r
+}
+
+// RecordOperationDetails records the supplied details in the status of the
+// supplied managed resource. Details are added in order of key until one would
+// exceed the size limit; it and any details that follow it are omitted.
+func (r *StatusOperationDetailsRecorder) RecordOperationDetails(mg resource.Managed, op xpv1.OperationType, ad AdditionalDetails) {
+	od, ok := mg.(resource.OperationDetailed)
+	if !ok {
+		return
+	}
+
+	d := &xpv1.OperationDetails{
+		Operation: op,
+		Time:      metav1.NewTime(r.clock.Now()),
+	}
+
+	keys := make([]string, 0, len(ad))
+	for k := range ad {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	size := 0
+	for _, k := range keys {
+		v := ad[k]
+		if r.redact.Has(k) {
+			v = RedactedValue
+		}
+
+		// Stop at the first detail that would exceed the limit, so that the
+		// recorded details are always a prefix of the sorted details.
+		if size+len(k)+len(v) > r.limit {
+			d.Truncated = true
+			break
+		}
+		size += len(k) + len(v)
+
+		if d.Details == nil {
+			d.Details = make(map[string]string, len(ad))
+		}
+		d.Details[k] = v
+	}
+
+	od.SetOperationDetails(d)
+}
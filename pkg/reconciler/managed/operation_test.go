@@ -0,0 +1,128 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+	umanaged "github.com/crossplane/crossplane-runtime/v2/pkg/resource/unstructured/managed"
+)
+
+type resourceOperationDetailed interface {
+	resource.Managed
+	resource.OperationDetailed
+}
+
+func TestStatusOperationDetailsRecorder(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type args struct {
+		o  []StatusOperationDetailsRecorderOption
+		op xpv1.OperationType
+		ad AdditionalDetails
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   *xpv1.OperationDetails
+	}{
+		"NoDetails": {
+			reason: "We should record the operation even if it returned no details.",
+			args: args{
+				op: xpv1.OperationCreate,
+			},
+			want: &xpv1.OperationDetails{Operation: xpv1.OperationCreate, Time: metav1.NewTime(now)},
+		},
+		"Details": {
+			reason: "We should record the details returned by the operation.",
+			args: args{
+				op: xpv1.OperationUpdate,
+				ad: AdditionalDetails{"operationID": "op-123", "consoleURL": "https://example.org"},
+			},
+			want: &xpv1.OperationDetails{
+				Operation: xpv1.OperationUpdate,
+				Time:      metav1.NewTime(now),
+				Details:   map[string]string{"operationID": "op-123", "consoleURL": "https://example.org"},
+			},
+		},
+		"Redacted": {
+			reason: "We should redact the values of sensitive details.",
+			args: args{
+				o:  []StatusOperationDetailsRecorderOption{WithRedactedOperationDetails("token")},
+				op: xpv1.OperationDelete,
+				ad: AdditionalDetails{"operationID": "op-123", "token": "secret"},
+			},
+			want: &xpv1.OperationDetails{
+				Operation: xpv1.OperationDelete,
+				Time:      metav1.NewTime(now),
+				Details:   map[string]string{"operationID": "op-123", "token": RedactedValue},
+			},
+		},
+		"Truncated": {
+			reason: "We should omit details that would exceed the size limit.",
+			args: args{
+				o:  []StatusOperationDetailsRecorderOption{WithOperationDetailsLimit(10)},
+				op: xpv1.OperationCreate,
+				ad: AdditionalDetails{"a": "short", "b": "this value is too long"},
+			},
+			want: &xpv1.OperationDetails{
+				Operation: xpv1.OperationCreate,
+				Time:      metav1.NewTime(now),
+				Details:   map[string]string{"a": "short"},
+				Truncated: true,
+			},
+		},
+		"TruncatedPrefix": {
+			reason: "We should omit all details after the first that would exceed the size limit, even if they'd fit.",
+			args: args{
+				o:  []StatusOperationDetailsRecorderOption{WithOperationDetailsLimit(10)},
+				op: xpv1.OperationCreate,
+				ad: AdditionalDetails{"a": "this value is too long", "b": "short"},
+			},
+			want: &xpv1.OperationDetails{
+				Operation: xpv1.OperationCreate,
+				Time:      metav1.NewTime(now),
+				Truncated: true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o := append([]StatusOperationDetailsRecorderOption{WithOperationDetailsClock(clocktesting.NewFakePassiveClock(now))}, tc.args.o...)
+
+			// The recorder should work the same for typed and unstructured
+			// managed resources.
+			for _, mg := range []resourceOperationDetailed{umanaged.New(), &fake.ModernManaged{}, &fake.LegacyManaged{}} {
+				NewStatusOperationDetailsRecorder(o...).RecordOperationDetails(mg, tc.args.op, tc.args.ad)
+
+				if diff := cmp.Diff(tc.want, mg.GetOperationDetails()); diff != "" {
+					t.Errorf("\n%s\nRecordOperationDetails(%T): -want, +got:\n%s", tc.reason, mg, diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+)
+
+// An OutcomeType describes which path a reconcile took.
+type OutcomeType string
+
+// Reconcile outcome types.
+const (
+	// OutcomeCreated indicates creation of the external resource was
+	// successfully requested.
+	OutcomeCreated OutcomeType = "Created"
+
+	// OutcomeUpdated indicates an update of the external resource was
+	// successfully requested.
+	OutcomeUpdated OutcomeType = "Updated"
+
+	// OutcomeUpToDate indicates the external resource was observed to be up
+	// to date.
+	OutcomeUpToDate OutcomeType = "UpToDate"
+
+	// OutcomeDeletionRequested indicates deletion of the external resource
+	// was successfully requested.
+	OutcomeDeletionRequested OutcomeType = "DeletionRequested"
+
+	// OutcomeDeletionBlocked indicates deletion of the external resource was
+	// blocked, either because the managed resource is deletion protected or
+	// because managed resources that depend on it still exist.
+	OutcomeDeletionBlocked OutcomeType = "DeletionBlocked"
+
+	// OutcomeCreationDeferred indicates creation of the external resource was
+	// deferred by the reconciler's CreationGate.
+	OutcomeCreationDeferred OutcomeType = "CreationDeferred"
+
+	// OutcomeCreationDenied indicates creation of the external resource was
+	// denied by the reconciler's CreationGate.
+	OutcomeCreationDenied OutcomeType = "CreationDenied"
+
+	// OutcomeExternalDeleting indicates the external resource was observed
+	// to be being deleted, so it was neither updated nor deleted.
+	OutcomeExternalDeleting OutcomeType = "ExternalDeleting"
+
+	// OutcomeDeleted indicates the managed resource was finalized, and
+	// should no longer exist.
+	OutcomeDeleted OutcomeType = "Deleted"
+
+	// OutcomeAlreadyFinalized indicates the managed resource was being
+	// deleted, its external resource was observed not to exist, and it no
+	// longer had our finalizer, so there was nothing left to finalize. This
+	// usually happens when a managed resource that was just finalized is
+	// reconciled again before the cache observes that it no longer exists,
+	// for example when many managed resources are deleted at once.
+	OutcomeAlreadyFinalized OutcomeType = "AlreadyFinalized"
+
+	// OutcomePending indicates the reconciler is waiting for a recently
+	// created external resource to be observed.
+	OutcomePending OutcomeType = "Pending"
+
+	// OutcomePausedSkip indicates reconciliation was skipped because the
+	// managed resource is paused.
+	OutcomePausedSkip OutcomeType = "PausedSkip"
+
+	// OutcomePolicySkip indicates an update of the external resource was
+	// skipped because the management policies do not allow it.
+	OutcomePolicySkip OutcomeType = "PolicySkip"
+
+	// OutcomeUpdateSkipped indicates an update of the external resource was
+	// skipped because the reconciler's UpdateSkipPredicate returned true.
+	OutcomeUpdateSkipped OutcomeType = "UpdateSkipped"
+
+	// OutcomeUpdateCooldown indicates an update of the external resource was
+	// deferred because it was updated within the reconciler's update cooldown.
+	OutcomeUpdateCooldown OutcomeType = "UpdateCooldown"
+
+	// OutcomeNotModified indicates the external client reported that the
+	// external resource has not changed since it was last observed.
+	OutcomeNotModified OutcomeType = "NotModified"
+
+	// OutcomePlanned indicates the reconciler planned what it would do to the
+	// external resource, without doing it, because the managed resource asked
+	// to be planned.
+	OutcomePlanned OutcomeType = "Planned"
+
+	// OutcomeReassigned indicates the managed resource was reassigned to
+	// another controller, which will reconcile it.
+	OutcomeReassigned OutcomeType = "Reassigned"
+
+	// OutcomeError indicates the reconcile failed. The outcome's Stage
+	// indicates where.
+	OutcomeError OutcomeType = "Error"
+)
+
+// A Stage of the managed resource reconcile.
+type Stage string
+
+// Reconcile stages.
+const (
+	StagePolicy            Stage = "Policy"
+	StageInitialize        Stage = "Initialize"
+	StageResolveReferences Stage = "ResolveReferences"
+	StageConnect           Stage = "Connect"
+	StageObserve           Stage = "Observe"
+	StagePlan              Stage = "Plan"
+	StageCreate            Stage = "Create"
+	StageLateInitialize    Stage = "LateInitialize"
+	StageUpdate            Stage = "Update"
+	StageDelete            Stage = "Delete"
+	StagePublish           Stage = "Publish"
+	StageUnpublish         Stage = "Unpublish"
+	StageFinalize          Stage = "Finalize"
+
+	// StageStatus indicates the reconcile failed to persist the managed
+	// resource's status after an otherwise successful stage.
+	StageStatus Stage = "Status"
+)
+
+// A ReconcileOutcome describes which path a reconcile of a managed resource
+// took.
+type ReconcileOutcome struct {
+	// Type of outcome.
+	Type OutcomeType
+
+	// Stage at which the reconcile failed. Only set when Type is
+	// OutcomeError.
+	Stage Stage
+
+	// Err that caused the reconcile to fail. Only set when Type is
+	// OutcomeError.
+	Err error
+}
+
+// String returns a human readable representation of the outcome.
+func (o ReconcileOutcome) String() string {
+	if o.Type != OutcomeError {
+		return string(o.Type)
+	}
+
+	return string(o.Type) + "(" + string(o.Stage) + ")"
+}
+
+func outcome(t OutcomeType) ReconcileOutcome {
+	return ReconcileOutcome{Type: t}
+}
+
+func outcomeError(s Stage, err error) ReconcileOutcome {
+	return ReconcileOutcome{Type: OutcomeError, Stage: s, Err: err}
+}
+
+// A ReconcileOutcomeObserver is notified of the outcome of each reconcile of
+// a managed resource. It is not notified when the managed resource could not
+// be read.
+type ReconcileOutcomeObserver interface {
+	ObserveOutcome(ctx context.Context, mg resource.Managed, o ReconcileOutcome)
+}
+
+// A ReconcileOutcomeObserverFn is a function that satisfies the
+// ReconcileOutcomeObserver interface.
+type ReconcileOutcomeObserverFn func(ctx context.Context, mg resource.Managed, o ReconcileOutcome)
+
+// ObserveOutcome calls ReconcileOutcomeObserverFn function.
+func (fn ReconcileOutcomeObserverFn) ObserveOutcome(ctx context.Context, mg resource.Managed, o ReconcileOutcome) {
+	fn(ctx, mg, o)
+}
+
+// A NopReconcileOutcomeObserver does nothing.
+type NopReconcileOutcomeObserver struct{}
+
+// ObserveOutcome does nothing.
+func (NopReconcileOutcomeObserver) ObserveOutcome(_ context.Context, _ resource.Managed, _ ReconcileOutcome) {
+}
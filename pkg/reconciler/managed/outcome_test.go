@@ -0,0 +1,335 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+)
+
+func TestReconcileOutcome(t *testing.T) {
+	errBoom := errors.New("boom")
+	now := metav1.Now()
+
+	mockClient := func(fn test.ObjectFn) *test.MockClient {
+		return &test.MockClient{
+			MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+				asModernManaged(obj, 42)
+				return fn(obj)
+			}),
+			MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+		}
+	}
+
+	type args struct {
+		c client.Client
+		o []ReconcilerOption
+	}
+
+	cases := map[string]struct {
+		reason  string
+		args    args
+		want    ReconcileOutcome
+		wantErr error
+	}{
+		"Paused": {
+			reason: "A paused managed resource should produce a PausedSkip outcome.",
+			args: args{
+				c: mockClient(func(obj client.Object) error {
+					meta.AddAnnotations(obj, map[string]string{meta.AnnotationKeyReconciliationPaused: "true"})
+					return nil
+				}),
+			},
+			want: outcome(OutcomePausedSkip),
+		},
+		"ConnectError": {
+			reason: "An error connecting to the provider should produce an Error outcome at the Connect stage.",
+			args: args{
+				c: mockClient(func(_ client.Object) error { return nil }),
+				o: []ReconcilerOption{
+					WithInitializers(),
+					WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+					WithExternalConnector(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+						return nil, errBoom
+					})),
+				},
+			},
+			want: outcomeError(StageConnect, errBoom),
+		},
+		"UpToDate": {
+			reason: "An up to date external resource should produce an UpToDate outcome.",
+			args: args{
+				c: mockClient(func(_ client.Object) error { return nil }),
+				o: []ReconcilerOption{
+					WithInitializers(),
+					WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+					WithExternalConnector(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+						return &ExternalClientFns{
+							ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+								return ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+							},
+							DisconnectFn: func(_ context.Context) error { return nil },
+						}, nil
+					})),
+					WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+				},
+			},
+			want: outcome(OutcomeUpToDate),
+		},
+		"UpdateSkipped": {
+			reason: "An update skipped by the update skip predicate should produce an UpdateSkipped outcome.",
+			args: args{
+				c: mockClient(func(_ client.Object) error { return nil }),
+				o: []ReconcilerOption{
+					WithInitializers(),
+					WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+					WithExternalConnector(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+						return &ExternalClientFns{
+							ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+								return ExternalObservation{ResourceExists: true, Diff: "-tags, +tags"}, nil
+							},
+							DisconnectFn: func(_ context.Context) error { return nil },
+						}, nil
+					})),
+					WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+					WithUpdateSkipPredicate(func(_ context.Context, _ resource.Managed, obs ExternalObservation) bool {
+						return obs.Diff == "-tags, +tags"
+					}),
+				},
+			},
+			want: outcome(OutcomeUpdateSkipped),
+		},
+		"ChangesIgnored": {
+			reason: "An update skipped because all changes are ignored should produce an UpdateSkipped outcome.",
+			args: args{
+				c: mockClient(func(obj client.Object) error {
+					obj.SetAnnotations(map[string]string{meta.AnnotationKeyIgnoreChanges: "spec.forProvider.desiredCapacity"})
+					return nil
+				}),
+				o: []ReconcilerOption{
+					WithInitializers(),
+					WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+					WithExternalConnector(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+						return &ExternalClientFns{
+							ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+								return ExternalObservation{ResourceExists: true, DiffPaths: []string{"spec.forProvider.desiredCapacity"}}, nil
+							},
+							DisconnectFn: func(_ context.Context) error { return nil },
+						}, nil
+					})),
+					WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+				},
+			},
+			want: outcome(OutcomeUpdateSkipped),
+		},
+		"UpdateCooldown": {
+			reason: "An update deferred by the update cooldown should produce an UpdateCooldown outcome.",
+			args: args{
+				c: mockClient(func(_ client.Object) error { return nil }),
+				o: []ReconcilerOption{
+					WithInitializers(),
+					WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+					WithExternalConnector(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+						return &ExternalClientFns{
+							ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+								return ExternalObservation{ResourceExists: true}, nil
+							},
+							DisconnectFn: func(_ context.Context) error { return nil },
+						}, nil
+					})),
+					WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+					WithUpdateCooldown(time.Hour),
+					func(r *Reconciler) {
+						// Simulate an update of this managed resource's
+						// external resource during a previous reconcile.
+						r.updateCooldown.Record(newModernManaged(42), time.Now())
+					},
+				},
+			},
+			want: outcome(OutcomeUpdateCooldown),
+		},
+		"NotModified": {
+			reason: "An external resource the external client reports is not modified should produce a NotModified outcome.",
+			args: args{
+				c: mockClient(func(_ client.Object) error { return nil }),
+				o: []ReconcilerOption{
+					WithInitializers(),
+					WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+					WithExternalConnector(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+						return &ExternalClientFns{
+							ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+								return ExternalObservation{ResourceExists: true, NotModified: true}, nil
+							},
+							DisconnectFn: func(_ context.Context) error { return nil },
+						}, nil
+					})),
+					WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+				},
+			},
+			want: outcome(OutcomeNotModified),
+		},
+		"Deleted": {
+			reason: "Finalizing a managed resource whose external resource no longer exists should produce a Deleted outcome.",
+			args: args{
+				c: mockClient(func(obj client.Object) error {
+					obj.SetDeletionTimestamp(&now)
+					obj.SetFinalizers([]string{FinalizerName})
+					return nil
+				}),
+				o: []ReconcilerOption{
+					WithInitializers(),
+					WithExternalConnector(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+						return &ExternalClientFns{
+							ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+								return ExternalObservation{ResourceExists: false}, nil
+							},
+							DisconnectFn: func(_ context.Context) error { return nil },
+						}, nil
+					})),
+					WithFinalizer(resource.FinalizerFns{RemoveFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+				},
+			},
+			want: outcome(OutcomeDeleted),
+		},
+		"AlreadyFinalized": {
+			reason: "Reconciling a stale copy of a managed resource that no longer has our finalizer should produce an AlreadyFinalized outcome without finalizing it again.",
+			args: args{
+				c: mockClient(func(obj client.Object) error {
+					obj.SetDeletionTimestamp(&now)
+					obj.SetFinalizers([]string{"example.org/other-controller"})
+					return nil
+				}),
+				o: []ReconcilerOption{
+					WithInitializers(),
+					WithExternalConnector(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+						return &ExternalClientFns{
+							ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+								return ExternalObservation{ResourceExists: false}, nil
+							},
+							DisconnectFn: func(_ context.Context) error { return nil },
+						}, nil
+					})),
+				},
+			},
+			want: outcome(OutcomeAlreadyFinalized),
+		},
+		"StatusUpdateError": {
+			reason: "An otherwise successful reconcile that can't persist its status should produce an Error outcome at the Status stage.",
+			args: args{
+				c: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						asModernManaged(obj, 42)
+						return nil
+					}),
+					MockStatusUpdate: test.NewMockSubResourceUpdateFn(errBoom),
+				},
+				o: []ReconcilerOption{
+					WithInitializers(),
+					WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+					WithExternalConnector(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+						return &ExternalClientFns{
+							ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+								return ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+							},
+							DisconnectFn: func(_ context.Context) error { return nil },
+						}, nil
+					})),
+					WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+				},
+			},
+			want:    outcomeError(StageStatus, errors.Wrap(errBoom, errUpdateManagedStatus)),
+			wantErr: errors.Wrap(errBoom, errUpdateManagedStatus),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got ReconcileOutcome
+
+			o := append([]ReconcilerOption{
+				WithReconcileOutcomeObserver(ReconcileOutcomeObserverFn(func(_ context.Context, _ resource.Managed, o ReconcileOutcome) {
+					got = o
+				})),
+			}, tc.args.o...)
+
+			m := &fake.Manager{Client: tc.args.c, Scheme: fake.SchemeWith(&fake.ModernManaged{})}
+			r := NewReconciler(m, resource.ManagedKind(fake.GVK(&fake.ModernManaged{})), o...)
+
+			_, err := r.Reconcile(context.Background(), reconcile.Request{})
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("\n%s\nr.Reconcile(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.want, got, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nr.Reconcile(...): -want outcome, +got outcome:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestExternalReconcileError(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		reason string
+		err    error
+		want   xpv1.Condition
+	}{
+		"Unclassified": {
+			reason: "An unclassified error should produce a ReconcileError condition.",
+			err:    errBoom,
+			want:   xpv1.ReconcileError(errBoom),
+		},
+		"Throttled": {
+			reason: "A throttled error should produce a Throttled condition.",
+			err:    errors.Throttled(errBoom, time.Minute),
+			want:   xpv1.Throttled(time.Minute).WithMessage("Requests to the external system are being throttled; retrying after 1m0s: boom"),
+		},
+		"QuotaExceeded": {
+			reason: "A quota exceeded error should produce a QuotaExceeded condition.",
+			err:    errors.QuotaExceeded(errBoom),
+			want:   xpv1.QuotaExceeded("boom"),
+		},
+		"UpstreamUnavailable": {
+			reason: "An upstream unavailable error should produce an UpstreamUnavailable condition.",
+			err:    errors.UpstreamUnavailable(errBoom),
+			want:   xpv1.UpstreamUnavailable("boom"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := externalReconcileError(tc.err)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nexternalReconcileError(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
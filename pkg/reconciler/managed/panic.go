@@ -0,0 +1,164 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+)
+
+// Errors include a stack trace, which is emitted as an event. Events have a
+// maximum length, so longer stack traces are truncated.
+const maxPanicStackLength = 1024
+
+// Error strings.
+const (
+	errFmtPanic = "recovered from panic in %s: %v\n%s"
+)
+
+// Operations on the external system, which may panic or be slow.
+const (
+	opConnect           = "Connect"
+	opObserve           = "Observe"
+	opCreate            = "Create"
+	opUpdate            = "Update"
+	opDelete            = "Delete"
+	opDisconnect        = "Disconnect"
+	opExternalNameInUse = "ExternalNameInUse"
+	opPing              = "Ping"
+)
+
+// A panicRecoveringConnector recovers from panics in an ExternalConnector and
+// the ExternalClients it produces, returning them as errors.
+type panicRecoveringConnector struct {
+	ExternalConnectDisconnector
+
+	kind    schema.GroupVersionKind
+	metrics MetricRecorder
+}
+
+func (c *panicRecoveringConnector) Connect(ctx context.Context, mg resource.Managed) (ec ExternalClient, err error) {
+	defer recoverPanic(c.metrics, c.kind, opConnect, &err)
+
+	ec, err = c.ExternalConnectDisconnector.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &panicRecoveringClient{ExternalClient: ec, kind: c.kind, metrics: c.metrics}, nil
+}
+
+func (c *panicRecoveringConnector) Disconnect(ctx context.Context) (err error) {
+	defer recoverPanic(c.metrics, c.kind, opDisconnect, &err)
+
+	return c.ExternalConnectDisconnector.Disconnect(ctx)
+}
+
+type panicRecoveringClient struct {
+	ExternalClient
+
+	kind    schema.GroupVersionKind
+	metrics MetricRecorder
+}
+
+func (c *panicRecoveringClient) Observe(ctx context.Context, mg resource.Managed) (o ExternalObservation, err error) {
+	defer recoverPanic(c.metrics, c.kind, opObserve, &err)
+	return c.ExternalClient.Observe(ctx, mg)
+}
+
+func (c *panicRecoveringClient) Create(ctx context.Context, mg resource.Managed) (cr ExternalCreation, err error) {
+	defer recoverPanic(c.metrics, c.kind, opCreate, &err)
+	return c.ExternalClient.Create(ctx, mg)
+}
+
+func (c *panicRecoveringClient) Update(ctx context.Context, mg resource.Managed) (u ExternalUpdate, err error) {
+	defer recoverPanic(c.metrics, c.kind, opUpdate, &err)
+	return c.ExternalClient.Update(ctx, mg)
+}
+
+func (c *panicRecoveringClient) Delete(ctx context.Context, mg resource.Managed) (d ExternalDelete, err error) {
+	defer recoverPanic(c.metrics, c.kind, opDelete, &err)
+	return c.ExternalClient.Delete(ctx, mg)
+}
+
+func (c *panicRecoveringClient) Disconnect(ctx context.Context) (err error) {
+	defer recoverPanic(c.metrics, c.kind, opDisconnect, &err)
+	return c.ExternalClient.Disconnect(ctx)
+}
+
+func (c *panicRecoveringClient) ExternalNameInUse(ctx context.Context, mg resource.Managed, name string) (inUse bool, err error) {
+	defer recoverPanic(c.metrics, c.kind, opExternalNameInUse, &err)
+	return externalNameInUse(ctx, c.ExternalClient, mg, name)
+}
+
+func (c *panicRecoveringClient) Ping(ctx context.Context) (err error) {
+	defer recoverPanic(c.metrics, c.kind, opPing, &err)
+	return ping(ctx, c.ExternalClient)
+}
+
+// recoverPanic recovers from a panic, if any, and sets the supplied error to
+// an error that describes it. It must be called directly by defer.
+func recoverPanic(m MetricRecorder, kind schema.GroupVersionKind, op string, err *error) {
+	p := recover()
+	if p == nil {
+		return
+	}
+
+	m.recordPanic(kind, op)
+
+	*err = errors.Errorf(errFmtPanic, op, p, panicStack())
+}
+
+// panicStack returns the stack of the goroutine that panicked, starting from
+// the function that panicked. It must be called by a deferred function that
+// recovered from the panic.
+func panicStack() string {
+	pcs := make([]uintptr, 64)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(0, pcs)])
+
+	b := &strings.Builder{}
+	panicked := false
+
+	for {
+		f, more := frames.Next()
+		if panicked {
+			fmt.Fprintf(b, "%s\n\t%s:%d\n", f.Function, f.File, f.Line)
+		}
+
+		if f.Function == "runtime.gopanic" {
+			panicked = true
+		}
+
+		if !more || b.Len() > maxPanicStackLength {
+			break
+		}
+	}
+
+	s := b.String()
+	if len(s) > maxPanicStackLength {
+		s = s[:maxPanicStackLength]
+	}
+
+	return strings.TrimSpace(s)
+}
@@ -0,0 +1,226 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+)
+
+type panicCountingMetricRecorder struct {
+	*NopMetricRecorder
+
+	panics []string
+}
+
+func (r *panicCountingMetricRecorder) recordPanic(_ schema.GroupVersionKind, op string) {
+	r.panics = append(r.panics, op)
+}
+
+func TestPanicRecovery(t *testing.T) {
+	panicky := func(context.Context) { panic("boom") }
+
+	cases := map[string]struct {
+		reason string
+		call   func(ctx context.Context, c ExternalConnectDisconnector) error
+		op     string
+	}{
+		"Connect": {
+			reason: "A panic while connecting should be returned as an error.",
+			call: func(ctx context.Context, c ExternalConnectDisconnector) error {
+				_, err := c.Connect(ctx, &fake.ModernManaged{ObjectMeta: metav1.ObjectMeta{Name: "panic"}})
+				return err
+			},
+			op: opConnect,
+		},
+		"Observe": {
+			reason: "A panic while observing should be returned as an error.",
+			call: func(ctx context.Context, c ExternalConnectDisconnector) error {
+				ec, _ := c.Connect(ctx, &fake.ModernManaged{})
+				_, err := ec.Observe(ctx, &fake.ModernManaged{})
+
+				return err
+			},
+			op: opObserve,
+		},
+		"Create": {
+			reason: "A panic while creating should be returned as an error.",
+			call: func(ctx context.Context, c ExternalConnectDisconnector) error {
+				ec, _ := c.Connect(ctx, &fake.ModernManaged{})
+				_, err := ec.Create(ctx, &fake.ModernManaged{})
+
+				return err
+			},
+			op: opCreate,
+		},
+		"Update": {
+			reason: "A panic while updating should be returned as an error.",
+			call: func(ctx context.Context, c ExternalConnectDisconnector) error {
+				ec, _ := c.Connect(ctx, &fake.ModernManaged{})
+				_, err := ec.Update(ctx, &fake.ModernManaged{})
+
+				return err
+			},
+			op: opUpdate,
+		},
+		"Delete": {
+			reason: "A panic while deleting should be returned as an error.",
+			call: func(ctx context.Context, c ExternalConnectDisconnector) error {
+				ec, _ := c.Connect(ctx, &fake.ModernManaged{})
+				_, err := ec.Delete(ctx, &fake.ModernManaged{})
+
+				return err
+			},
+			op: opDelete,
+		},
+		"Disconnect": {
+			reason: "A panic while disconnecting should be returned as an error.",
+			call: func(ctx context.Context, c ExternalConnectDisconnector) error {
+				ec, _ := c.Connect(ctx, &fake.ModernManaged{})
+				return ec.Disconnect(ctx)
+			},
+			op: opDisconnect,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			m := &panicCountingMetricRecorder{NopMetricRecorder: NewNopMetricRecorder()}
+			c := &panicRecoveringConnector{
+				ExternalConnectDisconnector: NewNopDisconnector(ExternalConnectorFn(func(ctx context.Context, mg resource.Managed) (ExternalClient, error) {
+					if mg.GetName() == "panic" {
+						panicky(ctx)
+					}
+
+					return &ExternalClientFns{
+						ObserveFn: func(ctx context.Context, _ resource.Managed) (ExternalObservation, error) {
+							panicky(ctx)
+							return ExternalObservation{}, nil
+						},
+						CreateFn: func(ctx context.Context, _ resource.Managed) (ExternalCreation, error) {
+							panicky(ctx)
+							return ExternalCreation{}, nil
+						},
+						UpdateFn: func(ctx context.Context, _ resource.Managed) (ExternalUpdate, error) {
+							panicky(ctx)
+							return ExternalUpdate{}, nil
+						},
+						DeleteFn: func(ctx context.Context, _ resource.Managed) (ExternalDelete, error) {
+							panicky(ctx)
+							return ExternalDelete{}, nil
+						},
+						DisconnectFn: func(ctx context.Context) error {
+							panicky(ctx)
+							return nil
+						},
+					}, nil
+				})),
+				metrics: m,
+			}
+
+			err := tc.call(context.Background(), c)
+			if err == nil {
+				t.Fatalf("\n%s\n%s(...): want error, got nil", tc.reason, tc.op)
+			}
+
+			if !strings.HasPrefix(err.Error(), "recovered from panic in "+tc.op+": boom\n") {
+				t.Errorf("\n%s\n%s(...): want panic error, got %q", tc.reason, tc.op, err)
+			}
+
+			// The stack trace should start at the function that panicked.
+			if !strings.Contains(strings.SplitN(err.Error(), "\n", 3)[1], "TestPanicRecovery") {
+				t.Errorf("\n%s\n%s(...): want stack trace starting at the function that panicked, got %q", tc.reason, tc.op, err)
+			}
+
+			if diff := cmp.Diff([]string{tc.op}, m.panics); diff != "" {
+				t.Errorf("\n%s\n%s(...): -want recorded panics, +got recorded panics:\n%s", tc.reason, tc.op, diff)
+			}
+		})
+	}
+}
+
+func TestReconcilerPanicRecovery(t *testing.T) {
+	m := &fake.Manager{
+		Client: &test.MockClient{
+			MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+				asModernManaged(obj, 42)
+				return nil
+			}),
+			MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+		},
+		Scheme: fake.SchemeWith(&fake.ModernManaged{}),
+	}
+
+	r := NewReconciler(m, resource.ManagedKind(fake.GVK(&fake.ModernManaged{})),
+		WithPanicRecovery(),
+		WithInitializers(),
+		WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+		WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+		WithExternalConnector(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+			return &ExternalClientFns{
+				ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+					var o *ExternalObservation
+					return *o, nil //nolint:nilness // We want to panic.
+				},
+				DisconnectFn: func(_ context.Context) error { return nil },
+			}, nil
+		})),
+	)
+
+	got, err := r.Reconcile(context.Background(), reconcile.Request{})
+	if err != nil {
+		t.Errorf("r.Reconcile(...): want no error, got %v", err)
+	}
+
+	if diff := cmp.Diff(reconcile.Result{Requeue: true}, got); diff != "" {
+		t.Errorf("r.Reconcile(...): a panic should be treated like any other error observing: -want, +got:\n%s", diff)
+	}
+}
+
+func TestPanicRecoveringClientForwards(t *testing.T) {
+	errUnhealthy := errors.New("unhealthy")
+
+	var ec ExternalClient = &panicRecoveringClient{ExternalClient: &optionalClient{
+		inUse: func(name string) (bool, error) { return name == "taken", nil },
+		ping:  func(_ context.Context) error { return errUnhealthy },
+	}}
+
+	checker, ok := ec.(ExternalNameCollisionChecker)
+	if !ok {
+		t.Fatalf("panicRecoveringClient: want a client that forwards ExternalNameCollisionChecker")
+	}
+
+	if inUse, _ := checker.ExternalNameInUse(context.Background(), &fake.ModernManaged{}, "taken"); !inUse {
+		t.Errorf("ExternalNameInUse(...): want the wrapped client's answer")
+	}
+
+	if err := ec.(Pinger).Ping(context.Background()); !errors.Is(err, errUnhealthy) {
+		t.Errorf("Ping(...): want the wrapped client's error, got %v", err)
+	}
+}
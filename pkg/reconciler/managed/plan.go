@@ -0,0 +1,178 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"encoding/json"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+)
+
+// PlanConfigMapKey is the key of the ConfigMap data a ConfigMapPlanRecorder
+// writes plans to.
+const PlanConfigMapKey = "plan.json"
+
+const (
+	errGetPlanKind    = "cannot determine the kind of managed resource"
+	errMarshalPlan    = "cannot marshal plan"
+	errApplyPlan      = "cannot apply plan ConfigMap"
+	errNoPlanLocation = "cannot record plan for a cluster scoped managed resource without a namespace"
+)
+
+// A PlanAction is an action the Reconciler would take on an external resource.
+type PlanAction string
+
+// Plan actions.
+const (
+	// PlanActionNone indicates the Reconciler would not change the external
+	// resource.
+	PlanActionNone PlanAction = "None"
+
+	// PlanActionCreate indicates the Reconciler would create the external
+	// resource.
+	PlanActionCreate PlanAction = "Create"
+
+	// PlanActionUpdate indicates the Reconciler would update the external
+	// resource.
+	PlanActionUpdate PlanAction = "Update"
+
+	// PlanActionDelete indicates the Reconciler would delete the external
+	// resource.
+	PlanActionDelete PlanAction = "Delete"
+)
+
+// A Plan describes what the Reconciler would do to a managed resource's
+// external resource.
+type Plan struct {
+	// Action the Reconciler would take.
+	Action PlanAction `json:"action"`
+
+	// Diff between the desired and observed state of the external resource,
+	// as reported by the ExternalClient. Only set for updates.
+	Diff string `json:"diff,omitempty"`
+
+	// ObservedGeneration is the generation of the managed resource that was
+	// planned.
+	ObservedGeneration int64 `json:"observedGeneration"`
+
+	// Time at which the plan was made.
+	Time metav1.Time `json:"time"`
+}
+
+// A PlanRecorder records plans.
+type PlanRecorder interface {
+	RecordPlan(ctx context.Context, mg resource.Managed, p Plan) error
+}
+
+// A PlanRecorderFn is a function that satisfies the PlanRecorder interface.
+type PlanRecorderFn func(ctx context.Context, mg resource.Managed, p Plan) error
+
+// RecordPlan calls PlanRecorderFn.
+func (fn PlanRecorderFn) RecordPlan(ctx context.Context, mg resource.Managed, p Plan) error {
+	return fn(ctx, mg, p)
+}
+
+// A NopPlanRecorder does nothing.
+type NopPlanRecorder struct{}
+
+// RecordPlan does nothing.
+func (NopPlanRecorder) RecordPlan(_ context.Context, _ resource.Managed, _ Plan) error {
+	return nil
+}
+
+// A ConfigMapPlanRecorder records plans as JSON in a ConfigMap controlled by
+// the planned managed resource. The ConfigMap is named PlanConfigMapName, and
+// is written to the managed resource's namespace.
+type ConfigMapPlanRecorder struct {
+	client    client.Client
+	applier   resource.Applicator
+	namespace string
+}
+
+// NewConfigMapPlanRecorder returns a PlanRecorder that records plans in
+// ConfigMaps. Plans for cluster scoped managed resources are written to the
+// supplied namespace.
+func NewConfigMapPlanRecorder(c client.Client, namespace string) *ConfigMapPlanRecorder {
+	return &ConfigMapPlanRecorder{client: c, applier: resource.NewAPIPatchingApplicator(c), namespace: namespace}
+}
+
+// PlanConfigMapName returns the name of the ConfigMap a ConfigMapPlanRecorder
+// writes the supplied managed resource's plan to.
+func PlanConfigMapName(mg resource.Managed) string {
+	return "plan-" + string(mg.GetUID())
+}
+
+// RecordPlan records the supplied plan in a ConfigMap.
+func (r *ConfigMapPlanRecorder) RecordPlan(ctx context.Context, mg resource.Managed, p Plan) error {
+	ns := mg.GetNamespace()
+	if ns == "" {
+		ns = r.namespace
+	}
+
+	if ns == "" {
+		return errors.New(errNoPlanLocation)
+	}
+
+	kind, err := resource.GetKind(mg, r.client.Scheme())
+	if err != nil {
+		return errors.Wrap(err, errGetPlanKind)
+	}
+
+	j, err := json.Marshal(p)
+	if err != nil {
+		return errors.Wrap(err, errMarshalPlan)
+	}
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       ns,
+			Name:            PlanConfigMapName(mg),
+			OwnerReferences: []metav1.OwnerReference{meta.AsController(meta.TypedReferenceTo(mg, kind))},
+		},
+		Data: map[string]string{PlanConfigMapKey: string(j)},
+	}
+
+	return errors.Wrap(r.applier.Apply(ctx, cm, resource.MustBeControllableBy(mg.GetUID())), errApplyPlan)
+}
+
+// planAction returns the action the Reconciler would take given the supplied
+// policy, managed resource, and observation of its external resource.
+func planAction(policy ManagementPoliciesChecker, mg resource.Managed, o ExternalObservation) PlanAction {
+	switch {
+	case meta.WasDeleted(mg):
+		if o.ResourceExists && policy.ShouldDelete() {
+			return PlanActionDelete
+		}
+	case !o.ResourceExists:
+		if policy.ShouldCreate() {
+			return PlanActionCreate
+		}
+	case !o.ResourceUpToDate:
+		if policy.ShouldUpdate() {
+			return PlanActionUpdate
+		}
+	}
+
+	return PlanActionNone
+}
@@ -0,0 +1,283 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+)
+
+var _ PlanRecorder = &ConfigMapPlanRecorder{}
+
+func TestReconcilePlan(t *testing.T) {
+	errBoom := errors.New("boom")
+	now := metav1.Now()
+
+	type args struct {
+		deleted     bool
+		observation ExternalObservation
+		record      error
+	}
+
+	type want struct {
+		plan   *Plan
+		result reconcile.Result
+		err    error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"Create": {
+			reason: "We should plan to create an external resource that doesn't exist.",
+			args: args{
+				observation: ExternalObservation{ResourceExists: false},
+			},
+			want: want{
+				plan:   &Plan{Action: PlanActionCreate, ObservedGeneration: 42},
+				result: reconcile.Result{RequeueAfter: defaultPollInterval},
+			},
+		},
+		"Update": {
+			reason: "We should plan to update an external resource that isn't up to date, and include the diff.",
+			args: args{
+				observation: ExternalObservation{ResourceExists: true, Diff: "-size: 1\n+size: 2"},
+			},
+			want: want{
+				plan:   &Plan{Action: PlanActionUpdate, Diff: "-size: 1\n+size: 2", ObservedGeneration: 42},
+				result: reconcile.Result{RequeueAfter: defaultPollInterval},
+			},
+		},
+		"Delete": {
+			reason: "We should plan to delete the external resource of a deleted managed resource.",
+			args: args{
+				deleted:     true,
+				observation: ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+			want: want{
+				plan:   &Plan{Action: PlanActionDelete, ObservedGeneration: 42},
+				result: reconcile.Result{RequeueAfter: defaultPollInterval},
+			},
+		},
+		"None": {
+			reason: "We should plan to do nothing to an external resource that is up to date.",
+			args: args{
+				observation: ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+			want: want{
+				plan:   &Plan{Action: PlanActionNone, ObservedGeneration: 42},
+				result: reconcile.Result{RequeueAfter: defaultPollInterval},
+			},
+		},
+		"RecordError": {
+			reason: "We should requeue if we can't record the plan.",
+			args: args{
+				observation: ExternalObservation{ResourceExists: false},
+				record:      errBoom,
+			},
+			want: want{
+				plan:   &Plan{Action: PlanActionCreate, ObservedGeneration: 42},
+				result: reconcile.Result{Requeue: true},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					mg := asModernManaged(obj, 42)
+					mg.SetAnnotations(map[string]string{meta.AnnotationKeyPlan: "true"})
+					if tc.args.deleted {
+						mg.SetDeletionTimestamp(&now)
+					}
+					return nil
+				}),
+				MockUpdate:       test.NewMockUpdateFn(errBoom),
+				MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+			}
+			m := &fake.Manager{Client: c, Scheme: fake.SchemeWith(&fake.ModernManaged{})}
+
+			var got *Plan
+
+			r := NewReconciler(m, resource.ManagedKind(fake.GVK(&fake.ModernManaged{})),
+				WithInitializers(),
+				WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+				WithExternalConnector(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+					return &ExternalClientFns{
+						ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+							return tc.args.observation, nil
+						},
+						CreateFn: func(_ context.Context, _ resource.Managed) (ExternalCreation, error) {
+							t.Errorf("Create(...): want no external operations while planning")
+							return ExternalCreation{}, nil
+						},
+						UpdateFn: func(_ context.Context, _ resource.Managed) (ExternalUpdate, error) {
+							t.Errorf("Update(...): want no external operations while planning")
+							return ExternalUpdate{}, nil
+						},
+						DeleteFn: func(_ context.Context, _ resource.Managed) (ExternalDelete, error) {
+							t.Errorf("Delete(...): want no external operations while planning")
+							return ExternalDelete{}, nil
+						},
+						DisconnectFn: func(_ context.Context) error { return nil },
+					}, nil
+				})),
+				WithPlanRecorder(PlanRecorderFn(func(_ context.Context, _ resource.Managed, p Plan) error {
+					got = &p
+					return tc.args.record
+				})),
+			)
+
+			result, err := r.Reconcile(context.Background(), reconcile.Request{})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nr.Reconcile(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.want.result, result); diff != "" {
+				t.Errorf("\n%s\nr.Reconcile(...): -want result, +got result:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.want.plan, got, cmpopts.IgnoreFields(Plan{}, "Time")); diff != "" {
+				t.Errorf("\n%s\nRecordPlan(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestConfigMapPlanRecorder(t *testing.T) {
+	errBoom := errors.New("boom")
+	now := metav1.NewTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	p := Plan{Action: PlanActionUpdate, Diff: "-a\n+b", ObservedGeneration: 3, Time: now}
+
+	j, _ := json.Marshal(p)
+
+	type args struct {
+		c         client.Client
+		namespace string
+		mg        resource.Managed
+	}
+
+	type want struct {
+		cm  *corev1.ConfigMap
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"Namespaced": {
+			reason: "We should write the plan of a namespaced managed resource to its namespace.",
+			args: args{
+				mg: &fake.ModernManaged{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "cool", UID: "cool-uid"}},
+			},
+			want: want{
+				cm: &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "plan-cool-uid"},
+					Data:       map[string]string{PlanConfigMapKey: string(j)},
+				},
+			},
+		},
+		"ClusterScoped": {
+			reason: "We should write the plan of a cluster scoped managed resource to the configured namespace.",
+			args: args{
+				namespace: "crossplane-system",
+				mg:        &fake.ModernManaged{ObjectMeta: metav1.ObjectMeta{Name: "cool", UID: "cool-uid"}},
+			},
+			want: want{
+				cm: &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "crossplane-system", Name: "plan-cool-uid"},
+					Data:       map[string]string{PlanConfigMapKey: string(j)},
+				},
+			},
+		},
+		"NoNamespace": {
+			reason: "We should return an error if we don't know where to write the plan of a cluster scoped managed resource.",
+			args: args{
+				mg: &fake.ModernManaged{ObjectMeta: metav1.ObjectMeta{Name: "cool", UID: "cool-uid"}},
+			},
+			want: want{
+				err: errors.New(errNoPlanLocation),
+			},
+		},
+		"ApplyError": {
+			reason: "We should return any error encountered applying the ConfigMap.",
+			args: args{
+				c: &test.MockClient{
+					MockGet:    test.NewMockGetFn(errBoom),
+					MockScheme: test.NewMockSchemeFn(fake.SchemeWith(&fake.ModernManaged{})),
+				},
+				mg: &fake.ModernManaged{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "cool", UID: "cool-uid"}},
+			},
+			want: want{
+				err: errors.Wrap(errors.Wrap(errBoom, "cannot get object"), errApplyPlan),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got *corev1.ConfigMap
+
+			c := tc.args.c
+			if c == nil {
+				c = &test.MockClient{
+					MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{Resource: "configmaps"}, "plan-cool-uid")),
+					MockCreate: test.NewMockCreateFn(nil, func(obj client.Object) error {
+						got = obj.(*corev1.ConfigMap)
+						return nil
+					}),
+					MockScheme: test.NewMockSchemeFn(fake.SchemeWith(&fake.ModernManaged{})),
+				}
+			}
+
+			err := NewConfigMapPlanRecorder(c, tc.args.namespace).RecordPlan(context.Background(), tc.args.mg, p)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nRecordPlan(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.want.cm, got, cmpopts.IgnoreFields(metav1.ObjectMeta{}, "OwnerReferences")); diff != "" {
+				t.Errorf("\n%s\nRecordPlan(...): -want, +got:\n%s", tc.reason, diff)
+			}
+
+			if got != nil && got.GetOwnerReferences()[0].UID != tc.args.mg.GetUID() {
+				t.Errorf("\n%s\nRecordPlan(...): want ConfigMap controlled by the managed resource", tc.reason)
+			}
+		})
+	}
+}
@@ -145,6 +145,14 @@ func NewLegacyManagementPoliciesResolver(managementPolicyEnabled bool, managemen
 	return &LegacyManagementPoliciesResolver{r, deletionPolicy}
 }
 
+// ValidateManagementPolicies returns an error if the supplied management
+// policies are not valid, using the same rules as the Reconciler. It may be
+// used to reject invalid management policies before they're reconciled, for
+// example by an admission webhook.
+func ValidateManagementPolicies(managementPolicyEnabled bool, managementPolicy xpv1.ManagementPolicies, o ...ManagementPoliciesResolverOption) error {
+	return NewManagementPoliciesResolver(managementPolicyEnabled, managementPolicy, o...).Validate()
+}
+
 // Validate checks if the management policy is valid.
 // If the management policy feature is disabled, but uses a non-default value,
 // it returns an error.
@@ -0,0 +1,67 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+)
+
+// WithPollIntervalBounds specifies the minimum and maximum poll interval a
+// managed resource may configure for itself via its spec. Configured poll
+// intervals outside these bounds are clamped to them. A zero bound is
+// ignored.
+func WithPollIntervalBounds(minimum, maximum time.Duration) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.minPollInterval = minimum
+		r.maxPollInterval = maximum
+	}
+}
+
+// pollIntervalFor returns how long to wait before polling the supplied
+// managed resource again. A poll interval configured by the managed resource
+// takes precedence over one hinted by its kind, which in turn takes
+// precedence over the reconciler's default. The result is processed by the
+// reconciler's PollIntervalHook.
+func (r *Reconciler) pollIntervalFor(mg resource.Managed) time.Duration {
+	interval := r.pollInterval
+
+	if h, ok := mg.(resource.PollIntervalHinter); ok && h.GetPollIntervalHint() > 0 {
+		interval = h.GetPollIntervalHint()
+	}
+
+	if c, ok := mg.(resource.PollIntervalConfigurator); ok {
+		if d := c.GetPollInterval(); d != nil && d.Duration > 0 {
+			interval = r.clampPollInterval(d.Duration)
+		}
+	}
+
+	return r.pollIntervalHook(mg, interval)
+}
+
+func (r *Reconciler) clampPollInterval(d time.Duration) time.Duration {
+	if r.minPollInterval > 0 && d < r.minPollInterval {
+		return r.minPollInterval
+	}
+
+	if r.maxPollInterval > 0 && d > r.maxPollInterval {
+		return r.maxPollInterval
+	}
+
+	return d
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+)
+
+var _ resource.PollIntervalHinter = &hintedManaged{}
+
+type hintedManaged struct {
+	fake.ModernManaged
+
+	hint time.Duration
+}
+
+func (m *hintedManaged) GetPollIntervalHint() time.Duration { return m.hint }
+
+var _ resource.PollIntervalConfigurator = &configuredManaged{}
+
+type configuredManaged struct {
+	hintedManaged
+
+	interval *metav1.Duration
+}
+
+func (m *configuredManaged) GetPollInterval() *metav1.Duration  { return m.interval }
+func (m *configuredManaged) SetPollInterval(d *metav1.Duration) { m.interval = d }
+
+func TestPollIntervalFor(t *testing.T) {
+	type args struct {
+		mg resource.Managed
+		o  []ReconcilerOption
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   time.Duration
+	}{
+		"ReconcilerDefault": {
+			reason: "The reconciler's poll interval should be used when the kind does not hint at one.",
+			args: args{
+				mg: &fake.ModernManaged{},
+				o:  []ReconcilerOption{WithPollInterval(2 * time.Minute)},
+			},
+			want: 2 * time.Minute,
+		},
+		"KindHint": {
+			reason: "A poll interval hinted by the kind should take precedence over the reconciler's poll interval.",
+			args: args{
+				mg: &hintedManaged{hint: 10 * time.Minute},
+				o:  []ReconcilerOption{WithPollInterval(2 * time.Minute)},
+			},
+			want: 10 * time.Minute,
+		},
+		"ZeroKindHint": {
+			reason: "A zero poll interval hint should be ignored.",
+			args: args{
+				mg: &hintedManaged{},
+				o:  []ReconcilerOption{WithPollInterval(2 * time.Minute)},
+			},
+			want: 2 * time.Minute,
+		},
+		"KindHintWithHook": {
+			reason: "A poll interval hinted by the kind should be processed by the poll interval hook.",
+			args: args{
+				mg: &hintedManaged{hint: 10 * time.Minute},
+				o: []ReconcilerOption{WithPollIntervalHook(func(_ resource.Managed, pollInterval time.Duration) time.Duration {
+					return 2 * pollInterval
+				})},
+			},
+			want: 20 * time.Minute,
+		},
+		"SpecOverride": {
+			reason: "A poll interval configured by the managed resource should take precedence over the kind's hint.",
+			args: args{
+				mg: &configuredManaged{hintedManaged: hintedManaged{hint: 10 * time.Minute}, interval: &metav1.Duration{Duration: 5 * time.Minute}},
+				o:  []ReconcilerOption{WithPollInterval(2 * time.Minute)},
+			},
+			want: 5 * time.Minute,
+		},
+		"ZeroSpecOverride": {
+			reason: "A zero poll interval configured by the managed resource should be ignored.",
+			args: args{
+				mg: &configuredManaged{hintedManaged: hintedManaged{hint: 10 * time.Minute}, interval: &metav1.Duration{}},
+				o:  []ReconcilerOption{WithPollInterval(2 * time.Minute)},
+			},
+			want: 10 * time.Minute,
+		},
+		"SpecOverrideBelowMinimum": {
+			reason: "A poll interval configured by the managed resource should be clamped to the minimum.",
+			args: args{
+				mg: &configuredManaged{interval: &metav1.Duration{Duration: time.Second}},
+				o:  []ReconcilerOption{WithPollIntervalBounds(30*time.Second, time.Hour)},
+			},
+			want: 30 * time.Second,
+		},
+		"SpecOverrideAboveMaximum": {
+			reason: "A poll interval configured by the managed resource should be clamped to the maximum.",
+			args: args{
+				mg: &configuredManaged{interval: &metav1.Duration{Duration: 24 * time.Hour}},
+				o:  []ReconcilerOption{WithPollIntervalBounds(30*time.Second, time.Hour)},
+			},
+			want: time.Hour,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			m := &fake.Manager{Scheme: fake.SchemeWith(&fake.ModernManaged{})}
+			r := NewReconciler(m, resource.ManagedKind(fake.GVK(&fake.ModernManaged{})), tc.args.o...)
+
+			got := r.pollIntervalFor(tc.args.mg)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nr.pollIntervalFor(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestReconcilePollIntervalFromSpec(t *testing.T) {
+	c := &test.MockClient{
+		MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+			mg := asModernManaged(obj, 42)
+			mg.SetPollInterval(&metav1.Duration{Duration: 5 * time.Minute})
+
+			return nil
+		}),
+		MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+	}
+	m := &fake.Manager{Client: c, Scheme: fake.SchemeWith(&fake.ModernManaged{})}
+
+	r := NewReconciler(m, resource.ManagedKind(fake.GVK(&fake.ModernManaged{})),
+		WithPollInterval(time.Minute),
+		WithInitializers(),
+		WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+		WithExternalConnector(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+			return &ExternalClientFns{
+				ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+					return ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+				},
+				DisconnectFn: func(_ context.Context) error { return nil },
+			}, nil
+		})),
+		WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+	)
+
+	got, err := r.Reconcile(context.Background(), reconcile.Request{})
+	if err != nil {
+		t.Fatalf("r.Reconcile(...): unexpected error: %v", err)
+	}
+
+	if diff := cmp.Diff(reconcile.Result{RequeueAfter: 5 * time.Minute}, got); diff != "" {
+		t.Errorf("r.Reconcile(...): -want result, +got result:\n%s", diff)
+	}
+}
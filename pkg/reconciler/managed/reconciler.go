@@ -18,13 +18,19 @@ package managed
 
 import (
 	"context"
+	"maps"
 	"math/rand"
 	"strings"
 	"time"
 
+	"github.com/google/uuid"
+	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -37,7 +43,9 @@ import (
 	"github.com/crossplane/crossplane-runtime/v2/pkg/feature"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconcilecontext"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	umanaged "github.com/crossplane/crossplane-runtime/v2/pkg/resource/unstructured/managed"
 )
 
 const (
@@ -50,6 +58,8 @@ const (
 
 	defaultPollInterval = 1 * time.Minute
 	defaultGracePeriod  = 30 * time.Second
+
+	defaultBeingDeletedPollInterval = 10 * time.Second
 )
 
 // Error strings.
@@ -66,10 +76,15 @@ const (
 	errReconcileUpdate          = "update failed"
 	errReconcileDelete          = "delete failed"
 	errRecordChangeLog          = "cannot record change log entry"
+	errPersistDriftUpdates      = "cannot persist consecutive drift updates"
+	errRecordPlan               = "cannot record plan"
 
 	errExternalResourceNotExist = "external resource does not exist"
 
 	errManagedNotImplemented = "managed resource does not implement connection details"
+	errFmtNotManaged         = "kind %v is not a managed resource"
+
+	errFmtPausedTooLong = "reconciliation has been paused for %s - remove the " + meta.AnnotationKeyReconciliationPaused + " annotation or update spec.managementPolicies to resume it"
 )
 
 // Event reasons.
@@ -85,14 +100,30 @@ const (
 	reasonCannotUnpublish         event.Reason = "CannotUnpublishConnectionDetails"
 	reasonCannotUpdate            event.Reason = "CannotUpdateExternalResource"
 	reasonCannotUpdateManaged     event.Reason = "CannotUpdateManagedResource"
+	reasonCannotPlan              event.Reason = "CannotPlanExternalResource"
+	reasonInvalidExternalName     event.Reason = "InvalidExternalName"
 	reasonManagementPolicyInvalid event.Reason = "CannotUseInvalidManagementPolicy"
 
 	reasonDeleted event.Reason = "DeletedExternalResource"
 	reasonCreated event.Reason = "CreatedExternalResource"
 	reasonUpdated event.Reason = "UpdatedExternalResource"
 	reasonPending event.Reason = "PendingExternalResource"
+	reasonPlanned event.Reason = "PlannedExternalResource"
+	reasonBlocked event.Reason = "DeletionBlockedByDependents"
+	reasonProtect event.Reason = "DeletionProtected"
+
+	reasonDeferred event.Reason = "CreationDeferred"
+	reasonDenied   event.Reason = "CreationDenied"
+
+	reasonDriftLoopDetected event.Reason = "DriftLoopDetected"
 
-	reasonReconciliationPaused event.Reason = "ReconciliationPaused"
+	reasonResolvedRefs event.Reason = "ResolvedReferences"
+
+	reasonReconciliationPaused        event.Reason = "ReconciliationPaused"
+	reasonReconciliationPausedTooLong event.Reason = "ReconciliationPausedTooLong"
+
+	reasonDebugging event.Reason = "Debugging"
+	reasonDebugDiff event.Reason = "ExternalResourceDiff"
 )
 
 // ControllerName returns the recommended name for controllers that use this
@@ -139,6 +170,20 @@ func (fn CriticalAnnotationUpdateFn) UpdateCriticalAnnotations(ctx context.Conte
 	return fn(ctx, o)
 }
 
+// A SpecPersister persists the spec of a managed resource whose fields were
+// late initialized when its external resource was observed.
+type SpecPersister interface {
+	PersistSpec(ctx context.Context, mg resource.Managed) error
+}
+
+// A SpecPersisterFn is a function that satisfies the SpecPersister interface.
+type SpecPersisterFn func(ctx context.Context, mg resource.Managed) error
+
+// PersistSpec of the supplied managed resource.
+func (fn SpecPersisterFn) PersistSpec(ctx context.Context, mg resource.Managed) error {
+	return fn(ctx, mg)
+}
+
 // ConnectionDetails created or updated during an operation on an external
 // resource, for example usernames, passwords, endpoints, ports, etc.
 type ConnectionDetails map[string][]byte
@@ -219,6 +264,12 @@ type Initializer interface {
 	Initialize(ctx context.Context, mg resource.Managed) error
 }
 
+// ErrReassigned may be returned, optionally wrapped, by an Initializer to
+// indicate that it reassigned the managed resource to another controller, for
+// example another shard. The Reconciler stops reconciling the managed resource
+// without reporting an error.
+var ErrReassigned = errors.New("managed resource was reassigned to another controller")
+
 // A InitializerChain chains multiple managed initializers.
 type InitializerChain []Initializer
 
@@ -261,6 +312,17 @@ func (m ReferenceResolverFn) ResolveReferences(ctx context.Context, mg resource.
 	return m(ctx, mg)
 }
 
+// A ReportingReferenceResolver resolves references to other managed resources,
+// and reports the result. The Reconciler uses ResolveReferencesWithResult in
+// place of ResolveReferences if its ReferenceResolver is also a
+// ReportingReferenceResolver.
+type ReportingReferenceResolver interface {
+	// ResolveReferencesWithResult resolves all fields in the supplied managed
+	// resource that are references to other managed resources, and reports
+	// the resources they resolved to.
+	ResolveReferencesWithResult(ctx context.Context, mg resource.Managed) (ReferenceResolution, error)
+}
+
 // An ExternalConnector produces a new ExternalClient given the supplied
 // Managed resource.
 type ExternalConnector = TypedExternalConnector[resource.Managed]
@@ -526,6 +588,51 @@ type ExternalObservation struct {
 	// finding where the observed diverges from the desired state.
 	// The string should be a cmp.Diff that details the difference.
 	Diff string
+
+	// DiffPaths are the paths of the managed resource's fields whose desired
+	// state differs from the observed state of the external resource, for
+	// example spec.forProvider.desiredCapacity. They're optional. If they're
+	// reported and all of them are ignored by the managed resource's
+	// ignore changes annotation, Crossplane doesn't update the external
+	// resource. See IsChangeIgnored.
+	DiffPaths []string
+
+	// ContentHash is an opaque hash of the observed external resource, for
+	// example an HTTP ETag. If the reconciler is configured with an
+	// ObservationCache it stores the content hash and supplies it to the next
+	// observation. See ContentHashFrom.
+	ContentHash string
+
+	// NotModified should be true if the external resource has not changed
+	// since the observation that returned the content hash supplied to
+	// Observe. The reconciler skips late initialization, connection
+	// publishing, and update logic for an external resource that is not
+	// modified.
+	NotModified bool
+
+	// ObservedState is the observed state of the external resource, for
+	// example the managed resource's status.atProvider. It's optional. If the
+	// reconciler is configured to hash observed state it records a hash of
+	// the observed state's JSON encoding in the managed resource's status.
+	// See WithObservedStateHashes.
+	ObservedState any
+
+	// ResourceBeingDeleted should be true if the external resource exists,
+	// but is being deleted, for example because it was deleted outside of
+	// Crossplane, or because deleting it takes a while. ResourceExists should
+	// remain true until the external resource no longer exists. Crossplane
+	// won't update or delete an external resource that is being deleted, and
+	// polls it at the being deleted poll interval until it no longer exists.
+	ResourceBeingDeleted bool
+
+	// AccessDenied should be set if the external resource was observed, but
+	// the external client lacks the permission or quota to fully manage it,
+	// for example because it may only read part of the external resource.
+	// Crossplane treats such a managed resource as if its management policies
+	// were observe only, except that it still deletes the external resource.
+	// The error explains what access is missing, and is reported using the
+	// managed resource's Degraded condition.
+	AccessDenied error
 }
 
 // An ExternalCreation is the result of the creation of an external resource.
@@ -569,16 +676,47 @@ type ExternalDelete struct {
 // for which it is responsible.
 type Reconciler struct {
 	client     client.Client
+	kind       schema.GroupVersionKind
 	newManaged func() resource.Managed
 
 	pollInterval     time.Duration
 	pollIntervalHook PollIntervalHook
+	requeueHook      RequeueHook
+	minPollInterval  time.Duration
+	maxPollInterval  time.Duration
+
+	beingDeletedPollInterval time.Duration
+	pausedWarnAfter          time.Duration
+	debugDuration            time.Duration
+
+	updateSkipPredicate UpdateSkipPredicate
+	observations        ObservationCache
+	hashObservedState   bool
+	operationDetails    OperationDetailsRecorder
+	plans               PlanRecorder
+	deletionGuard       DeletionGuard
+	creationGate        CreationGate
+	deprecations        DeprecationNotifier
+	finalizerName       string
+	finalizers          []string
+	auditor             ReconcileOutcomeObserver
+	auditOptions        []AuditAnnotatorOption
+	updateCooldown      *updateCooldown
+	references          *referenceResolutions
+	referencesCondition bool
+	driftLoops          *driftLoopDetector
+	externalNames       ExternalNameGenerator
+	nameConstraints     *ExternalNameConstraints
+	middleware          []StageMiddleware
+	stages              []StageFn
 
 	timeout             time.Duration
 	creationGracePeriod time.Duration
 
 	features feature.Flags
 
+	clock clock.PassiveClock
+
 	// The below structs embed the set of interfaces used to implement the
 	// managed resource reconciler. We do this primarily for readability, so
 	// that the reconciler logic reads r.external.Connect(),
@@ -593,8 +731,17 @@ type Reconciler struct {
 	log                       logging.Logger
 	record                    event.Recorder
 	metricRecorder            MetricRecorder
+	outcomeObserver           ReconcileOutcomeObserver
+	policyObserver            PolicyDecisionObserver
 	change                    ChangeLogger
 	deterministicExternalName bool
+	panicRecovery             bool
+	reconnectOnUnauthorized   bool
+	deadlineOverrunPercent    int
+	faultInjection            func(c ExternalConnectDisconnector) ExternalConnectDisconnector
+	deadlines                 *deadlineTracker
+	statusGracePeriod         time.Duration
+	criticalAnnotations       []string
 }
 
 type mrManaged struct {
@@ -604,17 +751,43 @@ type mrManaged struct {
 	Initializer
 	ReferenceResolver
 	LocalConnectionPublisher
+	SpecPersister
+
+	connectionSecretOptions []ConnectionSecretOption
 }
 
-func defaultMRManaged(m manager.Manager) mrManaged {
-	return mrManaged{
-		CriticalAnnotationUpdater: NewRetryingCriticalAnnotationUpdater(m.GetClient()),
-		Finalizer:                 resource.NewAPIFinalizer(m.GetClient(), FinalizerName),
-		Initializer:               NewNameAsExternalName(m.GetClient()),
-		ReferenceResolver:         NewAPISimpleReferenceResolver(m.GetClient()),
-		ConnectionPublisher:       NewAPISecretPublisher(m.GetClient(), m.GetScheme()),
-		LocalConnectionPublisher:  NewAPILocalSecretPublisher(m.GetClient(), m.GetScheme()),
+// withDefaults returns a copy of m with any unset fields set to defaults that
+// use the supplied client.
+func (m mrManaged) withDefaults(c client.Client, s *runtime.Scheme) mrManaged {
+	if m.CriticalAnnotationUpdater == nil {
+		m.CriticalAnnotationUpdater = NewRetryingCriticalAnnotationUpdater(c)
+	}
+
+	if m.Finalizer == nil {
+		m.Finalizer = resource.NewAPIFinalizer(c, FinalizerName)
+	}
+
+	if m.Initializer == nil {
+		m.Initializer = NewNameAsExternalName(c)
+	}
+
+	if m.ReferenceResolver == nil {
+		m.ReferenceResolver = NewAPISimpleReferenceResolver(c)
+	}
+
+	if m.SpecPersister == nil {
+		m.SpecPersister = NewAPISpecPersister(c)
+	}
+
+	if m.ConnectionPublisher == nil {
+		m.ConnectionPublisher = NewAPISecretPublisher(c, s, m.connectionSecretOptions...)
 	}
+
+	if m.LocalConnectionPublisher == nil {
+		m.LocalConnectionPublisher = NewAPILocalSecretPublisher(c, s, m.connectionSecretOptions...)
+	}
+
+	return m
 }
 
 func (m mrManaged) PublishConnection(ctx context.Context, managed resource.Managed, c ConnectionDetails) (bool, error) {
@@ -652,6 +825,28 @@ func defaultMRExternal() mrExternal {
 // A ReconcilerOption configures a Reconciler.
 type ReconcilerOption func(*Reconciler)
 
+// WithClient specifies the client used to read and write managed resources.
+// By default the manager's client is used. Supplying the client of another
+// cluster allows a controller to reconcile managed resources stored in a
+// cluster other than the one it runs in. The default finalizer, initializer,
+// reference resolver, and connection publishers also use the supplied client,
+// unless they're overridden by other options.
+func WithClient(c client.Client) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.client = c
+	}
+}
+
+// WithConditionsManager specifies how the Reconciler sets status conditions on
+// managed resources. By default conditions.ObservedGenerationPropagationManager
+// is used, which sets each condition's observed generation to the managed
+// resource's generation.
+func WithConditionsManager(m conditions.Manager) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.conditions = m
+	}
+}
+
 // WithTimeout specifies the timeout duration cumulatively for all the calls happen
 // in the reconciliation function. In case the deadline exceeds, reconciler will
 // still have some time to make the necessary calls to report the error such as
@@ -673,6 +868,111 @@ func WithPollInterval(after time.Duration) ReconcilerOption {
 	}
 }
 
+// WithBeingDeletedPollInterval specifies how often the Reconciler should poll
+// an external resource that is being deleted, until it no longer exists.
+func WithBeingDeletedPollInterval(after time.Duration) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.beingDeletedPollInterval = after
+	}
+}
+
+// WithPausedWarningAfter configures the Reconciler to emit a warning event
+// when a managed resource has been paused for longer than the supplied
+// duration, and to repeat the warning at that interval until the resource is
+// unpaused. This makes forgotten pauses discoverable. No warning is emitted by
+// default.
+func WithPausedWarningAfter(d time.Duration) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.pausedWarnAfter = d
+	}
+}
+
+// WithDeprecationNotifier configures how the Reconciler notifies users that a
+// managed resource uses deprecated fields. The Reconciler consults it each time
+// it initializes a managed resource. By default users aren't notified. Supply
+// a FieldPathDeprecationNotifier to emit a warning event when a managed
+// resource uses a deprecated field.
+func WithDeprecationNotifier(n DeprecationNotifier) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.deprecations = n
+	}
+}
+
+// WithStatusUpdateGracePeriod configures the Reconciler to give each update of
+// a managed resource's status at least the supplied duration to complete, even
+// if the reconcile's context is done or nearly done. Without a grace period a
+// reconcile that times out may be unable to record why, for example because
+// an ExternalClient ignored the context it was passed and blocked until the
+// reconcile's deadline. Events are emitted asynchronously, and aren't affected
+// by the reconcile's context. There's no grace period by default.
+func WithStatusUpdateGracePeriod(d time.Duration) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.statusGracePeriod = d
+	}
+}
+
+// WithPanicRecovery configures the Reconciler to recover from panics in its
+// ExternalConnector and ExternalClients. A panic is returned as an error from
+// the call that panicked, including a stack trace, and counted by the
+// Reconciler's MetricRecorder. This stops a bug that affects one kind of
+// managed resource from crashing the controller process, and every other
+// controller with it.
+func WithPanicRecovery() ReconcilerOption {
+	return func(r *Reconciler) {
+		r.panicRecovery = true
+	}
+}
+
+// WithDeadlineOverrunDetection configures the Reconciler to detect calls to
+// the external system that take longer than the supplied percentage of the
+// reconcile timeout, and reconciles that exceed the timeout. Each is counted
+// by the Reconciler's MetricRecorder, and emitted as an event on the managed
+// resource, so that the timeout can be tuned using WithTimeout before
+// reconciles start failing. A percentage of zero or less disables detection.
+func WithDeadlineOverrunDetection(percent int) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.deadlineOverrunPercent = min(percent, 100)
+	}
+}
+
+// WithFaultInjection configures the Reconciler to inject faults into calls to
+// its ExternalConnector and ExternalClients, while the
+// EnableAlphaFaultInjection feature flag is enabled in the supplied flags. See
+// FaultInjectingConnector. It must never be used in production.
+func WithFaultInjection(f *feature.Flags, o ...FaultInjectionOption) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.faultInjection = func(c ExternalConnectDisconnector) ExternalConnectDisconnector {
+			return NewFaultInjectingConnector(c, f, o...)
+		}
+	}
+}
+
+// WithReconnectOnUnauthorized configures the Reconciler to reconnect to the
+// external system, and retry the failed call, when a call to Observe, Create,
+// Update, Delete, or ExternalNameInUse fails because the external system
+// rejected the ExternalClient's credentials. An ExternalClient indicates this by returning
+// an error classified using errors.Unauthorized. If the ExternalClient was
+// produced by a CachingConnector it's evicted from the cache before the
+// Reconciler reconnects. The Reconciler reconnects at most once per
+// reconcile. This smooths over races with credential expiry.
+func WithReconnectOnUnauthorized() ReconcilerOption {
+	return func(r *Reconciler) {
+		r.reconnectOnUnauthorized = true
+	}
+}
+
+// WithDebugDuration configures how long the Reconciler debugs a managed
+// resource annotated with meta.AnnotationKeyDebug. While a managed resource is
+// being debugged the Reconciler logs its debug messages at info level, and
+// emits an event with each diff its ExternalClient observes. Debugging expires
+// an hour after the annotation is first observed by default. A duration of
+// zero disables debugging.
+func WithDebugDuration(d time.Duration) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.debugDuration = d
+	}
+}
+
 // WithMetricRecorder configures the Reconciler to use the supplied MetricRecorder.
 func WithMetricRecorder(recorder MetricRecorder) ReconcilerOption {
 	return func(r *Reconciler) {
@@ -680,6 +980,23 @@ func WithMetricRecorder(recorder MetricRecorder) ReconcilerOption {
 	}
 }
 
+// WithPolicyDecisionObserver configures the Reconciler to notify the supplied
+// PolicyDecisionObserver of each decision it makes about whether a managed
+// resource's management policies allow an action.
+func WithPolicyDecisionObserver(o PolicyDecisionObserver) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.policyObserver = o
+	}
+}
+
+// WithReconcileOutcomeObserver configures the Reconciler to notify the
+// supplied ReconcileOutcomeObserver of the outcome of each reconcile.
+func WithReconcileOutcomeObserver(o ReconcileOutcomeObserver) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.outcomeObserver = o
+	}
+}
+
 // PollIntervalHook represents the function type passed to the
 // WithPollIntervalHook option to support dynamic computation of the poll
 // interval.
@@ -689,6 +1006,162 @@ func defaultPollIntervalHook(_ resource.Managed, pollInterval time.Duration) tim
 	return pollInterval
 }
 
+// A RequeueHook is called at the end of each reconcile of a managed resource
+// with the outcome of the reconcile, and the Result the Reconciler computed.
+// It returns the Result the Reconciler should return, for example to delay
+// the next reconcile until a maintenance window opens. It's not called if the
+// managed resource couldn't be read, or if the reconcile returned an error,
+// in which case the managed resource is requeued with backoff.
+type RequeueHook func(ctx context.Context, mg resource.Managed, o ReconcileOutcome, result reconcile.Result) reconcile.Result
+
+func defaultRequeueHook(_ context.Context, _ resource.Managed, _ ReconcileOutcome, result reconcile.Result) reconcile.Result {
+	return result
+}
+
+// An UpdateSkipPredicate is called with the observation of an external
+// resource that is not up to date, before it is updated. It returns true if
+// the update should be skipped, for example because the observation's Diff
+// only contains fields the external system is known to normalize.
+type UpdateSkipPredicate func(ctx context.Context, mg resource.Managed, obs ExternalObservation) bool
+
+func defaultUpdateSkipPredicate(_ context.Context, _ resource.Managed, _ ExternalObservation) bool {
+	return false
+}
+
+// WithUpdateSkipPredicate configures a predicate that may skip updating an
+// external resource that was not observed to be up to date. A skipped update
+// is treated as if the external resource were up to date. This allows
+// providers to suppress noisy or no-op updates in one place rather than in
+// every ExternalClient's Observe method.
+func WithUpdateSkipPredicate(p UpdateSkipPredicate) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.updateSkipPredicate = p
+	}
+}
+
+// WithObservationCache configures the cache the Reconciler uses to store the
+// content hash of each observation, so that it can be supplied to the next.
+// By default content hashes are not stored, and ContentHashFrom always
+// returns false.
+func WithObservationCache(c ObservationCache) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.observations = c
+	}
+}
+
+// WithObservedStateHashes configures the Reconciler to record a hash of the
+// ObservedState returned by each observation in the status of managed
+// resources that satisfy resource.ObservedStateHashed, and to count how often
+// the hash changes. This makes it cheap to tell how often, and when, an
+// external resource changes. By default observed state is not hashed.
+func WithObservedStateHashes() ReconcilerOption {
+	return func(r *Reconciler) {
+		r.hashObservedState = true
+	}
+}
+
+// WithOperationDetailsRecorder configures how the Reconciler records the
+// AdditionalDetails returned by successful Create, Update, and Delete calls.
+// By default they're only recorded in the change log, if enabled. Supply a
+// StatusOperationDetailsRecorder to record them in the managed resource's
+// status.
+func WithOperationDetailsRecorder(odr OperationDetailsRecorder) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.operationDetails = odr
+	}
+}
+
+// WithDeletionGuard configures a DeletionGuard the Reconciler consults before
+// deleting an external resource. The Reconciler won't delete an external
+// resource while the DeletionGuard reports it has dependents. Deletion isn't
+// guarded by default.
+func WithDeletionGuard(g DeletionGuard) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.deletionGuard = g
+	}
+}
+
+// WithCreationGate configures a CreationGate the Reconciler consults before
+// creating an external resource. The Reconciler won't create an external
+// resource unless the CreationGate allows it. Creation isn't gated by default.
+func WithCreationGate(g CreationGate) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.creationGate = g
+	}
+}
+
+// WithPlanRecorder configures how the Reconciler records the plans it makes
+// for managed resources annotated with meta.AnnotationKeyPlan. By default plans
+// are only logged and emitted as events. Supply a ConfigMapPlanRecorder to
+// record them in a ConfigMap.
+func WithPlanRecorder(p PlanRecorder) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.plans = p
+	}
+}
+
+// WithAuditAnnotations configures the Reconciler to record the time of the
+// last successful create, update, and observation of each external resource,
+// and the error returned by the last failed operation, as annotations on its
+// managed resource. Annotations are persisted using the Reconciler's client.
+func WithAuditAnnotations(o ...AuditAnnotatorOption) ReconcilerOption {
+	return func(r *Reconciler) {
+		// The AuditAnnotator is built once options are applied, so that it
+		// uses the client supplied by WithClient, if any.
+		r.auditOptions = append(make([]AuditAnnotatorOption, 0, len(o)), o...)
+	}
+}
+
+// WithUpdateCooldown configures the minimum interval between updates of an
+// external resource. The Reconciler won't update an external resource within
+// the cooldown of its previous update, even if it's not up to date. This
+// prevents fights with other systems that mutate the external resource, such
+// as autoscalers or policy engines, from exhausting the external API's rate
+// limits. The cooldown doesn't apply to changes to the managed resource's
+// desired state, i.e. a new generation. The time of each update is tracked in
+// memory, so the cooldown is not enforced across controller restarts.
+func WithUpdateCooldown(d time.Duration) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.updateCooldown = newUpdateCooldown(d)
+	}
+}
+
+// WithReferenceResolutionPolicy configures how often the Reconciler resolves
+// a managed resource's references. By default references are resolved every
+// time a managed resource is reconciled, which may generate significant API
+// server load when many managed resources reference each other. References
+// are tracked in memory, so they're resolved again after a controller
+// restart regardless of the policy.
+func WithReferenceResolutionPolicy(p ReferenceResolutionPolicy) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.references = newReferenceResolutions(p)
+	}
+}
+
+// WithReferencesResolvedCondition configures the Reconciler to set the
+// ReferencesResolved condition of the managed resources it reconciles, each
+// time it resolves their references. The condition reports how many
+// references were resolved if the Reconciler's ReferenceResolver is a
+// ReportingReferenceResolver.
+func WithReferencesResolvedCondition() ReconcilerOption {
+	return func(r *Reconciler) {
+		r.referencesCondition = true
+	}
+}
+
+// WithDriftLoopDetection configures the Reconciler to detect fights with other
+// systems that mutate external resources. When an external resource has been
+// updated the supplied threshold of consecutive times without ever being
+// observed as up to date the Reconciler sets the DriftLoop condition, emits an
+// event, and polls it at the supplied poll interval, if that's longer than its
+// usual poll interval. The count of consecutive updates is recorded in the
+// crossplane.io/consecutive-drift-updates annotation.
+func WithDriftLoopDetection(threshold int, pollInterval time.Duration) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.driftLoops = newDriftLoopDetector(threshold, pollInterval)
+	}
+}
+
 // WithPollIntervalHook adds a hook that can be used to configure the
 // delay before an up-to-date resource is reconciled again after a successful
 // reconcile. If this option is passed multiple times, only the latest hook
@@ -699,17 +1172,53 @@ func WithPollIntervalHook(hook PollIntervalHook) ReconcilerOption {
 	}
 }
 
+// WithRequeueHook adds a hook that may adjust when a managed resource is
+// reconciled again, after each reconcile. Unlike a PollIntervalHook it's
+// called after every reconcile that doesn't return an error, not only after
+// reconciles that found the external resource up to date. If this option is
+// passed multiple times, only the latest hook will be used.
+func WithRequeueHook(hook RequeueHook) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.requeueHook = hook
+	}
+}
+
 // WithPollJitterHook adds a simple PollIntervalHook to add jitter to the poll
 // interval used when queuing a new reconciliation after a successful
 // reconcile. The added jitter will be a random duration between -jitter and
 // +jitter. This option wraps WithPollIntervalHook, and is subject to the same
 // constraint that only the latest hook will be used.
 func WithPollJitterHook(jitter time.Duration) ReconcilerOption {
+	return WithPollJitterHookSource(jitter, rand.Float64) //nolint:gosec // No need for secure randomness.
+}
+
+// A JitterSource returns a pseudo-random number in the half-open interval
+// [0.0,1.0). It must be safe for concurrent use.
+type JitterSource func() float64
+
+// WithPollJitterHookSource is like WithPollJitterHook, but reads random numbers
+// from the supplied JitterSource. This allows tests to make jitter
+// deterministic.
+func WithPollJitterHookSource(jitter time.Duration, src JitterSource) ReconcilerOption {
 	return WithPollIntervalHook(func(_ resource.Managed, pollInterval time.Duration) time.Duration {
-		return pollInterval + time.Duration((rand.Float64()-0.5)*2*float64(jitter)) //nolint:gosec // No need for secure randomness.
+		return pollInterval + time.Duration((src()-0.5)*2*float64(jitter))
 	})
 }
 
+// WithClock specifies the clock the Reconciler should use to determine the
+// current time, for example when recording external resource creation
+// timestamps or determining whether the creation grace period has elapsed.
+// The Reconciler uses the real clock by default. A nil clock is ignored.
+func WithClock(c clock.PassiveClock) ReconcilerOption {
+	return func(r *Reconciler) {
+		if c == nil {
+			return
+		}
+
+		r.clock = c
+	}
+}
+
 // WithCreationGracePeriod configures an optional period during which we will
 // wait for the external API to report that a newly created external resource
 // exists. This allows us to tolerate eventually consistent APIs that do not
@@ -749,6 +1258,37 @@ func WithCriticalAnnotationUpdater(u CriticalAnnotationUpdater) ReconcilerOption
 	}
 }
 
+// WithCriticalAnnotations configures the Reconciler to treat the supplied
+// annotation keys as critical, in addition to the external name and external
+// create annotations. Critical annotations set by an ExternalClient's Create
+// method are persisted even if the managed resource is updated concurrently.
+// This option has no effect if a CriticalAnnotationUpdater is supplied using
+// WithCriticalAnnotationUpdater.
+func WithCriticalAnnotations(keys ...string) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.criticalAnnotations = append(r.criticalAnnotations, keys...)
+	}
+}
+
+// WithSpecPersister specifies how the Reconciler should persist the spec of a
+// managed resource whose fields were late initialized. By default the managed
+// resource is updated. Supply a SpecPersister to, for example, persist only
+// the late initialized fields using server-side apply.
+func WithSpecPersister(p SpecPersister) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.managed.SpecPersister = p
+	}
+}
+
+// WithConnectionSecretOptions configures how the Reconciler writes connection
+// secrets, for example which object owns them. See
+// WithConnectionSecretOwnership.
+func WithConnectionSecretOptions(o ...ConnectionSecretOption) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.managed.connectionSecretOptions = append(r.managed.connectionSecretOptions, o...)
+	}
+}
+
 // withConnectionPublishers specifies how the Reconciler should publish
 // its connection details such as credentials and endpoints.
 // for unit testing only.
@@ -775,6 +1315,30 @@ func WithInitializers(i ...Initializer) ReconcilerOption {
 	}
 }
 
+// WithExternalNameGenerator specifies how the Reconciler should generate the
+// external name of a managed resource that does not have one. The external
+// name is generated once the Reconciler has resolved the managed resource's
+// references and connected to the external system. Generated names are
+// checked for collisions if the ExternalClient implements
+// ExternalNameCollisionChecker. The NameAsExternalName initializer is removed
+// from the Reconciler's initializers, which are otherwise left as is.
+func WithExternalNameGenerator(g ExternalNameGenerator) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.externalNames = g
+	}
+}
+
+// WithExternalNameConstraints configures the Reconciler to validate a managed
+// resource's external name against the supplied constraints before creating
+// its external resource. The Reconciler won't create an external resource with
+// an invalid external name, and won't retry until the managed resource
+// changes. External names aren't validated by default.
+func WithExternalNameConstraints(c ExternalNameConstraints) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.nameConstraints = &c
+	}
+}
+
 // WithFinalizer specifies how the Reconciler should add and remove
 // finalizers to and from the managed resource.
 func WithFinalizer(f resource.Finalizer) ReconcilerOption {
@@ -783,6 +1347,16 @@ func WithFinalizer(f resource.Finalizer) ReconcilerOption {
 	}
 }
 
+// WithFinalizerName specifies the finalizer the Reconciler should add to and
+// remove from the managed resource. Managed resources that have the default
+// FinalizerName are migrated to the supplied finalizer. This option has no
+// effect if WithFinalizer is also supplied.
+func WithFinalizerName(name string) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.finalizerName = name
+	}
+}
+
 // WithReferenceResolver specifies how the Reconciler should resolve any
 // inter-resource references it encounters while reconciling managed resources.
 func WithReferenceResolver(rr ReferenceResolver) ReconcilerOption {
@@ -820,6 +1394,27 @@ func WithReconcilerSupportedManagementPolicies(supported []sets.Set[xpv1.Managem
 	}
 }
 
+// WithKindRegistry configures the Reconciler to use the capabilities of its
+// kind of managed resource in the supplied registry. If the registry declares
+// which management policies the kind supports they replace the Reconciler's
+// supported management policies. Capabilities must be registered before the
+// Reconciler is created.
+func WithKindRegistry(reg *resource.KindRegistry) ReconcilerOption {
+	return func(r *Reconciler) {
+		c, ok := reg.Get(r.kind)
+		if !ok || len(c.ManagementPolicies) == 0 {
+			return
+		}
+
+		supported := make([]sets.Set[xpv1.ManagementAction], len(c.ManagementPolicies))
+		for i, p := range c.ManagementPolicies {
+			supported[i] = sets.New(p...)
+		}
+
+		r.supportedManagementPolicies = supported
+	}
+}
+
 // WithChangeLogger enables support for capturing change logs during
 // reconciliation.
 func WithChangeLogger(c ChangeLogger) ReconcilerOption {
@@ -841,6 +1436,25 @@ func WithDeterministicExternalName(b bool) ReconcilerOption {
 	}
 }
 
+// NewReconcilerWithError returns a Reconciler that reconciles managed
+// resources of the supplied ManagedKind. It is equivalent to NewReconciler,
+// except that it returns an error rather than panicking if asked to reconcile
+// a managed resource kind that is not registered with the supplied manager's
+// runtime.Scheme. This is useful for providers that register kinds at
+// runtime.
+func NewReconcilerWithError(m manager.Manager, of resource.ManagedKind, o ...ReconcilerOption) (*Reconciler, error) {
+	obj, err := resource.CreateObject(schema.GroupVersionKind(of), m.GetScheme())
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := obj.(resource.Managed); !ok {
+		return nil, errors.Errorf(errFmtNotManaged, of)
+	}
+
+	return NewReconciler(m, of, o...), nil
+}
+
 // NewReconciler returns a Reconciler that reconciles managed resources of the
 // supplied ManagedKind with resources in an external system such as a cloud
 // provider API. It panics if asked to reconcile a managed resource kind that is
@@ -858,19 +1472,56 @@ func NewReconciler(m manager.Manager, of resource.ManagedKind, o ...ReconcilerOp
 	// been registered with our controller manager's scheme.
 	_ = nm()
 
+	return newReconciler(m, of, nm, o...)
+}
+
+// NewUnstructuredReconciler returns a Reconciler that reconciles managed
+// resources of the supplied ManagedKind as unstructured data. Unlike
+// NewReconciler the kind need not be registered with the supplied manager's
+// runtime.Scheme, so it may be used to reconcile kinds whose Go types don't
+// exist at compile time, for example because they're generated from a schema
+// at runtime. The supplied ExternalConnector will be passed managed resources
+// of type *unstructured/managed.Unstructured. The controller should watch the
+// same type, e.g. For(umanaged.New(umanaged.WithGroupVersionKind(gvk))).
+func NewUnstructuredReconciler(m manager.Manager, of resource.ManagedKind, o ...ReconcilerOption) *Reconciler {
+	nm := func() resource.Managed {
+		return umanaged.New(umanaged.WithGroupVersionKind(schema.GroupVersionKind(of)))
+	}
+
+	return newReconciler(m, of, nm, o...)
+}
+
+func newReconciler(m manager.Manager, of resource.ManagedKind, nm func() resource.Managed, o ...ReconcilerOption) *Reconciler {
 	r := &Reconciler{
 		client:                      m.GetClient(),
+		kind:                        schema.GroupVersionKind(of),
 		newManaged:                  nm,
 		pollInterval:                defaultPollInterval,
+		beingDeletedPollInterval:    defaultBeingDeletedPollInterval,
+		debugDuration:               defaultDebugDuration,
 		pollIntervalHook:            defaultPollIntervalHook,
+		requeueHook:                 defaultRequeueHook,
+		updateSkipPredicate:         defaultUpdateSkipPredicate,
+		observations:                NopObservationCache{},
+		operationDetails:            NopOperationDetailsRecorder{},
+		plans:                       NopPlanRecorder{},
+		deletionGuard:               NopDeletionGuard{},
+		creationGate:                NopCreationGate{},
+		deprecations:                NopDeprecationNotifier{},
+		auditor:                     NopReconcileOutcomeObserver{},
+		updateCooldown:              newUpdateCooldown(0),
+		references:                  newReferenceResolutions(ReferenceResolutionAlways),
+		driftLoops:                  newDriftLoopDetector(0, 0),
 		creationGracePeriod:         defaultGracePeriod,
 		timeout:                     reconcileTimeout,
-		managed:                     defaultMRManaged(m),
+		clock:                       clock.RealClock{},
 		external:                    defaultMRExternal(),
 		supportedManagementPolicies: defaultSupportedManagementPolicies(),
 		log:                         logging.NewNopLogger(),
 		record:                      event.NewNopRecorder(),
 		metricRecorder:              NewNopMetricRecorder(),
+		outcomeObserver:             NopReconcileOutcomeObserver{},
+		policyObserver:              NopPolicyDecisionObserver{},
 		change:                      newNopChangeLogger(),
 		conditions:                  new(conditions.ObservedGenerationPropagationManager),
 	}
@@ -879,16 +1530,72 @@ func NewReconciler(m manager.Manager, of resource.ManagedKind, o ...ReconcilerOp
 		ro(r)
 	}
 
+	// We only know which finalizers our Finalizer adds if it wasn't supplied
+	// by WithFinalizer.
+	if r.managed.Finalizer == nil {
+		r.finalizers = []string{FinalizerName}
+		if r.finalizerName != "" {
+			r.finalizers = append(r.finalizers, r.finalizerName)
+		}
+	}
+
+	if r.managed.Finalizer == nil && r.finalizerName != "" {
+		r.managed.Finalizer = resource.NewAPIFinalizer(r.client, r.finalizerName, resource.WithLegacyFinalizers(FinalizerName))
+	}
+
+	if r.managed.CriticalAnnotationUpdater == nil && len(r.criticalAnnotations) > 0 {
+		r.managed.CriticalAnnotationUpdater = NewRetryingCriticalAnnotationUpdater(r.client, WithCriticalAnnotationKeys(r.criticalAnnotations...))
+	}
+
+	// Defaults are set after options are applied so that they use the
+	// client supplied by WithClient, if any, regardless of option order.
+	r.managed = r.managed.withDefaults(r.client, m.GetScheme())
+
+	if r.auditOptions != nil {
+		r.auditor = NewAuditAnnotator(r.client, r.auditOptions...)
+	}
+
+	if r.externalNames != nil {
+		r.managed.Initializer = withoutNameAsExternalName(r.managed.Initializer)
+	}
+
+	if r.statusGracePeriod > 0 {
+		r.client = &gracefulStatusClient{Client: r.client, grace: r.statusGracePeriod}
+	}
+
+	// Reconnecting happens first, so that reconnecting clients can tell
+	// whether the clients they wrap are cached.
+	if r.reconnectOnUnauthorized {
+		r.external.ExternalConnectDisconnector = &reconnectingConnector{ExternalConnectDisconnector: r.external.ExternalConnectDisconnector}
+	}
+
+	if r.panicRecovery {
+		r.external.ExternalConnectDisconnector = &panicRecoveringConnector{ExternalConnectDisconnector: r.external.ExternalConnectDisconnector, kind: r.kind, metrics: r.metricRecorder}
+	}
+
+	// Faults are injected inside deadline tracking, so that injected latency
+	// is tracked.
+	if r.faultInjection != nil {
+		r.external.ExternalConnectDisconnector = r.faultInjection(r.external.ExternalConnectDisconnector)
+	}
+
+	if r.deadlineOverrunPercent > 0 {
+		r.deadlines = &deadlineTracker{kind: r.kind, timeout: r.timeout, percent: r.deadlineOverrunPercent, clock: r.clock, record: r.record, metrics: r.metricRecorder}
+		r.external.ExternalConnectDisconnector = &deadlineTrackingConnector{ExternalConnectDisconnector: r.external.ExternalConnectDisconnector, tracker: r.deadlines}
+	}
+
+	r.stages = r.pipeline()
+
 	return r
 }
 
-// Reconcile a managed resource with an external resource.
-func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (result reconcile.Result, err error) { //nolint:gocognit // See note below.
-	// NOTE(negz): This method is a well over our cyclomatic complexity goal.
-	// Be wary of adding additional complexity.
+// Reconcile a managed resource with an external resource. Each reconcile runs
+// the Reconciler's stages in order, until one of them is done. See Stage.
+func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (result reconcile.Result, err error) {
 	defer func() { result, err = errors.SilentlyRequeueOnConflict(result, err) }()
 
-	log := r.log.WithValues("request", req)
+	id := uuid.NewString()
+	log := r.log.WithValues("request", req, logging.KeyReconcileID, id, logging.KeyGVK, r.kind.String())
 	log.Debug("Reconciling")
 
 	ctx, cancel := context.WithTimeout(ctx, r.timeout+reconcileGracePeriod)
@@ -897,24 +1604,165 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 	externalCtx, externalCancel := context.WithTimeout(ctx, r.timeout)
 	defer externalCancel()
 
+	s := &ReconcileState{Request: req, ID: id, Log: log, externalCtx: externalCtx}
+	defer s.done()
+
+	if r.deadlines != nil {
+		defer r.deadlines.done(s)
+	}
+
+	for _, stage := range r.stages {
+		if done, res, serr := stage(ctx, s); done {
+			// A stage that succeeded may still fail to persist the managed
+			// resource's status. Don't report that as a success.
+			if serr != nil && s.Managed != nil && s.Outcome.Type != OutcomeError {
+				s.Outcome = outcomeError(StageStatus, serr)
+			}
+
+			if serr == nil && s.Managed != nil {
+				res = r.requeueHook(ctx, s.Managed, s.Outcome, res)
+			}
+
+			return res, serr
+		}
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// pipeline returns the stages of a reconcile, in order, wrapped in the
+// Reconciler's stage middleware.
+func (r *Reconciler) pipeline() []StageFn {
+	stages := []struct {
+		stage Stage
+		fn    StageFn
+	}{
+		{stage: StageGetResource, fn: r.getResource},
+		{stage: StagePolicy, fn: r.policy},
+		{stage: StageFinalize, fn: r.finalize},
+		{stage: StageInitialize, fn: r.initialize},
+		{stage: StageResolveReferences, fn: r.resolveReferences},
+		{stage: StageConnect, fn: r.connect},
+		{stage: StageObserve, fn: r.observe},
+		{stage: StagePlan, fn: r.plan},
+		{stage: StageDelete, fn: r.delete},
+		{stage: StagePublish, fn: r.publish},
+		{stage: StageCreate, fn: r.create},
+		{stage: StageLateInitialize, fn: r.lateInitialize},
+		{stage: StageUpdate, fn: r.update},
+	}
+
+	p := make([]StageFn, len(stages))
+
+	for i, s := range stages {
+		fn := s.fn
+		for j := len(r.middleware) - 1; j >= 0; j-- {
+			fn = r.middleware[j](s.stage, fn)
+		}
+
+		p[i] = fn
+	}
+
+	return p
+}
+
+// getResource gets the managed resource, and arranges for the outcome of the
+// reconcile to be reported once it's done.
+func (r *Reconciler) getResource(ctx context.Context, s *ReconcileState) (bool, reconcile.Result, error) {
 	managed := r.newManaged()
-	if err := r.client.Get(ctx, req.NamespacedName, managed); err != nil {
+	if err := r.client.Get(ctx, s.Request.NamespacedName, managed); err != nil {
 		// There's no need to requeue if we no longer exist. Otherwise we'll be
 		// requeued implicitly because we return an error.
-		log.Debug("Cannot get managed resource", "error", err)
-		return reconcile.Result{}, errors.Wrap(resource.IgnoreNotFound(err), errGetManaged)
+		s.Log.Debug("Cannot get managed resource", "error", err)
+		return true, reconcile.Result{}, errors.Wrap(resource.IgnoreNotFound(err), errGetManaged)
 	}
 
-	r.metricRecorder.recordFirstTimeReconciled(managed)
-	status := r.conditions.For(managed)
+	s.Managed = managed
+
+	// Report which path this reconcile took once it's done.
+	s.Defer(func() {
+		r.metricRecorder.recordOutcome(managed, s.Outcome)
+		r.outcomeObserver.ObserveOutcome(ctx, managed, s.Outcome)
+		r.auditor.ObserveOutcome(ctx, managed, s.Outcome)
+
+		if err := r.driftLoops.Persist(ctx, r.client, managed, s.Outcome); err != nil {
+			s.Log.Debug(errPersistDriftUpdates, "error", err)
+		}
+	})
+
+	r.metricRecorder.recordFirstTimeReconciled(managed, r.clock.Now())
+	s.Status = r.conditions.For(managed)
+
+	// Tell the ExternalClient what's being reconciled, so it can correlate
+	// its own logs with ours.
+	deadline, _ := s.externalCtx.Deadline()
+	s.externalCtx = reconcilecontext.WithInfo(s.externalCtx, reconcilecontext.Info{
+		ID:        s.ID,
+		GVK:       r.kind,
+		Namespace: managed.GetNamespace(),
+		Name:      managed.GetName(),
+		UID:       managed.GetUID(),
+		Deadline:  deadline,
+	})
 
-	record := r.record.WithAnnotations("external-name", meta.GetExternalName(managed))
-	log = log.WithValues(
+	s.Record = r.record.WithAnnotations("external-name", meta.GetExternalName(managed), logging.KeyReconcileID, s.ID)
+	s.Log = s.Log.WithValues(
 		"uid", managed.GetUID(),
 		"version", managed.GetResourceVersion(),
-		"external-name", meta.GetExternalName(managed),
+		logging.KeyExternalName, meta.GetExternalName(managed),
 	)
 
+	r.debug(ctx, s)
+
+	return false, reconcile.Result{}, nil
+}
+
+// logChange records the supplied operation on the external resource to the
+// change logs. The change log entry includes the reconcile ID, so it can be
+// correlated with the reconcile's log messages.
+func (r *Reconciler) logChange(ctx context.Context, s *ReconcileState, log logging.Logger, op v1alpha1.OperationType, opErr error, ad AdditionalDetails) {
+	details := make(AdditionalDetails, len(ad)+1)
+	maps.Copy(details, ad)
+	details[logging.KeyReconcileID] = s.ID
+
+	if err := r.change.Log(ctx, s.ManagedPreOp, op, opErr, details); err != nil {
+		log.Info(errRecordChangeLog, "error", err, logging.KeyOperation, op.String())
+	}
+}
+
+// warnIfPausedTooLong emits a warning event if the managed resource has been
+// paused for longer than the configured duration. It returns a result that
+// requeues the managed resource when the next warning is due. It must be
+// called after the managed resource is marked paused.
+func (r *Reconciler) warnIfPausedTooLong(s *ReconcileState) reconcile.Result {
+	if r.pausedWarnAfter <= 0 {
+		return reconcile.Result{}
+	}
+
+	// The paused condition's last transition time is preserved while the
+	// managed resource remains paused.
+	since := s.Managed.GetCondition(xpv1.TypeSynced).LastTransitionTime.Time
+
+	paused := r.clock.Since(since)
+	if paused < r.pausedWarnAfter {
+		return reconcile.Result{RequeueAfter: r.pausedWarnAfter - paused}
+	}
+
+	s.Log.Info("Reconciliation has been paused for longer than expected", "paused-since", since, "warn-after", r.pausedWarnAfter)
+	s.Record.Event(s.Managed, event.Warning(reasonReconciliationPausedTooLong, errors.Errorf(errFmtPausedTooLong, paused.Round(time.Second))))
+
+	return reconcile.Result{RequeueAfter: r.pausedWarnAfter}
+}
+
+// policy determines what the reconcile may do to the managed resource and its
+// external resource. It stops the reconcile if the managed resource is paused,
+// or if its management policies are invalid.
+func (r *Reconciler) policy(ctx context.Context, s *ReconcileState) (bool, reconcile.Result, error) {
+	managed := s.Managed
+	log := s.Log
+	record := s.Record
+	status := s.Status
+
 	managementPoliciesEnabled := r.features.Enabled(feature.EnableBetaManagementPolicies)
 	if managementPoliciesEnabled {
 		log.WithValues("managementPolicies", managed.GetManagementPolicies())
@@ -932,6 +1780,15 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 		policy = NewManagementPoliciesResolver(managementPoliciesEnabled, managed.GetManagementPolicies(), WithSupportedManagementPolicies(r.supportedManagementPolicies))
 	}
 
+	s.Policy = &observedPolicy{
+		ManagementPoliciesChecker: policy,
+		ctx:                       ctx,
+		mg:                        managed,
+		log:                       log,
+		metrics:                   r.metricRecorder,
+		observer:                  r.policyObserver,
+	}
+
 	// Check if the resource has paused reconciliation based on the
 	// annotation or the management policies.
 	// Log, publish an event and update the SYNC status condition.
@@ -942,7 +1799,8 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 		status.MarkConditions(xpv1.ReconcilePaused())
 		// if the pause annotation is removed or the management policies changed, we will have a chance to reconcile
 		// again and resume and if status update fails, we will reconcile again to retry to update the status
-		return reconcile.Result{}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+		s.Outcome = outcome(OutcomePausedSkip)
+		return true, r.warnIfPausedTooLong(s), errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 	}
 
 	// Check if the ManagementPolicies is set to a non-default value while the
@@ -957,15 +1815,29 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 		log.Debug(err.Error())
 
 		if kerrors.IsConflict(err) {
-			return reconcile.Result{Requeue: true}, nil
+			s.Outcome = outcomeError(StagePolicy, err)
+			return true, reconcile.Result{Requeue: true}, nil
 		}
 
 		record.Event(managed, event.Warning(reasonManagementPolicyInvalid, err))
 		status.MarkConditions(xpv1.ReconcileError(err))
 
-		return reconcile.Result{}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+		s.Outcome = outcomeError(StagePolicy, err)
+		return true, reconcile.Result{}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 	}
 
+	return false, reconcile.Result{}, nil
+}
+
+// finalize a managed resource that was deleted with a policy of orphaning its
+// external resource. There is no need to observe the external resource first.
+func (r *Reconciler) finalize(ctx context.Context, s *ReconcileState) (bool, reconcile.Result, error) {
+	managed := s.Managed
+	log := s.Log
+	record := s.Record
+	status := s.Status
+	policy := s.Policy
+
 	// If managed resource has a deletion timestamp and a deletion policy of
 	// Orphan, we do not need to observe the external resource before attempting
 	// to unpublish connection details and remove finalizer.
@@ -985,13 +1857,15 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 			log.Debug("Cannot unpublish connection details", "error", err)
 
 			if kerrors.IsConflict(err) {
-				return reconcile.Result{Requeue: true}, nil
+				s.Outcome = outcomeError(StageUnpublish, err)
+				return true, reconcile.Result{Requeue: true}, nil
 			}
 
 			record.Event(managed, event.Warning(reasonCannotUnpublish, err))
 			status.MarkConditions(xpv1.Deleting(), xpv1.ReconcileError(err))
 
-			return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+			s.Outcome = outcomeError(StageUnpublish, err)
+			return true, reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 		}
 
 		if err := r.managed.RemoveFinalizer(ctx, managed); err != nil {
@@ -1002,40 +1876,74 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 			log.Debug("Cannot remove managed resource finalizer", "error", err)
 
 			if kerrors.IsConflict(err) {
-				return reconcile.Result{Requeue: true}, nil
+				s.Outcome = outcomeError(StageFinalize, err)
+				return true, reconcile.Result{Requeue: true}, nil
 			}
 
 			status.MarkConditions(xpv1.Deleting(), xpv1.ReconcileError(err))
 
-			return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+			s.Outcome = outcomeError(StageFinalize, err)
+			return true, reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 		}
 
+		r.observations.Delete(managed)
+		r.updateCooldown.Forget(managed)
+		r.references.Forget(managed)
+		r.driftLoops.Forget(managed)
+		r.deprecations.Forget(managed)
+
 		// We've successfully unpublished our managed resource's connection
 		// details and removed our finalizer. If we assume we were the only
 		// controller that added a finalizer to this resource then it should no
 		// longer exist and thus there is no point trying to update its status.
-		r.metricRecorder.recordDeleted(managed)
+		r.metricRecorder.recordDeleted(managed, r.clock.Now())
 		log.Debug("Successfully deleted managed resource")
 
-		return reconcile.Result{Requeue: false}, nil
+		s.Outcome = outcome(OutcomeDeleted)
+		return true, reconcile.Result{Requeue: false}, nil
 	}
 
+	return false, reconcile.Result{}, nil
+}
+
+// initialize the managed resource. It stops the reconcile if a previous
+// creation of the external resource may not have completed.
+func (r *Reconciler) initialize(ctx context.Context, s *ReconcileState) (bool, reconcile.Result, error) {
+	managed := s.Managed
+	log := s.Log
+	record := s.Record
+	status := s.Status
+
 	if err := r.managed.Initialize(ctx, managed); err != nil {
+		// Another controller is responsible for this managed resource now. It
+		// must not be reconciled here, or both controllers could act on the
+		// same external resource.
+		if errors.Is(err, ErrReassigned) {
+			log.Debug("Managed resource was reassigned to another controller")
+
+			s.Outcome = outcome(OutcomeReassigned)
+			return true, reconcile.Result{}, nil
+		}
+
 		// If this is the first time we encounter this issue we'll be requeued
 		// implicitly when we update our status with the new error condition. If
 		// not, we requeue explicitly, which will trigger backoff.
 		log.Debug("Cannot initialize managed resource", "error", err)
 
 		if kerrors.IsConflict(err) {
-			return reconcile.Result{Requeue: true}, nil
+			s.Outcome = outcomeError(StageInitialize, err)
+			return true, reconcile.Result{Requeue: true}, nil
 		}
 
 		record.Event(managed, event.Warning(reasonCannotInitialize, err))
 		status.MarkConditions(xpv1.ReconcileError(err))
 
-		return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+		s.Outcome = outcomeError(StageInitialize, err)
+		return true, reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 	}
 
+	r.deprecations.Notify(ctx, managed, record)
+
 	// If we started but never completed creation of an external resource we
 	// may have lost critical information. For example if we didn't persist
 	// an updated external name which is non-deterministic, we have leaked a
@@ -1047,12 +1955,24 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 			record.Event(managed, event.Warning(reasonCannotInitialize, errors.New(errCreateIncomplete)))
 			status.MarkConditions(xpv1.Creating(), xpv1.ReconcileError(errors.New(errCreateIncomplete)))
 
-			return reconcile.Result{Requeue: false}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+			s.Outcome = outcomeError(StageInitialize, errors.New(errCreateIncomplete))
+			return true, reconcile.Result{Requeue: false}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 		}
 
 		log.Debug("Cannot determine creation result, but proceeding due to deterministic external name")
 	}
 
+	return false, reconcile.Result{}, nil
+}
+
+// resolveReferences resolves any references the managed resource makes to
+// other resources.
+func (r *Reconciler) resolveReferences(ctx context.Context, s *ReconcileState) (bool, reconcile.Result, error) {
+	managed := s.Managed
+	log := s.Log
+	record := s.Record
+	status := s.Status
+
 	// We resolve any references before observing our external resource because
 	// in some rare examples we need a spec field to make the observe call, and
 	// that spec field could be set by a reference.
@@ -1062,8 +1982,13 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 	// resolution due to being unready or non-existent. It is unlikely (but not
 	// impossible) that we need to resolve a reference in order to process a
 	// delete, and that reference is stale at delete time.
-	if !meta.WasDeleted(managed) {
-		if err := r.managed.ResolveReferences(ctx, managed); err != nil {
+	//
+	// Depending on the reference resolution policy we may also skip resolution
+	// if we've already resolved references for this generation of the managed
+	// resource, or at all.
+	if !meta.WasDeleted(managed) && r.references.Required(managed) {
+		res, err := r.resolve(ctx, managed)
+		if err != nil {
 			// If any of our referenced resources are not yet ready (or if we
 			// encountered an error resolving them) we want to try again. If
 			// this is the first time we encounter this situation we'll be
@@ -1072,16 +1997,61 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 			log.Debug("Cannot resolve managed resource references", "error", err)
 
 			if kerrors.IsConflict(err) {
-				return reconcile.Result{Requeue: true}, nil
+				s.Outcome = outcomeError(StageResolveReferences, err)
+				return true, reconcile.Result{Requeue: true}, nil
 			}
 
+			r.references.Fail(managed)
 			record.Event(managed, event.Warning(reasonCannotResolveRefs, err))
 			status.MarkConditions(xpv1.ReconcileError(err))
 
-			return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+			if r.referencesCondition {
+				status.MarkConditions(xpv1.ReferencesUnresolved(err))
+			}
+
+			s.Outcome = outcomeError(StageResolveReferences, err)
+			return true, reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+		}
+
+		// Resolving references may have updated the managed resource, so we
+		// record the generation it has now.
+		if failed := r.references.Record(managed); failed || res.Changed {
+			msg := "Successfully resolved references"
+			if len(res.Targets) > 0 {
+				msg += " to " + strings.Join(res.Targets, ", ")
+			}
+
+			record.Event(managed, event.Normal(reasonResolvedRefs, msg))
 		}
+
+		if r.referencesCondition {
+			status.MarkConditions(xpv1.ReferencesResolved(len(res.Targets)))
+		}
+	}
+
+	return false, reconcile.Result{}, nil
+}
+
+// resolve the supplied managed resource's references, reporting the result if
+// the Reconciler's ReferenceResolver supports it.
+func (r *Reconciler) resolve(ctx context.Context, mg resource.Managed) (ReferenceResolution, error) {
+	if rr, ok := r.managed.ReferenceResolver.(ReportingReferenceResolver); ok {
+		return rr.ResolveReferencesWithResult(ctx, mg)
 	}
 
+	return ReferenceResolution{}, r.managed.ResolveReferences(ctx, mg)
+}
+
+// connect to the external system, and generate an external name for the
+// managed resource if the Reconciler is configured to. The connection is
+// closed when the reconcile is done.
+func (r *Reconciler) connect(ctx context.Context, s *ReconcileState) (bool, reconcile.Result, error) {
+	managed := s.Managed
+	log := s.Log
+	record := s.Record
+	status := s.Status
+	externalCtx := s.ExternalContext()
+
 	external, err := r.external.Connect(externalCtx, managed)
 	if err != nil {
 		// We'll usually hit this case if our Provider or its secret are missing
@@ -1092,16 +2062,19 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 		log.Debug("Cannot connect to provider", "error", err)
 
 		if kerrors.IsConflict(err) {
-			return reconcile.Result{Requeue: true}, nil
+			s.Outcome = outcomeError(StageConnect, err)
+			return true, reconcile.Result{Requeue: true}, nil
 		}
 
 		record.Event(managed, event.Warning(reasonCannotConnect, err))
-		status.MarkConditions(xpv1.ReconcileError(errors.Wrap(err, errReconcileConnect)))
+		status.MarkConditions(externalReconcileError(errors.Wrap(err, errReconcileConnect)))
 
-		return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+		s.Outcome = outcomeError(StageConnect, err)
+		return true, reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 	}
 
-	defer func() {
+	s.External = external
+	s.Defer(func() {
 		if err := r.external.Disconnect(ctx); err != nil {
 			log.Debug("Cannot disconnect from provider", "error", err)
 			record.Event(managed, event.Warning(reasonCannotDisconnect, err))
@@ -1111,9 +2084,47 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 			log.Debug("Cannot disconnect from provider", "error", err)
 			record.Event(managed, event.Warning(reasonCannotDisconnect, err))
 		}
-	}()
+	})
+
+	if r.externalNames == nil || meta.WasDeleted(managed) {
+		return false, reconcile.Result{}, nil
+	}
+
+	n := NewGeneratedExternalName(r.client, r.externalNames, WithExternalNameAttempts(defaultExternalNameAttempts))
+	if err := n.SetExternalName(externalCtx, managed, external); err != nil {
+		log.Debug("Cannot generate external name", "error", err)
+
+		if kerrors.IsConflict(err) {
+			s.Outcome = outcomeError(StageInitialize, err)
+			return true, reconcile.Result{Requeue: true}, nil
+		}
+
+		record.Event(managed, event.Warning(reasonCannotInitialize, err))
+		status.MarkConditions(xpv1.ReconcileError(err))
 
-	observation, err := external.Observe(externalCtx, managed)
+		s.Outcome = outcomeError(StageInitialize, err)
+		return true, reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+	}
+
+	return false, reconcile.Result{}, nil
+}
+
+// observe the external resource.
+func (r *Reconciler) observe(ctx context.Context, s *ReconcileState) (bool, reconcile.Result, error) {
+	managed := s.Managed
+	log := s.Log
+	record := s.Record
+	status := s.Status
+	policy := s.Policy
+	external := s.External
+	externalCtx := s.ExternalContext()
+
+	observeCtx := externalCtx
+	if h, ok := r.observations.Get(managed); ok && !meta.WasDeleted(managed) {
+		observeCtx = withContentHash(externalCtx, h)
+	}
+
+	observation, err := external.Observe(observeCtx, managed)
 	if err != nil {
 		// We'll usually hit this case if our Provider credentials are invalid
 		// or insufficient for observing the external resource type we're
@@ -1124,13 +2135,66 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 		log.Debug("Cannot observe external resource", "error", err)
 
 		if kerrors.IsConflict(err) {
-			return reconcile.Result{Requeue: true}, nil
+			s.Outcome = outcomeError(StageObserve, err)
+			return true, reconcile.Result{Requeue: true}, nil
 		}
 
 		record.Event(managed, event.Warning(reasonCannotObserve, err))
-		status.MarkConditions(xpv1.ReconcileError(errors.Wrap(err, errReconcileObserve)))
+		status.MarkConditions(externalReconcileError(errors.Wrap(err, errReconcileObserve)))
+
+		s.Outcome = outcomeError(StageObserve, err)
+		return true, reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+	}
+
+	s.Observation = observation
+
+	// The external client reported that the external resource has not
+	// changed since we last observed it, and the managed resource's desired
+	// state has not changed either. There's nothing to do until the next poll.
+	if observation.NotModified && !meta.WasDeleted(managed) {
+		reconcileAfter := r.pollIntervalFor(managed)
+		log.Debug("External resource is not modified", "requeue-after", r.clock.Now().Add(reconcileAfter))
+		status.MarkConditions(xpv1.ReconcileSuccess())
+		r.metricRecorder.recordUnchanged(managed.GetName(), r.clock.Now())
+
+		s.Outcome = outcome(OutcomeNotModified)
+		return true, reconcile.Result{RequeueAfter: reconcileAfter}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+	}
+
+	if r.hashObservedState && observation.ResourceExists && observation.ObservedState != nil {
+		changed, err := recordObservedStateHash(managed, observation.ObservedState)
+		if err != nil {
+			// Hashing is only used for analysis, so we don't fail the
+			// reconcile if we can't hash the observed state.
+			log.Debug("Cannot record observed state hash", "error", err)
+		}
+
+		if changed {
+			r.metricRecorder.recordObservedStateChange(managed)
+		}
+	}
 
-		return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+	// Only cache the content hash of an external resource that is up to
+	// date. If we cached the hash of one that needs to be updated and the
+	// update failed or was skipped, the next observe would report that it
+	// was not modified, and we'd never correct it.
+	if observation.ContentHash != "" && observation.ResourceExists && observation.ResourceUpToDate {
+		r.observations.Set(managed, observation.ContentHash)
+	} else {
+		r.observations.Delete(managed)
+	}
+
+	// Don't attempt operations that are known to fail because the external
+	// client lacks access to the external resource. Deletion is still
+	// attempted, rather than orphaning the external resource.
+	if observation.AccessDenied != nil {
+		log.Debug("Managing external resource as observe only", "error", observation.AccessDenied)
+		status.MarkConditions(xpv1.AccessDenied(observation.AccessDenied))
+
+		s.Policy = degradeToObserveOnly(s.Policy)
+		policy = s.Policy
+	} else if managed.GetCondition(xpv1.TypeDegraded).Status == corev1.ConditionTrue {
+		status.MarkConditions(xpv1.FullAccess())
 	}
 
 	// In the observe-only mode, !observation.ResourceExists will be an error
@@ -1139,7 +2203,8 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 		record.Event(managed, event.Warning(reasonCannotObserve, errors.New(errExternalResourceNotExist)))
 		status.MarkConditions(xpv1.ReconcileError(errors.Wrap(errors.New(errExternalResourceNotExist), errReconcileObserve)))
 
-		return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+		s.Outcome = outcomeError(StageObserve, errors.New(errExternalResourceNotExist))
+		return true, reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 	}
 
 	// If this resource has a non-zero creation grace period we want to wait
@@ -1147,23 +2212,152 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 	// doesn't exist. This is because some external APIs are eventually
 	// consistent and may report that a recently created resource does not
 	// exist.
-	if !observation.ResourceExists && meta.ExternalCreateSucceededDuring(managed, r.creationGracePeriod) {
+	if !observation.ResourceExists && meta.ExternalCreateSucceededWithin(managed, r.clock.Now(), r.creationGracePeriod) {
 		log.Debug("Waiting for external resource existence to be confirmed")
 		record.Event(managed, event.Normal(reasonPending, "Waiting for external resource existence to be confirmed"))
 
-		return reconcile.Result{Requeue: true}, nil
+		s.Outcome = outcome(OutcomePending)
+		return true, reconcile.Result{Requeue: true}, nil
+	}
+
+	// Don't try to update or delete an external resource that is already
+	// being deleted. Poll it until it no longer exists. If the managed
+	// resource wasn't deleted the external resource will then be created
+	// again, like any other external resource that doesn't exist.
+	if observation.ResourceExists && observation.ResourceBeingDeleted {
+		log.Debug("External resource is being deleted", "requeue-after", r.clock.Now().Add(r.beingDeletedPollInterval))
+
+		c := xpv1.ExternalDeleting()
+		if meta.WasDeleted(managed) {
+			c = xpv1.Deleting()
+		}
+
+		status.MarkConditions(c, xpv1.ReconcileSuccess())
+
+		s.Outcome = outcome(OutcomeExternalDeleting)
+		return true, reconcile.Result{RequeueAfter: r.beingDeletedPollInterval}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 	}
 
 	// deep copy the managed resource now that we've called Observe() and have
 	// not performed any external operations - we can use this as the
 	// pre-operation managed resource state in the change logs later
 	//nolint:forcetypeassert // managed.DeepCopyObject() will always be a resource.Managed.
-	managedPreOp := managed.DeepCopyObject().(resource.Managed)
+	s.ManagedPreOp = managed.DeepCopyObject().(resource.Managed)
+
+	return false, reconcile.Result{}, nil
+}
+
+// plan what the remaining stages would do to the external resource, and stop
+// the reconcile before they do it, if the managed resource asks to be planned.
+func (r *Reconciler) plan(ctx context.Context, s *ReconcileState) (bool, reconcile.Result, error) {
+	managed := s.Managed
+	log := s.Log
+	record := s.Record
+	status := s.Status
+
+	if !meta.IsPlanRequested(managed) {
+		return false, reconcile.Result{}, nil
+	}
+
+	p := Plan{
+		Action:             planAction(s.Policy, managed, s.Observation),
+		ObservedGeneration: managed.GetGeneration(),
+		Time:               metav1.NewTime(r.clock.Now()),
+	}
+	if p.Action == PlanActionUpdate {
+		p.Diff = s.Observation.Diff
+	}
+
+	if err := r.plans.RecordPlan(ctx, managed, p); err != nil {
+		// If this is the first time we encounter this issue we'll be requeued
+		// implicitly when we update our status with the new error condition. If
+		// not, we requeue explicitly, which will trigger backoff.
+		log.Debug("Cannot record plan", "error", err)
+		record.Event(managed, event.Warning(reasonCannotPlan, err))
+		status.MarkConditions(xpv1.ReconcileError(errors.Wrap(err, errRecordPlan)))
+
+		s.Outcome = outcomeError(StagePlan, err)
+		return true, reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+	}
+
+	// We don't touch the external resource or persist anything about the
+	// managed resource while it's being planned. We requeue after the poll
+	// interval to keep the plan up to date.
+	reconcileAfter := r.pollIntervalFor(managed)
+	log.Debug("Planned external resource", "action", p.Action, "requeue-after", r.clock.Now().Add(reconcileAfter))
+	record.Event(managed, event.Normal(reasonPlanned, "Planned action "+string(p.Action)))
+
+	s.Outcome = outcome(OutcomePlanned)
+	return true, reconcile.Result{RequeueAfter: reconcileAfter}, nil
+}
+
+// delete the external resource, if necessary, then finalize the managed
+// resource once the external resource no longer exists.
+// finalized returns true if the supplied managed resource has none of the
+// finalizers our Finalizer adds. It returns false if we don't know which
+// finalizers it adds, because it was supplied by WithFinalizer.
+func (r *Reconciler) finalized(mg resource.Managed) bool {
+	if len(r.finalizers) == 0 {
+		return false
+	}
+
+	for _, f := range r.finalizers {
+		if meta.FinalizerExists(mg, f) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (r *Reconciler) delete(ctx context.Context, s *ReconcileState) (bool, reconcile.Result, error) {
+	managed := s.Managed
+	log := s.Log
+	record := s.Record
+	status := s.Status
+	policy := s.Policy
+	external := s.External
+	observation := s.Observation
+	externalCtx := s.ExternalContext()
 
 	if meta.WasDeleted(managed) {
 		log = log.WithValues("deletion-timestamp", managed.GetDeletionTimestamp())
 
 		if observation.ResourceExists && policy.ShouldDelete() {
+			if meta.IsDeletionProtected(managed) {
+				// We'll be requeued when the annotation is removed, but poll
+				// in case that event is filtered out.
+				c := xpv1.DeletionProtected()
+				log.Debug("Deletion of external resource is blocked by deletion protection")
+				record.Event(managed, event.Warning(reasonProtect, errors.New(c.Message)))
+				status.MarkConditions(c, xpv1.ReconcileSuccess())
+
+				s.Outcome = outcome(OutcomeDeletionBlocked)
+				return true, reconcile.Result{RequeueAfter: r.pollInterval}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+			}
+
+			dependents, err := r.deletionGuard.Dependents(ctx, managed)
+			if err != nil {
+				log.Debug(errCheckDependents, "error", err)
+				record.Event(managed, event.Warning(reasonCannotDelete, errors.Wrap(err, errCheckDependents)))
+				status.MarkConditions(xpv1.Deleting(), xpv1.ReconcileError(errors.Wrap(err, errCheckDependents)))
+
+				s.Outcome = outcomeError(StageDelete, err)
+				return true, reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+			}
+
+			if len(dependents) > 0 {
+				// We won't be requeued when our dependents are deleted, so we
+				// poll until they're gone.
+				c := xpv1.DeletionBlocked(dependents)
+				log.Debug("Deletion of external resource is blocked by dependents", "dependents", len(dependents))
+				record.Event(managed, event.Normal(reasonBlocked, c.Message))
+				status.MarkConditions(c, xpv1.ReconcileSuccess())
+
+				s.Outcome = outcome(OutcomeDeletionBlocked)
+				return true, reconcile.Result{RequeueAfter: r.pollInterval}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+			}
+
 			deletion, err := external.Delete(externalCtx, managed)
 			if err != nil {
 				// We'll hit this condition if we can't delete our external
@@ -1174,14 +2368,13 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 				// explicitly, which will trigger backoff.
 				log.Debug("Cannot delete external resource", "error", err)
 
-				if err := r.change.Log(ctx, managedPreOp, v1alpha1.OperationType_OPERATION_TYPE_DELETE, err, deletion.AdditionalDetails); err != nil {
-					log.Info(errRecordChangeLog, "error", err)
-				}
+				r.logChange(ctx, s, log, v1alpha1.OperationType_OPERATION_TYPE_DELETE, err, deletion.AdditionalDetails)
 
 				record.Event(managed, event.Warning(reasonCannotDelete, err))
-				status.MarkConditions(xpv1.Deleting(), xpv1.ReconcileError(errors.Wrap(err, errReconcileDelete)))
+				status.MarkConditions(xpv1.Deleting(), externalReconcileError(errors.Wrap(err, errReconcileDelete)))
 
-				return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+				s.Outcome = outcomeError(StageDelete, err)
+				return true, reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 			}
 
 			// We've successfully requested deletion of our external resource.
@@ -1193,14 +2386,14 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 			// block and try again.
 			log.Debug("Successfully requested deletion of external resource")
 
-			if err := r.change.Log(ctx, managedPreOp, v1alpha1.OperationType_OPERATION_TYPE_DELETE, nil, deletion.AdditionalDetails); err != nil {
-				log.Info(errRecordChangeLog, "error", err)
-			}
+			r.logChange(ctx, s, log, v1alpha1.OperationType_OPERATION_TYPE_DELETE, nil, deletion.AdditionalDetails)
 
 			record.Event(managed, event.Normal(reasonDeleted, "Successfully requested deletion of external resource"))
+			r.operationDetails.RecordOperationDetails(managed, xpv1.OperationDelete, deletion.AdditionalDetails)
 			status.MarkConditions(xpv1.Deleting(), xpv1.ReconcileSuccess())
 
-			return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+			s.Outcome = outcome(OutcomeDeletionRequested)
+			return true, reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 		}
 
 		if err := r.managed.UnpublishConnection(ctx, managed, observation.ConnectionDetails); err != nil {
@@ -1211,13 +2404,27 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 			log.Debug("Cannot unpublish connection details", "error", err)
 
 			if kerrors.IsConflict(err) {
-				return reconcile.Result{Requeue: true}, nil
+				s.Outcome = outcomeError(StageUnpublish, err)
+				return true, reconcile.Result{Requeue: true}, nil
 			}
 
 			record.Event(managed, event.Warning(reasonCannotUnpublish, err))
 			status.MarkConditions(xpv1.Deleting(), xpv1.ReconcileError(err))
 
-			return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+			s.Outcome = outcomeError(StageUnpublish, err)
+			return true, reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+		}
+
+		// Our external resource no longer exists. If our managed resource
+		// doesn't have our finalizer then a previous reconcile already
+		// finalized it, and we're reconciling a stale copy from our cache.
+		// Other controllers' finalizers may remain. There's nothing left to
+		// finalize, and no point requeueing.
+		if r.finalized(managed) {
+			log.Debug("Managed resource was already finalized")
+
+			s.Outcome = outcome(OutcomeAlreadyFinalized)
+			return true, reconcile.Result{Requeue: false}, nil
 		}
 
 		if err := r.managed.RemoveFinalizer(ctx, managed); err != nil {
@@ -1228,24 +2435,45 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 			log.Debug("Cannot remove managed resource finalizer", "error", err)
 
 			if kerrors.IsConflict(err) {
-				return reconcile.Result{Requeue: true}, nil
+				s.Outcome = outcomeError(StageFinalize, err)
+				return true, reconcile.Result{Requeue: true}, nil
 			}
 
 			status.MarkConditions(xpv1.Deleting(), xpv1.ReconcileError(err))
 
-			return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+			s.Outcome = outcomeError(StageFinalize, err)
+			return true, reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 		}
 
+		r.observations.Delete(managed)
+		r.updateCooldown.Forget(managed)
+		r.references.Forget(managed)
+		r.driftLoops.Forget(managed)
+		r.deprecations.Forget(managed)
+
 		// We've successfully deleted our external resource (if necessary) and
 		// removed our finalizer. If we assume we were the only controller that
 		// added a finalizer to this resource then it should no longer exist and
 		// thus there is no point trying to update its status.
-		r.metricRecorder.recordDeleted(managed)
+		r.metricRecorder.recordDeleted(managed, r.clock.Now())
 		log.Debug("Successfully deleted managed resource")
 
-		return reconcile.Result{Requeue: false}, nil
+		s.Outcome = outcome(OutcomeDeleted)
+		return true, reconcile.Result{Requeue: false}, nil
 	}
 
+	return false, reconcile.Result{}, nil
+}
+
+// publish the external resource's connection details, and add a finalizer to
+// the managed resource.
+func (r *Reconciler) publish(ctx context.Context, s *ReconcileState) (bool, reconcile.Result, error) {
+	managed := s.Managed
+	log := s.Log
+	record := s.Record
+	status := s.Status
+	observation := s.Observation
+
 	if _, err := r.managed.PublishConnection(ctx, managed, observation.ConnectionDetails); err != nil {
 		// If this is the first time we encounter this issue we'll be requeued
 		// implicitly when we update our status with the new error condition. If
@@ -1253,13 +2481,15 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 		log.Debug("Cannot publish connection details", "error", err)
 
 		if kerrors.IsConflict(err) {
-			return reconcile.Result{Requeue: true}, nil
+			s.Outcome = outcomeError(StagePublish, err)
+			return true, reconcile.Result{Requeue: true}, nil
 		}
 
 		record.Event(managed, event.Warning(reasonCannotPublish, err))
 		status.MarkConditions(xpv1.ReconcileError(err))
 
-		return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+		s.Outcome = outcomeError(StagePublish, err)
+		return true, reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 	}
 
 	if err := r.managed.AddFinalizer(ctx, managed); err != nil {
@@ -1269,15 +2499,79 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 		log.Debug("Cannot add finalizer", "error", err)
 
 		if kerrors.IsConflict(err) {
-			return reconcile.Result{Requeue: true}, nil
+			s.Outcome = outcomeError(StageFinalize, err)
+			return true, reconcile.Result{Requeue: true}, nil
 		}
 
 		status.MarkConditions(xpv1.ReconcileError(err))
 
-		return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+		s.Outcome = outcomeError(StageFinalize, err)
+		return true, reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 	}
 
+	return false, reconcile.Result{}, nil
+}
+
+// create the external resource if it does not exist.
+func (r *Reconciler) create(ctx context.Context, s *ReconcileState) (bool, reconcile.Result, error) {
+	managed := s.Managed
+	log := s.Log
+	record := s.Record
+	status := s.Status
+	policy := s.Policy
+	external := s.External
+	observation := s.Observation
+	externalCtx := s.ExternalContext()
+
 	if !observation.ResourceExists && policy.ShouldCreate() {
+		if r.nameConstraints != nil {
+			if err := r.nameConstraints.Validate(meta.GetExternalName(managed)); err != nil {
+				// Creation would fail the same way every time, so we don't
+				// requeue. We'll be queued again when the managed resource
+				// changes.
+				log.Debug("Cannot create external resource with invalid external name", "error", err)
+				record.Event(managed, event.Warning(reasonInvalidExternalName, err))
+				status.MarkConditions(xpv1.Creating(), InvalidExternalName(err))
+
+				s.Outcome = outcomeError(StageCreate, err)
+				return true, reconcile.Result{Requeue: false}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+			}
+		}
+
+		decision, err := r.creationGate.AllowCreate(externalCtx, managed)
+		if err != nil {
+			log.Debug(errCheckCreationGate, "error", err)
+			record.Event(managed, event.Warning(reasonCannotCreate, errors.Wrap(err, errCheckCreationGate)))
+			status.MarkConditions(xpv1.Creating(), xpv1.ReconcileError(errors.Wrap(err, errCheckCreationGate)))
+
+			s.Outcome = outcomeError(StageCreate, err)
+			return true, reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+		}
+
+		switch decision.Verdict {
+		case CreateDeferred:
+			after := decision.RequeueAfter
+			if after == 0 {
+				after = r.pollInterval
+			}
+
+			log.Debug("Creation of external resource was deferred", "reason", decision.Message, "requeue-after", after)
+			record.Event(managed, event.Normal(reasonDeferred, decision.Message))
+			status.MarkConditions(CreationDeferred(decision.Message), xpv1.ReconcileSuccess())
+
+			s.Outcome = outcome(OutcomeCreationDeferred)
+			return true, reconcile.Result{RequeueAfter: after}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+		case CreateDenied:
+			// Asking again won't change the answer, so we don't requeue.
+			// We'll be queued again when the managed resource changes.
+			log.Debug("Creation of external resource was denied", "reason", decision.Message)
+			record.Event(managed, event.Warning(reasonDenied, errors.New(decision.Message)))
+			status.MarkConditions(CreationDenied(decision.Message), xpv1.ReconcileError(errors.New(decision.Message)))
+
+			s.Outcome = outcome(OutcomeCreationDenied)
+			return true, reconcile.Result{Requeue: false}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+		}
+
 		// We write this annotation for two reasons. Firstly, it helps
 		// us to detect the case in which we fail to persist critical
 		// information (like the external name) that may be set by the
@@ -1285,19 +2579,21 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 		// we're operating on the latest version of our resource. We
 		// don't use the CriticalAnnotationUpdater because we _want_ the
 		// update to fail if we get a 409 due to a stale version.
-		meta.SetExternalCreatePending(managed, time.Now())
+		meta.SetExternalCreatePending(managed, r.clock.Now())
 
 		if err := r.client.Update(ctx, managed); err != nil {
 			log.Debug(errUpdateManaged, "error", err)
 
 			if kerrors.IsConflict(err) {
-				return reconcile.Result{Requeue: true}, nil
+				s.Outcome = outcomeError(StageCreate, err)
+				return true, reconcile.Result{Requeue: true}, nil
 			}
 
 			record.Event(managed, event.Warning(reasonCannotUpdateManaged, errors.Wrap(err, errUpdateManaged)))
 			status.MarkConditions(xpv1.Creating(), xpv1.ReconcileError(errors.Wrap(err, errUpdateManaged)))
 
-			return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+			s.Outcome = outcomeError(StageCreate, err)
+			return true, reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 		}
 
 		creation, err := external.Create(externalCtx, managed)
@@ -1319,7 +2615,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 			// the reconciler will refuse to proceed, because it
 			// won't know whether or not it created an external
 			// resource.
-			meta.SetExternalCreateFailed(managed, time.Now())
+			meta.SetExternalCreateFailed(managed, r.clock.Now())
 
 			if err := r.managed.UpdateCriticalAnnotations(ctx, managed); err != nil {
 				log.Debug(errUpdateManagedAnnotations, "error", err)
@@ -1332,22 +2628,19 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 				// create failed.
 			}
 
-			if err := r.change.Log(ctx, managedPreOp, v1alpha1.OperationType_OPERATION_TYPE_CREATE, err, creation.AdditionalDetails); err != nil {
-				log.Info(errRecordChangeLog, "error", err)
-			}
+			r.logChange(ctx, s, log, v1alpha1.OperationType_OPERATION_TYPE_CREATE, err, creation.AdditionalDetails)
 
-			status.MarkConditions(xpv1.Creating(), xpv1.ReconcileError(errors.Wrap(err, errReconcileCreate)))
+			status.MarkConditions(xpv1.Creating(), externalReconcileError(errors.Wrap(err, errReconcileCreate)))
 
-			return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+			s.Outcome = outcomeError(StageCreate, err)
+			return true, reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 		}
 
 		// In some cases our external-name may be set by Create above.
-		log = log.WithValues("external-name", meta.GetExternalName(managed))
-		record = r.record.WithAnnotations("external-name", meta.GetExternalName(managed))
+		log = log.WithValues(logging.KeyExternalName, meta.GetExternalName(managed))
+		record = r.record.WithAnnotations("external-name", meta.GetExternalName(managed), logging.KeyReconcileID, s.ID)
 
-		if err := r.change.Log(ctx, managedPreOp, v1alpha1.OperationType_OPERATION_TYPE_CREATE, nil, creation.AdditionalDetails); err != nil {
-			log.Info(errRecordChangeLog, "error", err)
-		}
+		r.logChange(ctx, s, log, v1alpha1.OperationType_OPERATION_TYPE_CREATE, nil, creation.AdditionalDetails)
 
 		// We handle annotations specially here because it's critical
 		// that they are persisted to the API server. If we don't remove
@@ -1359,19 +2652,21 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 		// reverted when annotations are updated; at the time of writing
 		// Create implementations are advised not to alter status, but
 		// we may revisit this in future.
-		meta.SetExternalCreateSucceeded(managed, time.Now())
+		meta.SetExternalCreateSucceeded(managed, r.clock.Now())
 
 		if err := r.managed.UpdateCriticalAnnotations(ctx, managed); err != nil {
 			log.Debug(errUpdateManagedAnnotations, "error", err)
 
 			if kerrors.IsConflict(err) {
-				return reconcile.Result{Requeue: true}, nil
+				s.Outcome = outcomeError(StageCreate, err)
+				return true, reconcile.Result{Requeue: true}, nil
 			}
 
 			record.Event(managed, event.Warning(reasonCannotUpdateManaged, errors.Wrap(err, errUpdateManagedAnnotations)))
 			status.MarkConditions(xpv1.Creating(), xpv1.ReconcileError(errors.Wrap(err, errUpdateManagedAnnotations)))
 
-			return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+			s.Outcome = outcomeError(StageCreate, err)
+			return true, reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 		}
 
 		if _, err := r.managed.PublishConnection(ctx, managed, creation.ConnectionDetails); err != nil {
@@ -1381,13 +2676,15 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 			log.Debug("Cannot publish connection details", "error", err)
 
 			if kerrors.IsConflict(err) {
-				return reconcile.Result{Requeue: true}, nil
+				s.Outcome = outcomeError(StagePublish, err)
+				return true, reconcile.Result{Requeue: true}, nil
 			}
 
 			record.Event(managed, event.Warning(reasonCannotPublish, err))
 			status.MarkConditions(xpv1.Creating(), xpv1.ReconcileError(err))
 
-			return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+			s.Outcome = outcomeError(StagePublish, err)
+			return true, reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 		}
 
 		// We've successfully created our external resource. In many cases the
@@ -1396,11 +2693,26 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 		// ready for use.
 		log.Debug("Successfully requested creation of external resource")
 		record.Event(managed, event.Normal(reasonCreated, "Successfully requested creation of external resource"))
+		r.operationDetails.RecordOperationDetails(managed, xpv1.OperationCreate, creation.AdditionalDetails)
 		status.MarkConditions(xpv1.Creating(), xpv1.ReconcileSuccess())
 
-		return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+		s.Outcome = outcome(OutcomeCreated)
+		return true, reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 	}
 
+	return false, reconcile.Result{}, nil
+}
+
+// lateInitialize persists any fields of the managed resource that were late
+// initialized when the external resource was observed.
+func (r *Reconciler) lateInitialize(ctx context.Context, s *ReconcileState) (bool, reconcile.Result, error) {
+	managed := s.Managed
+	log := s.Log
+	record := s.Record
+	status := s.Status
+	policy := s.Policy
+	observation := s.Observation
+
 	if observation.ResourceLateInitialized && policy.ShouldLateInitialize() {
 		// Note that this update may reset any pending updates to the status of
 		// the managed resource from when it was observed above. This is because
@@ -1409,15 +2721,31 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 		// This is usually tolerable because the update will implicitly requeue
 		// an immediate reconcile which should re-observe the external resource
 		// and persist its status.
-		if err := r.client.Update(ctx, managed); err != nil {
+		if err := r.managed.PersistSpec(ctx, managed); err != nil {
 			log.Debug(errUpdateManaged, "error", err)
 			record.Event(managed, event.Warning(reasonCannotUpdateManaged, err))
 			status.MarkConditions(xpv1.ReconcileError(errors.Wrap(err, errUpdateManaged)))
 
-			return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+			s.Outcome = outcomeError(StageLateInitialize, err)
+			return true, reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 		}
 	}
 
+	return false, reconcile.Result{}, nil
+}
+
+// update the external resource if it is not up to date. This is the final
+// stage of the reconcile.
+func (r *Reconciler) update(ctx context.Context, s *ReconcileState) (bool, reconcile.Result, error) {
+	managed := s.Managed
+	log := s.Log
+	record := s.Record
+	status := s.Status
+	policy := s.Policy
+	external := s.External
+	observation := s.Observation
+	externalCtx := s.ExternalContext()
+
 	if observation.ResourceUpToDate {
 		// We did not need to create, update, or delete our external resource.
 		// Per the below issue nothing will notify us if and when the external
@@ -1425,31 +2753,69 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 		// after the specified poll interval in order to observe it and react
 		// accordingly.
 		// https://github.com/crossplane/crossplane/issues/289
-		reconcileAfter := r.pollIntervalHook(managed, r.pollInterval)
-		log.Debug("External resource is up to date", "requeue-after", time.Now().Add(reconcileAfter))
+		reconcileAfter := r.pollIntervalFor(managed)
+		log.Debug("External resource is up to date", "requeue-after", r.clock.Now().Add(reconcileAfter))
 		status.MarkConditions(xpv1.ReconcileSuccess())
-		r.metricRecorder.recordFirstTimeReady(managed)
+		r.metricRecorder.recordFirstTimeReady(managed, r.clock.Now())
+
+		// Any drift loop is over now that we've observed the external resource
+		// to be up to date.
+		r.driftLoops.Forget(managed)
+		if managed.GetCondition(xpv1.TypeDriftLoop).Status == corev1.ConditionTrue {
+			status.MarkConditions(xpv1.NoDriftLoop())
+		}
 
 		// record that we intentionally did not update the managed resource
 		// because no drift was detected. We call this so late in the reconcile
 		// because all the cases above could contribute (for different reasons)
 		// that the external object would not have been updated.
-		r.metricRecorder.recordUnchanged(managed.GetName())
+		r.metricRecorder.recordUnchanged(managed.GetName(), r.clock.Now())
 
-		return reconcile.Result{RequeueAfter: reconcileAfter}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+		s.Outcome = outcome(OutcomeUpToDate)
+		return true, reconcile.Result{RequeueAfter: reconcileAfter}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 	}
 
 	if observation.Diff != "" {
 		log.Debug("External resource differs from desired state", "diff", observation.Diff)
+		recordDiff(s, observation.Diff)
 	}
 
 	// skip the update if the management policy is set to ignore updates
 	if !policy.ShouldUpdate() {
-		reconcileAfter := r.pollIntervalHook(managed, r.pollInterval)
-		log.Debug("Skipping update due to managementPolicies. Reconciliation succeeded", "requeue-after", time.Now().Add(reconcileAfter))
+		reconcileAfter := r.pollIntervalFor(managed)
+		log.Debug("Skipping update due to managementPolicies. Reconciliation succeeded", "requeue-after", r.clock.Now().Add(reconcileAfter))
 		status.MarkConditions(xpv1.ReconcileSuccess())
 
-		return reconcile.Result{RequeueAfter: reconcileAfter}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+		s.Outcome = outcome(OutcomePolicySkip)
+		return true, reconcile.Result{RequeueAfter: reconcileAfter}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+	}
+
+	if allChangesIgnored(managed, observation) {
+		reconcileAfter := r.pollIntervalFor(managed)
+		log.Debug("Skipping update because all changes are ignored", "paths", observation.DiffPaths, "requeue-after", r.clock.Now().Add(reconcileAfter))
+		status.MarkConditions(xpv1.ReconcileSuccess())
+		r.metricRecorder.recordUnchanged(managed.GetName(), r.clock.Now())
+
+		s.Outcome = outcome(OutcomeUpdateSkipped)
+		return true, reconcile.Result{RequeueAfter: reconcileAfter}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+	}
+
+	if r.updateSkipPredicate(externalCtx, managed, observation) {
+		reconcileAfter := r.pollIntervalFor(managed)
+		log.Debug("Skipping update due to update skip predicate. Reconciliation succeeded", "requeue-after", r.clock.Now().Add(reconcileAfter))
+		status.MarkConditions(xpv1.ReconcileSuccess())
+		r.metricRecorder.recordUnchanged(managed.GetName(), r.clock.Now())
+
+		s.Outcome = outcome(OutcomeUpdateSkipped)
+		return true, reconcile.Result{RequeueAfter: reconcileAfter}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+	}
+
+	if wait := r.updateCooldown.Remaining(managed, r.clock.Now()); wait > 0 {
+		log.Debug("Skipping update during update cooldown", "requeue-after", r.clock.Now().Add(wait))
+		status.MarkConditions(xpv1.ReconcileSuccess())
+
+		s.Outcome = outcome(OutcomeUpdateCooldown)
+		return true, reconcile.Result{RequeueAfter: wait}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 	}
 
 	update, err := external.Update(externalCtx, managed)
@@ -1461,22 +2827,21 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 		// condition. If not, we requeue explicitly, which will trigger backoff.
 		log.Debug("Cannot update external resource")
 
-		if err := r.change.Log(ctx, managedPreOp, v1alpha1.OperationType_OPERATION_TYPE_UPDATE, err, update.AdditionalDetails); err != nil {
-			log.Info(errRecordChangeLog, "error", err)
-		}
+		r.logChange(ctx, s, log, v1alpha1.OperationType_OPERATION_TYPE_UPDATE, err, update.AdditionalDetails)
 
 		record.Event(managed, event.Warning(reasonCannotUpdate, err))
-		status.MarkConditions(xpv1.ReconcileError(errors.Wrap(err, errReconcileUpdate)))
+		status.MarkConditions(externalReconcileError(errors.Wrap(err, errReconcileUpdate)))
 
-		return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+		s.Outcome = outcomeError(StageUpdate, err)
+		return true, reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 	}
 
 	// record the drift after the successful update.
-	r.metricRecorder.recordDrift(managed)
+	r.metricRecorder.recordDrift(managed, r.clock.Now())
+	r.updateCooldown.Record(managed, r.clock.Now())
+	updates := r.driftLoops.Updated(managed)
 
-	if err := r.change.Log(ctx, managedPreOp, v1alpha1.OperationType_OPERATION_TYPE_UPDATE, nil, update.AdditionalDetails); err != nil {
-		log.Info(errRecordChangeLog, "error", err)
-	}
+	r.logChange(ctx, s, log, v1alpha1.OperationType_OPERATION_TYPE_UPDATE, nil, update.AdditionalDetails)
 
 	if _, err := r.managed.PublishConnection(ctx, managed, update.ConnectionDetails); err != nil {
 		// If this is the first time we encounter this issue we'll be requeued
@@ -1486,7 +2851,8 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 		record.Event(managed, event.Warning(reasonCannotPublish, err))
 		status.MarkConditions(xpv1.ReconcileError(err))
 
-		return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+		s.Outcome = outcomeError(StagePublish, err)
+		return true, reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
 	}
 
 	// We've successfully updated our external resource. Per the below issue
@@ -1494,10 +2860,46 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (resu
 	// changes, so we requeue a speculative reconcile after the specified poll
 	// interval in order to observe it and react accordingly.
 	// https://github.com/crossplane/crossplane/issues/289
-	reconcileAfter := r.pollIntervalHook(managed, r.pollInterval)
-	log.Debug("Successfully requested update of external resource", "requeue-after", time.Now().Add(reconcileAfter))
+	reconcileAfter := r.pollIntervalFor(managed)
+
+	if r.driftLoops.Detected(updates) {
+		// Something else keeps changing the external resource back. Tell
+		// the user, and back off so we don't fight it as often.
+		if updates == r.driftLoops.threshold {
+			r.metricRecorder.recordDriftLoop(managed)
+			record.Event(managed, event.Warning(reasonDriftLoopDetected, errors.Errorf("external resource was updated %d consecutive times without being observed as up to date", updates)))
+		}
+
+		reconcileAfter = r.driftLoops.PollInterval(reconcileAfter)
+		status.MarkConditions(xpv1.DriftLoopDetected(updates))
+	}
+
+	log.Debug("Successfully requested update of external resource", "requeue-after", r.clock.Now().Add(reconcileAfter))
 	record.Event(managed, event.Normal(reasonUpdated, "Successfully requested update of external resource"))
+	r.operationDetails.RecordOperationDetails(managed, xpv1.OperationUpdate, update.AdditionalDetails)
 	status.MarkConditions(xpv1.ReconcileSuccess())
 
-	return reconcile.Result{RequeueAfter: reconcileAfter}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+	s.Outcome = outcome(OutcomeUpdated)
+	return true, reconcile.Result{RequeueAfter: reconcileAfter}, errors.Wrap(r.client.Status().Update(ctx, managed), errUpdateManagedStatus)
+}
+
+// externalReconcileError returns a condition indicating that Crossplane
+// encountered the supplied error while calling the external system. Errors
+// classified as throttling, quota, or availability problems produce conditions
+// with more specific reasons than ReconcileError.
+func externalReconcileError(err error) xpv1.Condition {
+	if d, ok := errors.IsThrottled(err); ok {
+		c := xpv1.Throttled(d)
+		return c.WithMessage(c.Message + ": " + err.Error())
+	}
+
+	if errors.IsQuotaExceeded(err) {
+		return xpv1.QuotaExceeded(err.Error())
+	}
+
+	if errors.IsUpstreamUnavailable(err) {
+		return xpv1.UpstreamUnavailable(err.Error())
+	}
+
+	return xpv1.ReconcileError(err)
 }
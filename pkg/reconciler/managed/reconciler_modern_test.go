@@ -26,18 +26,23 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/sets"
+	clocktesting "k8s.io/utils/clock/testing"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/crossplane/crossplane-runtime/v2/apis/changelogs/proto/v1alpha1"
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/conditions"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+	umanaged "github.com/crossplane/crossplane-runtime/v2/pkg/resource/unstructured/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
 )
 
@@ -267,6 +272,38 @@ func TestModernReconciler(t *testing.T) {
 			},
 			want: want{result: reconcile.Result{Requeue: true}},
 		},
+		"ResolveReferencesErrorCondition": {
+			reason: "Errors during reference resolution should set the ReferencesResolved condition if configured to.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet: modernManagedMockGetFn(nil, 42),
+						MockStatusUpdate: test.MockSubResourceUpdateFn(func(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+							want := newModernManaged(42)
+							want.SetConditions(
+								xpv1.ReconcileError(errBoom).WithObservedGeneration(42),
+								xpv1.ReferencesUnresolved(errBoom).WithObservedGeneration(42),
+							)
+							if diff := cmp.Diff(want, obj, test.EquateConditions()); diff != "" {
+								reason := "Errors during reference resolution should be reported as conditions."
+								t.Errorf("\nReason: %s\n-want, +got:\n%s", reason, diff)
+							}
+							return nil
+						}),
+					},
+					Scheme: fake.SchemeWith(&fake.ModernManaged{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.ModernManaged{})),
+				o: []ReconcilerOption{
+					WithInitializers(),
+					WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error {
+						return errBoom
+					})),
+					WithReferencesResolvedCondition(),
+				},
+			},
+			want: want{result: reconcile.Result{Requeue: true}},
+		},
 		"ExternalConnectError": {
 			reason: "Errors connecting to the provider should trigger a requeue after a short wait.",
 			args: args{
@@ -492,6 +529,93 @@ func TestModernReconciler(t *testing.T) {
 			},
 			want: want{result: reconcile.Result{Requeue: true}},
 		},
+		"ExternalResourceBeingDeletedManagedDeleted": {
+			reason: "A deleted managed resource whose external resource is already being deleted should not delete it again, and should requeue after the being deleted poll interval.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+							mg := asModernManaged(obj, 42)
+							mg.SetDeletionTimestamp(&now)
+							return nil
+						}),
+						MockStatusUpdate: test.MockSubResourceUpdateFn(func(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+							want := newModernManaged(42)
+							want.SetDeletionTimestamp(&now)
+							want.SetConditions(xpv1.Deleting().WithObservedGeneration(42), xpv1.ReconcileSuccess().WithObservedGeneration(42))
+							if diff := cmp.Diff(want, obj, test.EquateConditions()); diff != "" {
+								reason := "An external resource that is being deleted should be reported as a conditioned status."
+								t.Errorf("\nReason: %s\n-want, +got:\n%s", reason, diff)
+							}
+							return nil
+						}),
+					},
+					Scheme: fake.SchemeWith(&fake.ModernManaged{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.ModernManaged{})),
+				o: []ReconcilerOption{
+					WithInitializers(),
+					WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+					WithExternalConnector(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+						c := &ExternalClientFns{
+							ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+								return ExternalObservation{ResourceExists: true, ResourceBeingDeleted: true}, nil
+							},
+							DeleteFn: func(_ context.Context, _ resource.Managed) (ExternalDelete, error) {
+								return ExternalDelete{}, errors.New("Delete should not be called")
+							},
+							DisconnectFn: func(_ context.Context) error {
+								return nil
+							},
+						}
+						return c, nil
+					})),
+				},
+			},
+			want: want{result: reconcile.Result{RequeueAfter: defaultBeingDeletedPollInterval}},
+		},
+		"ExternalResourceBeingDeleted": {
+			reason: "A managed resource whose external resource is being deleted should not update it, and should requeue after the being deleted poll interval.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet: modernManagedMockGetFn(nil, 42),
+						MockStatusUpdate: test.MockSubResourceUpdateFn(func(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+							want := newModernManaged(42)
+							want.SetConditions(xpv1.ExternalDeleting().WithObservedGeneration(42), xpv1.ReconcileSuccess().WithObservedGeneration(42))
+							if diff := cmp.Diff(want, obj, test.EquateConditions()); diff != "" {
+								reason := "An external resource that is being deleted should be reported as a conditioned status."
+								t.Errorf("\nReason: %s\n-want, +got:\n%s", reason, diff)
+							}
+							return nil
+						}),
+					},
+					Scheme: fake.SchemeWith(&fake.ModernManaged{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.ModernManaged{})),
+				o: []ReconcilerOption{
+					WithInitializers(),
+					WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+					WithExternalConnector(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+						c := &ExternalClientFns{
+							ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+								return ExternalObservation{ResourceExists: true, ResourceBeingDeleted: true}, nil
+							},
+							UpdateFn: func(_ context.Context, _ resource.Managed) (ExternalUpdate, error) {
+								return ExternalUpdate{}, errors.New("Update should not be called")
+							},
+							DisconnectFn: func(_ context.Context) error {
+								return nil
+							},
+						}
+						return c, nil
+					})),
+					WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+					WithBeingDeletedPollInterval(5 * time.Second),
+				},
+			},
+			want: want{result: reconcile.Result{RequeueAfter: 5 * time.Second}},
+		},
 		"UnpublishConnectionDetailsDeletionPolicyDeleteError": {
 			reason: "Errors unpublishing connection details should trigger a requeue after a short wait.",
 			args: args{
@@ -984,6 +1108,45 @@ func TestModernReconciler(t *testing.T) {
 			},
 			want: want{result: reconcile.Result{Requeue: true}},
 		},
+		"LateInitializeSpecPersisterError": {
+			reason: "Errors persisting late initialized fields using a custom SpecPersister should trigger a requeue after a short wait.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet: modernManagedMockGetFn(nil, 42),
+						MockStatusUpdate: test.MockSubResourceUpdateFn(func(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+							want := newModernManaged(42)
+							want.SetConditions(xpv1.ReconcileError(errors.Wrap(errBoom, errUpdateManaged)).WithObservedGeneration(42))
+							if diff := cmp.Diff(want, obj, test.EquateConditions()); diff != "" {
+								reason := "Errors persisting a managed resource's spec should be reported as a conditioned status."
+								t.Errorf("\nReason: %s\n-want, +got:\n%s", reason, diff)
+							}
+							return nil
+						}),
+					},
+					Scheme: fake.SchemeWith(&fake.ModernManaged{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.ModernManaged{})),
+				o: []ReconcilerOption{
+					WithInitializers(),
+					WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+					WithExternalConnector(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+						c := &ExternalClientFns{
+							ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+								return ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true}, nil
+							},
+							DisconnectFn: func(_ context.Context) error {
+								return nil
+							},
+						}
+						return c, nil
+					})),
+					WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+					WithSpecPersister(SpecPersisterFn(func(_ context.Context, _ resource.Managed) error { return errBoom })),
+				},
+			},
+			want: want{result: reconcile.Result{Requeue: true}},
+		},
 		"ExternalResourceUpToDate": {
 			reason: "When the external resource exists and is up to date a requeue should be triggered after a long wait.",
 			args: args{
@@ -1022,6 +1185,89 @@ func TestModernReconciler(t *testing.T) {
 			},
 			want: want{result: reconcile.Result{RequeueAfter: defaultPollInterval}},
 		},
+		"ExternalResourceAccessDenied": {
+			reason: "When the external client lacks access to the external resource we should not try to update it.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet: modernManagedMockGetFn(nil, 42),
+						MockStatusUpdate: test.MockSubResourceUpdateFn(func(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+							want := newModernManaged(42)
+							want.SetConditions(xpv1.AccessDenied(errBoom).WithObservedGeneration(42), xpv1.ReconcileSuccess().WithObservedGeneration(42))
+							if diff := cmp.Diff(want, obj, test.EquateConditions()); diff != "" {
+								reason := "A managed resource that can only be observed should be reported as a conditioned status."
+								t.Errorf("\nReason: %s\n-want, +got:\n%s", reason, diff)
+							}
+							return nil
+						}),
+					},
+					Scheme: fake.SchemeWith(&fake.ModernManaged{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.ModernManaged{})),
+				o: []ReconcilerOption{
+					WithInitializers(),
+					WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+					WithExternalConnector(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+						c := &ExternalClientFns{
+							ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+								return ExternalObservation{ResourceExists: true, ResourceUpToDate: false, AccessDenied: errBoom}, nil
+							},
+							UpdateFn: func(_ context.Context, _ resource.Managed) (ExternalUpdate, error) {
+								return ExternalUpdate{}, errors.New("Update should not be called")
+							},
+							DisconnectFn: func(_ context.Context) error {
+								return nil
+							},
+						}
+						return c, nil
+					})),
+					WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+				},
+			},
+			want: want{result: reconcile.Result{RequeueAfter: defaultPollInterval}},
+		},
+		"ExternalResourceAccessRestored": {
+			reason: "When the external client regains access to the external resource we should report that it's no longer degraded.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+							mg := asModernManaged(obj, 42)
+							mg.SetConditions(xpv1.AccessDenied(errBoom))
+							return nil
+						}),
+						MockStatusUpdate: test.MockSubResourceUpdateFn(func(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+							want := newModernManaged(42)
+							want.SetConditions(xpv1.FullAccess().WithObservedGeneration(42), xpv1.ReconcileSuccess().WithObservedGeneration(42))
+							if diff := cmp.Diff(want, obj, test.EquateConditions()); diff != "" {
+								reason := "A managed resource that is no longer degraded should be reported as a conditioned status."
+								t.Errorf("\nReason: %s\n-want, +got:\n%s", reason, diff)
+							}
+							return nil
+						}),
+					},
+					Scheme: fake.SchemeWith(&fake.ModernManaged{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.ModernManaged{})),
+				o: []ReconcilerOption{
+					WithInitializers(),
+					WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+					WithExternalConnector(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+						c := &ExternalClientFns{
+							ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+								return ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+							},
+							DisconnectFn: func(_ context.Context) error {
+								return nil
+							},
+						}
+						return c, nil
+					})),
+					WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+				},
+			},
+			want: want{result: reconcile.Result{RequeueAfter: defaultPollInterval}},
+		},
 		"ExternalResourceUpToDateWithJitter": {
 			reason: "When the external resource exists and is up to date a requeue should be triggered after a long wait with jitter added.",
 			args: args{
@@ -1064,6 +1310,75 @@ func TestModernReconciler(t *testing.T) {
 				})},
 			},
 		},
+		"ExternalResourceUpToDateWithDeterministicJitter": {
+			reason: "When the external resource exists and is up to date a requeue should be triggered after the poll interval plus jitter read from the supplied source.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet: modernManagedMockGetFn(nil, 42),
+						MockStatusUpdate: test.MockSubResourceUpdateFn(func(_ context.Context, _ client.Object, _ ...client.SubResourceUpdateOption) error {
+							return nil
+						}),
+					},
+					Scheme: fake.SchemeWith(&fake.ModernManaged{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.ModernManaged{})),
+				o: []ReconcilerOption{
+					WithInitializers(),
+					WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+					WithExternalConnector(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+						c := &ExternalClientFns{
+							ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+								return ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+							},
+							DisconnectFn: func(_ context.Context) error {
+								return nil
+							},
+						}
+						return c, nil
+					})),
+					WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+					WithPollJitterHookSource(time.Second, func() float64 { return 1 }),
+				},
+			},
+			want: want{
+				result: reconcile.Result{RequeueAfter: defaultPollInterval + time.Second},
+			},
+		},
+		"ExternalResourceMissingWithinFakeClockGracePeriod": {
+			reason: "When the external resource does not exist, but was created within the grace period according to the reconciler's clock, we should requeue without creating it.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+							mg := asModernManaged(obj, 42)
+							meta.SetExternalCreateSucceeded(mg, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+							return nil
+						}),
+					},
+					Scheme: fake.SchemeWith(&fake.ModernManaged{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.ModernManaged{})),
+				o: []ReconcilerOption{
+					WithInitializers(),
+					WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+					WithExternalConnector(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+						c := &ExternalClientFns{
+							ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+								return ExternalObservation{ResourceExists: false}, nil
+							},
+							DisconnectFn: func(_ context.Context) error {
+								return nil
+							},
+						}
+						return c, nil
+					})),
+					WithCreationGracePeriod(1 * time.Minute),
+					WithClock(clocktesting.NewFakePassiveClock(time.Date(2026, 1, 1, 0, 0, 30, 0, time.UTC))),
+				},
+			},
+			want: want{result: reconcile.Result{Requeue: true}},
+		},
 		"ExternalResourceUpToDateWithPollIntervalHook": {
 			reason: "When the external resource exists and is up to date a requeue should be triggered after a long wait processed by the interval hook.",
 			args: args{
@@ -1142,6 +1457,46 @@ func TestModernReconciler(t *testing.T) {
 				result: reconcile.Result{RequeueAfter: 3 * defaultPollInterval},
 			},
 		},
+		"ExternalResourceUpToDateWithRequeueHook": {
+			reason: "The requeue hook should be passed the outcome and computed result of the reconcile, and may adjust the result.",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet: modernManagedMockGetFn(nil, 42),
+						MockStatusUpdate: test.MockSubResourceUpdateFn(func(_ context.Context, _ client.Object, _ ...client.SubResourceUpdateOption) error {
+							return nil
+						}),
+					},
+					Scheme: fake.SchemeWith(&fake.ModernManaged{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.ModernManaged{})),
+				o: []ReconcilerOption{
+					WithInitializers(),
+					WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+					WithExternalConnector(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+						c := &ExternalClientFns{
+							ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+								return ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+							},
+							DisconnectFn: func(_ context.Context) error {
+								return nil
+							},
+						}
+						return c, nil
+					})),
+					WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+					WithRequeueHook(func(_ context.Context, _ resource.Managed, o ReconcileOutcome, result reconcile.Result) reconcile.Result {
+						if o.Type != OutcomeUpToDate || result.RequeueAfter != defaultPollInterval {
+							return result
+						}
+						return reconcile.Result{RequeueAfter: 24 * time.Hour}
+					}),
+				},
+			},
+			want: want{
+				result: reconcile.Result{RequeueAfter: 24 * time.Hour},
+			},
+		},
 		"UpdateExternalError": {
 			reason: "Errors while updating an external resource should trigger a requeue after a short wait.",
 			args: args{
@@ -1347,6 +1702,54 @@ func TestModernReconciler(t *testing.T) {
 			},
 			want: want{result: reconcile.Result{}},
 		},
+		"ReconciliationPausedRecently": {
+			reason: `If a managed resource was paused more recently than the paused warning duration, it should be requeued when the warning is due.`,
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+							mg := asModernManaged(obj, 42)
+							mg.SetAnnotations(map[string]string{meta.AnnotationKeyReconciliationPaused: "true"})
+							mg.SetConditions(xpv1.ReconcilePaused().WithObservedGeneration(42))
+							mg.Conditions[0].LastTransitionTime = metav1.NewTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+							return nil
+						}),
+						MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+					},
+					Scheme: fake.SchemeWith(&fake.ModernManaged{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.ModernManaged{})),
+				o: []ReconcilerOption{
+					WithPausedWarningAfter(1 * time.Hour),
+					WithClock(clocktesting.NewFakePassiveClock(time.Date(2026, 1, 1, 0, 10, 0, 0, time.UTC))),
+				},
+			},
+			want: want{result: reconcile.Result{RequeueAfter: 50 * time.Minute}},
+		},
+		"ReconciliationPausedTooLong": {
+			reason: `If a managed resource has been paused for longer than the paused warning duration, we should emit a warning and requeue when the next warning is due.`,
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+							mg := asModernManaged(obj, 42)
+							mg.SetAnnotations(map[string]string{meta.AnnotationKeyReconciliationPaused: "true"})
+							mg.SetConditions(xpv1.ReconcilePaused().WithObservedGeneration(42))
+							mg.Conditions[0].LastTransitionTime = metav1.NewTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+							return nil
+						}),
+						MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+					},
+					Scheme: fake.SchemeWith(&fake.ModernManaged{}),
+				},
+				mg: resource.ManagedKind(fake.GVK(&fake.ModernManaged{})),
+				o: []ReconcilerOption{
+					WithPausedWarningAfter(1 * time.Hour),
+					WithClock(clocktesting.NewFakePassiveClock(time.Date(2026, 1, 1, 2, 0, 0, 0, time.UTC))),
+				},
+			},
+			want: want{result: reconcile.Result{RequeueAfter: 1 * time.Hour}},
+		},
 		"ManagementPolicyReconciliationPausedSuccessful": {
 			reason: `If a managed resource has the pause annotation with value "true", there should be no further requeue requests.`,
 			args: args{
@@ -2663,6 +3066,10 @@ func TestReconcilerChangeLogs(t *testing.T) {
 			if diff := cmp.Diff(tc.want.errMessage, tc.args.c.requests[0].GetEntry().GetErrorMessage()); diff != "" {
 				t.Errorf("\nReason: %s\nr.Reconcile(...): -want errMessage, +got errMessage:\n%s", tc.reason, diff)
 			}
+
+			if id := tc.args.c.requests[0].GetEntry().GetAdditionalDetails()[logging.KeyReconcileID]; id == "" {
+				t.Errorf("\nReason: %s\nr.Reconcile(...): want change log entry with a reconcile ID", tc.reason)
+			}
 		})
 	}
 }
@@ -2687,3 +3094,237 @@ func modernManagedMockGetFn(err error, generation int64) test.MockGetFn {
 		return nil
 	})
 }
+
+func TestNewReconcilerWithError(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		m      manager.Manager
+		want   bool
+	}{
+		"KindRegistered": {
+			reason: "We should return a Reconciler if the managed resource kind is registered.",
+			m:      &fake.Manager{Scheme: fake.SchemeWith(&fake.ModernManaged{})},
+		},
+		"KindNotRegistered": {
+			reason: "We should return an error rather than panic if the managed resource kind is not registered.",
+			m:      &fake.Manager{Scheme: runtime.NewScheme()},
+			want:   true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewReconcilerWithError(tc.m, resource.ManagedKind(fake.GVK(&fake.ModernManaged{})))
+			if diff := cmp.Diff(tc.want, err != nil); diff != "" {
+				t.Errorf("\n%s\nNewReconcilerWithError(...): -want error, +got error:\n%s\n%v", tc.reason, diff, err)
+			}
+		})
+	}
+}
+
+func TestNewUnstructuredReconciler(t *testing.T) {
+	gvk := schema.GroupVersionKind{Group: "example.org", Version: "v1", Kind: "Cool"}
+
+	// The kind need not be registered with the manager's scheme.
+	r := NewUnstructuredReconciler(&fake.Manager{Scheme: runtime.NewScheme()}, resource.ManagedKind(gvk))
+
+	mg, ok := r.newManaged().(*umanaged.Unstructured)
+	if !ok {
+		t.Fatalf("r.newManaged(): want *managed.Unstructured, got %T", r.newManaged())
+	}
+
+	if diff := cmp.Diff(gvk, mg.GroupVersionKind()); diff != "" {
+		t.Errorf("r.newManaged().GroupVersionKind(): -want, +got:\n%s", diff)
+	}
+}
+
+func TestWithClient(t *testing.T) {
+	c := &test.MockClient{MockScheme: test.NewMockSchemeFn(fake.SchemeWith(&fake.ModernManaged{}))}
+	m := &fake.Manager{Scheme: fake.SchemeWith(&fake.ModernManaged{})}
+
+	r := NewReconciler(m, resource.ManagedKind(fake.GVK(&fake.ModernManaged{})), WithClient(c))
+
+	if r.client != c {
+		t.Errorf("WithClient(...): want the Reconciler to use the supplied client")
+	}
+}
+
+func TestWithClientOptionOrder(t *testing.T) {
+	errBoom := errors.New("boom")
+	updated := false
+	c := &test.MockClient{
+		MockUpdate: test.NewMockUpdateFn(nil, func(_ client.Object) error {
+			updated = true
+			return nil
+		}),
+		MockScheme: test.NewMockSchemeFn(fake.SchemeWith(&fake.ModernManaged{})),
+	}
+	m := &fake.Manager{
+		Client: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+		Scheme: fake.SchemeWith(&fake.ModernManaged{}),
+	}
+
+	i := InitializerFn(func(_ context.Context, _ resource.Managed) error { return errBoom })
+
+	r := NewReconciler(m, resource.ManagedKind(fake.GVK(&fake.ModernManaged{})), WithInitializers(i), WithClient(c))
+
+	if diff := cmp.Diff(errBoom, r.managed.Initialize(context.Background(), &fake.ModernManaged{}), test.EquateErrors()); diff != "" {
+		t.Errorf("Initialize(...): want the initializer supplied before WithClient to be used: -want error, +got error:\n%s", diff)
+	}
+
+	if err := r.managed.AddFinalizer(context.Background(), &fake.ModernManaged{}); err != nil {
+		t.Errorf("AddFinalizer(...): %v", err)
+	}
+
+	if !updated {
+		t.Errorf("AddFinalizer(...): want the default finalizer to use the client supplied by WithClient")
+	}
+}
+
+func TestWithAuditAnnotationsOptionOrder(t *testing.T) {
+	c := &test.MockClient{MockScheme: test.NewMockSchemeFn(fake.SchemeWith(&fake.ModernManaged{}))}
+	m := &fake.Manager{Client: &test.MockClient{}, Scheme: fake.SchemeWith(&fake.ModernManaged{})}
+
+	r := NewReconciler(m, resource.ManagedKind(fake.GVK(&fake.ModernManaged{})), WithAuditAnnotations(), WithClient(c))
+
+	a, ok := r.auditor.(*AuditAnnotator)
+	if !ok {
+		t.Fatalf("WithAuditAnnotations(): want an *AuditAnnotator, got %T", r.auditor)
+	}
+
+	if a.client != c {
+		t.Errorf("WithAuditAnnotations(): want the AuditAnnotator to use the client supplied by WithClient")
+	}
+}
+
+func TestWithFinalizerName(t *testing.T) {
+	m := &fake.Manager{
+		Client: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+		Scheme: fake.SchemeWith(&fake.ModernManaged{}),
+	}
+
+	r := NewReconciler(m, resource.ManagedKind(fake.GVK(&fake.ModernManaged{})), WithFinalizerName("cool.example.org"))
+
+	mg := &fake.ModernManaged{}
+	mg.SetFinalizers([]string{FinalizerName})
+
+	if err := r.managed.AddFinalizer(context.Background(), mg); err != nil {
+		t.Errorf("AddFinalizer(...): %v", err)
+	}
+
+	if diff := cmp.Diff([]string{"cool.example.org"}, mg.GetFinalizers()); diff != "" {
+		t.Errorf("AddFinalizer(...): want the default finalizer replaced: -want finalizers, +got finalizers:\n%s", diff)
+	}
+}
+
+func TestWithConditionsManager(t *testing.T) {
+	c := &test.MockClient{
+		MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+			asModernManaged(obj, 42)
+			meta.AddAnnotations(obj, map[string]string{meta.AnnotationKeyReconciliationPaused: "true"})
+			return nil
+		}),
+		MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+	}
+	m := &fake.Manager{Client: c, Scheme: fake.SchemeWith(&fake.ModernManaged{})}
+
+	var got []xpv1.Condition
+
+	cm := conditions.ManagerFn(func(_ conditions.ObjectWithConditions) conditions.ConditionSet {
+		return conditions.ConditionSetFn(func(c ...xpv1.Condition) { got = append(got, c...) })
+	})
+
+	r := NewReconciler(m, resource.ManagedKind(fake.GVK(&fake.ModernManaged{})), WithConditionsManager(cm))
+	if _, err := r.Reconcile(context.Background(), reconcile.Request{}); err != nil {
+		t.Fatalf("r.Reconcile(...): unexpected error: %v", err)
+	}
+
+	if diff := cmp.Diff([]xpv1.Condition{xpv1.ReconcilePaused()}, got); diff != "" {
+		t.Errorf("WithConditionsManager(...): -want conditions, +got conditions:\n%s", diff)
+	}
+}
+
+func TestWithKindRegistry(t *testing.T) {
+	gvk := fake.GVK(&fake.ModernManaged{})
+	m := &fake.Manager{Scheme: fake.SchemeWith(&fake.ModernManaged{})}
+
+	cases := map[string]struct {
+		reason string
+		c      *resource.Capabilities
+		want   []sets.Set[xpv1.ManagementAction]
+	}{
+		"NotRegistered": {
+			reason: "The default supported management policies should be used if the kind isn't registered.",
+			want:   defaultSupportedManagementPolicies(),
+		},
+		"NoManagementPolicies": {
+			reason: "The default supported management policies should be used if the kind doesn't declare any.",
+			c:      &resource.Capabilities{ObserveOnly: true},
+			want:   defaultSupportedManagementPolicies(),
+		},
+		"ManagementPolicies": {
+			reason: "The management policies the kind declares should be supported.",
+			c: &resource.Capabilities{ManagementPolicies: []xpv1.ManagementPolicies{
+				{xpv1.ManagementActionAll},
+				{xpv1.ManagementActionObserve},
+			}},
+			want: []sets.Set[xpv1.ManagementAction]{
+				sets.New(xpv1.ManagementActionAll),
+				sets.New(xpv1.ManagementActionObserve),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			reg := resource.NewKindRegistry()
+			if tc.c != nil {
+				reg.Register(gvk, *tc.c)
+			}
+
+			r := NewReconciler(m, resource.ManagedKind(gvk), WithKindRegistry(reg))
+			if diff := cmp.Diff(tc.want, r.supportedManagementPolicies); diff != "" {
+				t.Errorf("\n%s\nWithKindRegistry(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+type timeRecordingMetricRecorder struct {
+	*NopMetricRecorder
+
+	reconciled time.Time
+}
+
+func (r *timeRecordingMetricRecorder) recordFirstTimeReconciled(_ resource.Managed, now time.Time) {
+	r.reconciled = now
+}
+
+func TestWithClock(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	c := &test.MockClient{
+		MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+			asModernManaged(obj, 42)
+			meta.AddAnnotations(obj, map[string]string{meta.AnnotationKeyReconciliationPaused: "true"})
+			return nil
+		}),
+		MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+	}
+	m := &fake.Manager{Client: c, Scheme: fake.SchemeWith(&fake.ModernManaged{})}
+	mr := &timeRecordingMetricRecorder{NopMetricRecorder: NewNopMetricRecorder()}
+
+	// A nil clock should be ignored, rather than replacing the supplied one.
+	r := NewReconciler(m, resource.ManagedKind(fake.GVK(&fake.ModernManaged{})),
+		WithMetricRecorder(mr),
+		WithClock(clocktesting.NewFakePassiveClock(now)),
+		WithClock(nil),
+	)
+	if _, err := r.Reconcile(context.Background(), reconcile.Request{}); err != nil {
+		t.Fatalf("r.Reconcile(...): unexpected error: %v", err)
+	}
+
+	if diff := cmp.Diff(now, mr.reconciled); diff != "" {
+		t.Errorf("WithClock(...): -want metric time, +got metric time:\n%s", diff)
+	}
+}
@@ -78,3 +78,27 @@ func (c *typedExternalClientWrapper[managed]) Delete(ctx context.Context, mg res
 func (c *typedExternalClientWrapper[managed]) Disconnect(ctx context.Context) error {
 	return c.c.Disconnect(ctx)
 }
+
+// ExternalNameInUse checks whether the supplied external name is in use, if
+// the wrapped client is a TypedExternalNameCollisionChecker. Names are never
+// in use otherwise.
+func (c *typedExternalClientWrapper[managed]) ExternalNameInUse(ctx context.Context, mg resource.Managed, name string) (bool, error) {
+	cc, ok := c.c.(TypedExternalNameCollisionChecker[managed])
+	if !ok {
+		return false, nil
+	}
+
+	cr, ok := mg.(managed)
+	if !ok {
+		return false, errors.Errorf(errFmtUnexpectedObjectType, mg)
+	}
+
+	return cc.ExternalNameInUse(ctx, cr, name)
+}
+
+// Invalidate the wrapped client, if it's an Invalidator.
+func (c *typedExternalClientWrapper[managed]) Invalidate(ctx context.Context) {
+	if i, ok := c.c.(Invalidator); ok {
+		i.Invalidate(ctx)
+	}
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+)
+
+// A reconnectingConnector produces ExternalClients that reconnect once, and
+// retry the failed call, when a call fails because the external system
+// rejected their credentials. See errors.Unauthorized.
+type reconnectingConnector struct {
+	ExternalConnectDisconnector
+}
+
+func (c *reconnectingConnector) Connect(ctx context.Context, mg resource.Managed) (ExternalClient, error) {
+	ec, err := c.ExternalConnectDisconnector.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &reconnectingClient{ExternalClient: ec, connector: c.ExternalConnectDisconnector}, nil
+}
+
+// A reconnectingClient is used for a single reconcile, so it reconnects at
+// most once per reconcile. It's not safe for concurrent use.
+type reconnectingClient struct {
+	ExternalClient
+
+	connector   ExternalConnector
+	reconnected bool
+	replaced    []ExternalClient
+}
+
+// reconnect if the supplied error indicates the client's credentials were
+// rejected, and the client hasn't already reconnected. It returns true if
+// the failed call should be retried using the new client.
+func (c *reconnectingClient) reconnect(ctx context.Context, mg resource.Managed, err error) bool {
+	if c.reconnected || !errors.IsUnauthorized(err) {
+		return false
+	}
+
+	c.reconnected = true
+
+	// Invalidate the client, if it's cached, so that we don't reconnect to
+	// the same cached client.
+	if i, ok := c.ExternalClient.(Invalidator); ok {
+		i.Invalidate(ctx)
+	}
+
+	ec, cerr := c.connector.Connect(ctx, mg)
+	if cerr != nil {
+		return false
+	}
+
+	c.replaced = append(c.replaced, c.ExternalClient)
+	c.ExternalClient = ec
+
+	return true
+}
+
+func (c *reconnectingClient) Observe(ctx context.Context, mg resource.Managed) (ExternalObservation, error) {
+	o, err := c.ExternalClient.Observe(ctx, mg)
+	if err != nil && c.reconnect(ctx, mg, err) {
+		return c.ExternalClient.Observe(ctx, mg)
+	}
+
+	return o, err
+}
+
+func (c *reconnectingClient) Create(ctx context.Context, mg resource.Managed) (ExternalCreation, error) {
+	cr, err := c.ExternalClient.Create(ctx, mg)
+	if err != nil && c.reconnect(ctx, mg, err) {
+		return c.ExternalClient.Create(ctx, mg)
+	}
+
+	return cr, err
+}
+
+func (c *reconnectingClient) Update(ctx context.Context, mg resource.Managed) (ExternalUpdate, error) {
+	u, err := c.ExternalClient.Update(ctx, mg)
+	if err != nil && c.reconnect(ctx, mg, err) {
+		return c.ExternalClient.Update(ctx, mg)
+	}
+
+	return u, err
+}
+
+func (c *reconnectingClient) Delete(ctx context.Context, mg resource.Managed) (ExternalDelete, error) {
+	d, err := c.ExternalClient.Delete(ctx, mg)
+	if err != nil && c.reconnect(ctx, mg, err) {
+		return c.ExternalClient.Delete(ctx, mg)
+	}
+
+	return d, err
+}
+
+func (c *reconnectingClient) ExternalNameInUse(ctx context.Context, mg resource.Managed, name string) (bool, error) {
+	inUse, err := externalNameInUse(ctx, c.ExternalClient, mg, name)
+	if err != nil && c.reconnect(ctx, mg, err) {
+		return externalNameInUse(ctx, c.ExternalClient, mg, name)
+	}
+
+	return inUse, err
+}
+
+func (c *reconnectingClient) Ping(ctx context.Context) error {
+	return ping(ctx, c.ExternalClient)
+}
+
+// Invalidate the client, if it's an Invalidator.
+func (c *reconnectingClient) Invalidate(ctx context.Context) {
+	if i, ok := c.ExternalClient.(Invalidator); ok {
+		i.Invalidate(ctx)
+	}
+}
+
+// Disconnect the client, and any clients it replaced when it reconnected.
+func (c *reconnectingClient) Disconnect(ctx context.Context) error {
+	errs := make([]error, 0, len(c.replaced)+1)
+	for _, ec := range c.replaced {
+		errs = append(errs, ec.Disconnect(ctx))
+	}
+
+	return errors.Join(append(errs, c.ExternalClient.Disconnect(ctx))...)
+}
@@ -0,0 +1,195 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+)
+
+var _ ExternalConnectDisconnector = &reconnectingConnector{}
+
+func TestReconnectingConnector(t *testing.T) {
+	errBoom := errors.New("boom")
+	errExpired := errors.Unauthorized(errors.New("token expired"))
+
+	type args struct {
+		// errs are the errors returned by Observe for each client the
+		// connector produces, in order.
+		errs       []error
+		connectErr error
+	}
+
+	type want struct {
+		err         error
+		connects    int
+		observes    int
+		disconnects int
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"Success": {
+			reason: "A successful call shouldn't reconnect.",
+			args: args{
+				errs: []error{nil},
+			},
+			want: want{connects: 1, observes: 1, disconnects: 1},
+		},
+		"OtherError": {
+			reason: "A call that fails for a reason other than authorization shouldn't reconnect.",
+			args: args{
+				errs: []error{errBoom},
+			},
+			want: want{err: errBoom, connects: 1, observes: 1, disconnects: 1},
+		},
+		"Reconnect": {
+			reason: "A call that fails authorization should reconnect and be retried, and both clients should be disconnected.",
+			args: args{
+				errs: []error{errExpired, nil},
+			},
+			want: want{connects: 2, observes: 2, disconnects: 2},
+		},
+		"ReconnectOnce": {
+			reason: "A client should reconnect at most once.",
+			args: args{
+				errs: []error{errExpired, errExpired},
+			},
+			want: want{err: errExpired, connects: 2, observes: 2, disconnects: 2},
+		},
+		"ReconnectError": {
+			reason: "The original error should be returned if reconnecting fails.",
+			args: args{
+				errs:       []error{errExpired},
+				connectErr: errBoom,
+			},
+			want: want{err: errExpired, connects: 2, observes: 1, disconnects: 1},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := want{}
+
+			c := &reconnectingConnector{ExternalConnectDisconnector: &ExternalConnectDisconnectorFns{
+				ConnectFn: func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+					got.connects++
+					if got.connects > 1 && tc.args.connectErr != nil {
+						return nil, tc.args.connectErr
+					}
+
+					err := tc.args.errs[got.connects-1]
+
+					return &ExternalClientFns{
+						ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+							got.observes++
+							return ExternalObservation{}, err
+						},
+						DisconnectFn: func(_ context.Context) error {
+							got.disconnects++
+							return nil
+						},
+					}, nil
+				},
+			}}
+
+			ec, err := c.Connect(context.Background(), &fake.ModernManaged{})
+			if err != nil {
+				t.Fatalf("c.Connect(...): %v", err)
+			}
+
+			_, got.err = ec.Observe(context.Background(), &fake.ModernManaged{})
+			_ = ec.Disconnect(context.Background())
+
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{}), test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestReconnectingConnectorInvalidatesCachedClient(t *testing.T) {
+	connects := 0
+
+	cc := NewCachingConnector(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+		connects++
+		n := connects
+
+		return &ExternalClientFns{
+			ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+				if n == 1 {
+					return ExternalObservation{}, errors.Unauthorized(errors.New("token expired"))
+				}
+
+				return ExternalObservation{ResourceExists: true}, nil
+			},
+			DisconnectFn: func(_ context.Context) error { return nil },
+		}, nil
+	}), func(_ context.Context, mg resource.Managed) (string, error) { return mg.GetName(), nil })
+
+	c := &reconnectingConnector{ExternalConnectDisconnector: &ExternalConnectDisconnectorFns{ConnectFn: cc.Connect}}
+
+	ec, err := c.Connect(context.Background(), &fake.ModernManaged{})
+	if err != nil {
+		t.Fatalf("c.Connect(...): %v", err)
+	}
+
+	o, err := ec.Observe(context.Background(), &fake.ModernManaged{})
+	if err != nil {
+		t.Fatalf("ec.Observe(...): %v", err)
+	}
+
+	if !o.ResourceExists {
+		t.Errorf("ec.Observe(...): want observation from the new client")
+	}
+
+	if connects != 2 {
+		t.Errorf("ec.Observe(...): want the cached client to be invalidated and replaced, got %d connects", connects)
+	}
+}
+
+func TestReconnectingClientForwards(t *testing.T) {
+	errUnhealthy := errors.New("unhealthy")
+
+	var ec ExternalClient = &reconnectingClient{ExternalClient: &optionalClient{
+		inUse: func(name string) (bool, error) { return name == "taken", nil },
+		ping:  func(_ context.Context) error { return errUnhealthy },
+	}}
+
+	checker, ok := ec.(ExternalNameCollisionChecker)
+	if !ok {
+		t.Fatalf("reconnectingClient: want a client that forwards ExternalNameCollisionChecker")
+	}
+
+	if inUse, _ := checker.ExternalNameInUse(context.Background(), &fake.ModernManaged{}, "taken"); !inUse {
+		t.Errorf("ExternalNameInUse(...): want the wrapped client's answer")
+	}
+
+	if err := ec.(Pinger).Ping(context.Background()); !errors.Is(err, errUnhealthy) {
+		t.Errorf("Ping(...): want the wrapped client's error, got %v", err)
+	}
+}
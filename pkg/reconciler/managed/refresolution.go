@@ -0,0 +1,192 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+)
+
+// A ReferenceResolutionPolicy determines how often the Reconciler resolves a
+// managed resource's references.
+type ReferenceResolutionPolicy string
+
+// Reference resolution policies.
+const (
+	// ReferenceResolutionAlways resolves references every time a managed
+	// resource is reconciled.
+	ReferenceResolutionAlways ReferenceResolutionPolicy = "Always"
+
+	// ReferenceResolutionOnSpecChange resolves references when a managed
+	// resource's desired state changes, i.e. when it has a new generation.
+	ReferenceResolutionOnSpecChange ReferenceResolutionPolicy = "OnSpecChange"
+
+	// ReferenceResolutionOnce resolves references until they're resolved
+	// successfully, and never again.
+	ReferenceResolutionOnce ReferenceResolutionPolicy = "Once"
+)
+
+// A ReferenceResolution is the result of resolving a managed resource's
+// references.
+type ReferenceResolution struct {
+	// Targets the references resolved to, e.g. Bucket/cool-bucket, or
+	// Bucket/default/cool-bucket for a namespaced target. A reference
+	// resolved by selector is reported as the selector, e.g. Bucket matching
+	// labels cool=true.
+	Targets []string
+
+	// Changed is true if resolving references changed the managed resource,
+	// i.e. if any resolved values changed.
+	Changed bool
+}
+
+// A referenceResolutions tracks the generation of each managed resource at
+// which its references were last resolved, and whether the last attempt to
+// resolve them failed, in memory, in order to enforce a
+// ReferenceResolutionPolicy.
+type referenceResolutions struct {
+	policy ReferenceResolutionPolicy
+
+	mu       sync.Mutex
+	resolved map[types.UID]int64
+	failed   sets.Set[types.UID]
+}
+
+func newReferenceResolutions(p ReferenceResolutionPolicy) *referenceResolutions {
+	return &referenceResolutions{policy: p, resolved: make(map[types.UID]int64), failed: sets.New[types.UID]()}
+}
+
+// Required returns true if the supplied managed resource's references should
+// be resolved.
+func (r *referenceResolutions) Required(mg resource.Managed) bool {
+	if r.policy != ReferenceResolutionOnSpecChange && r.policy != ReferenceResolutionOnce {
+		return true
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	gen, ok := r.resolved[mg.GetUID()]
+	if !ok {
+		return true
+	}
+
+	return r.policy == ReferenceResolutionOnSpecChange && gen != mg.GetGeneration()
+}
+
+// Record that the supplied managed resource's references were resolved. It
+// returns true if the previous attempt to resolve them failed.
+func (r *referenceResolutions) Record(mg resource.Managed) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	failed := r.failed.Has(mg.GetUID())
+	r.failed.Delete(mg.GetUID())
+
+	if r.policy == ReferenceResolutionOnSpecChange || r.policy == ReferenceResolutionOnce {
+		r.resolved[mg.GetUID()] = mg.GetGeneration()
+	}
+
+	return failed
+}
+
+// Fail records that the supplied managed resource's references couldn't be
+// resolved.
+func (r *referenceResolutions) Fail(mg resource.Managed) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.failed.Insert(mg.GetUID())
+}
+
+// Forget the supplied managed resource.
+func (r *referenceResolutions) Forget(mg resource.Managed) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.resolved, mg.GetUID())
+	r.failed.Delete(mg.GetUID())
+}
+
+// A recordingReader is a client.Reader that records the objects it reads, in
+// order to report the targets of resolved references.
+type recordingReader struct {
+	client.Reader
+
+	targets []string
+}
+
+// Get the supplied object, and record it if it exists.
+func (r *recordingReader) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	if err := r.Reader.Get(ctx, key, obj, opts...); err != nil {
+		return err
+	}
+
+	t := kindOf(obj) + "/" + key.Name
+	if key.Namespace != "" {
+		t = kindOf(obj) + "/" + key.Namespace + "/" + key.Name
+	}
+
+	r.record(t)
+
+	return nil
+}
+
+// List the supplied objects, and record the selector used to list them.
+func (r *recordingReader) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	if err := r.Reader.List(ctx, list, opts...); err != nil {
+		return err
+	}
+
+	o := &client.ListOptions{}
+	o.ApplyOptions(opts)
+
+	t := strings.TrimSuffix(kindOf(list), "List")
+	if o.LabelSelector != nil && !o.LabelSelector.Empty() {
+		t += " matching labels " + o.LabelSelector.String()
+	}
+
+	r.record(t)
+
+	return nil
+}
+
+func (r *recordingReader) record(t string) {
+	if !slices.Contains(r.targets, t) {
+		r.targets = append(r.targets, t)
+	}
+}
+
+// kindOf returns the kind of the supplied object. Typed objects often don't
+// have their kind set, so we fall back to the name of their Go type.
+func kindOf(o runtime.Object) string {
+	if k := o.GetObjectKind().GroupVersionKind().Kind; k != "" {
+		return k
+	}
+
+	return reflect.Indirect(reflect.ValueOf(o)).Type().Name()
+}
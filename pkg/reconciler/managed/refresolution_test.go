@@ -0,0 +1,183 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+)
+
+func TestReferenceResolutionsRequired(t *testing.T) {
+	mg := &fake.Managed{ObjectMeta: metav1.ObjectMeta{UID: "cool-uid", Generation: 1}}
+	edited := &fake.Managed{ObjectMeta: metav1.ObjectMeta{UID: "cool-uid", Generation: 2}}
+
+	type args struct {
+		policy ReferenceResolutionPolicy
+		record bool
+		forget bool
+		mg     *fake.Managed
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   bool
+	}{
+		"AlwaysResolved": {
+			reason: "References should always be resolved using the Always policy.",
+			args: args{
+				policy: ReferenceResolutionAlways,
+				record: true,
+			},
+			want: true,
+		},
+		"UnknownPolicy": {
+			reason: "References should always be resolved using an unknown policy.",
+			args: args{
+				policy: "Sometimes",
+				record: true,
+			},
+			want: true,
+		},
+		"OnSpecChangeNeverResolved": {
+			reason: "References that were never resolved should be resolved using the OnSpecChange policy.",
+			args: args{
+				policy: ReferenceResolutionOnSpecChange,
+			},
+			want: true,
+		},
+		"OnSpecChangeSameGeneration": {
+			reason: "References resolved at the current generation shouldn't be resolved again using the OnSpecChange policy.",
+			args: args{
+				policy: ReferenceResolutionOnSpecChange,
+				record: true,
+			},
+			want: false,
+		},
+		"OnSpecChangeNewGeneration": {
+			reason: "References resolved at a previous generation should be resolved using the OnSpecChange policy.",
+			args: args{
+				policy: ReferenceResolutionOnSpecChange,
+				record: true,
+				mg:     edited,
+			},
+			want: true,
+		},
+		"OnceNeverResolved": {
+			reason: "References that were never resolved should be resolved using the Once policy.",
+			args: args{
+				policy: ReferenceResolutionOnce,
+			},
+			want: true,
+		},
+		"OnceNewGeneration": {
+			reason: "References resolved at a previous generation shouldn't be resolved again using the Once policy.",
+			args: args{
+				policy: ReferenceResolutionOnce,
+				record: true,
+				mg:     edited,
+			},
+			want: false,
+		},
+		"Forgotten": {
+			reason: "References of a forgotten resource should be resolved.",
+			args: args{
+				policy: ReferenceResolutionOnce,
+				record: true,
+				forget: true,
+			},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := newReferenceResolutions(tc.args.policy)
+			if tc.args.record {
+				r.Record(mg)
+			}
+
+			if tc.args.forget {
+				r.Forget(mg)
+			}
+
+			check := mg
+			if tc.args.mg != nil {
+				check = tc.args.mg
+			}
+
+			got := r.Required(check)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nr.Required(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestReferenceResolutionsRecord(t *testing.T) {
+	mg := &fake.Managed{ObjectMeta: metav1.ObjectMeta{UID: "cool-uid", Generation: 1}}
+
+	cases := map[string]struct {
+		reason string
+		fail   bool
+		forget bool
+		want   bool
+	}{
+		"NeverFailed": {
+			reason: "Recording resolved references that never failed to resolve should report no previous failure.",
+			want:   false,
+		},
+		"PreviouslyFailed": {
+			reason: "Recording resolved references that previously failed to resolve should report the failure.",
+			fail:   true,
+			want:   true,
+		},
+		"Forgotten": {
+			reason: "Recording resolved references of a forgotten resource should report no previous failure.",
+			fail:   true,
+			forget: true,
+			want:   false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := newReferenceResolutions(ReferenceResolutionAlways)
+			if tc.fail {
+				r.Fail(mg)
+			}
+
+			if tc.forget {
+				r.Forget(mg)
+			}
+
+			got := r.Record(mg)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nr.Record(...): -want, +got:\n%s", tc.reason, diff)
+			}
+
+			// A failure should only be reported once.
+			if diff := cmp.Diff(false, r.Record(mg)); diff != "" {
+				t.Errorf("\n%s\nr.Record(...): second call -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
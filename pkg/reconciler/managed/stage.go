@@ -0,0 +1,135 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/conditions"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+)
+
+// StageGetResource gets the managed resource to be reconciled. It's the first
+// stage of every reconcile.
+const StageGetResource Stage = "GetResource"
+
+// A ReconcileState is the state of a single reconcile of a managed resource.
+// It's shared by each stage of the reconcile. Stages may read state set by
+// earlier stages, and set state for later stages.
+type ReconcileState struct {
+	// Request being reconciled.
+	Request reconcile.Request
+
+	// ID uniquely identifies the reconcile. It's logged with every message,
+	// and included in change log entries.
+	ID string
+
+	// Managed resource being reconciled. Set by StageGetResource.
+	Managed resource.Managed
+
+	// Log, Record, and Status are used to log, emit events, and set status
+	// conditions for the managed resource. Set by StageGetResource.
+	Log    logging.Logger
+	Record event.Recorder
+	Status conditions.ConditionSet
+
+	// Debug is true if the managed resource is annotated with
+	// meta.AnnotationKeyDebug, and debugging hasn't expired. Set by
+	// StageGetResource.
+	Debug bool
+
+	// Policy determines what the reconcile may do to the managed resource
+	// and its external resource. Set by StagePolicy.
+	Policy ManagementPoliciesChecker
+
+	// External client connected to the external system. Set by StageConnect.
+	External ExternalClient
+
+	// Observation of the external resource, and a copy of the managed
+	// resource taken when it was observed, before any external operations.
+	// Set by StageObserve.
+	Observation  ExternalObservation
+	ManagedPreOp resource.Managed
+
+	// Outcome of the reconcile. Stages that are done should set it.
+	Outcome ReconcileOutcome
+
+	// The external context applies the Reconciler's timeout to calls to the
+	// external system. It spans stages, so it can't be passed to them.
+	externalCtx context.Context //nolint:containedctx // See above.
+
+	deferred []func()
+}
+
+// ExternalContext returns the context that should be used to call the external
+// system. It applies the Reconciler's timeout. Once StageGetResource is done it
+// also carries information about the reconcile; see reconcilecontext.InfoFrom.
+func (s *ReconcileState) ExternalContext() context.Context {
+	return s.externalCtx
+}
+
+// Defer calls the supplied function when the reconcile is done, after all
+// stages have run. Deferred functions are called in last in, first out order.
+func (s *ReconcileState) Defer(fn func()) {
+	s.deferred = append(s.deferred, fn)
+}
+
+func (s *ReconcileState) done() {
+	for i := len(s.deferred) - 1; i >= 0; i-- {
+		s.deferred[i]()
+	}
+}
+
+// A StageFn is a stage of a managed resource reconcile. Each stage either
+// returns done, in which case the reconcile returns the stage's result and
+// error, or returns not done, in which case the reconcile continues to the
+// next stage.
+type StageFn func(ctx context.Context, s *ReconcileState) (done bool, result reconcile.Result, err error)
+
+// A StageMiddleware wraps a stage of a managed resource reconcile. It's
+// passed the stage it wraps, and the function that runs it. It may run
+// code before or after the stage, or replace the stage entirely.
+type StageMiddleware func(stage Stage, next StageFn) StageFn
+
+// WithStageMiddleware wraps each stage of the reconcile with the supplied
+// middleware. Middleware is applied in the order it's supplied, i.e. the first
+// middleware is the outermost. The stages of a reconcile are, in order:
+// StageGetResource, StagePolicy, StageFinalize, StageInitialize,
+// StageResolveReferences, StageConnect, StageObserve, StagePlan,
+// StageDelete, StagePublish, StageCreate, StageLateInitialize, and
+// StageUpdate.
+func WithStageMiddleware(m ...StageMiddleware) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.middleware = append(r.middleware, m...)
+	}
+}
+
+// WithStage replaces the supplied stage of the reconcile with the supplied
+// function.
+func WithStage(stage Stage, fn StageFn) ReconcilerOption {
+	return WithStageMiddleware(func(s Stage, next StageFn) StageFn {
+		if s != stage {
+			return next
+		}
+
+		return fn
+	})
+}
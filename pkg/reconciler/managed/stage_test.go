@@ -0,0 +1,204 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconcilecontext"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+)
+
+func TestStageMiddleware(t *testing.T) {
+	c := &test.MockClient{
+		MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+			asModernManaged(obj, 42)
+			return nil
+		}),
+		MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+	}
+
+	upToDate := []ReconcilerOption{
+		WithInitializers(),
+		WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+		WithExternalConnector(ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (ExternalClient, error) {
+			return &ExternalClientFns{
+				ObserveFn: func(_ context.Context, _ resource.Managed) (ExternalObservation, error) {
+					return ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+				},
+				DisconnectFn: func(_ context.Context) error { return nil },
+			}, nil
+		})),
+		WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+		WithPollInterval(time.Minute),
+	}
+
+	type want struct {
+		stages []Stage
+		result reconcile.Result
+	}
+
+	cases := map[string]struct {
+		reason string
+		o      []ReconcilerOption
+		want   want
+	}{
+		"AllStages": {
+			reason: "Middleware should wrap every stage of a reconcile, in order.",
+			want: want{
+				stages: []Stage{
+					StageGetResource,
+					StagePolicy,
+					StageFinalize,
+					StageInitialize,
+					StageResolveReferences,
+					StageConnect,
+					StageObserve,
+					StagePlan,
+					StageDelete,
+					StagePublish,
+					StageCreate,
+					StageLateInitialize,
+					StageUpdate,
+				},
+				result: reconcile.Result{RequeueAfter: time.Minute},
+			},
+		},
+		"ReplaceStage": {
+			reason: "A replaced stage should be able to end the reconcile early.",
+			o: []ReconcilerOption{
+				WithStage(StageObserve, func(_ context.Context, _ *ReconcileState) (bool, reconcile.Result, error) {
+					return true, reconcile.Result{RequeueAfter: time.Hour}, nil
+				}),
+			},
+			want: want{
+				stages: []Stage{
+					StageGetResource,
+					StagePolicy,
+					StageFinalize,
+					StageInitialize,
+					StageResolveReferences,
+					StageConnect,
+					StageObserve,
+				},
+				result: reconcile.Result{RequeueAfter: time.Hour},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := want{}
+
+			o := append([]ReconcilerOption{}, upToDate...)
+			o = append(o, WithStageMiddleware(func(stage Stage, next StageFn) StageFn {
+				return func(ctx context.Context, s *ReconcileState) (bool, reconcile.Result, error) {
+					got.stages = append(got.stages, stage)
+					return next(ctx, s)
+				}
+			}))
+			o = append(o, tc.o...)
+
+			m := &fake.Manager{Client: c, Scheme: fake.SchemeWith(&fake.ModernManaged{})}
+			r := NewReconciler(m, resource.ManagedKind(fake.GVK(&fake.ModernManaged{})), o...)
+
+			result, err := r.Reconcile(context.Background(), reconcile.Request{})
+			if err != nil {
+				t.Fatalf("\n%s\nr.Reconcile(...): unexpected error: %v", tc.reason, err)
+			}
+
+			got.result = result
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nr.Reconcile(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestExternalContext(t *testing.T) {
+	c := &test.MockClient{
+		MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+			mg := asModernManaged(obj, 42)
+			mg.SetName("cool")
+			mg.SetUID("cool-uid")
+			return nil
+		}),
+		MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+	}
+
+	got := make([]reconcilecontext.Info, 0)
+	record := func(ctx context.Context) {
+		i, ok := reconcilecontext.InfoFrom(ctx)
+		if !ok {
+			t.Errorf("reconcilecontext.InfoFrom(...): want reconcile information")
+		}
+
+		got = append(got, i)
+	}
+
+	m := &fake.Manager{Client: c, Scheme: fake.SchemeWith(&fake.ModernManaged{})}
+	r := NewReconciler(m, resource.ManagedKind(fake.GVK(&fake.ModernManaged{})),
+		WithInitializers(),
+		WithReferenceResolver(ReferenceResolverFn(func(_ context.Context, _ resource.Managed) error { return nil })),
+		WithExternalConnector(ExternalConnectorFn(func(ctx context.Context, _ resource.Managed) (ExternalClient, error) {
+			record(ctx)
+
+			return &ExternalClientFns{
+				ObserveFn: func(ctx context.Context, _ resource.Managed) (ExternalObservation, error) {
+					record(ctx)
+					return ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+				},
+				DisconnectFn: func(_ context.Context) error { return nil },
+			}, nil
+		})),
+		WithFinalizer(resource.FinalizerFns{AddFinalizerFn: func(_ context.Context, _ resource.Object) error { return nil }}),
+	)
+
+	if _, err := r.Reconcile(context.Background(), reconcile.Request{}); err != nil {
+		t.Fatalf("r.Reconcile(...): unexpected error: %v", err)
+	}
+
+	if len(got) != 2 {
+		t.Fatalf("r.Reconcile(...): want Connect and Observe to be called, got %d calls", len(got))
+	}
+
+	if got[0].ID == "" || got[0].Deadline.IsZero() {
+		t.Errorf("r.Reconcile(...): want reconcile information with an ID and a deadline, got %+v", got[0])
+	}
+
+	want := reconcilecontext.Info{
+		ID:       got[0].ID,
+		GVK:      fake.GVK(&fake.ModernManaged{}),
+		Name:     "cool",
+		UID:      "cool-uid",
+		Deadline: got[0].Deadline,
+	}
+
+	for _, i := range got {
+		if diff := cmp.Diff(want, i); diff != "" {
+			t.Errorf("r.Reconcile(...): -want reconcile information, +got reconcile information:\n%s", diff)
+		}
+	}
+}
@@ -0,0 +1,339 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+)
+
+const (
+	defaultStatusWriteWindow  = 2 * time.Second
+	defaultStatusWriteTimeout = 30 * time.Second
+)
+
+// Error strings.
+const (
+	errGetLatestStatus = "cannot get latest resource to retry status update"
+)
+
+// A WriteBehindStatusOption configures a WriteBehindStatusClient.
+type WriteBehindStatusOption func(c *WriteBehindStatusClient)
+
+// WithStatusWriteWindow configures how long a WriteBehindStatusClient waits
+// after a status update before writing it. Updates of the same resource made
+// within the window are coalesced into a single write.
+func WithStatusWriteWindow(d time.Duration) WriteBehindStatusOption {
+	return func(c *WriteBehindStatusClient) {
+		c.window = d
+	}
+}
+
+// WithStatusWriteClock configures the clock a WriteBehindStatusClient uses to
+// schedule writes.
+func WithStatusWriteClock(cl clock.WithDelayedExecution) WriteBehindStatusOption {
+	return func(c *WriteBehindStatusClient) {
+		c.clock = cl
+	}
+}
+
+// WithStatusWriteLogger configures the logger used to report status updates
+// that could not be written.
+func WithStatusWriteLogger(l logging.Logger) WriteBehindStatusOption {
+	return func(c *WriteBehindStatusClient) {
+		c.log = l
+	}
+}
+
+type pendingStatus struct {
+	// obj is the latest status update, or nil if it has been written.
+	obj client.Object
+
+	// writing is true while the status update is being written.
+	writing bool
+}
+
+// A WriteBehindStatusClient is a client that defers status updates, so that
+// updates of the same resource made within a short window, for example by
+// consecutive reconciles, are coalesced into a single API write. Only the
+// latest update is written. All other calls are passed through to the
+// underlying client.
+//
+// Status updates are always reported as successful. A deferred update that
+// conflicts with a newer version of the resource is retried against that
+// version, and an update of a resource that no longer exists is dropped.
+// Other errors are logged; the status is expected to be written again by a
+// later reconcile.
+//
+// Use WithClient to configure a managed resource Reconciler to use a
+// WriteBehindStatusClient. Add it to the controller manager as a Runnable to
+// write any pending status updates when the manager stops.
+type WriteBehindStatusClient struct {
+	client.Client
+
+	window time.Duration
+	clock  clock.WithDelayedExecution
+	log    logging.Logger
+
+	mu      sync.Mutex
+	pending map[types.UID]*pendingStatus
+}
+
+// NewWriteBehindStatusClient returns a WriteBehindStatusClient that writes
+// coalesced status updates using the supplied client.
+func NewWriteBehindStatusClient(c client.Client, o ...WriteBehindStatusOption) *WriteBehindStatusClient {
+	wb := &WriteBehindStatusClient{
+		Client:  c,
+		window:  defaultStatusWriteWindow,
+		clock:   clock.RealClock{},
+		log:     logging.NewNopLogger(),
+		pending: make(map[types.UID]*pendingStatus),
+	}
+
+	for _, fn := range o {
+		fn(wb)
+	}
+
+	return wb
+}
+
+// Status returns a status writer that defers status updates.
+func (c *WriteBehindStatusClient) Status() client.SubResourceWriter {
+	return &writeBehindStatusWriter{SubResourceWriter: c.Client.Status(), client: c}
+}
+
+// Start blocks until the supplied context is done, then writes any pending
+// status updates. It allows a WriteBehindStatusClient to be added to a
+// controller manager.
+func (c *WriteBehindStatusClient) Start(ctx context.Context) error {
+	<-ctx.Done()
+
+	fctx, cancel := context.WithTimeout(context.Background(), defaultStatusWriteTimeout)
+	defer cancel()
+
+	return c.Flush(fctx)
+}
+
+// Flush immediately writes all pending status updates, except those that are
+// already being written.
+func (c *WriteBehindStatusClient) Flush(ctx context.Context) error {
+	c.mu.Lock()
+
+	uids := make([]types.UID, 0, len(c.pending))
+	for uid := range c.pending {
+		uids = append(uids, uid)
+	}
+
+	c.mu.Unlock()
+
+	errs := make([]error, 0)
+
+	for _, uid := range uids {
+		if err := c.write(ctx, uid); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// enqueue the supplied status update until the write window has passed.
+func (c *WriteBehindStatusClient) enqueue(obj client.Object) {
+	//nolint:forcetypeassert // A deep copy of a client.Object is a client.Object.
+	cp := obj.DeepCopyObject().(client.Object)
+	uid := obj.GetUID()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if p, ok := c.pending[uid]; ok {
+		// A write is already scheduled, or is in progress. In the latter
+		// case it will be rescheduled once it completes.
+		p.obj = cp
+		return
+	}
+
+	c.pending[uid] = &pendingStatus{obj: cp}
+	c.schedule(uid)
+}
+
+// schedule a write of the pending status update of the supplied UID. The
+// caller must hold the lock.
+func (c *WriteBehindStatusClient) schedule(uid types.UID) {
+	c.clock.AfterFunc(c.window, func() {
+		ctx, cancel := context.WithTimeout(context.Background(), defaultStatusWriteTimeout)
+		defer cancel()
+
+		if err := c.write(ctx, uid); err != nil {
+			c.log.Info("Cannot write deferred status update", "uid", uid, "error", err)
+		}
+	})
+}
+
+// write the pending status update of the supplied UID, if any.
+func (c *WriteBehindStatusClient) write(ctx context.Context, uid types.UID) error {
+	c.mu.Lock()
+
+	p, ok := c.pending[uid]
+	if !ok || p.writing || p.obj == nil {
+		c.mu.Unlock()
+		return nil
+	}
+
+	obj := p.obj
+	p.obj = nil
+	p.writing = true
+
+	c.mu.Unlock()
+
+	err := c.update(ctx, obj)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	p.writing = false
+
+	if p.obj == nil {
+		delete(c.pending, uid)
+		return err
+	}
+
+	// The status was updated again while we were writing it.
+	c.schedule(uid)
+
+	return err
+}
+
+// update the status of the supplied object, retrying against the latest
+// version of the object on conflict.
+func (c *WriteBehindStatusClient) update(ctx context.Context, obj client.Object) error {
+	key := types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		err := c.Client.Status().Update(ctx, obj)
+		if !kerrors.IsConflict(err) {
+			return err
+		}
+
+		//nolint:forcetypeassert // A deep copy of a client.Object is a client.Object.
+		latest := obj.DeepCopyObject().(client.Object)
+		if err := c.Client.Get(ctx, key, latest); err != nil {
+			return errors.Wrap(err, errGetLatestStatus)
+		}
+
+		if latest.GetUID() != obj.GetUID() {
+			// The resource was deleted and recreated. Our status update
+			// doesn't apply to it.
+			return kerrors.NewNotFound(schema.GroupResource{}, key.Name)
+		}
+
+		// Only the status of the object is written, so it's safe to retry
+		// with the latest resource version.
+		obj.SetResourceVersion(latest.GetResourceVersion())
+
+		return err
+	})
+
+	return resource.IgnoreNotFound(err)
+}
+
+type writeBehindStatusWriter struct {
+	client.SubResourceWriter
+
+	client *WriteBehindStatusClient
+}
+
+// Update defers the status update of the supplied object. Updates with
+// options, and updates of objects without a UID, are written immediately.
+func (w *writeBehindStatusWriter) Update(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+	if len(opts) > 0 || obj.GetUID() == "" {
+		return w.SubResourceWriter.Update(ctx, obj, opts...)
+	}
+
+	w.client.enqueue(obj)
+
+	return nil
+}
+
+// Patch writes any pending status update of the supplied object, then patches
+// its status.
+func (w *writeBehindStatusWriter) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+	if err := w.client.write(ctx, obj.GetUID()); err != nil {
+		return err
+	}
+
+	return w.SubResourceWriter.Patch(ctx, obj, patch, opts...)
+}
+
+// A gracefulStatusClient gives status updates a fresh deadline if the context
+// they're made with is done, or will be done within the grace period. This
+// ensures the outcome of a reconcile that ran out of time, for example an
+// error condition, is still recorded. All other calls are passed through to
+// the underlying client.
+type gracefulStatusClient struct {
+	client.Client
+
+	grace time.Duration
+}
+
+func (c *gracefulStatusClient) Status() client.SubResourceWriter {
+	return &gracefulStatusWriter{SubResourceWriter: c.Client.Status(), grace: c.grace}
+}
+
+type gracefulStatusWriter struct {
+	client.SubResourceWriter
+
+	grace time.Duration
+}
+
+func (w *gracefulStatusWriter) Update(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+	ctx, cancel := graceContext(ctx, w.grace)
+	defer cancel()
+
+	return w.SubResourceWriter.Update(ctx, obj, opts...)
+}
+
+func (w *gracefulStatusWriter) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+	ctx, cancel := graceContext(ctx, w.grace)
+	defer cancel()
+
+	return w.SubResourceWriter.Patch(ctx, obj, patch, opts...)
+}
+
+// graceContext returns the supplied context, unless it's done or will be done
+// within the supplied grace period. In that case it returns a new context that
+// has the supplied context's values, and is done after the grace period.
+func graceContext(ctx context.Context, grace time.Duration) (context.Context, context.CancelFunc) {
+	dl, ok := ctx.Deadline()
+	if ctx.Err() == nil && (!ok || time.Until(dl) >= grace) {
+		return ctx, func() {}
+	}
+
+	return context.WithTimeout(context.WithoutCancel(ctx), grace)
+}
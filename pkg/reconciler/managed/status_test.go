@@ -0,0 +1,334 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	testingclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+)
+
+func TestWriteBehindStatusClient(t *testing.T) {
+	errBoom := errors.New("boom")
+	errConflict := kerrors.NewConflict(schema.GroupResource{}, "cool", errBoom)
+
+	status := func(uid types.UID, rv string, c ...xpv1.Condition) *fake.ModernManaged {
+		mg := &fake.ModernManaged{}
+		mg.SetName("cool")
+		mg.SetUID(uid)
+		mg.SetResourceVersion(rv)
+		mg.SetConditions(c...)
+
+		return mg
+	}
+
+	type args struct {
+		// get is called to get the latest version of a resource.
+		get test.MockGetFn
+
+		// errs are returned by successive status updates.
+		errs []error
+
+		// updates are deferred, then flushed.
+		updates []client.Object
+		opts    []client.SubResourceUpdateOption
+	}
+
+	type want struct {
+		err     error
+		written []client.Object
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"Coalesce": {
+			reason: "Multiple status updates of the same resource should be coalesced into a write of the latest.",
+			args: args{
+				updates: []client.Object{
+					status("cool-uid", "1", xpv1.Creating()),
+					status("cool-uid", "1", xpv1.ReconcileSuccess()),
+					status("cool-uid", "1", xpv1.Available()),
+				},
+			},
+			want: want{
+				written: []client.Object{status("cool-uid", "1", xpv1.Available())},
+			},
+		},
+		"WithOptions": {
+			reason: "Status updates with options should be written immediately.",
+			args: args{
+				updates: []client.Object{status("cool-uid", "1", xpv1.Creating()), status("cool-uid", "1", xpv1.Available())},
+				opts:    []client.SubResourceUpdateOption{client.DryRunAll},
+			},
+			want: want{
+				written: []client.Object{status("cool-uid", "1", xpv1.Creating()), status("cool-uid", "1", xpv1.Available())},
+			},
+		},
+		"NoUID": {
+			reason: "Status updates of resources without a UID should be written immediately.",
+			args: args{
+				updates: []client.Object{status("", "1", xpv1.Creating()), status("", "1", xpv1.Available())},
+			},
+			want: want{
+				written: []client.Object{status("", "1", xpv1.Creating()), status("", "1", xpv1.Available())},
+			},
+		},
+		"RetryConflict": {
+			reason: "A status update that conflicts should be retried against the latest resource version.",
+			args: args{
+				get: test.NewMockGetFn(nil, func(obj client.Object) error {
+					obj.SetResourceVersion("2")
+					return nil
+				}),
+				errs:    []error{errConflict},
+				updates: []client.Object{status("cool-uid", "1", xpv1.Available())},
+			},
+			want: want{
+				written: []client.Object{status("cool-uid", "1", xpv1.Available()), status("cool-uid", "2", xpv1.Available())},
+			},
+		},
+		"GetLatestError": {
+			reason: "We should return any error encountered getting the latest version of a conflicting resource.",
+			args: args{
+				get:     test.NewMockGetFn(errBoom),
+				errs:    []error{errConflict},
+				updates: []client.Object{status("cool-uid", "1", xpv1.Available())},
+			},
+			want: want{
+				err:     errors.Join(errors.Wrap(errBoom, errGetLatestStatus)),
+				written: []client.Object{status("cool-uid", "1", xpv1.Available())},
+			},
+		},
+		"Recreated": {
+			reason: "A status update of a resource that was deleted and recreated should be dropped.",
+			args: args{
+				get: test.NewMockGetFn(nil, func(obj client.Object) error {
+					obj.SetUID("new-uid")
+					obj.SetResourceVersion("2")
+					return nil
+				}),
+				errs:    []error{errConflict},
+				updates: []client.Object{status("cool-uid", "1", xpv1.Available())},
+			},
+			want: want{
+				written: []client.Object{status("cool-uid", "1", xpv1.Available())},
+			},
+		},
+		"NotFound": {
+			reason: "A status update of a resource that no longer exists should be dropped.",
+			args: args{
+				errs:    []error{kerrors.NewNotFound(schema.GroupResource{}, "cool")},
+				updates: []client.Object{status("cool-uid", "1", xpv1.Available())},
+			},
+			want: want{
+				written: []client.Object{status("cool-uid", "1", xpv1.Available())},
+			},
+		},
+		"UpdateError": {
+			reason: "We should return any other error encountered writing a status update.",
+			args: args{
+				errs:    []error{errBoom},
+				updates: []client.Object{status("cool-uid", "1", xpv1.Available())},
+			},
+			want: want{
+				err:     errors.Join(errBoom),
+				written: []client.Object{status("cool-uid", "1", xpv1.Available())},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			written := make([]client.Object, 0)
+			calls := 0
+
+			c := &test.MockClient{
+				MockGet: tc.args.get,
+				MockStatusUpdate: func(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+					written = append(written, obj.DeepCopyObject().(client.Object))
+					calls++
+
+					if calls <= len(tc.args.errs) {
+						return tc.args.errs[calls-1]
+					}
+
+					return nil
+				},
+			}
+
+			wb := NewWriteBehindStatusClient(c, WithStatusWriteWindow(time.Hour))
+			for _, o := range tc.args.updates {
+				if err := wb.Status().Update(context.Background(), o, tc.args.opts...); err != nil {
+					t.Fatalf("\n%s\nStatus().Update(...): %v", tc.reason, err)
+				}
+			}
+
+			err := wb.Flush(context.Background())
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nFlush(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.want.written, written, test.EquateConditions()); diff != "" {
+				t.Errorf("\n%s\nFlush(...): -want written, +got written:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestWriteBehindStatusClientWindow(t *testing.T) {
+	written := 0
+	c := &test.MockClient{
+		MockStatusUpdate: func(_ context.Context, _ client.Object, _ ...client.SubResourceUpdateOption) error {
+			written++
+			return nil
+		},
+	}
+
+	clk := testingclock.NewFakeClock(time.Now())
+	wb := NewWriteBehindStatusClient(c, WithStatusWriteWindow(time.Second), WithStatusWriteClock(clk))
+
+	mg := &fake.ModernManaged{}
+	mg.SetUID("cool-uid")
+
+	for range 3 {
+		if err := wb.Status().Update(context.Background(), mg); err != nil {
+			t.Fatalf("Status().Update(...): %v", err)
+		}
+	}
+
+	if written != 0 {
+		t.Errorf("Status().Update(...): want 0 writes before the window passed, got %d", written)
+	}
+
+	// The fake clock calls the scheduled write synchronously.
+	clk.Step(time.Second)
+
+	if written != 1 {
+		t.Errorf("Status().Update(...): want 1 write after the window passed, got %d", written)
+	}
+
+	if err := wb.Flush(context.Background()); err != nil {
+		t.Errorf("Flush(...): %v", err)
+	}
+
+	if written != 1 {
+		t.Errorf("Flush(...): want no pending writes, got %d writes", written)
+	}
+}
+
+func TestGracefulStatusClient(t *testing.T) {
+	grace := 10 * time.Second
+
+	type want struct {
+		live     bool
+		extended bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		ctx    func() (context.Context, context.CancelFunc)
+		want   want
+	}{
+		"Live": {
+			reason: "A status update made with a context that has plenty of time left should use it.",
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithTimeout(context.Background(), time.Hour)
+			},
+			want: want{live: true},
+		},
+		"NoDeadline": {
+			reason: "A status update made with a context that has no deadline should use it.",
+			ctx:    func() (context.Context, context.CancelFunc) { return context.WithCancel(context.Background()) },
+			want:   want{live: true},
+		},
+		"Cancelled": {
+			reason: "A status update made with a cancelled context should get a fresh deadline.",
+			ctx: func() (context.Context, context.CancelFunc) {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+
+				return ctx, cancel
+			},
+			want: want{live: true, extended: true},
+		},
+		"NearlyExpired": {
+			reason: "A status update made with a context that will expire within the grace period should get a fresh deadline.",
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithTimeout(context.Background(), time.Second)
+			},
+			want: want{live: true, extended: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := tc.ctx()
+			defer cancel()
+
+			check := func(got context.Context) {
+				if live := got.Err() == nil; live != tc.want.live {
+					t.Errorf("\n%s\nwant live context %t, got %t", tc.reason, tc.want.live, live)
+				}
+
+				if extended := got != ctx; extended != tc.want.extended {
+					t.Errorf("\n%s\nwant extended context %t, got %t", tc.reason, tc.want.extended, extended)
+				}
+
+				if dl, ok := got.Deadline(); tc.want.extended && (!ok || time.Until(dl) > grace) {
+					t.Errorf("\n%s\nwant deadline within the grace period, got %s", tc.reason, dl)
+				}
+			}
+
+			c := &gracefulStatusClient{
+				Client: &test.MockClient{
+					MockStatusUpdate: func(ctx context.Context, _ client.Object, _ ...client.SubResourceUpdateOption) error {
+						check(ctx)
+						return nil
+					},
+					MockStatusPatch: func(ctx context.Context, _ client.Object, _ client.Patch, _ ...client.SubResourcePatchOption) error {
+						check(ctx)
+						return nil
+					},
+				},
+				grace: grace,
+			}
+
+			if err := c.Status().Update(ctx, &fake.ModernManaged{}); err != nil {
+				t.Errorf("Status().Update(...): %v", err)
+			}
+
+			if err := c.Status().Patch(ctx, &fake.ModernManaged{}, client.MergeFrom(&fake.ModernManaged{})); err != nil {
+				t.Errorf("Status().Patch(...): %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/fieldpath"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+)
+
+// DefaultTagsFieldPath is the field path at which a ProvenanceTagger writes
+// tags, unless configured otherwise.
+const DefaultTagsFieldPath = "spec.forProvider.tags"
+
+// Error strings.
+const (
+	errPaveManaged    = "cannot pave managed resource"
+	errFmtGetTags     = "cannot get tags at field path %q"
+	errFmtSetTags     = "cannot set tags at field path %q"
+	errConvertManaged = "cannot convert paved managed resource"
+)
+
+// A ProvenanceTaggerOption configures a ProvenanceTagger.
+type ProvenanceTaggerOption func(t *ProvenanceTagger)
+
+// WithTagsFieldPath configures the field path of the string map to which a
+// ProvenanceTagger writes tags, e.g. spec.forProvider.labels.
+func WithTagsFieldPath(path string) ProvenanceTaggerOption {
+	return func(t *ProvenanceTagger) {
+		t.fieldPath = path
+	}
+}
+
+// A ProvenanceTagger is an Initializer that writes tags identifying where a
+// managed resource came from to a string map in its spec. Tags identify the
+// managed resource's kind, name, namespace, and provider config, as well as
+// any composite resource or claim it was composed for. See GetProvenanceTags.
+// Providers typically propagate this map to the external resource's tags or
+// labels. Existing tags with other keys are left untouched.
+type ProvenanceTagger struct {
+	client    client.Client
+	fieldPath string
+}
+
+// NewProvenanceTagger returns a new ProvenanceTagger.
+func NewProvenanceTagger(c client.Client, o ...ProvenanceTaggerOption) *ProvenanceTagger {
+	t := &ProvenanceTagger{client: c, fieldPath: DefaultTagsFieldPath}
+	for _, fn := range o {
+		fn(t)
+	}
+
+	return t
+}
+
+// Initialize the supplied managed resource by writing provenance tags to its
+// spec. The managed resource is only updated if its tags changed.
+func (t *ProvenanceTagger) Initialize(ctx context.Context, mg resource.Managed) error {
+	p, err := fieldpath.PaveObject(mg)
+	if err != nil {
+		return errors.Wrap(err, errPaveManaged)
+	}
+
+	tags, err := p.GetStringObject(t.fieldPath)
+	if err != nil && !fieldpath.IsNotFound(err) {
+		return errors.Wrapf(err, errFmtGetTags, t.fieldPath)
+	}
+
+	if tags == nil {
+		tags = map[string]string{}
+	}
+
+	changed := false
+
+	for k, v := range resource.GetProvenanceTags(mg) {
+		if existing, ok := tags[k]; ok && existing == v {
+			continue
+		}
+
+		tags[k] = v
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+
+	if err := p.SetValue(t.fieldPath, tags); err != nil {
+		return errors.Wrapf(err, errFmtSetTags, t.fieldPath)
+	}
+
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(p.UnstructuredContent(), mg); err != nil {
+		return errors.Wrap(err, errConvertManaged)
+	}
+
+	return errors.Wrap(t.client.Update(ctx, mg), errUpdateManaged)
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+)
+
+type taggedSpec struct {
+	ForProvider struct {
+		Tags map[string]string `json:"tags,omitempty"`
+	} `json:"forProvider"`
+}
+
+type taggedManaged struct {
+	fake.ModernManaged
+
+	Spec taggedSpec `json:"spec"`
+}
+
+func TestProvenanceTaggerInitialize(t *testing.T) {
+	errBoom := errors.New("boom")
+	kind := strings.ToLower((&fake.ModernManaged{}).GetObjectKind().GroupVersionKind().GroupKind().String())
+
+	newManaged := func(tags map[string]string) *taggedManaged {
+		mg := &taggedManaged{}
+		mg.SetName("cool")
+		mg.SetNamespace("default")
+		mg.SetLabels(map[string]string{
+			meta.LabelKeyComposite:      "cool-xr",
+			meta.LabelKeyClaimName:      "cool-claim",
+			meta.LabelKeyClaimNamespace: "team",
+		})
+		mg.Spec.ForProvider.Tags = tags
+
+		return mg
+	}
+
+	provenance := map[string]string{
+		resource.ExternalResourceTagKeyKind:           kind,
+		resource.ExternalResourceTagKeyName:           "cool",
+		resource.ExternalResourceTagKeyNamespace:      "default",
+		resource.ExternalResourceTagKeyComposite:      "cool-xr",
+		resource.ExternalResourceTagKeyClaimName:      "cool-claim",
+		resource.ExternalResourceTagKeyClaimNamespace: "team",
+	}
+
+	withExisting := map[string]string{"team": "platform"}
+	for k, v := range provenance {
+		withExisting[k] = v
+	}
+
+	// The fake managed resource has no kind, so its kind tag is empty.
+	withoutKind := map[string]string{}
+	for k, v := range withExisting {
+		if k != resource.ExternalResourceTagKeyKind {
+			withoutKind[k] = v
+		}
+	}
+
+	type args struct {
+		c  client.Client
+		mg *taggedManaged
+	}
+
+	type want struct {
+		err  error
+		tags map[string]string
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"AlreadyTagged": {
+			reason: "We should not update a managed resource that already has its provenance tags.",
+			args: args{
+				c:  &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				mg: newManaged(withExisting),
+			},
+			want: want{
+				tags: withExisting,
+			},
+		},
+		"AddTags": {
+			reason: "We should add provenance tags alongside any existing tags.",
+			args: args{
+				c:  &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				mg: newManaged(map[string]string{"team": "platform"}),
+			},
+			want: want{
+				tags: withExisting,
+			},
+		},
+		"AddEmptyTag": {
+			reason: "We should add a provenance tag with an empty value if it's missing.",
+			args: args{
+				c:  &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				mg: newManaged(withoutKind),
+			},
+			want: want{
+				tags: withExisting,
+			},
+		},
+		"UpdateError": {
+			reason: "We should return any error encountered updating the managed resource.",
+			args: args{
+				c:  &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				mg: newManaged(nil),
+			},
+			want: want{
+				err:  errors.Wrap(errBoom, errUpdateManaged),
+				tags: provenance,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := NewProvenanceTagger(tc.args.c).Initialize(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nInitialize(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.want.tags, tc.args.mg.Spec.ForProvider.Tags); diff != "" {
+				t.Errorf("\n%s\nInitialize(...): -want tags, +got tags:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
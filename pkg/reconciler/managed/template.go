@@ -0,0 +1,127 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"bytes"
+	"encoding/base64"
+	"maps"
+	"net/url"
+	"strings"
+	"text/template"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+)
+
+// Error strings.
+const (
+	errFmtParseTemplate   = "cannot parse template for connection detail %q"
+	errFmtExecuteTemplate = "cannot derive connection detail %q"
+)
+
+// TemplateFuncs are the functions available to connection detail templates,
+// in addition to Go's builtin template functions. They're a small subset of
+// the functions provided by the sprig library.
+func TemplateFuncs() template.FuncMap {
+	return template.FuncMap{
+		"lower":       strings.ToLower,
+		"upper":       strings.ToUpper,
+		"trim":        strings.TrimSpace,
+		"trimPrefix":  func(prefix, s string) string { return strings.TrimPrefix(s, prefix) },
+		"trimSuffix":  func(suffix, s string) string { return strings.TrimSuffix(s, suffix) },
+		"replace":     func(old, replacement, s string) string { return strings.ReplaceAll(s, old, replacement) },
+		"contains":    func(substr, s string) bool { return strings.Contains(s, substr) },
+		"hasPrefix":   func(prefix, s string) bool { return strings.HasPrefix(s, prefix) },
+		"hasSuffix":   func(suffix, s string) bool { return strings.HasSuffix(s, suffix) },
+		"quote":       func(s string) string { return `"` + strings.ReplaceAll(s, `"`, `\"`) + `"` },
+		"default":     templateDefault,
+		"b64enc":      func(s string) string { return base64.StdEncoding.EncodeToString([]byte(s)) },
+		"b64dec":      templateB64Dec,
+		"urlPathEsc":  url.PathEscape,
+		"urlQueryEsc": url.QueryEscape,
+		"urlUserInfo": func(user, password string) string { return url.UserPassword(user, password).String() },
+	}
+}
+
+// templateDefault returns the supplied value, or the default if it's empty.
+// Like sprig's default function it's intended to be used in a pipeline, e.g.
+// {{ .port | default "5432" }}.
+func templateDefault(d string, v ...string) string {
+	if len(v) == 0 || v[0] == "" {
+		return d
+	}
+
+	return v[0]
+}
+
+func templateB64Dec(s string) (string, error) {
+	b, err := base64.StdEncoding.DecodeString(s)
+	return string(b), err
+}
+
+// ConnectionDetailsTemplates derive additional connection details from the
+// raw connection details returned by an ExternalClient, for example a JDBC URL
+// composed from a host, port, username, and password.
+type ConnectionDetailsTemplates struct {
+	templates map[string]*template.Template
+}
+
+// NewConnectionDetailsTemplates parses the supplied Go templates, keyed by the
+// connection detail they derive. Each template is executed with the raw
+// connection details as a map of strings, so a template may refer to a raw
+// connection detail as e.g. {{ .host }}, or {{ index . "my-host" }} if its key
+// isn't a valid Go identifier. Referring to a connection detail that doesn't
+// exist is an error. See TemplateFuncs for the available functions.
+func NewConnectionDetailsTemplates(t map[string]string) (*ConnectionDetailsTemplates, error) {
+	ct := &ConnectionDetailsTemplates{templates: make(map[string]*template.Template, len(t))}
+
+	for k, v := range t {
+		tmpl, err := template.New(k).Funcs(TemplateFuncs()).Option("missingkey=error").Parse(v)
+		if err != nil {
+			return nil, errors.Wrapf(err, errFmtParseTemplate, k)
+		}
+
+		ct.templates[k] = tmpl
+	}
+
+	return ct, nil
+}
+
+// Derive returns a copy of the supplied connection details with the derived
+// connection details added. Derived connection details replace any raw
+// connection details with the same key. Templates are only passed the raw
+// connection details; they can't refer to other derived connection details.
+func (ct *ConnectionDetailsTemplates) Derive(c ConnectionDetails) (ConnectionDetails, error) {
+	data := make(map[string]string, len(c))
+	for k, v := range c {
+		data[k] = string(v)
+	}
+
+	out := make(ConnectionDetails, len(c)+len(ct.templates))
+	maps.Copy(out, c)
+
+	for k, tmpl := range ct.templates {
+		b := &bytes.Buffer{}
+		if err := tmpl.Execute(b, data); err != nil {
+			return nil, errors.Wrapf(err, errFmtExecuteTemplate, k)
+		}
+
+		out[k] = b.Bytes()
+	}
+
+	return out, nil
+}
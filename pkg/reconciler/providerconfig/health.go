@@ -0,0 +1,74 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package providerconfig
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+)
+
+// Condition types and reasons.
+const (
+	TypeHealthy xpv1.ConditionType = "Healthy"
+
+	ReasonHealthCheckSucceeded xpv1.ConditionReason = "HealthCheckSucceeded"
+	ReasonHealthCheckFailed    xpv1.ConditionReason = "HealthCheckFailed"
+)
+
+// Healthy indicates a ProviderConfig's credentials passed their most recent
+// health check.
+func Healthy() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeHealthy,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonHealthCheckSucceeded,
+	}
+}
+
+// Unhealthy indicates a ProviderConfig's credentials failed their most recent
+// health check.
+func Unhealthy() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeHealthy,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonHealthCheckFailed,
+	}
+}
+
+// A HealthChecker checks whether a ProviderConfig's credentials are usable,
+// for example by calling an identity API such as AWS STS GetCallerIdentity.
+// It returns the identity the credentials authenticate as, for example an
+// account ID and ARN, which is written to the ProviderConfig's status if it
+// is a resource.IdentityReporter.
+type HealthChecker interface {
+	CheckHealth(ctx context.Context, pc resource.ProviderConfig) (map[string]string, error)
+}
+
+// A HealthCheckerFn is a function that satisfies the HealthChecker interface.
+type HealthCheckerFn func(ctx context.Context, pc resource.ProviderConfig) (map[string]string, error)
+
+// CheckHealth calls HealthCheckerFn.
+func (fn HealthCheckerFn) CheckHealth(ctx context.Context, pc resource.ProviderConfig) (map[string]string, error) {
+	return fn(ctx, pc)
+}
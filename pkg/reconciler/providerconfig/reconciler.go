@@ -43,15 +43,16 @@ const (
 	timeout   = 2 * time.Minute
 
 	errGetPC        = "cannot get ProviderConfig"
-	errListPCUs     = "cannot list ProviderConfigUsages"
 	errDeletePCU    = "cannot delete ProviderConfigUsage"
 	errUpdate       = "cannot update ProviderConfig"
 	errUpdateStatus = "cannot update ProviderConfig status"
+	errCheckHealth  = "cannot check ProviderConfig health"
 )
 
 // Event reasons.
 const (
-	reasonAccount event.Reason = "UsageAccounting"
+	reasonAccount     event.Reason = "UsageAccounting"
+	reasonHealthCheck event.Reason = "HealthCheck"
 )
 
 // Condition types and reasons.
@@ -84,10 +85,11 @@ func ControllerName(kind string) string {
 type Reconciler struct {
 	client client.Client
 
-	newConfig    func() resource.ProviderConfig
-	newUsageList func() resource.ProviderConfigUsageList
+	newConfig func() resource.ProviderConfig
+	usages    *resource.ProviderConfigUsageLister
 
-	legacyPCU bool
+	health         HealthChecker
+	healthInterval time.Duration
 
 	log    logging.Logger
 	record event.Recorder
@@ -110,6 +112,17 @@ func WithRecorder(er event.Recorder) ReconcilerOption {
 	}
 }
 
+// WithHealthChecker specifies how the Reconciler should check the health of a
+// ProviderConfig's credentials. The Reconciler checks each ProviderConfig's
+// health every supplied interval, and reflects the result in its Healthy and
+// Ready status conditions. Health isn't checked by default.
+func WithHealthChecker(hc HealthChecker, interval time.Duration) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.health = hc
+		r.healthInterval = interval
+	}
+}
+
 // NewReconciler returns a Reconciler of ProviderConfigs.
 func NewReconciler(m manager.Manager, of resource.ProviderConfigKinds, o ...ReconcilerOption) *Reconciler {
 	nc := func() resource.ProviderConfig {
@@ -124,14 +137,18 @@ func NewReconciler(m manager.Manager, of resource.ProviderConfigKinds, o ...Reco
 
 	// Panic early if we've been asked to reconcile a resource kind that has not
 	// been registered with our controller manager's scheme.
-	_, _ = nc(), nul()
+	_ = nc()
+
+	ul := resource.NewProviderConfigUsageLister(m.GetClient(), nul())
+	if isLegacyPCU {
+		ul = resource.NewLegacyProviderConfigUsageLister(m.GetClient(), nul())
+	}
 
 	r := &Reconciler{
 		client: m.GetClient(),
 
-		newConfig:    nc,
-		newUsageList: nul,
-		legacyPCU:    isLegacyPCU,
+		newConfig: nc,
+		usages:    ul,
 
 		log:    logging.NewNopLogger(),
 		record: event.NewNopRecorder(),
@@ -168,25 +185,15 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		"name", pc.GetName(),
 	)
 
-	l := r.newUsageList()
-
-	matchingLabels := client.MatchingLabels{
-		xpv1.LabelKeyProviderName: pc.GetName(),
-	}
-
-	if !r.legacyPCU {
-		matchingLabels[xpv1.LabelKeyProviderKind] = pc.GetObjectKind().GroupVersionKind().Kind
-	}
-
-	if err := r.client.List(ctx, l, matchingLabels); err != nil {
-		log.Debug(errListPCUs, "error", err)
-		r.record.Event(pc, event.Warning(reasonAccount, errors.Wrap(err, errListPCUs)))
+	pcus, err := r.usages.List(ctx, pc)
+	if err != nil {
+		log.Debug("Cannot list usages", "error", err)
+		r.record.Event(pc, event.Warning(reasonAccount, err))
 
 		return reconcile.Result{RequeueAfter: shortWait}, nil
 	}
 
-	users := int64(len(l.GetItems()))
-	for _, pcu := range l.GetItems() {
+	for _, pcu := range pcus {
 		if metav1.GetControllerOf(pcu) == nil {
 			// Usages should always have a controller reference. If this one has
 			// none it's probably been stripped off (e.g. by a Velero restore).
@@ -198,11 +205,19 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 
 				return reconcile.Result{RequeueAfter: shortWait}, nil
 			}
-
-			users--
 		}
 	}
 
+	// Usages without a controller reference aren't counted, so there's no
+	// need to wait for the deletions above to reach our cache.
+	users, err := r.usages.Count(ctx, pc)
+	if err != nil {
+		log.Debug("Cannot count usages", "error", err)
+		r.record.Event(pc, event.Warning(reasonAccount, err))
+
+		return reconcile.Result{RequeueAfter: shortWait}, nil
+	}
+
 	log = log.WithValues("usages", users)
 
 	if meta.WasDeleted(pc) {
@@ -230,15 +245,53 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		return reconcile.Result{Requeue: false}, nil
 	}
 
-	meta.AddFinalizer(pc, finalizer)
+	// Only block deletion of a ProviderConfig while it's in use. Note that
+	// this races with managed resources that start to use an unused
+	// ProviderConfig. A managed resource creates its usage before it uses
+	// the ProviderConfig, but the ProviderConfig may be deleted after the
+	// usage is created and before we add the finalizer in response. The
+	// managed resource then fails to connect until the ProviderConfig is
+	// recreated.
+	if inUse := users > 0; inUse != meta.FinalizerExists(pc, finalizer) {
+		if inUse {
+			meta.AddFinalizer(pc, finalizer)
+		} else {
+			meta.RemoveFinalizer(pc, finalizer)
+		}
 
-	if err := r.client.Update(ctx, pc); err != nil {
-		r.log.Debug(errUpdate, "error", err)
-		return reconcile.Result{RequeueAfter: shortWait}, nil
+		if err := r.client.Update(ctx, pc); err != nil {
+			r.log.Debug(errUpdate, "error", err)
+			return reconcile.Result{RequeueAfter: shortWait}, nil
+		}
 	}
 
-	// There's no need to requeue explicitly - we're watching all PCs.
 	pc.SetUsers(users)
 
-	return reconcile.Result{Requeue: false}, errors.Wrap(r.client.Status().Update(ctx, pc), errUpdateStatus)
+	if r.health == nil {
+		// There's no need to requeue explicitly - we're watching all PCs.
+		return reconcile.Result{Requeue: false}, errors.Wrap(r.client.Status().Update(ctx, pc), errUpdateStatus)
+	}
+
+	r.checkHealth(ctx, log, pc)
+
+	// Requeue to check health again, even if nothing about the PC changes.
+	return reconcile.Result{RequeueAfter: r.healthInterval}, errors.Wrap(r.client.Status().Update(ctx, pc), errUpdateStatus)
+}
+
+func (r *Reconciler) checkHealth(ctx context.Context, log logging.Logger, pc resource.ProviderConfig) {
+	id, err := r.health.CheckHealth(ctx, pc)
+	if err != nil {
+		// Don't report a stale identity if the health check failed.
+		id = nil
+		err = errors.Wrap(err, errCheckHealth)
+		log.Debug("Health check failed", "error", err)
+		r.record.Event(pc, event.Warning(reasonHealthCheck, err))
+		pc.SetConditions(Unhealthy().WithMessage(err.Error()), xpv1.Unavailable().WithMessage(err.Error()))
+	} else {
+		pc.SetConditions(Healthy(), xpv1.Available())
+	}
+
+	if ir, ok := pc.(resource.IdentityReporter); ok {
+		ir.SetIdentity(id)
+	}
 }
@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
@@ -31,6 +32,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
@@ -267,7 +269,45 @@ func TestReconciler(t *testing.T) {
 			args: args{
 				m: &fake.Manager{
 					Client: &test.MockClient{
-						MockGet:    test.NewMockGetFn(nil),
+						MockGet: test.NewMockGetFn(nil),
+						MockList: test.NewMockListFn(nil, func(obj client.ObjectList) error {
+							l := obj.(*ProviderConfigUsageList)
+							l.Items = []resource.ProviderConfigUsage{
+								&fake.ProviderConfigUsage{
+									ObjectMeta: metav1.ObjectMeta{
+										OwnerReferences: []metav1.OwnerReference{{
+											UID:        uid,
+											Controller: &ctrl,
+										}},
+									},
+								},
+							}
+							return nil
+						}),
+						MockUpdate: test.NewMockUpdateFn(errBoom),
+					},
+					Scheme: fake.SchemeWith(&fake.ProviderConfig{}, &fake.ProviderConfigUsage{}, &ProviderConfigUsageList{}),
+				},
+				of: resource.ProviderConfigKinds{
+					Config:    fake.GVK(&fake.ProviderConfig{}),
+					Usage:     fake.GVK(&fake.ProviderConfigUsage{}),
+					UsageList: fake.GVK(&ProviderConfigUsageList{}),
+				},
+			},
+			want: want{
+				result: reconcile.Result{RequeueAfter: shortWait},
+			},
+		},
+		"RemoveUnusedFinalizerError": {
+			reason: "We should requeue after a short wait if we encounter an error while removing our finalizer from an unused provider config",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+							pc := obj.(*fake.ProviderConfig)
+							pc.SetFinalizers([]string{finalizer})
+							return nil
+						}),
 						MockList:   test.NewMockListFn(nil),
 						MockUpdate: test.NewMockUpdateFn(errBoom),
 					},
@@ -345,3 +385,100 @@ func TestReconciler(t *testing.T) {
 		})
 	}
 }
+
+func TestReconcilerHealthCheck(t *testing.T) {
+	errBoom := errors.New("boom")
+	interval := 5 * time.Minute
+	id := map[string]string{"account": "123456789012"}
+
+	type args struct {
+		hc HealthChecker
+	}
+
+	type want struct {
+		result reconcile.Result
+		pc     *fake.ProviderConfig
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"Healthy": {
+			reason: "We should mark a ProviderConfig healthy and report its identity if its health check passes.",
+			args: args{
+				hc: HealthCheckerFn(func(_ context.Context, _ resource.ProviderConfig) (map[string]string, error) {
+					return id, nil
+				}),
+			},
+			want: want{
+				result: reconcile.Result{RequeueAfter: interval},
+				pc: &fake.ProviderConfig{
+					IdentityReporter: fake.IdentityReporter{Identity: id},
+					ConditionedStatus: xpv1.ConditionedStatus{
+						Conditions: []xpv1.Condition{Healthy(), xpv1.Available()},
+					},
+				},
+			},
+		},
+		"Unhealthy": {
+			reason: "We should mark a ProviderConfig unhealthy and clear its identity if its health check fails.",
+			args: args{
+				hc: HealthCheckerFn(func(_ context.Context, _ resource.ProviderConfig) (map[string]string, error) {
+					return id, errBoom
+				}),
+			},
+			want: want{
+				result: reconcile.Result{RequeueAfter: interval},
+				pc: &fake.ProviderConfig{
+					ConditionedStatus: xpv1.ConditionedStatus{
+						Conditions: []xpv1.Condition{
+							Unhealthy().WithMessage(errors.Wrap(errBoom, errCheckHealth).Error()),
+							xpv1.Unavailable().WithMessage(errors.Wrap(errBoom, errCheckHealth).Error()),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := &fake.ProviderConfig{}
+			m := &fake.Manager{
+				Client: &test.MockClient{
+					MockGet:    test.NewMockGetFn(nil),
+					MockList:   test.NewMockListFn(nil),
+					MockUpdate: test.NewMockUpdateFn(nil),
+					MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil, func(obj client.Object) error {
+						//nolint:forcetypeassert // This is always a ProviderConfig.
+						*got = *obj.(*fake.ProviderConfig)
+						return nil
+					}),
+				},
+				Scheme: fake.SchemeWith(&fake.ProviderConfig{}, &fake.ProviderConfigUsage{}, &ProviderConfigUsageList{}),
+			}
+			of := resource.ProviderConfigKinds{
+				Config:    fake.GVK(&fake.ProviderConfig{}),
+				Usage:     fake.GVK(&fake.ProviderConfigUsage{}),
+				UsageList: fake.GVK(&ProviderConfigUsageList{}),
+			}
+
+			r := NewReconciler(m, of, WithHealthChecker(tc.args.hc, interval))
+
+			result, err := r.Reconcile(context.Background(), reconcile.Request{})
+			if err != nil {
+				t.Errorf("\n%s\nr.Reconcile(...): %v", tc.reason, err)
+			}
+
+			if diff := cmp.Diff(tc.want.result, result); diff != "" {
+				t.Errorf("\n%s\nr.Reconcile(...): -want, +got:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.want.pc, got, test.EquateConditions()); diff != "" {
+				t.Errorf("\n%s\nr.Reconcile(...): -want ProviderConfig, +got ProviderConfig:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reference
+
+import (
+	"context"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/fieldpath"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+)
+
+// Error strings.
+const (
+	errGetObject = "cannot get referenced object"
+)
+
+// An ExtractObjectValueFn specifies how to extract a value from a resolved
+// object of any kind.
+type ExtractObjectValueFn func(o *unstructured.Unstructured) string
+
+// FromObjectField extracts the string at the supplied field path of the
+// resolved object, e.g. data.endpoint. It extracts an empty string if the
+// field doesn't exist or isn't a string.
+func FromObjectField(path string) ExtractObjectValueFn {
+	return func(o *unstructured.Unstructured) string {
+		v, err := fieldpath.Pave(o.Object).GetString(path)
+		if err != nil {
+			return ""
+		}
+
+		return v
+	}
+}
+
+// An ObjectResolutionRequest requests that a reference to an object of any
+// kind be resolved.
+type ObjectResolutionRequest struct {
+	CurrentValue string
+	Reference    *xpv1.NamespacedTypedReference
+	Extract      ExtractObjectValueFn
+}
+
+// IsNoOp returns true if the supplied ObjectResolutionRequest cannot or should
+// not be processed.
+func (rr *ObjectResolutionRequest) IsNoOp() bool {
+	// We can't resolve anything if no reference was provided.
+	if rr.Reference == nil {
+		return true
+	}
+
+	// We don't resolve values that are already set, unless the reference's
+	// resolve policy is Always.
+	return rr.CurrentValue != "" && !rr.Reference.Policy.IsResolvePolicyAlways()
+}
+
+// An ObjectResolutionResponse returns the result of an object reference
+// resolution. The returned values are always safe to set if resolution was
+// successful.
+type ObjectResolutionResponse struct {
+	ResolvedValue     string
+	ResolvedReference *xpv1.NamespacedTypedReference
+}
+
+// Validate this ObjectResolutionResponse.
+func (rr ObjectResolutionResponse) Validate() error {
+	if rr.ResolvedValue == "" {
+		return errors.New(errNoValue)
+	}
+
+	return nil
+}
+
+// ResolveObject resolves the supplied ObjectResolutionRequest. The referenced
+// object may be of any kind, for example a ConfigMap. It defaults to the
+// namespace of the referencing managed resource. The returned
+// ObjectResolutionResponse always contains valid values unless an error was
+// returned.
+func (r *APINamespacedResolver) ResolveObject(ctx context.Context, req ObjectResolutionRequest) (ObjectResolutionResponse, error) {
+	// Return early if from is being deleted, or the request is a no-op.
+	if meta.WasDeleted(r.from) || req.IsNoOp() {
+		return ObjectResolutionResponse{ResolvedValue: req.CurrentValue, ResolvedReference: req.Reference}, nil
+	}
+
+	o := &unstructured.Unstructured{}
+	o.SetGroupVersionKind(req.Reference.GroupVersionKind())
+
+	if err := r.client.Get(ctx, meta.NamespacedNameOfTyped(req.Reference, r.from.GetNamespace()), o); err != nil {
+		if kerrors.IsNotFound(err) {
+			return ObjectResolutionResponse{}, getResolutionError(req.Reference.Policy, errors.Wrap(err, errGetObject))
+		}
+
+		return ObjectResolutionResponse{}, errors.Wrap(err, errGetObject)
+	}
+
+	rsp := ObjectResolutionResponse{ResolvedValue: req.Extract(o), ResolvedReference: req.Reference}
+
+	return rsp, getResolutionError(req.Reference.Policy, rsp.Validate())
+}
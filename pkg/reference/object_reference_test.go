@@ -0,0 +1,167 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reference
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+)
+
+func TestResolveObject(t *testing.T) {
+	errBoom := errors.New("boom")
+	errNotFound := kerrors.NewNotFound(schema.GroupResource{Resource: "configmaps"}, "cool")
+	now := metav1.Now()
+	value := "https://example.org"
+	optionalPolicy := xpv1.ResolutionPolicyOptional
+	alwaysPolicy := xpv1.ResolvePolicyAlways
+
+	ref := &xpv1.NamespacedTypedReference{APIVersion: "v1", Kind: "ConfigMap", Name: "cool"}
+	optionalRef := &xpv1.NamespacedTypedReference{APIVersion: "v1", Kind: "ConfigMap", Name: "cool", Policy: &xpv1.Policy{Resolution: &optionalPolicy}}
+	alwaysRef := &xpv1.NamespacedTypedReference{APIVersion: "v1", Kind: "ConfigMap", Name: "cool", Namespace: "other-ns", Policy: &xpv1.Policy{Resolve: &alwaysPolicy}}
+
+	withEndpoint := func(wantKey client.ObjectKey) func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+		return func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+			if diff := cmp.Diff(wantKey, key); diff != "" {
+				return errors.Errorf("Get(...): -want key, +got key:\n%s", diff)
+			}
+
+			u := obj.(*unstructured.Unstructured) //nolint:forcetypeassert // This is always unstructured.
+			if diff := cmp.Diff(schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}, u.GroupVersionKind()); diff != "" {
+				return errors.Errorf("Get(...): -want kind, +got kind:\n%s", diff)
+			}
+
+			u.Object["data"] = map[string]any{"endpoint": value}
+
+			return nil
+		}
+	}
+
+	type want struct {
+		rsp ObjectResolutionResponse
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		c      client.Reader
+		from   resource.Managed
+		req    ObjectResolutionRequest
+		want   want
+	}{
+		"FromDeleted": {
+			reason: "Should return early if the referencing managed resource was deleted",
+			from:   &fake.Managed{ObjectMeta: metav1.ObjectMeta{DeletionTimestamp: &now}},
+			req:    ObjectResolutionRequest{Reference: ref},
+			want: want{
+				rsp: ObjectResolutionResponse{ResolvedReference: ref},
+			},
+		},
+		"AlreadyResolved": {
+			reason: "Should return early if the current value is non-zero",
+			from:   &fake.Managed{},
+			req:    ObjectResolutionRequest{CurrentValue: value, Reference: ref},
+			want: want{
+				rsp: ObjectResolutionResponse{ResolvedValue: value, ResolvedReference: ref},
+			},
+		},
+		"Unresolvable": {
+			reason: "Should return early if no reference was provided",
+			from:   &fake.Managed{},
+			req:    ObjectResolutionRequest{},
+		},
+		"GetError": {
+			reason: "Should return errors encountered while getting the referenced object",
+			c:      &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			from:   &fake.Managed{},
+			req:    ObjectResolutionRequest{Reference: optionalRef, Extract: FromObjectField("data.endpoint")},
+			want: want{
+				err: errors.Wrap(errBoom, errGetObject),
+			},
+		},
+		"NotFound": {
+			reason: "Should return an error if the referenced object doesn't exist",
+			c:      &test.MockClient{MockGet: test.NewMockGetFn(errNotFound)},
+			from:   &fake.Managed{},
+			req:    ObjectResolutionRequest{Reference: ref, Extract: FromObjectField("data.endpoint")},
+			want: want{
+				err: errors.Wrap(errNotFound, errGetObject),
+			},
+		},
+		"OptionalNotFound": {
+			reason: "Should not return an error if an optional referenced object doesn't exist",
+			c:      &test.MockClient{MockGet: test.NewMockGetFn(errNotFound)},
+			from:   &fake.Managed{},
+			req:    ObjectResolutionRequest{Reference: optionalRef, Extract: FromObjectField("data.endpoint")},
+		},
+		"NoValue": {
+			reason: "Should return an error if the extracted value is empty",
+			c:      &test.MockClient{MockGet: withEndpoint(client.ObjectKey{Namespace: "cool-ns", Name: "cool"})},
+			from:   &fake.Managed{ObjectMeta: metav1.ObjectMeta{Namespace: "cool-ns"}},
+			req:    ObjectResolutionRequest{Reference: ref, Extract: FromObjectField("data.missing")},
+			want: want{
+				rsp: ObjectResolutionResponse{ResolvedReference: ref},
+				err: errors.New(errNoValue),
+			},
+		},
+		"SuccessfulResolve": {
+			reason: "Should resolve a reference to an object in the referencing managed resource's namespace",
+			c:      &test.MockClient{MockGet: withEndpoint(client.ObjectKey{Namespace: "cool-ns", Name: "cool"})},
+			from:   &fake.Managed{ObjectMeta: metav1.ObjectMeta{Namespace: "cool-ns"}},
+			req:    ObjectResolutionRequest{Reference: ref, Extract: FromObjectField("data.endpoint")},
+			want: want{
+				rsp: ObjectResolutionResponse{ResolvedValue: value, ResolvedReference: ref},
+			},
+		},
+		"AlwaysResolveReference": {
+			reason: "Should resolve a reference to an object in another namespace, even if the current value is non-zero, when the resolve policy is Always",
+			c:      &test.MockClient{MockGet: withEndpoint(client.ObjectKey{Namespace: "other-ns", Name: "cool"})},
+			from:   &fake.Managed{ObjectMeta: metav1.ObjectMeta{Namespace: "cool-ns"}},
+			req:    ObjectResolutionRequest{CurrentValue: "old", Reference: alwaysRef, Extract: FromObjectField("data.endpoint")},
+			want: want{
+				rsp: ObjectResolutionResponse{ResolvedValue: value, ResolvedReference: alwaysRef},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := NewAPINamespacedResolver(tc.c, tc.from)
+
+			got, err := r.ResolveObject(context.Background(), tc.req)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nResolveObject(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.want.rsp, got); diff != "" {
+				t.Errorf("\n%s\nResolveObject(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package remote connects controllers to clusters other than the one they run
+// in, for example to reconcile managed resources stored in a central cluster.
+package remote
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+)
+
+// Error strings.
+const (
+	errGetSecret     = "cannot get kubeconfig secret"
+	errParseConfig   = "cannot parse kubeconfig"
+	errNewCluster    = "cannot create cluster"
+	errFmtMissingKey = "kubeconfig secret has no key %q"
+)
+
+// RESTConfigFromSecret returns a REST config for the cluster described by the
+// kubeconfig stored at the supplied secret key. The kubeconfig may use any
+// authentication method supported by client-go, for example a service
+// account token.
+func RESTConfigFromSecret(ctx context.Context, c client.Reader, sel xpv1.SecretKeySelector) (*rest.Config, error) {
+	s := &corev1.Secret{}
+	if err := c.Get(ctx, types.NamespacedName{Namespace: sel.Namespace, Name: sel.Name}, s); err != nil {
+		return nil, errors.Wrap(err, errGetSecret)
+	}
+
+	kc, ok := s.Data[sel.Key]
+	if !ok {
+		return nil, errors.Errorf(errFmtMissingKey, sel.Key)
+	}
+
+	cfg, err := clientcmd.RESTConfigFromKubeConfig(kc)
+	return cfg, errors.Wrap(err, errParseConfig)
+}
+
+// NewClusterFromSecret returns a cluster described by the kubeconfig stored at
+// the supplied secret key. The returned cluster must be added to the
+// controller's manager, which starts its cache. Supply its client to the
+// managed resource reconciler using managed.WithClient, and watch managed
+// resources using its cache, e.g. source.Kind(cl.GetCache(), ...).
+func NewClusterFromSecret(ctx context.Context, c client.Reader, sel xpv1.SecretKeySelector, o ...cluster.Option) (cluster.Cluster, error) {
+	cfg, err := RESTConfigFromSecret(ctx, c, sel)
+	if err != nil {
+		return nil, err
+	}
+
+	cl, err := cluster.New(cfg, o...)
+	return cl, errors.Wrap(err, errNewCluster)
+}
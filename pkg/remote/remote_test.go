@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package remote
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+)
+
+const kubeconfig = `
+apiVersion: v1
+kind: Config
+clusters:
+- name: remote
+  cluster:
+    server: https://remote.example.org
+contexts:
+- name: remote
+  context:
+    cluster: remote
+    user: remote
+current-context: remote
+users:
+- name: remote
+  user:
+    token: cool-token
+`
+
+func TestRESTConfigFromSecret(t *testing.T) {
+	errBoom := errors.New("boom")
+	sel := xpv1.SecretKeySelector{
+		SecretReference: xpv1.SecretReference{Namespace: "ns", Name: "kubeconfig"},
+		Key:             "kubeconfig",
+	}
+
+	withData := func(d map[string][]byte) test.ObjectFn {
+		return func(obj client.Object) error {
+			obj.(*corev1.Secret).Data = d
+			return nil
+		}
+	}
+
+	type want struct {
+		cfg *rest.Config
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		c      client.Reader
+		want   want
+	}{
+		"GetSecretError": {
+			reason: "We should return any error encountered getting the secret.",
+			c:      &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			want: want{
+				err: errors.Wrap(errBoom, errGetSecret),
+			},
+		},
+		"MissingKey": {
+			reason: "We should return an error if the secret does not contain the key.",
+			c:      &test.MockClient{MockGet: test.NewMockGetFn(nil, withData(nil))},
+			want: want{
+				err: errors.Errorf(errFmtMissingKey, "kubeconfig"),
+			},
+		},
+		"Success": {
+			reason: "We should return a REST config parsed from the kubeconfig.",
+			c:      &test.MockClient{MockGet: test.NewMockGetFn(nil, withData(map[string][]byte{"kubeconfig": []byte(kubeconfig)}))},
+			want: want{
+				cfg: &rest.Config{Host: "https://remote.example.org", BearerToken: "cool-token"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := RESTConfigFromSecret(context.Background(), tc.c, sel)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nRESTConfigFromSecret(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+
+			if tc.want.cfg == nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.want.cfg.Host, got.Host); diff != "" {
+				t.Errorf("\n%s\nRESTConfigFromSecret(...): -want host, +got host:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.want.cfg.BearerToken, got.BearerToken); diff != "" {
+				t.Errorf("\n%s\nRESTConfigFromSecret(...): -want token, +got token:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
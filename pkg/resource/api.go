@@ -141,6 +141,19 @@ func (a *APIUpdatingApplicator) Apply(ctx context.Context, o client.Object, ao .
 type APIFinalizer struct {
 	client    client.Client
 	finalizer string
+	legacy    []string
+}
+
+// An APIFinalizerOption configures an APIFinalizer.
+type APIFinalizerOption func(a *APIFinalizer)
+
+// WithLegacyFinalizers configures an APIFinalizer to migrate resources from the
+// supplied legacy finalizers. Any legacy finalizers are replaced with the
+// APIFinalizer's finalizer when it's added, and removed when it's removed.
+func WithLegacyFinalizers(finalizers ...string) APIFinalizerOption {
+	return func(a *APIFinalizer) {
+		a.legacy = finalizers
+	}
 }
 
 // NewNopFinalizer returns a Finalizer that does nothing.
@@ -157,13 +170,21 @@ func (f nopFinalizer) RemoveFinalizer(_ context.Context, _ Object) error {
 }
 
 // NewAPIFinalizer returns a new APIFinalizer.
-func NewAPIFinalizer(c client.Client, finalizer string) *APIFinalizer {
-	return &APIFinalizer{client: c, finalizer: finalizer}
+func NewAPIFinalizer(c client.Client, finalizer string, o ...APIFinalizerOption) *APIFinalizer {
+	a := &APIFinalizer{client: c, finalizer: finalizer}
+	for _, fn := range o {
+		fn(a)
+	}
+
+	return a
 }
 
-// AddFinalizer to the supplied Managed resource.
+// AddFinalizer to the supplied Managed resource. Any legacy finalizers are
+// replaced in the same update, so the resource is never left without a
+// finalizer.
 func (a *APIFinalizer) AddFinalizer(ctx context.Context, obj Object) error {
-	if meta.FinalizerExists(obj, a.finalizer) {
+	migrated := a.removeLegacy(obj)
+	if meta.FinalizerExists(obj, a.finalizer) && !migrated {
 		return nil
 	}
 
@@ -172,9 +193,11 @@ func (a *APIFinalizer) AddFinalizer(ctx context.Context, obj Object) error {
 	return errors.Wrap(a.client.Update(ctx, obj), errUpdateObject)
 }
 
-// RemoveFinalizer from the supplied Managed resource.
+// RemoveFinalizer from the supplied Managed resource. Any legacy finalizers
+// are removed too.
 func (a *APIFinalizer) RemoveFinalizer(ctx context.Context, obj Object) error {
-	if !meta.FinalizerExists(obj, a.finalizer) {
+	removed := a.removeLegacy(obj)
+	if !meta.FinalizerExists(obj, a.finalizer) && !removed {
 		return nil
 	}
 
@@ -183,6 +206,24 @@ func (a *APIFinalizer) RemoveFinalizer(ctx context.Context, obj Object) error {
 	return errors.Wrap(IgnoreNotFound(a.client.Update(ctx, obj)), errUpdateObject)
 }
 
+// removeLegacy removes any legacy finalizers from the supplied object. It
+// returns true if any were removed.
+func (a *APIFinalizer) removeLegacy(obj Object) bool {
+	removed := false
+
+	for _, f := range a.legacy {
+		if f == a.finalizer || !meta.FinalizerExists(obj, f) {
+			continue
+		}
+
+		meta.RemoveFinalizer(obj, f)
+
+		removed = true
+	}
+
+	return removed
+}
+
 // A FinalizerFns satisfy the Finalizer interface.
 type FinalizerFns struct {
 	AddFinalizerFn    func(ctx context.Context, obj Object) error
@@ -288,6 +288,7 @@ func TestAPIUpdatingApplicator(t *testing.T) {
 
 func TestManagedRemoveFinalizer(t *testing.T) {
 	finalizer := "veryfinal"
+	legacy := "legacyfinal"
 
 	type args struct {
 		ctx context.Context
@@ -303,6 +304,7 @@ func TestManagedRemoveFinalizer(t *testing.T) {
 
 	cases := map[string]struct {
 		client client.Client
+		o      []APIFinalizerOption
 		args   args
 		want   want
 	}{
@@ -328,11 +330,35 @@ func TestManagedRemoveFinalizer(t *testing.T) {
 				obj: &fake.Object{ObjectMeta: metav1.ObjectMeta{Finalizers: []string{}}},
 			},
 		},
+		"NotFinalized": {
+			client: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			o:      []APIFinalizerOption{WithLegacyFinalizers(legacy)},
+			args: args{
+				ctx: context.Background(),
+				obj: &fake.Object{ObjectMeta: metav1.ObjectMeta{Finalizers: []string{"other"}}},
+			},
+			want: want{
+				err: nil,
+				obj: &fake.Object{ObjectMeta: metav1.ObjectMeta{Finalizers: []string{"other"}}},
+			},
+		},
+		"RemoveLegacy": {
+			client: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			o:      []APIFinalizerOption{WithLegacyFinalizers(legacy)},
+			args: args{
+				ctx: context.Background(),
+				obj: &fake.Object{ObjectMeta: metav1.ObjectMeta{Finalizers: []string{legacy}}},
+			},
+			want: want{
+				err: nil,
+				obj: &fake.Object{ObjectMeta: metav1.ObjectMeta{Finalizers: []string{}}},
+			},
+		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			api := NewAPIFinalizer(tc.client, finalizer)
+			api := NewAPIFinalizer(tc.client, finalizer, tc.o...)
 
 			err := api.RemoveFinalizer(tc.args.ctx, tc.args.obj)
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -348,6 +374,7 @@ func TestManagedRemoveFinalizer(t *testing.T) {
 
 func TestAPIFinalizerAdder(t *testing.T) {
 	finalizer := "veryfinal"
+	legacy := "legacyfinal"
 
 	type args struct {
 		ctx context.Context
@@ -363,6 +390,7 @@ func TestAPIFinalizerAdder(t *testing.T) {
 
 	cases := map[string]struct {
 		client client.Client
+		o      []APIFinalizerOption
 		args   args
 		want   want
 	}{
@@ -388,11 +416,47 @@ func TestAPIFinalizerAdder(t *testing.T) {
 				obj: &fake.Object{ObjectMeta: metav1.ObjectMeta{Finalizers: []string{finalizer}}},
 			},
 		},
+		"AlreadyFinalized": {
+			client: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			o:      []APIFinalizerOption{WithLegacyFinalizers(legacy)},
+			args: args{
+				ctx: context.Background(),
+				obj: &fake.Object{ObjectMeta: metav1.ObjectMeta{Finalizers: []string{finalizer}}},
+			},
+			want: want{
+				err: nil,
+				obj: &fake.Object{ObjectMeta: metav1.ObjectMeta{Finalizers: []string{finalizer}}},
+			},
+		},
+		"MigrateLegacy": {
+			client: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			o:      []APIFinalizerOption{WithLegacyFinalizers(legacy)},
+			args: args{
+				ctx: context.Background(),
+				obj: &fake.Object{ObjectMeta: metav1.ObjectMeta{Finalizers: []string{legacy}}},
+			},
+			want: want{
+				err: nil,
+				obj: &fake.Object{ObjectMeta: metav1.ObjectMeta{Finalizers: []string{finalizer}}},
+			},
+		},
+		"RemoveLegacy": {
+			client: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			o:      []APIFinalizerOption{WithLegacyFinalizers(legacy)},
+			args: args{
+				ctx: context.Background(),
+				obj: &fake.Object{ObjectMeta: metav1.ObjectMeta{Finalizers: []string{finalizer, legacy}}},
+			},
+			want: want{
+				err: nil,
+				obj: &fake.Object{ObjectMeta: metav1.ObjectMeta{Finalizers: []string{finalizer}}},
+			},
+		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			api := NewAPIFinalizer(tc.client, finalizer)
+			api := NewAPIFinalizer(tc.client, finalizer, tc.o...)
 
 			err := api.AddFinalizer(tc.args.ctx, tc.args.obj)
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
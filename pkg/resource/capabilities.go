@@ -0,0 +1,181 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+)
+
+// Error strings.
+const (
+	errFmtMarshalCapabilities = "cannot marshal capabilities of %s"
+	errApplyCapabilities      = "cannot apply capabilities ConfigMap"
+)
+
+// Capabilities of a kind of managed resource.
+type Capabilities struct {
+	// ObserveOnly is true if the kind's managed resources can be observed
+	// without being managed, i.e. using only the Observe management policy.
+	ObserveOnly bool `json:"observeOnly"`
+
+	// DeterministicExternalName is true if the external name of the kind's
+	// managed resources is known before they're created, for example because
+	// the external system lets the caller choose it. Managed resources of
+	// kinds with a deterministic external name can be imported by name.
+	DeterministicExternalName bool `json:"deterministicExternalName"`
+
+	// ConnectionDetailsKeys are the keys of the connection details the kind's
+	// managed resources may publish.
+	ConnectionDetailsKeys []string `json:"connectionDetailsKeys,omitempty"`
+
+	// ManagementPolicies the kind supports. The managed resource reconciler's
+	// default supported management policies are used if none are declared.
+	ManagementPolicies []xpv1.ManagementPolicies `json:"managementPolicies,omitempty"`
+}
+
+// A KindRegistry records the capabilities of each kind of managed resource a
+// provider reconciles, so that reconcilers can consult them, and so that they
+// can be exported for UIs and validation. It's safe for concurrent use.
+type KindRegistry struct {
+	mu    sync.RWMutex
+	kinds map[schema.GroupVersionKind]Capabilities
+}
+
+// NewKindRegistry returns an empty registry.
+func NewKindRegistry() *KindRegistry {
+	return &KindRegistry{kinds: make(map[schema.GroupVersionKind]Capabilities)}
+}
+
+// Register the capabilities of the supplied kind of managed resource,
+// replacing any that were previously registered.
+func (r *KindRegistry) Register(gvk schema.GroupVersionKind, c Capabilities) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.kinds[gvk] = c.clone()
+}
+
+// Get the capabilities of the supplied kind of managed resource. It returns
+// false if none were registered.
+func (r *KindRegistry) Get(gvk schema.GroupVersionKind) (Capabilities, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	c, ok := r.kinds[gvk]
+
+	return c.clone(), ok
+}
+
+// All returns the capabilities of every registered kind of managed resource.
+func (r *KindRegistry) All() map[schema.GroupVersionKind]Capabilities {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	out := make(map[schema.GroupVersionKind]Capabilities, len(r.kinds))
+	for gvk, c := range r.kinds {
+		out[gvk] = c.clone()
+	}
+
+	return out
+}
+
+func (c Capabilities) clone() Capabilities {
+	c.ConnectionDetailsKeys = slices.Clone(c.ConnectionDetailsKeys)
+
+	if c.ManagementPolicies != nil {
+		mp := make([]xpv1.ManagementPolicies, len(c.ManagementPolicies))
+		for i := range c.ManagementPolicies {
+			mp[i] = slices.Clone(c.ManagementPolicies[i])
+		}
+
+		c.ManagementPolicies = mp
+	}
+
+	return c
+}
+
+// CapabilitiesConfigMapKey returns the key of the ConfigMap data a
+// ConfigMapCapabilitiesPublisher writes the supplied kind of managed
+// resource's capabilities to, e.g. bucket.v1.s3.example.org.
+func CapabilitiesConfigMapKey(gvk schema.GroupVersionKind) string {
+	return strings.ToLower(gvk.Kind) + "." + gvk.Version + "." + gvk.Group
+}
+
+// A ConfigMapCapabilitiesPublisher publishes the capabilities in a registry as
+// JSON in a well-known ConfigMap, with one data key per kind of managed
+// resource. See CapabilitiesConfigMapKey.
+//
+// It's a controller-runtime Runnable. Add it to a controller manager after
+// registering capabilities, and it publishes them when the manager starts.
+type ConfigMapCapabilitiesPublisher struct {
+	applier  Applicator
+	name     types.NamespacedName
+	registry *KindRegistry
+}
+
+// NewConfigMapCapabilitiesPublisher returns a publisher that writes the
+// capabilities in the supplied registry to the named ConfigMap.
+func NewConfigMapCapabilitiesPublisher(c client.Client, nn types.NamespacedName, reg *KindRegistry) *ConfigMapCapabilitiesPublisher {
+	return &ConfigMapCapabilitiesPublisher{applier: NewAPIPatchingApplicator(c), name: nn, registry: reg}
+}
+
+// Publish the registered capabilities.
+func (p *ConfigMapCapabilitiesPublisher) Publish(ctx context.Context) error {
+	all := p.registry.All()
+
+	data := make(map[string]string, len(all))
+
+	for gvk, c := range all {
+		j, err := json.Marshal(c)
+		if err != nil {
+			return errors.Wrapf(err, errFmtMarshalCapabilities, gvk)
+		}
+
+		data[CapabilitiesConfigMapKey(gvk)] = string(j)
+	}
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: p.name.Namespace, Name: p.name.Name},
+		Data:       data,
+	}
+
+	return errors.Wrap(p.applier.Apply(ctx, cm), errApplyCapabilities)
+}
+
+// Start publishes the registered capabilities. It satisfies
+// controller-runtime's Runnable interface.
+func (p *ConfigMapCapabilitiesPublisher) Start(ctx context.Context) error {
+	return p.Publish(ctx)
+}
+
+// NeedLeaderElection returns true; only the leader publishes capabilities.
+func (p *ConfigMapCapabilitiesPublisher) NeedLeaderElection() bool {
+	return true
+}
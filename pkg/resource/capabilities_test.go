@@ -0,0 +1,147 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+)
+
+var (
+	_ manager.Runnable               = &ConfigMapCapabilitiesPublisher{}
+	_ manager.LeaderElectionRunnable = &ConfigMapCapabilitiesPublisher{}
+)
+
+func TestKindRegistry(t *testing.T) {
+	gvk := schema.GroupVersionKind{Group: "s3.example.org", Version: "v1", Kind: "Bucket"}
+	c := Capabilities{
+		ObserveOnly:           true,
+		ConnectionDetailsKeys: []string{"endpoint"},
+		ManagementPolicies:    []xpv1.ManagementPolicies{{xpv1.ManagementActionAll}},
+	}
+
+	reg := NewKindRegistry()
+	reg.Register(gvk, c)
+
+	// Changing the registered capabilities shouldn't change the registry.
+	c.ConnectionDetailsKeys[0] = "changed"
+
+	got, ok := reg.Get(gvk)
+	if !ok {
+		t.Fatalf("reg.Get(%s): want registered capabilities", gvk)
+	}
+
+	want := Capabilities{
+		ObserveOnly:           true,
+		ConnectionDetailsKeys: []string{"endpoint"},
+		ManagementPolicies:    []xpv1.ManagementPolicies{{xpv1.ManagementActionAll}},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("reg.Get(%s): -want, +got:\n%s", gvk, diff)
+	}
+
+	if diff := cmp.Diff(map[schema.GroupVersionKind]Capabilities{gvk: want}, reg.All()); diff != "" {
+		t.Errorf("reg.All(): -want, +got:\n%s", diff)
+	}
+
+	if _, ok := reg.Get(schema.GroupVersionKind{Group: "example.org", Version: "v1", Kind: "Other"}); ok {
+		t.Errorf("reg.Get(...): want no capabilities for an unregistered kind")
+	}
+}
+
+func TestConfigMapCapabilitiesPublisher(t *testing.T) {
+	errBoom := errors.New("boom")
+	nn := types.NamespacedName{Namespace: "crossplane-system", Name: "capabilities"}
+	gvk := schema.GroupVersionKind{Group: "s3.example.org", Version: "v1", Kind: "Bucket"}
+
+	reg := NewKindRegistry()
+	reg.Register(gvk, Capabilities{
+		ObserveOnly:               true,
+		DeterministicExternalName: true,
+		ConnectionDetailsKeys:     []string{"endpoint"},
+		ManagementPolicies:        []xpv1.ManagementPolicies{{xpv1.ManagementActionAll}, {xpv1.ManagementActionObserve}},
+	})
+
+	type want struct {
+		cm  *corev1.ConfigMap
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		c      client.Client
+		want   want
+	}{
+		"Success": {
+			reason: "We should write the registered capabilities of each kind to the ConfigMap.",
+			want: want{
+				cm: &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Namespace: nn.Namespace, Name: nn.Name},
+					Data: map[string]string{
+						"bucket.v1.s3.example.org": `{"observeOnly":true,"deterministicExternalName":true,"connectionDetailsKeys":["endpoint"],"managementPolicies":[["*"],["Observe"]]}`,
+					},
+				},
+			},
+		},
+		"ApplyError": {
+			reason: "We should return any error encountered applying the ConfigMap.",
+			c:      &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			want: want{
+				err: errors.Wrap(errors.Wrap(errBoom, "cannot get object"), errApplyCapabilities),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got *corev1.ConfigMap
+
+			c := tc.c
+			if c == nil {
+				c = &test.MockClient{
+					MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{Resource: "configmaps"}, nn.Name)),
+					MockCreate: test.NewMockCreateFn(nil, func(obj client.Object) error {
+						got = obj.(*corev1.ConfigMap)
+						return nil
+					}),
+				}
+			}
+
+			err := NewConfigMapCapabilitiesPublisher(c, nn, reg).Start(context.Background())
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nStart(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.want.cm, got); diff != "" {
+				t.Errorf("\n%s\nStart(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+)
+
+// A managed resource, as built by NewManaged, NewModernManaged, or
+// NewLegacyManaged.
+type managed interface {
+	metav1.Object
+	SetConditions(c ...xpv1.Condition)
+	SetManagementPolicies(p xpv1.ManagementPolicies)
+}
+
+// A ManagedOption configures a fake managed resource.
+type ManagedOption func(mg managed)
+
+// NewManaged returns a Managed configured by the supplied options.
+func NewManaged(o ...ManagedOption) *Managed {
+	mg := &Managed{}
+	for _, fn := range o {
+		fn(mg)
+	}
+
+	return mg
+}
+
+// NewModernManaged returns a ModernManaged configured by the supplied options.
+func NewModernManaged(o ...ManagedOption) *ModernManaged {
+	mg := &ModernManaged{}
+	for _, fn := range o {
+		fn(mg)
+	}
+
+	return mg
+}
+
+// NewLegacyManaged returns a LegacyManaged configured by the supplied options.
+func NewLegacyManaged(o ...ManagedOption) *LegacyManaged {
+	mg := &LegacyManaged{}
+	for _, fn := range o {
+		fn(mg)
+	}
+
+	return mg
+}
+
+// WithName sets the managed resource's name.
+func WithName(name string) ManagedOption {
+	return func(mg managed) {
+		mg.SetName(name)
+	}
+}
+
+// WithNamespace sets the managed resource's namespace.
+func WithNamespace(namespace string) ManagedOption {
+	return func(mg managed) {
+		mg.SetNamespace(namespace)
+	}
+}
+
+// WithUID sets the managed resource's UID.
+func WithUID(uid types.UID) ManagedOption {
+	return func(mg managed) {
+		mg.SetUID(uid)
+	}
+}
+
+// WithGeneration sets the managed resource's generation.
+func WithGeneration(g int64) ManagedOption {
+	return func(mg managed) {
+		mg.SetGeneration(g)
+	}
+}
+
+// WithLabels adds the supplied labels to the managed resource.
+func WithLabels(l map[string]string) ManagedOption {
+	return func(mg managed) {
+		meta.AddLabels(mg, l)
+	}
+}
+
+// WithAnnotations adds the supplied annotations to the managed resource.
+func WithAnnotations(a map[string]string) ManagedOption {
+	return func(mg managed) {
+		meta.AddAnnotations(mg, a)
+	}
+}
+
+// WithExternalName sets the managed resource's external name annotation.
+func WithExternalName(name string) ManagedOption {
+	return func(mg managed) {
+		meta.SetExternalName(mg, name)
+	}
+}
+
+// WithFinalizers sets the managed resource's finalizers.
+func WithFinalizers(f ...string) ManagedOption {
+	return func(mg managed) {
+		mg.SetFinalizers(f)
+	}
+}
+
+// WithDeletionTimestamp sets the managed resource's deletion timestamp, so
+// that it appears to have been deleted.
+func WithDeletionTimestamp(t metav1.Time) ManagedOption {
+	return func(mg managed) {
+		mg.SetDeletionTimestamp(&t)
+	}
+}
+
+// WithConditions sets the managed resource's status conditions.
+func WithConditions(c ...xpv1.Condition) ManagedOption {
+	return func(mg managed) {
+		mg.SetConditions(c...)
+	}
+}
+
+// WithManagementPolicies sets the managed resource's management policies.
+func WithManagementPolicies(p ...xpv1.ManagementAction) ManagedOption {
+	return func(mg managed) {
+		mg.SetManagementPolicies(p)
+	}
+}
+
+// WithProviderConfig sets the name of the managed resource's provider config.
+// It sets the provider config kind of a ModernManaged to ProviderConfig, and
+// has no effect on a Managed.
+func WithProviderConfig(name string) ManagedOption {
+	return func(mg managed) {
+		switch m := mg.(type) {
+		case *ModernManaged:
+			m.SetProviderConfigReference(&xpv1.ProviderConfigReference{Kind: "ProviderConfig", Name: name})
+		case *LegacyManaged:
+			m.SetProviderConfigReference(&xpv1.Reference{Name: name})
+		}
+	}
+}
+
+// WithConnectionSecret sets the name of the secret the managed resource
+// writes its connection details to. A LegacyManaged's secret is written to the
+// supplied namespace; a ModernManaged's secret is always written to its own
+// namespace. It has no effect on a Managed.
+func WithConnectionSecret(namespace, name string) ManagedOption {
+	return func(mg managed) {
+		switch m := mg.(type) {
+		case *ModernManaged:
+			m.SetWriteConnectionSecretToReference(&xpv1.LocalSecretReference{Name: name})
+		case *LegacyManaged:
+			m.SetWriteConnectionSecretToReference(&xpv1.SecretReference{Namespace: namespace, Name: name})
+		}
+	}
+}
+
+// WithDeletionPolicy sets a LegacyManaged's deletion policy. It has no effect
+// on a Managed or ModernManaged.
+func WithDeletionPolicy(p xpv1.DeletionPolicy) ManagedOption {
+	return func(mg managed) {
+		if m, ok := mg.(*LegacyManaged); ok {
+			m.SetDeletionPolicy(p)
+		}
+	}
+}
@@ -170,6 +170,33 @@ func (m *Manageable) SetManagementPolicies(p xpv1.ManagementPolicies) { m.Policy
 // GetManagementPolicies gets the ManagementPolicies.
 func (m *Manageable) GetManagementPolicies() xpv1.ManagementPolicies { return m.Policy }
 
+// PollIntervalConfigurator implements the PollIntervalConfigurator interface.
+type PollIntervalConfigurator struct{ Interval *metav1.Duration }
+
+// SetPollInterval sets the PollInterval.
+func (m *PollIntervalConfigurator) SetPollInterval(d *metav1.Duration) { m.Interval = d }
+
+// GetPollInterval gets the PollInterval.
+func (m *PollIntervalConfigurator) GetPollInterval() *metav1.Duration { return m.Interval }
+
+// OperationDetailed implements the OperationDetailed interface.
+type OperationDetailed struct{ Details *xpv1.OperationDetails }
+
+// SetOperationDetails sets the OperationDetails.
+func (m *OperationDetailed) SetOperationDetails(d *xpv1.OperationDetails) { m.Details = d }
+
+// GetOperationDetails gets the OperationDetails.
+func (m *OperationDetailed) GetOperationDetails() *xpv1.OperationDetails { return m.Details }
+
+// ObservedStateHashed implements the ObservedStateHashed interface.
+type ObservedStateHashed struct{ Hash string }
+
+// SetObservedStateHash sets the ObservedStateHash.
+func (m *ObservedStateHashed) SetObservedStateHash(h string) { m.Hash = h }
+
+// GetObservedStateHash gets the ObservedStateHash.
+func (m *ObservedStateHashed) GetObservedStateHash() string { return m.Hash }
+
 // Orphanable implements the Orphanable interface.
 type Orphanable struct{ Policy xpv1.DeletionPolicy }
 
@@ -315,6 +342,19 @@ func (m *UserCounter) GetUsers() int64 {
 	return m.Users
 }
 
+// IdentityReporter is a mock that satisfies IdentityReporter interface.
+type IdentityReporter struct{ Identity map[string]string }
+
+// SetIdentity sets the identity.
+func (m *IdentityReporter) SetIdentity(id map[string]string) {
+	m.Identity = id
+}
+
+// GetIdentity gets the identity.
+func (m *IdentityReporter) GetIdentity() map[string]string {
+	return m.Identity
+}
+
 // Object is a mock that implements Object interface.
 type Object struct {
 	metav1.ObjectMeta
@@ -372,6 +412,9 @@ type ModernManaged struct {
 	TypedProviderConfigReferencer
 	LocalConnectionSecretWriterTo
 	Manageable
+	PollIntervalConfigurator
+	OperationDetailed
+	ObservedStateHashed
 	xpv1.ConditionedStatus
 }
 
@@ -401,6 +444,9 @@ type LegacyManaged struct {
 	ConnectionSecretWriterTo
 	Manageable
 	Orphanable
+	PollIntervalConfigurator
+	OperationDetailed
+	ObservedStateHashed
 	xpv1.ConditionedStatus
 }
 
@@ -655,6 +701,7 @@ type ProviderConfig struct {
 	metav1.ObjectMeta
 
 	UserCounter
+	IdentityReporter
 	xpv1.ConditionedStatus
 }
 
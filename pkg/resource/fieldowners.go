@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	smdpath "sigs.k8s.io/structured-merge-diff/v4/fieldpath"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+)
+
+const errFmtDecodeManagedFields = "cannot decode fields managed by field manager %q"
+
+// FieldOwners returns the field managers that own each field of the supplied
+// object, according to its managed fields. Fields are keyed by their path,
+// for example spec.forProvider.region. Elements of associative lists are
+// identified by their keys, for example spec.forProvider.tags[key="team"].
+// Only fields that have no owned children are included. Fields of the
+// object's subresources, for example its status, aren't included.
+func FieldOwners(o metav1.Object) (map[string][]string, error) {
+	owners := make(map[string][]string)
+
+	for _, mf := range o.GetManagedFields() {
+		if mf.Subresource != "" || mf.FieldsV1 == nil {
+			continue
+		}
+
+		s := &smdpath.Set{}
+		if err := s.FromJSON(bytes.NewReader(mf.FieldsV1.Raw)); err != nil {
+			return nil, errors.Wrapf(err, errFmtDecodeManagedFields, mf.Manager)
+		}
+
+		s.Leaves().Iterate(func(p smdpath.Path) {
+			k := strings.TrimPrefix(p.String(), ".")
+			if !slices.Contains(owners[k], mf.Manager) {
+				owners[k] = append(owners[k], mf.Manager)
+			}
+		})
+	}
+
+	for k := range owners {
+		slices.Sort(owners[k])
+	}
+
+	return owners, nil
+}
+
+// A FieldOwnership reports which fields of an object are owned by a field
+// manager, for example a provider, and which are owned by other managers,
+// for example users.
+type FieldOwnership struct {
+	// Owned fields are owned only by the field manager.
+	Owned []string
+
+	// Shared fields are owned by the field manager and by other managers.
+	// Each field is mapped to the other managers that own it. The field
+	// manager and the other managers each set the field, so an update by one
+	// may be reverting edits made by another.
+	Shared map[string][]string
+
+	// Others are fields that are owned only by other managers. Each field is
+	// mapped to the managers that own it.
+	Others map[string][]string
+}
+
+// FieldOwnershipOf returns which fields of the supplied object are owned by
+// the supplied field manager, and which are owned by other managers. Only
+// fields whose path starts with the supplied prefix, for example spec, are
+// included. See FieldOwners.
+func FieldOwnershipOf(o metav1.Object, manager, prefix string) (FieldOwnership, error) {
+	owners, err := FieldOwners(o)
+	if err != nil {
+		return FieldOwnership{}, err
+	}
+
+	fo := FieldOwnership{Shared: map[string][]string{}, Others: map[string][]string{}}
+
+	for path, managers := range owners {
+		if !strings.HasPrefix(path, prefix) {
+			continue
+		}
+
+		others := slices.DeleteFunc(slices.Clone(managers), func(m string) bool { return m == manager })
+
+		switch {
+		case len(others) == len(managers):
+			fo.Others[path] = others
+		case len(others) > 0:
+			fo.Shared[path] = others
+		default:
+			fo.Owned = append(fo.Owned, path)
+		}
+	}
+
+	slices.Sort(fo.Owned)
+
+	return fo, nil
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+)
+
+func TestFieldOwnershipOf(t *testing.T) {
+	provider := metav1.ManagedFieldsEntry{
+		Manager:   "provider-example",
+		Operation: metav1.ManagedFieldsOperationUpdate,
+		FieldsV1: &metav1.FieldsV1{Raw: []byte(`{
+			"f:metadata":{"f:annotations":{".":{},"f:crossplane.io/external-name":{}}},
+			"f:spec":{"f:forProvider":{"f:region":{},"f:size":{},"f:tags":{"k:{\"key\":\"team\"}":{".":{},"f:key":{},"f:value":{}}}}}
+		}`)},
+	}
+	user := metav1.ManagedFieldsEntry{
+		Manager:   "kubectl-edit",
+		Operation: metav1.ManagedFieldsOperationUpdate,
+		FieldsV1:  &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:forProvider":{"f:size":{},"f:name":{}}}}`)},
+	}
+	status := metav1.ManagedFieldsEntry{
+		Manager:     "provider-example",
+		Operation:   metav1.ManagedFieldsOperationUpdate,
+		Subresource: "status",
+		FieldsV1:    &metav1.FieldsV1{Raw: []byte(`{"f:status":{"f:atProvider":{"f:id":{}}}}`)},
+	}
+	invalid := metav1.ManagedFieldsEntry{
+		Manager:  "broken",
+		FieldsV1: &metav1.FieldsV1{Raw: []byte(`{`)},
+	}
+
+	type args struct {
+		mf      []metav1.ManagedFieldsEntry
+		manager string
+		prefix  string
+	}
+
+	type want struct {
+		fo  FieldOwnership
+		err bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"Ownership": {
+			reason: "Spec fields should be reported as owned by the manager, shared with other managers, or owned by other managers.",
+			args: args{
+				mf:      []metav1.ManagedFieldsEntry{provider, user, status},
+				manager: "provider-example",
+				prefix:  "spec.",
+			},
+			want: want{
+				fo: FieldOwnership{
+					Owned: []string{
+						"spec.forProvider.region",
+						`spec.forProvider.tags[key="team"].key`,
+						`spec.forProvider.tags[key="team"].value`,
+					},
+					Shared: map[string][]string{"spec.forProvider.size": {"kubectl-edit"}},
+					Others: map[string][]string{"spec.forProvider.name": {"kubectl-edit"}},
+				},
+			},
+		},
+		"NoManagedFields": {
+			reason: "An object without managed fields has no owned fields.",
+			args: args{
+				manager: "provider-example",
+				prefix:  "spec.",
+			},
+			want: want{
+				fo: FieldOwnership{Shared: map[string][]string{}, Others: map[string][]string{}},
+			},
+		},
+		"InvalidManagedFields": {
+			reason: "We should return an error if managed fields can't be decoded.",
+			args: args{
+				mf:      []metav1.ManagedFieldsEntry{invalid},
+				manager: "provider-example",
+			},
+			want: want{
+				err: true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg := &fake.ModernManaged{ObjectMeta: metav1.ObjectMeta{ManagedFields: tc.args.mf}}
+
+			got, err := FieldOwnershipOf(mg, tc.args.manager, tc.args.prefix)
+			if (err != nil) != tc.want.err {
+				t.Errorf("\n%s\nFieldOwnershipOf(...): want error %t, got %v", tc.reason, tc.want.err, err)
+			}
+
+			if diff := cmp.Diff(tc.want.fo, got); diff != "" {
+				t.Errorf("\n%s\nFieldOwnershipOf(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
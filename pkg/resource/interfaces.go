@@ -18,6 +18,7 @@ package resource
 
 import (
 	"context"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -35,6 +36,20 @@ type Conditioned interface {
 	GetCondition(ct xpv1.ConditionType) xpv1.Condition
 }
 
+// An OperationDetailed may record details of the most recent successful
+// operation on its external resource.
+type OperationDetailed interface {
+	SetOperationDetails(d *xpv1.OperationDetails)
+	GetOperationDetails() *xpv1.OperationDetails
+}
+
+// An ObservedStateHashed may record a hash of the most recently observed state
+// of its external resource.
+type ObservedStateHashed interface {
+	SetObservedStateHash(h string)
+	GetObservedStateHash() string
+}
+
 // A ClaimReferencer may reference a resource claim.
 type ClaimReferencer interface {
 	SetClaimReference(r *reference.Claim)
@@ -176,6 +191,12 @@ type UserCounter interface {
 	GetUsers() int64
 }
 
+// An IdentityReporter can report the identity its credentials authenticate as.
+type IdentityReporter interface {
+	SetIdentity(id map[string]string)
+	GetIdentity() map[string]string
+}
+
 // A ConnectionDetailsPublishedTimer can record the last time its connection
 // details were published.
 type ConnectionDetailsPublishedTimer interface {
@@ -189,6 +210,22 @@ type ReconciliationObserver interface {
 	GetObservedGeneration() int64
 }
 
+// A PollIntervalHinter may suggest how often it should be polled. Kinds
+// whose external resources change slowly (or quickly) may use this to
+// suggest a longer (or shorter) poll interval than the reconciler's default.
+type PollIntervalHinter interface {
+	// GetPollIntervalHint returns the suggested poll interval. A zero or
+	// negative duration means there is no suggestion.
+	GetPollIntervalHint() time.Duration
+}
+
+// A PollIntervalConfigurator may specify how often it should be polled,
+// overriding the reconciler's default.
+type PollIntervalConfigurator interface {
+	GetPollInterval() *metav1.Duration
+	SetPollInterval(d *metav1.Duration)
+}
+
 // An Object is a Kubernetes object.
 type Object interface {
 	metav1.Object
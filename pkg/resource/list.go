@@ -0,0 +1,97 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"iter"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+)
+
+// DefaultPageSize is the number of managed resources ListManaged requests per
+// page by default.
+const DefaultPageSize = 500
+
+const errListManaged = "cannot list managed resources"
+
+// A ListManagedOption configures ListManaged.
+type ListManagedOption func(o *listManagedOptions)
+
+type listManagedOptions struct {
+	pageSize int64
+	list     []client.ListOption
+}
+
+// WithPageSize configures how many managed resources ListManaged requests per
+// page. It's DefaultPageSize by default.
+func WithPageSize(n int64) ListManagedOption {
+	return func(o *listManagedOptions) {
+		o.pageSize = n
+	}
+}
+
+// WithListOptions configures the options ListManaged passes to each List
+// call, for example to select managed resources by label or namespace.
+func WithListOptions(lo ...client.ListOption) ListManagedOption {
+	return func(o *listManagedOptions) {
+		o.list = append(o.list, lo...)
+	}
+}
+
+// ListManaged returns an iterator over the managed resources of the supplied
+// list kind. It lists them one page at a time using continue tokens, so that
+// very large numbers of managed resources can be scanned without requesting
+// them all at once. If listing a page fails the iterator yields the error and
+// stops. Each page is listed into a new copy of the supplied list.
+func ListManaged(ctx context.Context, c client.Reader, of ManagedList, o ...ListManagedOption) iter.Seq2[Managed, error] {
+	opts := &listManagedOptions{pageSize: DefaultPageSize}
+	for _, fn := range o {
+		fn(opts)
+	}
+
+	return func(yield func(Managed, error) bool) {
+		token := ""
+
+		for {
+			//nolint:forcetypeassert // Will always be a managed resource list.
+			l := of.DeepCopyObject().(ManagedList)
+
+			lo := make([]client.ListOption, 0, len(opts.list)+2)
+			lo = append(lo, opts.list...)
+			lo = append(lo, client.Limit(opts.pageSize), client.Continue(token))
+
+			if err := c.List(ctx, l, lo...); err != nil {
+				yield(nil, errors.Wrap(err, errListManaged))
+				return
+			}
+
+			for _, mg := range l.GetItems() {
+				if !yield(mg, nil) {
+					return
+				}
+			}
+
+			token = l.GetContinue()
+			if token == "" {
+				return
+			}
+		}
+	}
+}
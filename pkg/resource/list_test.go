@@ -0,0 +1,180 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+)
+
+type mgList struct {
+	metav1.TypeMeta
+	metav1.ListMeta
+
+	Items []Managed
+}
+
+func (l *mgList) DeepCopyObject() runtime.Object {
+	return &mgList{TypeMeta: l.TypeMeta, ListMeta: *l.ListMeta.DeepCopy(), Items: append([]Managed{}, l.Items...)}
+}
+
+func (l *mgList) GetItems() []Managed {
+	return l.Items
+}
+
+func TestListManaged(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type page struct {
+		names []string
+		next  string
+	}
+
+	// pages maps a continue token to the page it returns.
+	pages := map[string]page{
+		"":   {names: []string{"a", "b"}, next: "p2"},
+		"p2": {names: []string{"c", "d"}, next: "p3"},
+		"p3": {names: []string{"e"}},
+	}
+
+	list := func(err error, limits *[]int64) test.MockListFn {
+		return func(_ context.Context, obj client.ObjectList, opts ...client.ListOption) error {
+			lo := &client.ListOptions{}
+			lo.ApplyOptions(opts)
+
+			*limits = append(*limits, lo.Limit)
+
+			if err != nil && lo.Continue != "" {
+				return err
+			}
+
+			p := pages[lo.Continue]
+			l := obj.(*mgList)
+			l.SetContinue(p.next)
+
+			for _, n := range p.names {
+				l.Items = append(l.Items, &fake.ModernManaged{ObjectMeta: metav1.ObjectMeta{Name: n, Namespace: lo.Namespace}})
+			}
+
+			return nil
+		}
+	}
+
+	type args struct {
+		err   error
+		opts  []ListManagedOption
+		limit int
+	}
+
+	type want struct {
+		names  []string
+		limits []int64
+		err    error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"AllPages": {
+			reason: "We should yield the managed resources of every page.",
+			args: args{
+				opts: []ListManagedOption{WithPageSize(2)},
+			},
+			want: want{
+				names:  []string{"a", "b", "c", "d", "e"},
+				limits: []int64{2, 2, 2},
+			},
+		},
+		"DefaultPageSize": {
+			reason: "We should request DefaultPageSize managed resources per page by default.",
+			want: want{
+				names:  []string{"a", "b", "c", "d", "e"},
+				limits: []int64{DefaultPageSize, DefaultPageSize, DefaultPageSize},
+			},
+		},
+		"StopEarly": {
+			reason: "We shouldn't list more pages once the caller stops iterating.",
+			args: args{
+				opts:  []ListManagedOption{WithPageSize(2)},
+				limit: 2,
+			},
+			want: want{
+				names:  []string{"a", "b"},
+				limits: []int64{2},
+			},
+		},
+		"ListError": {
+			reason: "We should yield any error listing a page, and stop.",
+			args: args{
+				err:  errBoom,
+				opts: []ListManagedOption{WithPageSize(2)},
+			},
+			want: want{
+				names:  []string{"a", "b"},
+				limits: []int64{2, 2},
+				err:    errors.Wrap(errBoom, errListManaged),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			limits := []int64{}
+			c := &test.MockClient{MockList: list(tc.args.err, &limits)}
+
+			var (
+				names []string
+				err   error
+			)
+
+			for mg, lerr := range ListManaged(context.Background(), c, &mgList{}, tc.args.opts...) {
+				if lerr != nil {
+					err = lerr
+					break
+				}
+
+				names = append(names, mg.GetName())
+				if len(names) == tc.args.limit {
+					break
+				}
+			}
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nListManaged(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.want.names, names); diff != "" {
+				t.Errorf("\n%s\nListManaged(...): -want names, +got names:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.want.limits, limits); diff != "" {
+				t.Errorf("\n%s\nListManaged(...): -want page sizes, +got page sizes:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package resource
 
 import (
+	"strings"
+
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
@@ -42,13 +44,50 @@ func NewPredicates(fn PredicateFn) predicate.Funcs {
 	}
 }
 
+// A DesiredStateChangedOption configures the DesiredStateChanged predicate.
+type DesiredStateChangedOption func(o *desiredStateChangedOptions)
+
+type desiredStateChangedOptions struct {
+	annotationPrefixes []string
+	labelPrefixes      []string
+}
+
+// WithIgnoredAnnotationPrefixes configures the DesiredStateChanged predicate
+// to ignore changes to annotations whose keys start with any of the supplied
+// prefixes, for example kubectl.kubernetes.io/ or a provider's own
+// bookkeeping annotations.
+func WithIgnoredAnnotationPrefixes(p ...string) DesiredStateChangedOption {
+	return func(o *desiredStateChangedOptions) {
+		o.annotationPrefixes = append(o.annotationPrefixes, p...)
+	}
+}
+
+// WithIgnoredLabelPrefixes configures the DesiredStateChanged predicate to
+// ignore changes to labels whose keys start with any of the supplied
+// prefixes.
+func WithIgnoredLabelPrefixes(p ...string) DesiredStateChangedOption {
+	return func(o *desiredStateChangedOptions) {
+		o.labelPrefixes = append(o.labelPrefixes, p...)
+	}
+}
+
 // DesiredStateChanged accepts objects that have changed their desired state, i.e.
 // the state that is not managed by the controller.
 // To be more specific, it accepts update events that have changes in one of the followings:
 // - `metadata.annotations` (except for certain annotations)
 // - `metadata.labels`
 // - `spec`.
-func DesiredStateChanged() predicate.Predicate {
+func DesiredStateChanged(o ...DesiredStateChangedOption) predicate.Predicate {
+	opts := &desiredStateChangedOptions{}
+	for _, fn := range o {
+		fn(opts)
+	}
+
+	var labels predicate.Predicate = predicate.LabelChangedPredicate{}
+	if len(opts.labelPrefixes) > 0 {
+		labels = LabelChangedPredicate{ignoredPrefixes: opts.labelPrefixes}
+	}
+
 	return predicate.Or(
 		AnnotationChangedPredicate{
 			ignored: []string{
@@ -58,9 +97,15 @@ func DesiredStateChanged() predicate.Predicate {
 				// updating these annotations.
 				meta.AnnotationKeyExternalCreateFailed,
 				meta.AnnotationKeyExternalCreatePending,
+				meta.AnnotationKeyLastCreateTime,
+				meta.AnnotationKeyLastUpdateTime,
+				meta.AnnotationKeyLastObservedTime,
+				meta.AnnotationKeyLastOperationError,
+				meta.AnnotationKeyConsecutiveDriftUpdates,
 			},
+			ignoredPrefixes: opts.annotationPrefixes,
 		},
-		predicate.LabelChangedPredicate{},
+		labels,
 		predicate.GenerationChangedPredicate{},
 	)
 }
@@ -73,7 +118,64 @@ func DesiredStateChanged() predicate.Predicate {
 type AnnotationChangedPredicate struct {
 	predicate.Funcs
 
-	ignored []string
+	ignored         []string
+	ignoredPrefixes []string
+}
+
+// LabelChangedPredicate implements a default update predicate function on
+// label change by ignoring labels with the given key prefixes, if any.
+//
+// This predicate extends controller-runtime's LabelChangedPredicate by being
+// able to ignore certain labels.
+type LabelChangedPredicate struct {
+	predicate.Funcs
+
+	ignoredPrefixes []string
+}
+
+// Update implements default UpdateEvent filter for validating label change.
+func (l LabelChangedPredicate) Update(e event.UpdateEvent) bool {
+	if e.ObjectOld == nil || e.ObjectNew == nil {
+		return false
+	}
+
+	return changedIgnoringPrefixes(e.ObjectOld.GetLabels(), e.ObjectNew.GetLabels(), l.ignoredPrefixes)
+}
+
+// changedIgnoringPrefixes returns true if the supplied maps differ, ignoring
+// any keys that start with one of the supplied prefixes.
+func changedIgnoringPrefixes(oldm, newm map[string]string, prefixes []string) bool {
+	ignored := func(k string) bool {
+		for _, p := range prefixes {
+			if strings.HasPrefix(k, p) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	n := 0
+
+	for k, v := range newm {
+		if ignored(k) {
+			continue
+		}
+
+		if ov, ok := oldm[k]; !ok || ov != v {
+			return true
+		}
+
+		n++
+	}
+
+	for k := range oldm {
+		if !ignored(k) {
+			n--
+		}
+	}
+
+	return n != 0
 }
 
 func copyAnnotations(an map[string]string) map[string]string {
@@ -107,18 +209,5 @@ func (a AnnotationChangedPredicate) Update(e event.UpdateEvent) bool {
 
 	// Below is the same as controller-runtime's AnnotationChangedPredicate
 	// implementation but optimized to avoid using reflect.DeepEqual.
-	if len(na) != len(oa) {
-		// annotation length changed
-		return true
-	}
-
-	for k, v := range na {
-		if oa[k] != v {
-			// annotation value changed
-			return true
-		}
-	}
-
-	// annotations unchanged.
-	return false
+	return changedIgnoringPrefixes(oa, na, a.ignoredPrefixes)
 }
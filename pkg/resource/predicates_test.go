@@ -31,8 +31,9 @@ import (
 
 func TestDesiredStateChanged(t *testing.T) {
 	type args struct {
-		old client.Object
-		new client.Object
+		old  client.Object
+		new  client.Object
+		opts []DesiredStateChangedOption
 	}
 
 	type want struct {
@@ -122,6 +123,77 @@ func TestDesiredStateChanged(t *testing.T) {
 				desiredStateChanged: true,
 			},
 		},
+		"IgnoredAnnotationPrefixChanged": {
+			args: args{
+				old: func() client.Object {
+					mg := &fake.Managed{}
+					mg.SetAnnotations(map[string]string{"kubectl.kubernetes.io/last-applied-configuration": "{}"})
+					return mg
+				}(),
+				new: func() client.Object {
+					mg := &fake.Managed{}
+					mg.SetAnnotations(map[string]string{"kubectl.kubernetes.io/last-applied-configuration": `{"spec":{}}`})
+					return mg
+				}(),
+				opts: []DesiredStateChangedOption{WithIgnoredAnnotationPrefixes("kubectl.kubernetes.io/")},
+			},
+			want: want{
+				desiredStateChanged: false,
+			},
+		},
+		"UnignoredAnnotationChanged": {
+			args: args{
+				old: func() client.Object {
+					mg := &fake.Managed{}
+					mg.SetAnnotations(map[string]string{"foo": "bar"})
+					return mg
+				}(),
+				new: func() client.Object {
+					mg := &fake.Managed{}
+					mg.SetAnnotations(map[string]string{"kubectl.kubernetes.io/last-applied-configuration": "{}"})
+					return mg
+				}(),
+				opts: []DesiredStateChangedOption{WithIgnoredAnnotationPrefixes("kubectl.kubernetes.io/")},
+			},
+			want: want{
+				desiredStateChanged: true,
+			},
+		},
+		"IgnoredLabelPrefixChanged": {
+			args: args{
+				old: func() client.Object {
+					mg := &fake.Managed{}
+					mg.SetLabels(map[string]string{"internal.example.org/revision": "1"})
+					return mg
+				}(),
+				new: func() client.Object {
+					mg := &fake.Managed{}
+					mg.SetLabels(map[string]string{"internal.example.org/revision": "2"})
+					return mg
+				}(),
+				opts: []DesiredStateChangedOption{WithIgnoredLabelPrefixes("internal.example.org/")},
+			},
+			want: want{
+				desiredStateChanged: false,
+			},
+		},
+		"UnignoredLabelRemoved": {
+			args: args{
+				old: func() client.Object {
+					mg := &fake.Managed{}
+					mg.SetLabels(map[string]string{"foo": "bar"})
+					return mg
+				}(),
+				new: func() client.Object {
+					mg := &fake.Managed{}
+					return mg
+				}(),
+				opts: []DesiredStateChangedOption{WithIgnoredLabelPrefixes("internal.example.org/")},
+			},
+			want: want{
+				desiredStateChanged: true,
+			},
+		},
 		// This happens when spec is changed.
 		"GenerationChanged": {
 			args: args{
@@ -143,7 +215,7 @@ func TestDesiredStateChanged(t *testing.T) {
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			got := DesiredStateChanged().Update(event.UpdateEvent{
+			got := DesiredStateChanged(tc.opts...).Update(event.UpdateEvent{
 				ObjectOld: tc.old,
 				ObjectNew: tc.new,
 			})
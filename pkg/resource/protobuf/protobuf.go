@@ -0,0 +1,118 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package protobuf converts resources to and from the protobuf structs used to
+// represent them in gRPC APIs, such as the composition function protocol.
+//
+// A protobuf struct represents every number as a float64. Converting a
+// resource from a struct restores integers the way the Kubernetes API
+// machinery does when it decodes JSON, so a resource survives a round trip
+// unchanged. Integers with a magnitude larger than 2^53 can't be represented
+// exactly by a float64, and may not survive a round trip.
+package protobuf
+
+import (
+	"encoding/json"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+	kunstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	kjson "k8s.io/apimachinery/pkg/util/json"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/unstructured"
+)
+
+// Error strings.
+const (
+	errMarshalStruct   = "cannot marshal protobuf struct to JSON"
+	errUnmarshalObject = "cannot unmarshal JSON to object"
+	errFmtAsStruct     = "cannot convert resource %q to a protobuf struct"
+	errFmtFromStruct   = "cannot convert protobuf struct %q to a resource"
+)
+
+// AsStruct converts the supplied resource to a protobuf struct.
+func AsStruct(o runtime.Object) (*structpb.Struct, error) {
+	return resource.AsProtobufStruct(o)
+}
+
+// FromStruct converts the supplied protobuf struct to the supplied resource.
+// The resource may be typed, or unstructured. Any existing content of an
+// unstructured resource is replaced.
+func FromStruct(s *structpb.Struct, o runtime.Object) error {
+	b, err := protojson.Marshal(s)
+	if err != nil {
+		return errors.Wrap(err, errMarshalStruct)
+	}
+
+	switch u := o.(type) {
+	case *kunstructured.Unstructured:
+		return errors.Wrap(unmarshalUnstructured(b, u), errUnmarshalObject)
+	case unstructured.Wrapper:
+		return errors.Wrap(unmarshalUnstructured(b, u.GetUnstructured()), errUnmarshalObject)
+	}
+
+	return errors.Wrap(json.Unmarshal(b, o), errUnmarshalObject)
+}
+
+// unmarshalUnstructured unmarshals the supplied JSON into the supplied
+// unstructured object, converting whole numbers to int64.
+func unmarshalUnstructured(b []byte, u *kunstructured.Unstructured) error {
+	obj := map[string]any{}
+	if err := kjson.Unmarshal(b, &obj); err != nil {
+		return err
+	}
+
+	u.Object = obj
+
+	return nil
+}
+
+// AsStructs converts the supplied resources, keyed by name, to protobuf
+// structs.
+func AsStructs[T runtime.Object](rs map[string]T) (map[string]*structpb.Struct, error) {
+	out := make(map[string]*structpb.Struct, len(rs))
+
+	for name, o := range rs {
+		s, err := AsStruct(o)
+		if err != nil {
+			return nil, errors.Wrapf(err, errFmtAsStruct, name)
+		}
+
+		out[name] = s
+	}
+
+	return out, nil
+}
+
+// FromStructs converts the supplied protobuf structs, keyed by name, to
+// unstructured resources.
+func FromStructs(ss map[string]*structpb.Struct) (map[string]*kunstructured.Unstructured, error) {
+	out := make(map[string]*kunstructured.Unstructured, len(ss))
+
+	for name, s := range ss {
+		u := &kunstructured.Unstructured{}
+		if err := FromStruct(s, u); err != nil {
+			return nil, errors.Wrapf(err, errFmtFromStruct, name)
+		}
+
+		out[name] = u
+	}
+
+	return out, nil
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package protobuf
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/protobuf/types/known/structpb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kunstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/unstructured/composite"
+)
+
+func TestRoundTrip(t *testing.T) {
+	xr := composite.New()
+	xr.SetAPIVersion("example.org/v1")
+	xr.SetKind("XDatabase")
+	xr.SetName("cool")
+	xr.Object["spec"] = map[string]any{"replicas": int64(3)}
+
+	cases := map[string]struct {
+		reason string
+		in     runtime.Object
+		out    runtime.Object
+	}{
+		"Unstructured": {
+			reason: "Unstructured content, including integers, floats, and nested lists, should survive a round trip.",
+			in: &kunstructured.Unstructured{Object: map[string]any{
+				"apiVersion": "example.org/v1",
+				"kind":       "Bucket",
+				"metadata": map[string]any{
+					"name":       "cool",
+					"generation": int64(42),
+					"labels":     map[string]any{"cool": "very"},
+				},
+				"spec": map[string]any{
+					"forProvider": map[string]any{
+						"region":        "us-east-1",
+						"versioned":     true,
+						"retentionDays": int64(30),
+						"ratio":         0.5,
+						"maxSize":       int64(1) << 52,
+						"negative":      int64(-7),
+						"empty":         nil,
+						"rules": []any{
+							map[string]any{"prefix": "logs/", "days": int64(7)},
+							[]any{"a", int64(1), 1.5, false},
+						},
+					},
+				},
+			}},
+			out: &kunstructured.Unstructured{},
+		},
+		"UnstructuredWrapper": {
+			reason: "A type that wraps unstructured content should survive a round trip.",
+			in:     xr,
+			out:    composite.New(),
+		},
+		"Typed": {
+			reason: "A typed resource should survive a round trip.",
+			in: &fake.ModernManaged{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "cool",
+					Namespace:   "default",
+					Generation:  42,
+					Labels:      map[string]string{"cool": "very"},
+					Annotations: map[string]string{"crossplane.io/external-name": "cooler"},
+				},
+			},
+			out: &fake.ModernManaged{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s, err := AsStruct(tc.in)
+			if err != nil {
+				t.Fatalf("\n%s\nAsStruct(...): %v", tc.reason, err)
+			}
+
+			if err := FromStruct(s, tc.out); err != nil {
+				t.Fatalf("\n%s\nFromStruct(...): %v", tc.reason, err)
+			}
+
+			if diff := cmp.Diff(tc.in, tc.out, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("\n%s\nFromStruct(AsStruct(...)): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestFromStruct(t *testing.T) {
+	type want struct {
+		o   runtime.Object
+		err bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		s      map[string]any
+		o      runtime.Object
+		want   want
+	}{
+		"WholeNumbersAsIntegers": {
+			reason: "Whole numbers, which a protobuf struct represents as floats, should be converted to int64.",
+			s:      map[string]any{"spec": map[string]any{"replicas": 3.0, "ratio": 0.25}},
+			o:      &kunstructured.Unstructured{},
+			want: want{
+				o: &kunstructured.Unstructured{Object: map[string]any{"spec": map[string]any{"replicas": int64(3), "ratio": 0.25}}},
+			},
+		},
+		"ReplaceUnstructuredContent": {
+			reason: "Existing unstructured content should be replaced, not merged.",
+			s:      map[string]any{"spec": map[string]any{"cool": true}},
+			o:      &kunstructured.Unstructured{Object: map[string]any{"status": map[string]any{"ready": true}}},
+			want: want{
+				o: &kunstructured.Unstructured{Object: map[string]any{"spec": map[string]any{"cool": true}}},
+			},
+		},
+		"TypedMismatch": {
+			reason: "An error should be returned if the struct can't be unmarshalled to the typed resource.",
+			s:      map[string]any{"name": 42.0},
+			o:      &fake.ModernManaged{},
+			want: want{
+				o:   &fake.ModernManaged{},
+				err: true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s, err := structpb.NewStruct(tc.s)
+			if err != nil {
+				t.Fatalf("structpb.NewStruct(...): %v", err)
+			}
+
+			err = FromStruct(s, tc.o)
+			if (err != nil) != tc.want.err {
+				t.Errorf("\n%s\nFromStruct(...): want error %t, got %v", tc.reason, tc.want.err, err)
+			}
+
+			if diff := cmp.Diff(tc.want.o, tc.o, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("\n%s\nFromStruct(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestStructs(t *testing.T) {
+	rs := map[string]*kunstructured.Unstructured{
+		"a": {Object: map[string]any{"apiVersion": "example.org/v1", "kind": "A", "spec": map[string]any{"count": int64(1)}}},
+		"b": {Object: map[string]any{"apiVersion": "example.org/v1", "kind": "B", "spec": map[string]any{"items": []any{"x", int64(2)}}}},
+	}
+
+	ss, err := AsStructs(rs)
+	if err != nil {
+		t.Fatalf("AsStructs(...): %v", err)
+	}
+
+	got, err := FromStructs(ss)
+	if err != nil {
+		t.Fatalf("FromStructs(...): %v", err)
+	}
+
+	if diff := cmp.Diff(rs, got); diff != "" {
+		t.Errorf("FromStructs(AsStructs(...)): -want, +got:\n%s", diff)
+	}
+}
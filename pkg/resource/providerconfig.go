@@ -19,9 +19,11 @@ package resource
 import (
 	"context"
 	"os"
+	"strings"
 
 	"github.com/spf13/afero"
 	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -41,6 +43,11 @@ const (
 	errMissingPCRef          = "managed resource does not reference a ProviderConfig"
 	errMissingPCRefKind      = "managed resource ProviderConfig reference has no Kind"
 	errApplyPCU              = "cannot apply ProviderConfigUsage"
+	errListPCUs              = "cannot list ProviderConfigUsages"
+	errFmtUnknownPCKind      = "managed resource references unknown ProviderConfig kind %q"
+	errFmtGetPC              = "cannot get %s %q"
+	errFmtPCNotFound         = "cannot find %s %q in %s"
+	errFmtNoPCNamespace      = "cannot resolve %s %q for a cluster scoped managed resource without a shared namespace"
 )
 
 type missingRefError struct{ error }
@@ -78,12 +85,19 @@ func ExtractFs(_ context.Context, fs afero.Fs, s xpv1.CommonCredentialSelectors)
 	return afero.ReadFile(fs, s.Fs.Path)
 }
 
-// ExtractSecret extracts credentials from a Kubernetes secret.
+// ExtractSecret extracts credentials from a Kubernetes secret. If the secret
+// key selector is optional or has a default it's resolved using
+// ResolveSecretKey.
 func ExtractSecret(ctx context.Context, client client.Client, s xpv1.CommonCredentialSelectors) ([]byte, error) {
 	if s.SecretRef == nil {
 		return nil, errors.New(errExtractSecretKey)
 	}
 
+	if s.SecretRef.Optional != nil || s.SecretRef.Default != nil {
+		b, err := ResolveSecretKey(ctx, client, *s.SecretRef)
+		return b, errors.Wrap(err, errGetCredentialsSecret)
+	}
+
 	secret := &corev1.Secret{}
 	if err := client.Get(ctx, types.NamespacedName{Namespace: s.SecretRef.Namespace, Name: s.SecretRef.Name}, secret); err != nil {
 		return nil, errors.Wrap(err, errGetCredentialsSecret)
@@ -228,3 +242,255 @@ func (u *LegacyProviderConfigUsageTracker) Track(ctx context.Context, mg LegacyM
 
 	return errors.Wrap(Ignore(IsNotAllowed, err), errApplyPCU)
 }
+
+// A ProviderConfigUsageLister lists the usages of a ProviderConfig.
+type ProviderConfigUsageLister struct {
+	client client.Reader
+	of     ProviderConfigUsageList
+	legacy bool
+}
+
+// NewProviderConfigUsageLister returns a ProviderConfigUsageLister that lists
+// usages of the supplied list kind. Usages are matched by the name and kind of
+// the ProviderConfig they use.
+func NewProviderConfigUsageLister(c client.Reader, of ProviderConfigUsageList) *ProviderConfigUsageLister {
+	return &ProviderConfigUsageLister{client: c, of: of}
+}
+
+// NewLegacyProviderConfigUsageLister returns a ProviderConfigUsageLister that
+// lists legacy usages of the supplied list kind. Legacy usages are matched only
+// by the name of the ProviderConfig they use.
+func NewLegacyProviderConfigUsageLister(c client.Reader, of ProviderConfigUsageList) *ProviderConfigUsageLister {
+	return &ProviderConfigUsageLister{client: c, of: of, legacy: true}
+}
+
+// List the usages of the supplied ProviderConfig.
+func (l *ProviderConfigUsageLister) List(ctx context.Context, pc ProviderConfig) ([]ProviderConfigUsage, error) {
+	//nolint:forcetypeassert // Will always be a PCU list.
+	pcul := l.of.DeepCopyObject().(ProviderConfigUsageList)
+
+	labels := client.MatchingLabels{xpv1.LabelKeyProviderName: pc.GetName()}
+	if !l.legacy {
+		labels[xpv1.LabelKeyProviderKind] = pc.GetObjectKind().GroupVersionKind().Kind
+	}
+
+	if err := l.client.List(ctx, pcul, labels); err != nil {
+		return nil, errors.Wrap(err, errListPCUs)
+	}
+
+	return pcul.GetItems(), nil
+}
+
+// Count the usages of the supplied ProviderConfig. Usages without a controller
+// reference are stale, and aren't counted.
+func (l *ProviderConfigUsageLister) Count(ctx context.Context, pc ProviderConfig) (int64, error) {
+	pcus, err := l.List(ctx, pc)
+	if err != nil {
+		return 0, err
+	}
+
+	users := int64(0)
+
+	for _, pcu := range pcus {
+		if metav1.GetControllerOf(pcu) != nil {
+			users++
+		}
+	}
+
+	return users, nil
+}
+
+// A ProviderConfigSource is where a ProviderConfigResolver found the
+// ProviderConfig a managed resource uses.
+type ProviderConfigSource string
+
+// ProviderConfig sources, in order of precedence.
+const (
+	// ProviderConfigSourceNamespace indicates the ProviderConfig was found in
+	// the managed resource's namespace.
+	ProviderConfigSourceNamespace ProviderConfigSource = "Namespace"
+
+	// ProviderConfigSourceSharedNamespace indicates the ProviderConfig was
+	// found in the resolver's shared namespace.
+	ProviderConfigSourceSharedNamespace ProviderConfigSource = "SharedNamespace"
+
+	// ProviderConfigSourceCluster indicates the ProviderConfig is cluster
+	// scoped.
+	ProviderConfigSourceCluster ProviderConfigSource = "Cluster"
+)
+
+// A ProviderConfigKind is a kind of ProviderConfig, as it appears in a
+// managed resource's ProviderConfigReference.
+type ProviderConfigKind struct {
+	// Kind of ProviderConfig.
+	Kind string
+
+	// Of is an empty ProviderConfig of this kind.
+	Of ProviderConfig
+}
+
+// A ResolvedProviderConfig is the ProviderConfig a managed resource uses.
+type ResolvedProviderConfig struct {
+	// ProviderConfig the managed resource uses.
+	ProviderConfig ProviderConfig
+
+	// Source of the ProviderConfig.
+	Source ProviderConfigSource
+}
+
+// A ProviderConfigResolverOption configures a ProviderConfigResolver.
+type ProviderConfigResolverOption func(r *ProviderConfigResolver)
+
+// WithSharedProviderConfigNamespace configures a namespace in which to look
+// for a namespaced ProviderConfig that isn't found in the managed resource's
+// namespace. This lets platform teams share ProviderConfigs with many
+// namespaces.
+func WithSharedProviderConfigNamespace(namespace string) ProviderConfigResolverOption {
+	return func(r *ProviderConfigResolver) {
+		r.shared = namespace
+	}
+}
+
+// WithClusterProviderConfigFallback configures the resolver to fall back to a
+// cluster scoped ProviderConfig of the same name when a namespaced
+// ProviderConfig isn't found in the managed resource's namespace or the
+// shared namespace.
+func WithClusterProviderConfigFallback() ProviderConfigResolverOption {
+	return func(r *ProviderConfigResolver) {
+		r.clusterFallback = true
+	}
+}
+
+// A ProviderConfigResolver resolves the ProviderConfig a managed resource
+// references. A reference to the cluster scoped kind always resolves to the
+// cluster scoped ProviderConfig of that name. A reference to the namespaced
+// kind resolves to the first ProviderConfig of that name found in:
+//
+//  1. The managed resource's namespace.
+//  2. The shared namespace, if configured.
+//  3. Cluster scope, if configured to fall back to it.
+//
+// Only a ProviderConfig that doesn't exist is skipped. Any other error getting
+// a ProviderConfig is returned, so that the resolver never silently uses a
+// ProviderConfig of lower precedence.
+type ProviderConfigResolver struct {
+	client     client.Reader
+	namespaced ProviderConfigKind
+	cluster    ProviderConfigKind
+
+	shared          string
+	clusterFallback bool
+}
+
+// NewProviderConfigResolver returns a ProviderConfigResolver that resolves
+// references to the supplied namespaced and cluster scoped kinds of
+// ProviderConfig.
+func NewProviderConfigResolver(c client.Reader, namespaced, cluster ProviderConfigKind, o ...ProviderConfigResolverOption) *ProviderConfigResolver {
+	r := &ProviderConfigResolver{client: c, namespaced: namespaced, cluster: cluster}
+	for _, fn := range o {
+		fn(r)
+	}
+
+	return r
+}
+
+// Resolve the ProviderConfig the supplied managed resource references. Resolve
+// sets the managed resource's ProviderConfigResolved condition to report which
+// ProviderConfig it uses, or why none could be resolved. The caller is
+// responsible for persisting the condition.
+func (r *ProviderConfigResolver) Resolve(ctx context.Context, mg ModernManaged) (ResolvedProviderConfig, error) {
+	rpc, err := r.resolve(ctx, mg)
+	if err != nil {
+		mg.SetConditions(xpv1.ProviderConfigUnresolved(err))
+		return ResolvedProviderConfig{}, err
+	}
+
+	pc := rpc.ProviderConfig
+	mg.SetConditions(xpv1.ProviderConfigResolved(r.kindOf(rpc.Source), pc.GetNamespace(), pc.GetName()))
+
+	return rpc, nil
+}
+
+func (r *ProviderConfigResolver) resolve(ctx context.Context, mg ModernManaged) (ResolvedProviderConfig, error) {
+	ref := mg.GetProviderConfigReference()
+	if ref == nil {
+		return ResolvedProviderConfig{}, missingRefError{errors.New(errMissingPCRef)}
+	}
+
+	if ref.Kind == "" {
+		return ResolvedProviderConfig{}, missingRefError{errors.New(errMissingPCRefKind)}
+	}
+
+	switch ref.Kind {
+	case r.cluster.Kind:
+		return r.get(ctx, ref.Name, "", ProviderConfigSourceCluster)
+	case r.namespaced.Kind:
+	default:
+		return ResolvedProviderConfig{}, errors.Errorf(errFmtUnknownPCKind, ref.Kind)
+	}
+
+	type candidate struct {
+		namespace string
+		source    ProviderConfigSource
+	}
+
+	candidates := make([]candidate, 0, 2)
+	if ns := mg.GetNamespace(); ns != "" {
+		candidates = append(candidates, candidate{namespace: ns, source: ProviderConfigSourceNamespace})
+	}
+
+	if r.shared != "" && r.shared != mg.GetNamespace() {
+		candidates = append(candidates, candidate{namespace: r.shared, source: ProviderConfigSourceSharedNamespace})
+	}
+
+	if len(candidates) == 0 && !r.clusterFallback {
+		return ResolvedProviderConfig{}, errors.Errorf(errFmtNoPCNamespace, ref.Kind, ref.Name)
+	}
+
+	searched := make([]string, 0, len(candidates)+1)
+
+	for _, c := range candidates {
+		rpc, err := r.get(ctx, ref.Name, c.namespace, c.source)
+		if !kerrors.IsNotFound(err) {
+			return rpc, err
+		}
+
+		searched = append(searched, "namespace "+c.namespace)
+	}
+
+	if r.clusterFallback {
+		rpc, err := r.get(ctx, ref.Name, "", ProviderConfigSourceCluster)
+		if !kerrors.IsNotFound(err) {
+			return rpc, err
+		}
+
+		searched = append(searched, "cluster scope")
+	}
+
+	return ResolvedProviderConfig{}, errors.Errorf(errFmtPCNotFound, ref.Kind, ref.Name, strings.Join(searched, ", "))
+}
+
+// get the ProviderConfig with the supplied name from the supplied source. The
+// namespace is ignored for cluster scoped ProviderConfigs.
+func (r *ProviderConfigResolver) get(ctx context.Context, name, namespace string, s ProviderConfigSource) (ResolvedProviderConfig, error) {
+	of, nn := r.namespaced.Of, types.NamespacedName{Namespace: namespace, Name: name}
+	if s == ProviderConfigSourceCluster {
+		of, nn = r.cluster.Of, types.NamespacedName{Name: name}
+	}
+
+	//nolint:forcetypeassert // Will always be a ProviderConfig.
+	pc := of.DeepCopyObject().(ProviderConfig)
+	if err := r.client.Get(ctx, nn, pc); err != nil {
+		return ResolvedProviderConfig{}, errors.Wrapf(err, errFmtGetPC, r.kindOf(s), name)
+	}
+
+	return ResolvedProviderConfig{ProviderConfig: pc, Source: s}, nil
+}
+
+func (r *ProviderConfigResolver) kindOf(s ProviderConfigSource) string {
+	if s == ProviderConfigSourceCluster {
+		return r.cluster.Kind
+	}
+
+	return r.namespaced.Kind
+}
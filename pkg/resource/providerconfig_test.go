@@ -23,6 +23,12 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/spf13/afero"
 	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
@@ -192,6 +198,27 @@ func TestExtractSecret(t *testing.T) {
 				b: credentials,
 			},
 		},
+		"SecretDefault": {
+			reason: "Successful extraction of the default credentials when the Secret key is missing",
+			args: args{
+				client: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil),
+				},
+				creds: xpv1.CommonCredentialSelectors{
+					SecretRef: &xpv1.SecretKeySelector{
+						SecretReference: xpv1.SecretReference{
+							Name:      "super",
+							Namespace: "secret",
+						},
+						Key:     "creds",
+						Default: ptr.To(string(credentials)),
+					},
+				},
+			},
+			want: want{
+				b: credentials,
+			},
+		},
 		"SecretFailureNotDefined": {
 			reason: "Failed extraction of credentials from Secret when key not defined",
 			args:   args{},
@@ -423,3 +450,291 @@ func TestTrackModern(t *testing.T) {
 		})
 	}
 }
+
+type pcuList struct {
+	client.ObjectList
+	Items []ProviderConfigUsage
+}
+
+func (l *pcuList) DeepCopyObject() runtime.Object {
+	return &pcuList{Items: append([]ProviderConfigUsage{}, l.Items...)}
+}
+
+func (l *pcuList) GetItems() []ProviderConfigUsage {
+	return l.Items
+}
+
+func TestProviderConfigUsageListerCount(t *testing.T) {
+	errBoom := errors.New("boom")
+	ctrl := true
+
+	pc := &fake.ProviderConfig{ObjectMeta: metav1.ObjectMeta{Name: "cool"}}
+	controlled := &fake.ProviderConfigUsage{ObjectMeta: metav1.ObjectMeta{
+		OwnerReferences: []metav1.OwnerReference{{UID: "cool-mr", Controller: &ctrl}},
+	}}
+	stale := &fake.ProviderConfigUsage{}
+
+	// List returns the supplied usages if it's called with the supplied labels.
+	list := func(want client.MatchingLabels, items ...ProviderConfigUsage) test.MockListFn {
+		return func(_ context.Context, obj client.ObjectList, opts ...client.ListOption) error {
+			lo := &client.ListOptions{}
+			lo.ApplyOptions(opts)
+
+			if diff := cmp.Diff(labels.SelectorFromSet(labels.Set(want)).String(), lo.LabelSelector.String()); diff != "" {
+				return errors.Errorf("-want labels, +got labels:\n%s", diff)
+			}
+
+			//nolint:forcetypeassert // This is always a pcuList.
+			obj.(*pcuList).Items = items
+
+			return nil
+		}
+	}
+
+	type args struct {
+		l *ProviderConfigUsageLister
+	}
+
+	type want struct {
+		users int64
+		err   error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"ListError": {
+			reason: "We should return any error encountered listing usages.",
+			args: args{
+				l: NewProviderConfigUsageLister(&test.MockClient{MockList: test.NewMockListFn(errBoom)}, &pcuList{}),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errListPCUs),
+			},
+		},
+		"Modern": {
+			reason: "We should match usages by ProviderConfig name and kind, and count only those with a controller.",
+			args: args{
+				l: NewProviderConfigUsageLister(&test.MockClient{
+					MockList: list(client.MatchingLabels{xpv1.LabelKeyProviderName: "cool", xpv1.LabelKeyProviderKind: ""}, controlled, stale),
+				}, &pcuList{}),
+			},
+			want: want{
+				users: 1,
+			},
+		},
+		"Legacy": {
+			reason: "We should match legacy usages by ProviderConfig name only.",
+			args: args{
+				l: NewLegacyProviderConfigUsageLister(&test.MockClient{
+					MockList: list(client.MatchingLabels{xpv1.LabelKeyProviderName: "cool"}, controlled, controlled),
+				}, &pcuList{}),
+			},
+			want: want{
+				users: 2,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			users, err := tc.args.l.Count(context.Background(), pc)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCount(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.want.users, users); diff != "" {
+				t.Errorf("\n%s\nCount(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestProviderConfigResolverResolve(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	namespaced := ProviderConfigKind{Kind: "ProviderConfig", Of: &fake.ProviderConfig{}}
+	cluster := ProviderConfigKind{Kind: "ClusterProviderConfig", Of: &fake.ProviderConfig{}}
+
+	// get returns the ProviderConfigs that exist, keyed by namespace/name.
+	// Cluster scoped ProviderConfigs have an empty namespace. It returns the
+	// supplied error for any other key, or NotFound if the error is nil.
+	get := func(err error, exists ...string) test.MockGetFn {
+		return func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+			for _, e := range exists {
+				if key.String() == e {
+					obj.SetNamespace(key.Namespace)
+					obj.SetName(key.Name)
+
+					return nil
+				}
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return kerrors.NewNotFound(schema.GroupResource{}, key.Name)
+		}
+	}
+
+	mg := func(namespace string, ref *xpv1.ProviderConfigReference) *fake.ModernManaged {
+		return &fake.ModernManaged{
+			ObjectMeta:                    metav1.ObjectMeta{Namespace: namespace, Name: "cool-mr"},
+			TypedProviderConfigReferencer: fake.TypedProviderConfigReferencer{Ref: ref},
+		}
+	}
+
+	type args struct {
+		c  client.Reader
+		o  []ProviderConfigResolverOption
+		mg *fake.ModernManaged
+	}
+
+	type want struct {
+		source    ProviderConfigSource
+		namespace string
+		condition xpv1.Condition
+		err       error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"MissingRef": {
+			reason: "An error that satisfies IsMissingReference should be returned if the managed resource has no provider config reference.",
+			args: args{
+				c:  &test.MockClient{},
+				mg: mg("team", nil),
+			},
+			want: want{
+				condition: xpv1.ProviderConfigUnresolved(errors.New(errMissingPCRef)),
+				err:       missingRefError{errors.New(errMissingPCRef)},
+			},
+		},
+		"UnknownKind": {
+			reason: "An error should be returned if the managed resource references an unknown kind of ProviderConfig.",
+			args: args{
+				c:  &test.MockClient{},
+				mg: mg("team", &xpv1.ProviderConfigReference{Kind: "Unknown", Name: "cool"}),
+			},
+			want: want{
+				condition: xpv1.ProviderConfigUnresolved(errors.Errorf(errFmtUnknownPCKind, "Unknown")),
+				err:       errors.Errorf(errFmtUnknownPCKind, "Unknown"),
+			},
+		},
+		"ClusterKind": {
+			reason: "A reference to the cluster scoped kind should resolve to the cluster scoped ProviderConfig, even if a namespaced one of the same name exists.",
+			args: args{
+				c:  &test.MockClient{MockGet: get(nil, "team/cool", "/cool")},
+				mg: mg("team", &xpv1.ProviderConfigReference{Kind: "ClusterProviderConfig", Name: "cool"}),
+			},
+			want: want{
+				source:    ProviderConfigSourceCluster,
+				condition: xpv1.ProviderConfigResolved("ClusterProviderConfig", "", "cool"),
+			},
+		},
+		"Namespace": {
+			reason: "A ProviderConfig in the managed resource's namespace should take precedence over one in the shared namespace.",
+			args: args{
+				c:  &test.MockClient{MockGet: get(nil, "team/cool", "shared/cool")},
+				o:  []ProviderConfigResolverOption{WithSharedProviderConfigNamespace("shared")},
+				mg: mg("team", &xpv1.ProviderConfigReference{Kind: "ProviderConfig", Name: "cool"}),
+			},
+			want: want{
+				source:    ProviderConfigSourceNamespace,
+				namespace: "team",
+				condition: xpv1.ProviderConfigResolved("ProviderConfig", "team", "cool"),
+			},
+		},
+		"SharedNamespace": {
+			reason: "A ProviderConfig in the shared namespace should be used if none exists in the managed resource's namespace.",
+			args: args{
+				c:  &test.MockClient{MockGet: get(nil, "shared/cool", "/cool")},
+				o:  []ProviderConfigResolverOption{WithSharedProviderConfigNamespace("shared"), WithClusterProviderConfigFallback()},
+				mg: mg("team", &xpv1.ProviderConfigReference{Kind: "ProviderConfig", Name: "cool"}),
+			},
+			want: want{
+				source:    ProviderConfigSourceSharedNamespace,
+				namespace: "shared",
+				condition: xpv1.ProviderConfigResolved("ProviderConfig", "shared", "cool"),
+			},
+		},
+		"ClusterFallback": {
+			reason: "A cluster scoped ProviderConfig should be used if no namespaced one exists and the resolver falls back to cluster scope.",
+			args: args{
+				c:  &test.MockClient{MockGet: get(nil, "/cool")},
+				o:  []ProviderConfigResolverOption{WithSharedProviderConfigNamespace("shared"), WithClusterProviderConfigFallback()},
+				mg: mg("team", &xpv1.ProviderConfigReference{Kind: "ProviderConfig", Name: "cool"}),
+			},
+			want: want{
+				source:    ProviderConfigSourceCluster,
+				condition: xpv1.ProviderConfigResolved("ClusterProviderConfig", "", "cool"),
+			},
+		},
+		"NotFound": {
+			reason: "An error listing where the resolver looked should be returned if no ProviderConfig exists.",
+			args: args{
+				c:  &test.MockClient{MockGet: get(nil, "/cool")},
+				o:  []ProviderConfigResolverOption{WithSharedProviderConfigNamespace("shared")},
+				mg: mg("team", &xpv1.ProviderConfigReference{Kind: "ProviderConfig", Name: "cool"}),
+			},
+			want: want{
+				condition: xpv1.ProviderConfigUnresolved(errors.Errorf(errFmtPCNotFound, "ProviderConfig", "cool", "namespace team, namespace shared")),
+				err:       errors.Errorf(errFmtPCNotFound, "ProviderConfig", "cool", "namespace team, namespace shared"),
+			},
+		},
+		"NoNamespace": {
+			reason: "An error should be returned if a cluster scoped managed resource references a namespaced ProviderConfig, and there's nowhere to look for it.",
+			args: args{
+				c:  &test.MockClient{},
+				mg: mg("", &xpv1.ProviderConfigReference{Kind: "ProviderConfig", Name: "cool"}),
+			},
+			want: want{
+				condition: xpv1.ProviderConfigUnresolved(errors.Errorf(errFmtNoPCNamespace, "ProviderConfig", "cool")),
+				err:       errors.Errorf(errFmtNoPCNamespace, "ProviderConfig", "cool"),
+			},
+		},
+		"GetError": {
+			reason: "Errors other than NotFound should be returned rather than falling back to a ProviderConfig of lower precedence.",
+			args: args{
+				c:  &test.MockClient{MockGet: get(errBoom, "shared/cool")},
+				o:  []ProviderConfigResolverOption{WithSharedProviderConfigNamespace("shared")},
+				mg: mg("team", &xpv1.ProviderConfigReference{Kind: "ProviderConfig", Name: "cool"}),
+			},
+			want: want{
+				condition: xpv1.ProviderConfigUnresolved(errors.Wrapf(errBoom, errFmtGetPC, "ProviderConfig", "cool")),
+				err:       errors.Wrapf(errBoom, errFmtGetPC, "ProviderConfig", "cool"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := NewProviderConfigResolver(tc.args.c, namespaced, cluster, tc.args.o...)
+
+			got, err := r.Resolve(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nResolve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.want.source, got.Source); diff != "" {
+				t.Errorf("\n%s\nResolve(...): -want source, +got source:\n%s", tc.reason, diff)
+			}
+
+			if got.ProviderConfig != nil {
+				if diff := cmp.Diff(tc.want.namespace, got.ProviderConfig.GetNamespace()); diff != "" {
+					t.Errorf("\n%s\nResolve(...): -want namespace, +got namespace:\n%s", tc.reason, diff)
+				}
+			}
+
+			if diff := cmp.Diff(tc.want.condition, tc.args.mg.GetCondition(xpv1.TypeProviderConfigResolved), test.EquateConditions()); diff != "" {
+				t.Errorf("\n%s\nResolve(...): -want condition, +got condition:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -19,6 +19,7 @@ package resource
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 
@@ -51,10 +52,15 @@ const (
 	ExternalResourceTagKeyName               = "crossplane-name"
 	ExternalResourceTagKeyProvider           = "crossplane-providerconfig"
 	ExternalResourceTagKeyProviderConfigKind = "crossplane-providerconfig-kind"
+	ExternalResourceTagKeyNamespace          = "crossplane-namespace"
+	ExternalResourceTagKeyComposite          = "crossplane-composite"
+	ExternalResourceTagKeyClaimName          = "crossplane-claim-name"
+	ExternalResourceTagKeyClaimNamespace     = "crossplane-claim-namespace"
 
 	errMarshalJSON            = "cannot marshal to JSON"
 	errUnmarshalJSON          = "cannot unmarshal JSON data"
 	errStructFromUnstructured = "cannot create Struct"
+	errFmtCreateObject        = "cannot create object of kind %s"
 )
 
 // A ManagedKind contains the type metadata for a kind of managed resource.
@@ -120,6 +126,13 @@ func ConnectionSecretFor(o ConnectionSecretOwner, kind schema.GroupVersionKind)
 	}
 }
 
+// CreateObject returns a new Object of the supplied kind. It returns an error
+// if the kind is unknown to the supplied ObjectCreator.
+func CreateObject(kind schema.GroupVersionKind, oc runtime.ObjectCreater) (runtime.Object, error) {
+	obj, err := oc.New(kind)
+	return obj, errors.Wrapf(err, errFmtCreateObject, kind)
+}
+
 // MustCreateObject returns a new Object of the supplied kind. It panics if the
 // kind is unknown to the supplied ObjectCreator.
 func MustCreateObject(kind schema.GroupVersionKind, oc runtime.ObjectCreater) runtime.Object {
@@ -334,6 +347,13 @@ func MustBeControllableBy(u types.UID) ApplyOption {
 // IsNotControllable will be returned if the current secret is not a connection
 // secret or cannot be controlled by the supplied UID.
 func ConnectionSecretMustBeControllableBy(u types.UID) ApplyOption {
+	return ConnectionSecretMustBeControllableByAny(u)
+}
+
+// ConnectionSecretMustBeControllableByAny is like
+// ConnectionSecretMustBeControllableBy, except that the current object may be
+// controlled by an object with any of the supplied UIDs.
+func ConnectionSecretMustBeControllableByAny(u ...types.UID) ApplyOption {
 	return func(_ context.Context, current, _ runtime.Object) error {
 		s, ok := current.(*corev1.Secret)
 		if !ok {
@@ -347,8 +367,10 @@ func ConnectionSecretMustBeControllableBy(u types.UID) ApplyOption {
 			return notControllableError{errors.Errorf("refusing to modify uncontrolled secret of type %q", s.Type)}
 		case c == nil:
 			return nil
-		case c.UID != u:
-			return notControllableError{errors.Errorf("existing secret is not controlled by UID %q", u)}
+		case !slices.Contains(u, c.UID) && len(u) == 1:
+			return notControllableError{errors.Errorf("existing secret is not controlled by UID %q", u[0])}
+		case !slices.Contains(u, c.UID):
+			return notControllableError{errors.Errorf("existing secret is not controlled by any of UIDs %q", u)}
 		}
 
 		return nil
@@ -434,6 +456,30 @@ func GetExternalTags(mg Managed) map[string]string {
 	return tags
 }
 
+// GetProvenanceTags returns the external tags of the supplied managed resource,
+// as returned by GetExternalTags, along with tags identifying its namespace and
+// any composite resource or claim it was composed for.
+func GetProvenanceTags(mg Managed) map[string]string {
+	tags := GetExternalTags(mg)
+
+	if ns := mg.GetNamespace(); ns != "" {
+		tags[ExternalResourceTagKeyNamespace] = ns
+	}
+
+	l := mg.GetLabels()
+	for label, tag := range map[string]string{
+		meta.LabelKeyComposite:      ExternalResourceTagKeyComposite,
+		meta.LabelKeyClaimName:      ExternalResourceTagKeyClaimName,
+		meta.LabelKeyClaimNamespace: ExternalResourceTagKeyClaimNamespace,
+	} {
+		if v := l[label]; v != "" {
+			tags[tag] = v
+		}
+	}
+
+	return tags
+}
+
 // DefaultFirstN is the default number of names to return in FirstNAndSomeMore.
 const DefaultFirstN = 3
 
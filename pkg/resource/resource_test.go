@@ -34,6 +34,7 @@ import (
 
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
 )
@@ -281,6 +282,58 @@ func TestMustCreateObject(t *testing.T) {
 	}
 }
 
+func TestCreateObject(t *testing.T) {
+	type args struct {
+		kind schema.GroupVersionKind
+		oc   runtime.ObjectCreater
+	}
+
+	type want struct {
+		obj runtime.Object
+		err bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"KindRegistered": {
+			reason: "We should return a new object of a registered kind.",
+			args: args{
+				kind: fake.GVK(&fake.Managed{}),
+				oc:   fake.SchemeWith(&fake.Managed{}),
+			},
+			want: want{
+				obj: &fake.Managed{},
+			},
+		},
+		"KindNotRegistered": {
+			reason: "We should return an error if the kind is not registered.",
+			args: args{
+				kind: fake.GVK(&fake.Managed{}),
+				oc:   runtime.NewScheme(),
+			},
+			want: want{
+				err: true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := CreateObject(tc.args.kind, tc.args.oc)
+			if diff := cmp.Diff(tc.want.err, err != nil); diff != "" {
+				t.Errorf("\n%s\nCreateObject(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.want.obj, got); diff != "" {
+				t.Errorf("\n%s\nCreateObject(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
 func TestIgnore(t *testing.T) {
 	errBoom := errors.New("boom")
 
@@ -572,6 +625,48 @@ func TestConnectionSecretMustBeControllableBy(t *testing.T) {
 	}
 }
 
+func TestConnectionSecretMustBeControllableByAny(t *testing.T) {
+	controller := true
+	uids := []types.UID{"mr-uid", "xr-uid"}
+
+	cases := map[string]struct {
+		reason  string
+		current runtime.Object
+		want    error
+	}{
+		"ControlledByAnySuppliedUID": {
+			reason: "A Secret that is already controlled by any of the supplied UIDs is controllable",
+			current: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{OwnerReferences: []metav1.OwnerReference{{
+					UID:        "xr-uid",
+					Controller: &controller,
+				}}},
+				Type: SecretTypeConnection,
+			},
+		},
+		"ControlledBySomeoneElse": {
+			reason: "A Secret that is controlled by none of the supplied UIDs is not controllable",
+			current: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{OwnerReferences: []metav1.OwnerReference{{
+					UID:        "some-other-uid",
+					Controller: &controller,
+				}}},
+				Type: SecretTypeConnection,
+			},
+			want: notControllableError{errors.Errorf("existing secret is not controlled by any of UIDs %q", uids)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := ConnectionSecretMustBeControllableByAny(uids...)(context.Background(), tc.current, nil)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nConnectionSecretMustBeControllableByAny(...)(...): -want error, +got error\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
 func TestAllowUpdateIf(t *testing.T) {
 	type args struct {
 		ctx     context.Context
@@ -672,6 +767,57 @@ func TestGetExternalTags(t *testing.T) {
 	}
 }
 
+func TestGetProvenanceTags(t *testing.T) {
+	cases := map[string]struct {
+		o    Managed
+		want map[string]string
+	}{
+		"NotComposed": {
+			o: &fake.ModernManaged{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace,
+				},
+			},
+			want: map[string]string{
+				ExternalResourceTagKeyKind:      strings.ToLower((&fake.ModernManaged{}).GetObjectKind().GroupVersionKind().GroupKind().String()),
+				ExternalResourceTagKeyName:      name,
+				ExternalResourceTagKeyNamespace: namespace,
+			},
+		},
+		"Composed": {
+			o: &fake.ModernManaged{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace,
+					Labels: map[string]string{
+						meta.LabelKeyComposite:      "cool-xr",
+						meta.LabelKeyClaimName:      "cool-claim",
+						meta.LabelKeyClaimNamespace: "team",
+					},
+				},
+			},
+			want: map[string]string{
+				ExternalResourceTagKeyKind:           strings.ToLower((&fake.ModernManaged{}).GetObjectKind().GroupVersionKind().GroupKind().String()),
+				ExternalResourceTagKeyName:           name,
+				ExternalResourceTagKeyNamespace:      namespace,
+				ExternalResourceTagKeyComposite:      "cool-xr",
+				ExternalResourceTagKeyClaimName:      "cool-claim",
+				ExternalResourceTagKeyClaimNamespace: "team",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GetProvenanceTags(tc.o)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GetProvenanceTags(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 // single test case => not using tables.
 func Test_notControllableError_NotControllable(t *testing.T) {
 	err := notControllableError{
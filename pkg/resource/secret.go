@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+)
+
+// Error strings.
+const (
+	errFmtGetSecret      = "cannot get secret %s/%s"
+	errFmtSecretNotFound = "required secret %s/%s does not exist"
+	errFmtKeyNotFound    = "required key %q is missing from secret %s/%s"
+)
+
+// ResolveSecretKey returns the value of the selected secret key. If the secret
+// or its key is missing it returns the selector's default value, if any. If
+// there's no default it returns nil if the selector is optional, or an error
+// that explains what's missing if it's not.
+func ResolveSecretKey(ctx context.Context, c client.Reader, sel xpv1.SecretKeySelector) ([]byte, error) {
+	s := &corev1.Secret{}
+
+	err := c.Get(ctx, types.NamespacedName{Namespace: sel.Namespace, Name: sel.Name}, s)
+	if kerrors.IsNotFound(err) {
+		return missingSecretKey(sel, errors.Errorf(errFmtSecretNotFound, sel.Namespace, sel.Name))
+	}
+
+	if err != nil {
+		return nil, errors.Wrapf(err, errFmtGetSecret, sel.Namespace, sel.Name)
+	}
+
+	v, ok := s.Data[sel.Key]
+	if !ok {
+		return missingSecretKey(sel, errors.Errorf(errFmtKeyNotFound, sel.Key, sel.Namespace, sel.Name))
+	}
+
+	return v, nil
+}
+
+// ResolveLocalSecretKey returns the value of the selected secret key in the
+// supplied namespace. It treats a missing secret or key the same way as
+// ResolveSecretKey.
+func ResolveLocalSecretKey(ctx context.Context, c client.Reader, namespace string, sel xpv1.LocalSecretKeySelector) ([]byte, error) {
+	return ResolveSecretKey(ctx, c, *sel.ToSecretKeySelector(namespace))
+}
+
+func missingSecretKey(sel xpv1.SecretKeySelector, err error) ([]byte, error) {
+	if sel.Default != nil {
+		return []byte(*sel.Default), nil
+	}
+
+	if sel.Optional != nil && *sel.Optional {
+		return nil, nil
+	}
+
+	return nil, err
+}
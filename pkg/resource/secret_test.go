@@ -0,0 +1,150 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+)
+
+func TestResolveSecretKey(t *testing.T) {
+	errBoom := errors.New("boom")
+	errNotFound := kerrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, "creds")
+
+	withData := test.NewMockGetFn(nil, func(o client.Object) error {
+		o.(*corev1.Secret).Data = map[string][]byte{"password": []byte("hunter2")} //nolint:forcetypeassert // This is always a Secret.
+		return nil
+	})
+
+	type args struct {
+		c   client.Reader
+		sel xpv1.SecretKeySelector
+	}
+
+	type want struct {
+		b   []byte
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"Success": {
+			reason: "We should return the value of the selected key.",
+			args: args{
+				c:   &test.MockClient{MockGet: withData},
+				sel: xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Namespace: "ns", Name: "creds"}, Key: "password"},
+			},
+			want: want{b: []byte("hunter2")},
+		},
+		"GetError": {
+			reason: "We should return any error encountered getting the secret, even if the key is optional.",
+			args: args{
+				c:   &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+				sel: xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Namespace: "ns", Name: "creds"}, Key: "password", Optional: ptr.To(true)},
+			},
+			want: want{err: errors.Wrapf(errBoom, errFmtGetSecret, "ns", "creds")},
+		},
+		"RequiredSecretMissing": {
+			reason: "We should explain that a required secret is missing.",
+			args: args{
+				c:   &test.MockClient{MockGet: test.NewMockGetFn(errNotFound)},
+				sel: xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Namespace: "ns", Name: "creds"}, Key: "password"},
+			},
+			want: want{err: errors.Errorf(errFmtSecretNotFound, "ns", "creds")},
+		},
+		"RequiredKeyMissing": {
+			reason: "We should explain that a required key is missing.",
+			args: args{
+				c:   &test.MockClient{MockGet: withData},
+				sel: xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Namespace: "ns", Name: "creds"}, Key: "token", Optional: ptr.To(false)},
+			},
+			want: want{err: errors.Errorf(errFmtKeyNotFound, "token", "ns", "creds")},
+		},
+		"OptionalSecretMissing": {
+			reason: "We should return nothing if an optional secret is missing.",
+			args: args{
+				c:   &test.MockClient{MockGet: test.NewMockGetFn(errNotFound)},
+				sel: xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Namespace: "ns", Name: "creds"}, Key: "password", Optional: ptr.To(true)},
+			},
+			want: want{},
+		},
+		"DefaultKeyMissing": {
+			reason: "We should return the default if the key is missing.",
+			args: args{
+				c:   &test.MockClient{MockGet: withData},
+				sel: xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Namespace: "ns", Name: "creds"}, Key: "region", Default: ptr.To("us-east-1")},
+			},
+			want: want{b: []byte("us-east-1")},
+		},
+		"DefaultKeyPresent": {
+			reason: "We should ignore the default if the key is present.",
+			args: args{
+				c:   &test.MockClient{MockGet: withData},
+				sel: xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Namespace: "ns", Name: "creds"}, Key: "password", Default: ptr.To("nope")},
+			},
+			want: want{b: []byte("hunter2")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ResolveSecretKey(context.Background(), tc.args.c, tc.args.sel)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nResolveSecretKey(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.want.b, got); diff != "" {
+				t.Errorf("\n%s\nResolveSecretKey(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestResolveLocalSecretKey(t *testing.T) {
+	c := &test.MockClient{MockGet: func(_ context.Context, key client.ObjectKey, _ client.Object) error {
+		if diff := cmp.Diff(client.ObjectKey{Namespace: "ns", Name: "creds"}, key); diff != "" {
+			t.Errorf("Get(...): -want key, +got key:\n%s", diff)
+		}
+
+		return kerrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, key.Name)
+	}}
+
+	sel := xpv1.LocalSecretKeySelector{LocalSecretReference: xpv1.LocalSecretReference{Name: "creds"}, Key: "region", Default: ptr.To("us-east-1")}
+
+	got, err := ResolveLocalSecretKey(context.Background(), c, "ns", sel)
+	if err != nil {
+		t.Fatalf("ResolveLocalSecretKey(...): %v", err)
+	}
+
+	if diff := cmp.Diff([]byte("us-east-1"), got); diff != "" {
+		t.Errorf("ResolveLocalSecretKey(...): -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/fieldpath"
+)
+
+// Field paths of the provider specific fields of a managed resource.
+const (
+	FieldPathForProvider = "spec.forProvider"
+	FieldPathAtProvider  = "status.atProvider"
+)
+
+const errFmtDecodeField = "cannot decode field path %q"
+
+// FieldOf decodes the value at the supplied field path of the supplied object
+// into a new T. The object may be typed or unstructured. The value is decoded
+// via JSON, so T should have JSON tags matching the object's schema. Use
+// fieldpath.IsNotFound to determine whether an error indicates the field path
+// doesn't exist.
+func FieldOf[T any](o runtime.Object, path string) (*T, error) {
+	p, err := fieldpath.PaveObject(o)
+	if err != nil {
+		return nil, errors.Wrapf(err, errFmtDecodeField, path)
+	}
+
+	out := new(T)
+	if err := p.GetValueInto(path, out); err != nil {
+		return nil, errors.Wrapf(err, errFmtDecodeField, path)
+	}
+
+	return out, nil
+}
+
+// ForProviderOf decodes the spec.forProvider field of the supplied managed
+// resource into a new T.
+func ForProviderOf[T any](mg Managed) (*T, error) {
+	return FieldOf[T](mg, FieldPathForProvider)
+}
+
+// AtProviderOf decodes the status.atProvider field of the supplied managed
+// resource into a new T.
+func AtProviderOf[T any](mg Managed) (*T, error) {
+	return FieldOf[T](mg, FieldPathAtProvider)
+}
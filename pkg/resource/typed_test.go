@@ -0,0 +1,135 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/fieldpath"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+)
+
+type bucketParameters struct {
+	Region string `json:"region"`
+	Size   int    `json:"size,omitempty"`
+}
+
+type bucket struct {
+	fake.ModernManaged
+
+	Spec struct {
+		ForProvider bucketParameters `json:"forProvider"`
+	} `json:"spec"`
+}
+
+func (b *bucket) DeepCopyObject() runtime.Object {
+	out := *b
+	return &out
+}
+
+func TestFieldOf(t *testing.T) {
+	typed := &bucket{}
+	typed.Spec.ForProvider = bucketParameters{Region: "us-east-1", Size: 3}
+
+	u := &unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{
+			"forProvider": map[string]any{"region": "eu-west-1"},
+		},
+	}}
+
+	type args struct {
+		o    runtime.Object
+		path string
+	}
+
+	type want struct {
+		out      *bucketParameters
+		notFound bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"Typed": {
+			reason: "We should decode a field of a typed object.",
+			args: args{
+				o:    typed,
+				path: FieldPathForProvider,
+			},
+			want: want{
+				out: &bucketParameters{Region: "us-east-1", Size: 3},
+			},
+		},
+		"Unstructured": {
+			reason: "We should decode a field of an unstructured object.",
+			args: args{
+				o:    u,
+				path: FieldPathForProvider,
+			},
+			want: want{
+				out: &bucketParameters{Region: "eu-west-1"},
+			},
+		},
+		"NotFound": {
+			reason: "We should return an error that satisfies fieldpath.IsNotFound if the field doesn't exist.",
+			args: args{
+				o:    u,
+				path: FieldPathAtProvider,
+			},
+			want: want{
+				notFound: true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := FieldOf[bucketParameters](tc.args.o, tc.args.path)
+			if diff := cmp.Diff(tc.want.notFound, fieldpath.IsNotFound(err)); diff != "" {
+				t.Errorf("\n%s\nFieldOf(...): -want not found, +got not found:\n%s", tc.reason, diff)
+			}
+
+			if !tc.want.notFound && err != nil {
+				t.Errorf("\n%s\nFieldOf(...): %v", tc.reason, err)
+			}
+
+			if diff := cmp.Diff(tc.want.out, got); diff != "" {
+				t.Errorf("\n%s\nFieldOf(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestForProviderOf(t *testing.T) {
+	mg := &bucket{}
+	mg.Spec.ForProvider = bucketParameters{Region: "us-east-1"}
+
+	got, err := ForProviderOf[bucketParameters](mg)
+	if err != nil {
+		t.Fatalf("ForProviderOf(...): %v", err)
+	}
+
+	if diff := cmp.Diff(&bucketParameters{Region: "us-east-1"}, got); diff != "" {
+		t.Errorf("ForProviderOf(...): -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,284 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package managed contains an unstructured managed resource.
+package managed
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/fieldpath"
+)
+
+// An Option modifies an unstructured managed resource.
+type Option func(mg *Unstructured)
+
+// WithGroupVersionKind sets the GroupVersionKind of the unstructured managed
+// resource.
+func WithGroupVersionKind(gvk schema.GroupVersionKind) Option {
+	return func(mg *Unstructured) {
+		mg.SetGroupVersionKind(gvk)
+	}
+}
+
+// WithConditions returns an Option that sets the supplied conditions on an
+// unstructured managed resource.
+func WithConditions(c ...xpv1.Condition) Option {
+	return func(mg *Unstructured) {
+		mg.SetConditions(c...)
+	}
+}
+
+// New returns a new unstructured managed resource.
+func New(opts ...Option) *Unstructured {
+	mg := &Unstructured{unstructured.Unstructured{Object: make(map[string]any)}}
+	for _, f := range opts {
+		f(mg)
+	}
+
+	return mg
+}
+
+// +k8s:deepcopy-gen=true
+// +kubebuilder:object:root=true
+
+// An Unstructured managed resource. It satisfies resource.ModernManaged,
+// allowing kinds whose Go types don't exist at compile time to be reconciled.
+type Unstructured struct {
+	unstructured.Unstructured
+}
+
+// GetUnstructured returns the underlying *unstructured.Unstructured.
+func (mg *Unstructured) GetUnstructured() *unstructured.Unstructured {
+	return &mg.Unstructured
+}
+
+// GetCondition of this managed resource.
+func (mg *Unstructured) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
+	conditioned := xpv1.ConditionedStatus{}
+	// The path is directly `status` because conditions are inline.
+	if err := fieldpath.Pave(mg.Object).GetValueInto("status", &conditioned); err != nil {
+		return xpv1.Condition{}
+	}
+
+	return conditioned.GetCondition(ct)
+}
+
+// SetConditions of this managed resource.
+func (mg *Unstructured) SetConditions(c ...xpv1.Condition) {
+	conditioned := xpv1.ConditionedStatus{}
+	// The path is directly `status` because conditions are inline.
+	_ = fieldpath.Pave(mg.Object).GetValueInto("status", &conditioned)
+	conditioned.SetConditions(c...)
+	_ = fieldpath.Pave(mg.Object).SetValue("status.conditions", conditioned.Conditions)
+}
+
+// GetManagementPolicies of this managed resource.
+func (mg *Unstructured) GetManagementPolicies() xpv1.ManagementPolicies {
+	out := xpv1.ManagementPolicies{}
+	if err := fieldpath.Pave(mg.Object).GetValueInto("spec.managementPolicies", &out); err != nil {
+		return nil
+	}
+
+	return out
+}
+
+// SetManagementPolicies of this managed resource.
+func (mg *Unstructured) SetManagementPolicies(p xpv1.ManagementPolicies) {
+	_ = fieldpath.Pave(mg.Object).SetValue("spec.managementPolicies", p)
+}
+
+// GetPollInterval of this managed resource.
+func (mg *Unstructured) GetPollInterval() *metav1.Duration {
+	out := &metav1.Duration{}
+	if err := fieldpath.Pave(mg.Object).GetValueInto("spec.pollInterval", out); err != nil {
+		return nil
+	}
+
+	return out
+}
+
+// SetPollInterval of this managed resource.
+func (mg *Unstructured) SetPollInterval(d *metav1.Duration) {
+	_ = fieldpath.Pave(mg.Object).SetValue("spec.pollInterval", d)
+}
+
+// GetWriteConnectionSecretToReference of this managed resource.
+func (mg *Unstructured) GetWriteConnectionSecretToReference() *xpv1.LocalSecretReference {
+	out := &xpv1.LocalSecretReference{}
+	if err := fieldpath.Pave(mg.Object).GetValueInto("spec.writeConnectionSecretToRef", out); err != nil {
+		return nil
+	}
+
+	return out
+}
+
+// SetWriteConnectionSecretToReference of this managed resource.
+func (mg *Unstructured) SetWriteConnectionSecretToReference(r *xpv1.LocalSecretReference) {
+	_ = fieldpath.Pave(mg.Object).SetValue("spec.writeConnectionSecretToRef", r)
+}
+
+// GetProviderConfigReference of this managed resource.
+func (mg *Unstructured) GetProviderConfigReference() *xpv1.ProviderConfigReference {
+	out := &xpv1.ProviderConfigReference{}
+	if err := fieldpath.Pave(mg.Object).GetValueInto("spec.providerConfigRef", out); err != nil {
+		return nil
+	}
+
+	return out
+}
+
+// SetProviderConfigReference of this managed resource.
+func (mg *Unstructured) SetProviderConfigReference(r *xpv1.ProviderConfigReference) {
+	_ = fieldpath.Pave(mg.Object).SetValue("spec.providerConfigRef", r)
+}
+
+// GetOperationDetails of this managed resource.
+func (mg *Unstructured) GetOperationDetails() *xpv1.OperationDetails {
+	out := &xpv1.OperationDetails{}
+	if err := fieldpath.Pave(mg.Object).GetValueInto("status.operationDetails", out); err != nil {
+		return nil
+	}
+
+	return out
+}
+
+// SetOperationDetails of this managed resource.
+func (mg *Unstructured) SetOperationDetails(d *xpv1.OperationDetails) {
+	_ = fieldpath.Pave(mg.Object).SetValue("status.operationDetails", d)
+}
+
+// GetObservedStateHash of this managed resource.
+func (mg *Unstructured) GetObservedStateHash() string {
+	h, _ := fieldpath.Pave(mg.Object).GetString("status.observedStateHash")
+	return h
+}
+
+// SetObservedStateHash of this managed resource.
+func (mg *Unstructured) SetObservedStateHash(h string) {
+	_ = fieldpath.Pave(mg.Object).SetValue("status.observedStateHash", h)
+}
+
+// SetObservedGeneration of this managed resource.
+func (mg *Unstructured) SetObservedGeneration(generation int64) {
+	status := &xpv1.ObservedStatus{}
+	_ = fieldpath.Pave(mg.Object).GetValueInto("status", status)
+	status.SetObservedGeneration(generation)
+	_ = fieldpath.Pave(mg.Object).SetValue("status.observedGeneration", status.ObservedGeneration)
+}
+
+// GetObservedGeneration of this managed resource.
+func (mg *Unstructured) GetObservedGeneration() int64 {
+	status := &xpv1.ObservedStatus{}
+	_ = fieldpath.Pave(mg.Object).GetValueInto("status", status)
+
+	return status.GetObservedGeneration()
+}
+
+// NewLegacy returns a new unstructured legacy managed resource.
+func NewLegacy(opts ...Option) *LegacyUnstructured {
+	return &LegacyUnstructured{Unstructured: *New(opts...)}
+}
+
+// +k8s:deepcopy-gen=true
+// +kubebuilder:object:root=true
+
+// A LegacyUnstructured managed resource. It satisfies resource.LegacyManaged,
+// i.e. it has a deletion policy, a namespaced connection secret reference and
+// an untyped provider config reference.
+type LegacyUnstructured struct {
+	Unstructured
+}
+
+// GetDeletionPolicy of this managed resource.
+func (mg *LegacyUnstructured) GetDeletionPolicy() xpv1.DeletionPolicy {
+	s, err := fieldpath.Pave(mg.Object).GetString("spec.deletionPolicy")
+	if err != nil {
+		return ""
+	}
+
+	return xpv1.DeletionPolicy(s)
+}
+
+// SetDeletionPolicy of this managed resource.
+func (mg *LegacyUnstructured) SetDeletionPolicy(p xpv1.DeletionPolicy) {
+	_ = fieldpath.Pave(mg.Object).SetValue("spec.deletionPolicy", p)
+}
+
+// GetWriteConnectionSecretToReference of this managed resource.
+func (mg *LegacyUnstructured) GetWriteConnectionSecretToReference() *xpv1.SecretReference {
+	out := &xpv1.SecretReference{}
+	if err := fieldpath.Pave(mg.Object).GetValueInto("spec.writeConnectionSecretToRef", out); err != nil {
+		return nil
+	}
+
+	return out
+}
+
+// SetWriteConnectionSecretToReference of this managed resource.
+func (mg *LegacyUnstructured) SetWriteConnectionSecretToReference(r *xpv1.SecretReference) {
+	_ = fieldpath.Pave(mg.Object).SetValue("spec.writeConnectionSecretToRef", r)
+}
+
+// GetProviderConfigReference of this managed resource.
+func (mg *LegacyUnstructured) GetProviderConfigReference() *xpv1.Reference {
+	out := &xpv1.Reference{}
+	if err := fieldpath.Pave(mg.Object).GetValueInto("spec.providerConfigRef", out); err != nil {
+		return nil
+	}
+
+	return out
+}
+
+// SetProviderConfigReference of this managed resource.
+func (mg *LegacyUnstructured) SetProviderConfigReference(r *xpv1.Reference) {
+	_ = fieldpath.Pave(mg.Object).SetValue("spec.providerConfigRef", r)
+}
+
+// A ListOption modifies an unstructured list of managed resources.
+type ListOption func(*UnstructuredList)
+
+// FromGroupVersionKindToList returns a ListOption that sets the apiVersion and
+// kind of an unstructured list of managed resources of the supplied kind.
+func FromGroupVersionKindToList(gvk schema.GroupVersionKind) ListOption {
+	return func(list *UnstructuredList) {
+		list.SetAPIVersion(gvk.GroupVersion().String())
+		list.SetKind(gvk.Kind + "List")
+	}
+}
+
+// NewList returns a new unstructured list of managed resources.
+func NewList(opts ...ListOption) *UnstructuredList {
+	l := &UnstructuredList{unstructured.UnstructuredList{Object: make(map[string]any)}}
+	for _, f := range opts {
+		f(l)
+	}
+
+	return l
+}
+
+// An UnstructuredList of managed resources.
+type UnstructuredList struct {
+	unstructured.UnstructuredList
+}
+
+// GetUnstructuredList returns the underlying *unstructured.UnstructuredList.
+func (l *UnstructuredList) GetUnstructuredList() *unstructured.UnstructuredList {
+	return &l.UnstructuredList
+}
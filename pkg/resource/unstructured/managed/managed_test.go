@@ -0,0 +1,364 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+)
+
+var (
+	_ resource.ModernManaged            = &Unstructured{}
+	_ resource.LegacyManaged            = &LegacyUnstructured{}
+	_ resource.PollIntervalConfigurator = &Unstructured{}
+)
+
+func TestNew(t *testing.T) {
+	gvk := schema.GroupVersionKind{Group: "example.org", Version: "v1", Kind: "Cool"}
+	cases := map[string]struct {
+		opts []Option
+		want *Unstructured
+	}{
+		"WithGroupVersionKind": {
+			opts: []Option{WithGroupVersionKind(gvk)},
+			want: &Unstructured{
+				Unstructured: unstructured.Unstructured{
+					Object: map[string]any{
+						"apiVersion": "example.org/v1",
+						"kind":       "Cool",
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := New(tc.opts...)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("New(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestConditions(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		u      *Unstructured
+		set    []xpv1.Condition
+		get    xpv1.ConditionType
+		want   xpv1.Condition
+	}{
+		"NewCondition": {
+			reason: "It should be possible to set a condition of an empty Unstructured.",
+			u:      New(),
+			set:    []xpv1.Condition{xpv1.Available(), xpv1.ReconcileSuccess()},
+			get:    xpv1.TypeReady,
+			want:   xpv1.Available(),
+		},
+		"ExistingCondition": {
+			reason: "It should be possible to overwrite a condition that is already set.",
+			u:      New(WithConditions(xpv1.Creating())),
+			set:    []xpv1.Condition{xpv1.Available()},
+			get:    xpv1.TypeReady,
+			want:   xpv1.Available(),
+		},
+		"WeirdStatus": {
+			reason: "It should not be possible to set a condition when status is not an object.",
+			u: &Unstructured{unstructured.Unstructured{Object: map[string]any{
+				"status": "wat",
+			}}},
+			set:  []xpv1.Condition{xpv1.Available()},
+			get:  xpv1.TypeReady,
+			want: xpv1.Condition{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tc.u.SetConditions(tc.set...)
+
+			got := tc.u.GetCondition(tc.get)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nu.GetCondition(%s): -want, +got:\n%s", tc.reason, tc.get, diff)
+			}
+		})
+	}
+}
+
+func TestManagementPolicies(t *testing.T) {
+	cases := map[string]struct {
+		u    *Unstructured
+		set  xpv1.ManagementPolicies
+		want xpv1.ManagementPolicies
+	}{
+		"NewPolicies": {
+			u:    New(),
+			set:  xpv1.ManagementPolicies{xpv1.ManagementActionObserve},
+			want: xpv1.ManagementPolicies{xpv1.ManagementActionObserve},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tc.u.SetManagementPolicies(tc.set)
+
+			got := tc.u.GetManagementPolicies()
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\nu.GetManagementPolicies(): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPollInterval(t *testing.T) {
+	d := &metav1.Duration{Duration: 10 * time.Minute}
+	cases := map[string]struct {
+		u    *Unstructured
+		set  *metav1.Duration
+		want *metav1.Duration
+	}{
+		"NewInterval": {
+			u:    New(),
+			set:  d,
+			want: d,
+		},
+		"NotFound": {
+			u: New(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if tc.set != nil {
+				tc.u.SetPollInterval(tc.set)
+			}
+
+			got := tc.u.GetPollInterval()
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\nu.GetPollInterval(): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWriteConnectionSecretToReference(t *testing.T) {
+	ref := &xpv1.LocalSecretReference{Name: "cool"}
+	cases := map[string]struct {
+		u    *Unstructured
+		set  *xpv1.LocalSecretReference
+		want *xpv1.LocalSecretReference
+	}{
+		"NewRef": {
+			u:    New(),
+			set:  ref,
+			want: ref,
+		},
+		"NotFound": {
+			u: New(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if tc.set != nil {
+				tc.u.SetWriteConnectionSecretToReference(tc.set)
+			}
+
+			got := tc.u.GetWriteConnectionSecretToReference()
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\nu.GetWriteConnectionSecretToReference(): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestProviderConfigReference(t *testing.T) {
+	ref := &xpv1.ProviderConfigReference{Kind: "ProviderConfig", Name: "cool"}
+	cases := map[string]struct {
+		u    *Unstructured
+		set  *xpv1.ProviderConfigReference
+		want *xpv1.ProviderConfigReference
+	}{
+		"NewRef": {
+			u:    New(),
+			set:  ref,
+			want: ref,
+		},
+		"NotFound": {
+			u: New(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if tc.set != nil {
+				tc.u.SetProviderConfigReference(tc.set)
+			}
+
+			got := tc.u.GetProviderConfigReference()
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\nu.GetProviderConfigReference(): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObservedGeneration(t *testing.T) {
+	cases := map[string]struct {
+		u    *Unstructured
+		want int64
+	}{
+		"Set": {
+			u: New(func(u *Unstructured) {
+				u.SetObservedGeneration(123)
+			}),
+			want: 123,
+		},
+		"NotFound": {
+			u: New(),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := tc.u.GetObservedGeneration()
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\nu.GetObservedGeneration(): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLegacyDeletionPolicy(t *testing.T) {
+	cases := map[string]struct {
+		u    *LegacyUnstructured
+		set  xpv1.DeletionPolicy
+		want xpv1.DeletionPolicy
+	}{
+		"NewPolicy": {
+			u:    NewLegacy(),
+			set:  xpv1.DeletionOrphan,
+			want: xpv1.DeletionOrphan,
+		},
+		"NotFound": {
+			u: NewLegacy(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if tc.set != "" {
+				tc.u.SetDeletionPolicy(tc.set)
+			}
+
+			got := tc.u.GetDeletionPolicy()
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\nu.GetDeletionPolicy(): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLegacyWriteConnectionSecretToReference(t *testing.T) {
+	ref := &xpv1.SecretReference{Namespace: "ns", Name: "cool"}
+	cases := map[string]struct {
+		u    *LegacyUnstructured
+		set  *xpv1.SecretReference
+		want *xpv1.SecretReference
+	}{
+		"NewRef": {
+			u:    NewLegacy(),
+			set:  ref,
+			want: ref,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tc.u.SetWriteConnectionSecretToReference(tc.set)
+
+			got := tc.u.GetWriteConnectionSecretToReference()
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\nu.GetWriteConnectionSecretToReference(): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLegacyProviderConfigReference(t *testing.T) {
+	ref := &xpv1.Reference{Name: "cool"}
+	cases := map[string]struct {
+		u    *LegacyUnstructured
+		set  *xpv1.Reference
+		want *xpv1.Reference
+	}{
+		"NewRef": {
+			u:    NewLegacy(),
+			set:  ref,
+			want: ref,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tc.u.SetProviderConfigReference(tc.set)
+
+			got := tc.u.GetProviderConfigReference()
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\nu.GetProviderConfigReference(): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewList(t *testing.T) {
+	gvk := schema.GroupVersionKind{Group: "example.org", Version: "v1", Kind: "Cool"}
+	cases := map[string]struct {
+		opts []ListOption
+		want *UnstructuredList
+	}{
+		"FromGroupVersionKindToList": {
+			opts: []ListOption{FromGroupVersionKindToList(gvk)},
+			want: &UnstructuredList{
+				UnstructuredList: unstructured.UnstructuredList{
+					Object: map[string]any{
+						"apiVersion": "example.org/v1",
+						"kind":       "CoolList",
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := NewList(tc.opts...)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("NewList(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
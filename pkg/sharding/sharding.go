@@ -0,0 +1,178 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package sharding assigns managed resources to controller replicas, allowing
+// a provider to scale out beyond a single active replica.
+//
+// Each managed resource is assigned to a shard by a consistent hash of its
+// UID, which is recorded in a label. Each replica caches and reconciles only
+// the managed resources labelled with its shard. Shard zero also caches
+// managed resources that are not yet labelled, and labels them using a
+// Labeler. A labelled managed resource leaves shard zero's cache and enters
+// the cache of the shard it was assigned to.
+package sharding
+
+import (
+	"context"
+	"hash/fnv"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+)
+
+// LabelKeyShard is the label used to record the shard a managed resource is
+// assigned to.
+const LabelKeyShard = "crossplane.io/shard"
+
+// Error strings.
+const (
+	errUpdateManaged = "cannot update managed resource"
+	errFmtInvalid    = "shard %d is not in the range [0, %d)"
+	errSelector      = "cannot build shard label selector"
+)
+
+// Shard returns the shard, in the range [0, shards), that the supplied UID is
+// assigned to. It uses a jump consistent hash, so changing the number of
+// shards reassigns as few managed resources as possible.
+func Shard(uid types.UID, shards int) int {
+	if shards <= 1 {
+		return 0
+	}
+
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(uid))
+
+	return jump(h.Sum64(), shards)
+}
+
+// jump implements the jump consistent hash described in 'A Fast, Minimal
+// Memory, Consistent Hash Algorithm' by Lamping and Veach.
+func jump(key uint64, buckets int) int {
+	var b, j int64 = -1, 0
+	for j < int64(buckets) {
+		b = j
+		key = key*2862933555777941757 + 1
+		j = int64(float64(b+1) * (float64(int64(1)<<31) / float64((key>>33)+1)))
+	}
+
+	return int(b)
+}
+
+// Selector returns a label selector that matches the managed resources the
+// supplied shard should reconcile. Shard zero matches managed resources that
+// are labelled with shard zero, or that aren't labelled.
+func Selector(shard, shards int) (labels.Selector, error) {
+	if shard < 0 || shard >= shards {
+		return nil, errors.Errorf(errFmtInvalid, shard, shards)
+	}
+
+	if shards == 1 {
+		return labels.Everything(), nil
+	}
+
+	if shard > 0 {
+		r, err := labels.NewRequirement(LabelKeyShard, selection.Equals, []string{strconv.Itoa(shard)})
+		if err != nil {
+			return nil, errors.Wrap(err, errSelector)
+		}
+
+		return labels.NewSelector().Add(*r), nil
+	}
+
+	// A NotIn requirement also matches objects that don't have the label.
+	others := make([]string, 0, shards-1)
+	for i := 1; i < shards; i++ {
+		others = append(others, strconv.Itoa(i))
+	}
+
+	r, err := labels.NewRequirement(LabelKeyShard, selection.NotIn, others)
+	if err != nil {
+		return nil, errors.Wrap(err, errSelector)
+	}
+
+	return labels.NewSelector().Add(*r), nil
+}
+
+// ByObject returns cache options that restrict the cache of the supplied
+// shard to the managed resources it should reconcile. Supply them as the
+// ByObject cache options of the controller manager, e.g:
+//
+//	bo, err := sharding.ByObject(shard, shards, &v1.Bucket{}, &v1.Queue{})
+//	mgr, err := ctrl.NewManager(cfg, ctrl.Options{Cache: cache.Options{ByObject: bo}})
+func ByObject(shard, shards int, objs ...client.Object) (map[client.Object]cache.ByObject, error) {
+	s, err := Selector(shard, shards)
+	if err != nil {
+		return nil, err
+	}
+
+	bo := make(map[client.Object]cache.ByObject, len(objs))
+	for _, o := range objs {
+		bo[o] = cache.ByObject{Label: s}
+	}
+
+	return bo, nil
+}
+
+// A Labeler labels managed resources with the shard they're assigned to. It
+// satisfies the managed reconciler's Initializer interface, and should be
+// used by shard zero, which is the only shard that reconciles managed
+// resources that aren't yet labelled.
+type Labeler struct {
+	client client.Client
+	shards int
+}
+
+// NewLabeler returns a Labeler that assigns managed resources to one of the
+// supplied number of shards.
+func NewLabeler(c client.Client, shards int) *Labeler {
+	return &Labeler{client: c, shards: shards}
+}
+
+// Initialize labels the supplied managed resource with the shard it's assigned
+// to. Managed resources that are already labelled with a valid shard aren't
+// reassigned. Those labelled with a shard that no longer exists, because the
+// number of shards was reduced, are matched by shard zero and reassigned.
+// Initialize returns managed.ErrReassigned if it assigns the managed resource
+// to a shard other than zero, so that shard zero stops reconciling it.
+func (l *Labeler) Initialize(ctx context.Context, mg resource.Managed) error {
+	if v, ok := mg.GetLabels()[LabelKeyShard]; ok {
+		if s, err := strconv.Atoi(v); err == nil && s >= 0 && s < l.shards {
+			return nil
+		}
+	}
+
+	s := Shard(mg.GetUID(), l.shards)
+	meta.AddLabels(mg, map[string]string{LabelKeyShard: strconv.Itoa(s)})
+
+	if err := l.client.Update(ctx, mg); err != nil {
+		return errors.Wrap(err, errUpdateManaged)
+	}
+
+	if s != 0 {
+		return managed.ErrReassigned
+	}
+
+	return nil
+}
@@ -0,0 +1,253 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sharding
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+)
+
+func TestShard(t *testing.T) {
+	const shards = 8
+
+	moved := 0
+	for i := range 1000 {
+		uid := types.UID(fmt.Sprintf("uid-%d", i))
+
+		s := Shard(uid, shards)
+		if s < 0 || s >= shards {
+			t.Fatalf("Shard(%q, %d): got %d, want a shard in [0, %d)", uid, shards, s, shards)
+		}
+
+		if s != Shard(uid, shards) {
+			t.Fatalf("Shard(%q, %d): want a stable assignment", uid, shards)
+		}
+
+		if s != Shard(uid, shards+1) {
+			moved++
+		}
+	}
+
+	// Adding a ninth shard should move roughly one ninth of the UIDs.
+	if moved > 200 {
+		t.Errorf("Shard(...): adding a shard moved %d of 1000 UIDs", moved)
+	}
+
+	if got := Shard("uid", 1); got != 0 {
+		t.Errorf("Shard(uid, 1): got %d, want 0", got)
+	}
+}
+
+func TestSelector(t *testing.T) {
+	type args struct {
+		shard  int
+		shards int
+	}
+	type want struct {
+		matches map[string]bool
+		err     error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"InvalidShard": {
+			reason: "We should return an error for a shard that is out of range.",
+			args:   args{shard: 3, shards: 3},
+			want:   want{err: errors.Errorf(errFmtInvalid, 3, 3)},
+		},
+		"SingleShard": {
+			reason: "A single shard should match all managed resources.",
+			args:   args{shard: 0, shards: 1},
+			want:   want{matches: map[string]bool{"": true, "0": true, "1": true}},
+		},
+		"ShardZero": {
+			reason: "Shard zero should match unlabelled managed resources, and those labelled with unknown shards.",
+			args:   args{shard: 0, shards: 3},
+			want:   want{matches: map[string]bool{"": true, "0": true, "1": false, "2": false, "3": true}},
+		},
+		"OtherShard": {
+			reason: "Other shards should match only managed resources labelled with their shard.",
+			args:   args{shard: 2, shards: 3},
+			want:   want{matches: map[string]bool{"": false, "0": false, "1": false, "2": true}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s, err := Selector(tc.args.shard, tc.args.shards)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nSelector(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+
+			if err != nil {
+				return
+			}
+
+			got := map[string]bool{}
+			for v := range tc.want.matches {
+				l := labels.Set{}
+				if v != "" {
+					l[LabelKeyShard] = v
+				}
+				got[v] = s.Matches(l)
+			}
+
+			if diff := cmp.Diff(tc.want.matches, got); diff != "" {
+				t.Errorf("\n%s\nSelector(...).Matches(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+// uidFor returns a UID that is assigned to the supplied shard.
+func uidFor(t *testing.T, shard, shards int) types.UID {
+	t.Helper()
+
+	for i := range 1000 {
+		uid := types.UID(fmt.Sprintf("cool-uid-%d", i))
+		if Shard(uid, shards) == shard {
+			return uid
+		}
+	}
+
+	t.Fatalf("cannot find a UID assigned to shard %d", shard)
+
+	return ""
+}
+
+func TestLabeler(t *testing.T) {
+	errBoom := errors.New("boom")
+	zero := uidFor(t, 0, 3)
+	other := uidFor(t, 2, 3)
+
+	type args struct {
+		c  client.Client
+		mg resource.Managed
+	}
+	type want struct {
+		label string
+		err   error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"AlreadyLabelled": {
+			reason: "We should not reassign a managed resource labelled with a valid shard.",
+			args: args{
+				c:  &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				mg: &fake.Managed{ObjectMeta: metav1.ObjectMeta{UID: other, Labels: map[string]string{LabelKeyShard: "1"}}},
+			},
+			want: want{label: "1"},
+		},
+		"AssignedToShardZero": {
+			reason: "We should label an unlabelled managed resource assigned to shard zero, and carry on reconciling it.",
+			args: args{
+				c:  &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				mg: &fake.Managed{ObjectMeta: metav1.ObjectMeta{UID: zero}},
+			},
+			want: want{label: "0"},
+		},
+		"AssignedToOtherShard": {
+			reason: "We should label an unlabelled managed resource assigned to another shard, and stop reconciling it.",
+			args: args{
+				c:  &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				mg: &fake.Managed{ObjectMeta: metav1.ObjectMeta{UID: other}},
+			},
+			want: want{label: "2", err: managed.ErrReassigned},
+		},
+		"UnknownShard": {
+			reason: "We should reassign a managed resource labelled with a shard that no longer exists.",
+			args: args{
+				c:  &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				mg: &fake.Managed{ObjectMeta: metav1.ObjectMeta{UID: zero, Labels: map[string]string{LabelKeyShard: "7"}}},
+			},
+			want: want{label: "0"},
+		},
+		"UpdateError": {
+			reason: "We should return any error encountered updating the managed resource.",
+			args: args{
+				c:  &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				mg: &fake.Managed{ObjectMeta: metav1.ObjectMeta{UID: other}},
+			},
+			want: want{label: "2", err: errors.Wrap(errBoom, errUpdateManaged)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := NewLabeler(tc.args.c, 3).Initialize(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nInitialize(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.want.label, tc.args.mg.GetLabels()[LabelKeyShard]); diff != "" {
+				t.Errorf("\n%s\nInitialize(...): -want label, +got label:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestLabelerStopsReconcile(t *testing.T) {
+	uid := uidFor(t, 2, 3)
+
+	c := &test.MockClient{
+		MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+			obj.SetUID(uid)
+			return nil
+		}),
+		MockUpdate:       test.NewMockUpdateFn(nil),
+		MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+	}
+	m := &fake.Manager{Client: c, Scheme: fake.SchemeWith(&fake.ModernManaged{})}
+
+	r := managed.NewReconciler(m, resource.ManagedKind(fake.GVK(&fake.ModernManaged{})),
+		managed.WithInitializers(NewLabeler(c, 3)),
+		managed.WithExternalConnector(managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+			t.Errorf("Connect(...): want no external calls after the managed resource was reassigned to another shard")
+			return nil, errors.New("reassigned")
+		})),
+	)
+
+	got, err := r.Reconcile(context.Background(), reconcile.Request{})
+	if err != nil {
+		t.Errorf("Reconcile(...): %v", err)
+	}
+
+	if diff := cmp.Diff(reconcile.Result{}, got); diff != "" {
+		t.Errorf("Reconcile(...): -want, +got:\n%s", diff)
+	}
+}
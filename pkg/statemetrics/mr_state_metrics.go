@@ -18,17 +18,20 @@ package statemetrics
 
 import (
 	"context"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 )
 
@@ -37,6 +40,7 @@ type MRStateMetrics struct {
 	Exists *prometheus.GaugeVec
 	Ready  *prometheus.GaugeVec
 	Synced *prometheus.GaugeVec
+	Paused *prometheus.GaugeVec
 }
 
 // NewMRStateMetrics returns a new MRStateMetrics.
@@ -57,6 +61,11 @@ func NewMRStateMetrics() *MRStateMetrics {
 			Name:      "managed_resource_synced",
 			Help:      "The number of managed resources in Synced=True state",
 		}, []string{"gvk"}),
+		Paused: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Subsystem: subSystem,
+			Name:      "managed_resource_paused",
+			Help:      "The number of managed resources whose reconciliation is paused",
+		}, []string{"gvk"}),
 	}
 }
 
@@ -67,6 +76,7 @@ func (r *MRStateMetrics) Describe(ch chan<- *prometheus.Desc) {
 	r.Exists.Describe(ch)
 	r.Ready.Describe(ch)
 	r.Synced.Describe(ch)
+	r.Paused.Describe(ch)
 }
 
 // Collect is called by the Prometheus registry when collecting
@@ -76,6 +86,24 @@ func (r *MRStateMetrics) Collect(ch chan<- prometheus.Metric) {
 	r.Exists.Collect(ch)
 	r.Ready.Collect(ch)
 	r.Synced.Collect(ch)
+	r.Paused.Collect(ch)
+}
+
+// maxReportedPaused is the maximum number of names of managed resources that
+// have been paused for too long to include in a single log message.
+const maxReportedPaused = 10
+
+// A MRStateRecorderOption configures a MRStateRecorder.
+type MRStateRecorderOption func(r *MRStateRecorder)
+
+// WithPausedReportAfter configures the MRStateRecorder to log a summary of
+// the managed resources that have been paused for longer than the supplied
+// duration each time it records their state, so that forgotten pauses are
+// discoverable. No summary is logged by default.
+func WithPausedReportAfter(d time.Duration) MRStateRecorderOption {
+	return func(r *MRStateRecorder) {
+		r.pausedReportAfter = d
+	}
 }
 
 // A MRStateRecorder records the state of managed resources.
@@ -85,18 +113,26 @@ type MRStateRecorder struct {
 	interval    time.Duration
 	managedList resource.ManagedList
 
+	pausedReportAfter time.Duration
+
 	metrics *MRStateMetrics
 }
 
 // NewMRStateRecorder returns a new MRStateRecorder which records the state of managed resources.
-func NewMRStateRecorder(c client.Client, log logging.Logger, metrics *MRStateMetrics, managedList resource.ManagedList, interval time.Duration) *MRStateRecorder {
-	return &MRStateRecorder{
+func NewMRStateRecorder(c client.Client, log logging.Logger, metrics *MRStateMetrics, managedList resource.ManagedList, interval time.Duration, o ...MRStateRecorderOption) *MRStateRecorder {
+	r := &MRStateRecorder{
 		client:      c,
 		log:         log,
 		metrics:     metrics,
 		managedList: managedList,
 		interval:    interval,
 	}
+
+	for _, fn := range o {
+		fn(r)
+	}
+
+	return r
 }
 
 // Record records the state of managed resources.
@@ -113,20 +149,41 @@ func (r *MRStateRecorder) Record(ctx context.Context) error {
 	mrs := r.managedList.GetItems()
 	r.metrics.Exists.With(labels).Set(float64(len(mrs)))
 
-	var numReady, numSynced float64 = 0, 0
+	var numReady, numSynced, numPaused float64 = 0, 0, 0
+
+	tooLong := make([]string, 0)
 
 	for _, o := range mrs {
 		if o.GetCondition(xpv1.TypeReady).Status == corev1.ConditionTrue {
 			numReady++
 		}
 
-		if o.GetCondition(xpv1.TypeSynced).Status == corev1.ConditionTrue {
+		synced := o.GetCondition(xpv1.TypeSynced)
+		if synced.Status == corev1.ConditionTrue {
 			numSynced++
 		}
+
+		// Resources paused using their management policies don't have the
+		// pause annotation, but are marked paused by the reconciler.
+		if !meta.IsPaused(o) && synced.Reason != xpv1.ReasonReconcilePaused {
+			continue
+		}
+
+		numPaused++
+
+		if r.pausedReportAfter > 0 && synced.Reason == xpv1.ReasonReconcilePaused && time.Since(synced.LastTransitionTime.Time) > r.pausedReportAfter {
+			tooLong = append(tooLong, types.NamespacedName{Namespace: o.GetNamespace(), Name: o.GetName()}.String())
+		}
 	}
 
 	r.metrics.Ready.With(labels).Set(numReady)
 	r.metrics.Synced.With(labels).Set(numSynced)
+	r.metrics.Paused.With(labels).Set(numPaused)
+
+	if len(tooLong) > 0 {
+		slices.Sort(tooLong)
+		r.log.Info("Managed resources have been paused for longer than expected", "gvk", labels["gvk"], "paused-after", r.pausedReportAfter, "count", len(tooLong), "names", tooLong[:min(len(tooLong), maxReportedPaused)])
+	}
 
 	return nil
 }
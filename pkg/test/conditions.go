@@ -0,0 +1,86 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+// EquateConditionsIgnoringTimeAndOrder sorts any slices of Crossplane or
+// Kubernetes conditions by type, and ignores their last transition times,
+// before comparing them.
+func EquateConditionsIgnoringTimeAndOrder() cmp.Option {
+	return cmp.Options{
+		// Crossplane conditions' Equal method already ignores their last
+		// transition time.
+		EquateConditions(),
+		cmpopts.SortSlices(func(i, j metav1.Condition) bool { return i.Type < j.Type }),
+		cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime"),
+	}
+}
+
+// DiffCondition compares the condition of the supplied type with the supplied
+// condition. Only the fields of the supplied condition that are set are
+// compared, so e.g. a condition with only a type and reason asserts only the
+// reason. The last transition time is never compared. It returns a
+// human-readable diff if the condition doesn't match, or an empty string if it
+// does.
+func DiffCondition(got []xpv1.Condition, want xpv1.Condition) string {
+	for _, c := range got {
+		if c.Type != want.Type {
+			continue
+		}
+
+		partial := xpv1.Condition{Type: c.Type}
+		if want.Status != "" {
+			partial.Status = c.Status
+		}
+
+		if want.Reason != "" {
+			partial.Reason = c.Reason
+		}
+
+		if want.Message != "" {
+			partial.Message = c.Message
+		}
+
+		if want.ObservedGeneration != 0 {
+			partial.ObservedGeneration = c.ObservedGeneration
+		}
+
+		return cmp.Diff(want, partial, cmpopts.IgnoreFields(xpv1.Condition{}, "LastTransitionTime"))
+	}
+
+	return fmt.Sprintf("no condition of type %q", want.Type)
+}
+
+// AssertCondition fails the test if the condition of the supplied type doesn't
+// match the supplied condition. See DiffCondition.
+func AssertCondition(t testing.TB, got []xpv1.Condition, want xpv1.Condition) {
+	t.Helper()
+
+	if diff := DiffCondition(got, want); diff != "" {
+		t.Errorf("condition %q: -want, +got:\n%s", want.Type, diff)
+	}
+}
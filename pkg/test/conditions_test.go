@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+func TestEquateConditionsIgnoringTimeAndOrder(t *testing.T) {
+	then := metav1.NewTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	now := metav1.NewTime(then.Add(time.Hour))
+
+	a := []metav1.Condition{
+		{Type: "Ready", Status: metav1.ConditionTrue, LastTransitionTime: then},
+		{Type: "Synced", Status: metav1.ConditionTrue, LastTransitionTime: then},
+	}
+	b := []metav1.Condition{
+		{Type: "Synced", Status: metav1.ConditionTrue, LastTransitionTime: now},
+		{Type: "Ready", Status: metav1.ConditionTrue, LastTransitionTime: now},
+	}
+
+	if diff := cmp.Diff(a, b, EquateConditionsIgnoringTimeAndOrder()); diff != "" {
+		t.Errorf("cmp.Diff(...): want Kubernetes conditions to be equal, -want, +got:\n%s", diff)
+	}
+
+	x := []xpv1.Condition{xpv1.Available(), xpv1.ReconcileSuccess()}
+	y := []xpv1.Condition{xpv1.ReconcileSuccess(), xpv1.Available()}
+	y[0].LastTransitionTime = now
+
+	if diff := cmp.Diff(x, y, EquateConditionsIgnoringTimeAndOrder()); diff != "" {
+		t.Errorf("cmp.Diff(...): want Crossplane conditions to be equal, -want, +got:\n%s", diff)
+	}
+}
+
+func TestDiffCondition(t *testing.T) {
+	got := []xpv1.Condition{
+		xpv1.Available().WithObservedGeneration(3),
+		xpv1.ReconcileError(errors.New("boom")),
+	}
+
+	cases := map[string]struct {
+		reason   string
+		want     xpv1.Condition
+		wantDiff bool
+	}{
+		"ReasonOnly": {
+			reason: "A condition with only a type and reason should match on reason.",
+			want:   xpv1.Condition{Type: xpv1.TypeReady, Reason: xpv1.ReasonAvailable},
+		},
+		"StatusOnly": {
+			reason: "A condition with only a type and status should match on status.",
+			want:   xpv1.Condition{Type: xpv1.TypeSynced, Status: corev1.ConditionFalse},
+		},
+		"Full": {
+			reason: "A fully specified condition should match every field except the last transition time.",
+			want:   xpv1.Available().WithObservedGeneration(3),
+		},
+		"Mismatch": {
+			reason:   "A condition with a different reason shouldn't match.",
+			want:     xpv1.Condition{Type: xpv1.TypeReady, Reason: xpv1.ReasonUnavailable},
+			wantDiff: true,
+		},
+		"Missing": {
+			reason:   "A condition of a type that isn't present shouldn't match.",
+			want:     xpv1.Condition{Type: "Healthy", Status: corev1.ConditionTrue},
+			wantDiff: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			diff := DiffCondition(got, tc.want)
+			if (diff != "") != tc.wantDiff {
+				t.Errorf("\n%s\nDiffCondition(...): want diff %t, got %q", tc.reason, tc.wantDiff, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,198 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"sigs.k8s.io/yaml"
+)
+
+// UpdateGoldenEnvVar is the environment variable that, when set to "true",
+// causes AssertGolden to update golden files rather than compare against them.
+const UpdateGoldenEnvVar = "UPDATE_GOLDEN"
+
+// RedactedValue replaces the value of any redacted field.
+const RedactedValue = "REDACTED"
+
+// DefaultScrubbedFields are removed from all objects serialized by
+// CanonicalYAML. They're set by the API server, and thus vary between runs.
+// A path segment of * matches all elements of an array or values of an
+// object.
+//
+//nolint:gochecknoglobals // We treat this as a constant.
+var DefaultScrubbedFields = []string{
+	"metadata.creationTimestamp",
+	"metadata.generation",
+	"metadata.managedFields",
+	"metadata.resourceVersion",
+	"metadata.selfLink",
+	"metadata.uid",
+	"status.conditions.*.lastTransitionTime",
+}
+
+type canonicalOptions struct {
+	scrub  []string
+	redact []string
+}
+
+// A CanonicalOption configures how CanonicalYAML serializes an object.
+type CanonicalOption func(o *canonicalOptions)
+
+// WithScrubbedFields removes the supplied dot separated field paths, in
+// addition to DefaultScrubbedFields.
+func WithScrubbedFields(paths ...string) CanonicalOption {
+	return func(o *canonicalOptions) {
+		o.scrub = append(o.scrub, paths...)
+	}
+}
+
+// WithRedactedFields replaces the values of the supplied dot separated field
+// paths with RedactedValue, if they exist. This is useful for fields like
+// secret data that should not be written to golden files.
+func WithRedactedFields(paths ...string) CanonicalOption {
+	return func(o *canonicalOptions) {
+		o.redact = append(o.redact, paths...)
+	}
+}
+
+// CanonicalYAML serializes the supplied object as YAML with sorted keys, with
+// fields that are set by the API server removed. The result is stable across
+// runs and thus suitable for comparison with a golden file.
+func CanonicalYAML(obj any, o ...CanonicalOption) ([]byte, error) {
+	opts := &canonicalOptions{scrub: append([]string{}, DefaultScrubbedFields...)}
+	for _, fn := range o {
+		fn(opts)
+	}
+
+	j, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	var content any
+	if err := json.Unmarshal(j, &content); err != nil {
+		return nil, err
+	}
+
+	for _, p := range opts.scrub {
+		walk(content, strings.Split(p, "."), func(parent map[string]any, key string) {
+			delete(parent, key)
+		})
+	}
+
+	for _, p := range opts.redact {
+		walk(content, strings.Split(p, "."), func(parent map[string]any, key string) {
+			parent[key] = RedactedValue
+		})
+	}
+
+	// Objects are marshalled via JSON, which sorts map keys.
+	return yaml.Marshal(content)
+}
+
+// walk calls fn for each object field matching the supplied path segments.
+func walk(v any, segments []string, fn func(parent map[string]any, key string)) {
+	if len(segments) == 0 {
+		return
+	}
+
+	s := segments[0]
+
+	switch t := v.(type) {
+	case map[string]any:
+		if s == "*" {
+			for k, child := range t {
+				if len(segments) == 1 {
+					fn(t, k)
+					continue
+				}
+
+				walk(child, segments[1:], fn)
+			}
+
+			return
+		}
+
+		child, ok := t[s]
+		if !ok {
+			return
+		}
+
+		if len(segments) == 1 {
+			fn(t, s)
+			return
+		}
+
+		walk(child, segments[1:], fn)
+	case []any:
+		if s != "*" {
+			return
+		}
+
+		for _, child := range t {
+			walk(child, segments[1:], fn)
+		}
+	}
+}
+
+// AssertGolden compares the supplied bytes with the content of the golden
+// file at the supplied path, and reports an error if they differ. If the
+// UpdateGoldenEnvVar environment variable is set to "true" the golden file is
+// written instead.
+func AssertGolden(tb testing.TB, path string, got []byte) {
+	tb.Helper()
+
+	if os.Getenv(UpdateGoldenEnvVar) == "true" {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			tb.Fatalf("cannot create golden file directory: %v", err)
+		}
+
+		if err := os.WriteFile(path, got, 0o600); err != nil {
+			tb.Fatalf("cannot write golden file %s: %v", path, err)
+		}
+
+		return
+	}
+
+	want, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		tb.Fatalf("cannot read golden file %s (set %s=true to create it): %v", path, UpdateGoldenEnvVar, err)
+	}
+
+	if diff := cmp.Diff(string(want), string(got)); diff != "" {
+		tb.Errorf("%s: -want golden, +got:\n%s", path, diff)
+	}
+}
+
+// AssertGoldenYAML serializes the supplied object using CanonicalYAML and
+// compares it with the golden file at the supplied path using AssertGolden.
+func AssertGoldenYAML(tb testing.TB, path string, obj any, o ...CanonicalOption) {
+	tb.Helper()
+
+	got, err := CanonicalYAML(obj, o...)
+	if err != nil {
+		tb.Fatalf("cannot serialize object as canonical YAML: %v", err)
+	}
+
+	AssertGolden(tb, path, got)
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCanonicalYAML(t *testing.T) {
+	type args struct {
+		obj any
+		o   []CanonicalOption
+	}
+
+	type want struct {
+		yaml string
+		err  error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"ScrubServerSetFields": {
+			reason: "Fields set by the API server should be removed, and keys should be sorted.",
+			args: args{
+				obj: map[string]any{
+					"kind":       "Cool",
+					"apiVersion": "example.org/v1",
+					"metadata": map[string]any{
+						"name":              "cool",
+						"uid":               "some-uid",
+						"resourceVersion":   "42",
+						"creationTimestamp": "2026-01-01T00:00:00Z",
+					},
+					"status": map[string]any{
+						"conditions": []any{
+							map[string]any{"type": "Ready", "status": "True", "lastTransitionTime": "2026-01-01T00:00:00Z"},
+						},
+					},
+				},
+			},
+			want: want{
+				yaml: `apiVersion: example.org/v1
+kind: Cool
+metadata:
+  name: cool
+status:
+  conditions:
+  - status: "True"
+    type: Ready
+`,
+			},
+		},
+		"ScrubAndRedactFields": {
+			reason: "Additional scrubbed fields should be removed, and redacted fields should be replaced.",
+			args: args{
+				obj: map[string]any{
+					"metadata": map[string]any{"name": "cool", "labels": map[string]any{"a": "b"}},
+					"data":     map[string]any{"password": "secret", "username": "admin"},
+				},
+				o: []CanonicalOption{
+					WithScrubbedFields("metadata.labels"),
+					WithRedactedFields("data.*", "data.missing"),
+				},
+			},
+			want: want{
+				yaml: `data:
+  password: REDACTED
+  username: REDACTED
+metadata:
+  name: cool
+`,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := CanonicalYAML(tc.args.obj, tc.args.o...)
+			if diff := cmp.Diff(tc.want.err, err, EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCanonicalYAML(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+
+			if diff := cmp.Diff(tc.want.yaml, string(got)); diff != "" {
+				t.Errorf("\n%s\nCanonicalYAML(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestAssertGolden(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "golden", "cool.yaml")
+
+	t.Setenv(UpdateGoldenEnvVar, "true")
+	AssertGolden(t, path, []byte("cool: true\n"))
+
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("AssertGolden(...): cannot read updated golden file: %v", err)
+	}
+
+	if diff := cmp.Diff("cool: true\n", string(got)); diff != "" {
+		t.Errorf("AssertGolden(...): -want golden, +got golden:\n%s", diff)
+	}
+
+	t.Setenv(UpdateGoldenEnvVar, "")
+	AssertGolden(t, path, []byte("cool: true\n"))
+}
@@ -0,0 +1,270 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package integration provides an envtest based harness for running managed
+// resource reconcilers against a real API server in integration tests.
+//
+// The harness lives here rather than in package test because it depends on the
+// managed reconciler, whose own tests depend on package test.
+package integration
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+)
+
+const (
+	defaultWaitTimeout  = 30 * time.Second
+	defaultWaitInterval = 250 * time.Millisecond
+)
+
+// Error strings.
+const (
+	errStartEnvironment = "cannot start envtest environment"
+	errStopEnvironment  = "cannot stop envtest environment"
+	errNewManager       = "cannot create controller manager"
+	errSetupController  = "cannot setup managed resource controller"
+	errNotManaged       = "supplied kind is not a registered managed resource"
+	errFmtWaitCondition = "timed out waiting for condition %s to be %s"
+	errFmtWaitFinalizer = "timed out waiting for finalizer %s"
+	errWaitSecret       = "timed out waiting for connection secret"
+	errWaitDeletion     = "timed out waiting for managed resource to be deleted"
+)
+
+// A HarnessOption configures a ManagedReconcilerHarness.
+type HarnessOption func(h *ManagedReconcilerHarness)
+
+// WithCRDDirectoryPaths configures the directories from which CRDs will be
+// installed into the envtest API server.
+func WithCRDDirectoryPaths(paths ...string) HarnessOption {
+	return func(h *ManagedReconcilerHarness) {
+		h.env.CRDDirectoryPaths = append(h.env.CRDDirectoryPaths, paths...)
+	}
+}
+
+// WithCRDs configures CRDs that will be installed into the envtest API server.
+func WithCRDs(crds ...*apiextensionsv1.CustomResourceDefinition) HarnessOption {
+	return func(h *ManagedReconcilerHarness) {
+		h.env.CRDs = append(h.env.CRDs, crds...)
+	}
+}
+
+// WithScheme configures the scheme used by the controller manager. The scheme
+// must include the managed resource kind being reconciled.
+func WithScheme(s *runtime.Scheme) HarnessOption {
+	return func(h *ManagedReconcilerHarness) {
+		h.scheme = s
+	}
+}
+
+// WithExternalConnector configures the ExternalConnector used by the
+// reconciler, typically one backed by a fake ExternalClient. The reconciler
+// uses a no-op ExternalClient by default.
+func WithExternalConnector(c managed.ExternalConnector) HarnessOption {
+	return func(h *ManagedReconcilerHarness) {
+		h.reconcilerOptions = append(h.reconcilerOptions, managed.WithExternalConnector(c))
+	}
+}
+
+// WithReconcilerOptions configures additional options for the reconciler.
+func WithReconcilerOptions(o ...managed.ReconcilerOption) HarnessOption {
+	return func(h *ManagedReconcilerHarness) {
+		h.reconcilerOptions = append(h.reconcilerOptions, o...)
+	}
+}
+
+// WithWaitTimeout configures how long the harness's Wait methods will wait
+// before giving up, and how often they will check.
+func WithWaitTimeout(timeout, interval time.Duration) HarnessOption {
+	return func(h *ManagedReconcilerHarness) {
+		h.timeout = timeout
+		h.interval = interval
+	}
+}
+
+// A ManagedReconcilerHarness runs a managed resource reconciler against an
+// envtest API server.
+type ManagedReconcilerHarness struct {
+	env    *envtest.Environment
+	scheme *runtime.Scheme
+	client client.Client
+	cancel context.CancelFunc
+
+	reconcilerOptions []managed.ReconcilerOption
+
+	timeout  time.Duration
+	interval time.Duration
+}
+
+// NewManagedReconcilerHarness starts an envtest API server, installs the
+// supplied CRDs, and starts a controller manager running a managed resource
+// reconciler for the supplied kind. Callers must call Stop when they are done
+// with the harness.
+func NewManagedReconcilerHarness(ctx context.Context, of resource.ManagedKind, o ...HarnessOption) (*ManagedReconcilerHarness, error) {
+	h := &ManagedReconcilerHarness{
+		env:      &envtest.Environment{ErrorIfCRDPathMissing: true},
+		scheme:   runtime.NewScheme(),
+		timeout:  defaultWaitTimeout,
+		interval: defaultWaitInterval,
+	}
+
+	for _, fn := range o {
+		fn(h)
+	}
+
+	// Fail before starting the environment if we've been asked to reconcile
+	// a kind that isn't registered with our scheme.
+	obj, err := resource.CreateObject(schema.GroupVersionKind(of), h.scheme)
+	if err != nil {
+		return nil, errors.Wrap(err, errNotManaged)
+	}
+
+	mg, ok := obj.(resource.Managed)
+	if !ok {
+		return nil, errors.New(errNotManaged)
+	}
+
+	cfg, err := h.env.Start()
+	if err != nil {
+		return nil, errors.Wrap(err, errStartEnvironment)
+	}
+
+	mgr, err := manager.New(cfg, manager.Options{
+		Scheme:  h.scheme,
+		Metrics: metricsserver.Options{BindAddress: "0"},
+	})
+	if err != nil {
+		return nil, errors.Join(errors.Wrap(err, errNewManager), errors.Wrap(h.env.Stop(), errStopEnvironment))
+	}
+
+	r := managed.NewReconciler(mgr, of, h.reconcilerOptions...)
+	if err := ctrl.NewControllerManagedBy(mgr).Named(managed.ControllerName(of.Kind)).For(mg).Complete(r); err != nil {
+		return nil, errors.Join(errors.Wrap(err, errSetupController), errors.Wrap(h.env.Stop(), errStopEnvironment))
+	}
+
+	ctx, h.cancel = context.WithCancel(ctx)
+
+	go func() {
+		// The manager only returns when its context is cancelled, or if
+		// it fails to start. Either way the Wait methods will time out.
+		_ = mgr.Start(ctx)
+	}()
+
+	h.client = mgr.GetClient()
+
+	return h, nil
+}
+
+// Client returns a client for the envtest API server. Reads are served from
+// the controller manager's cache.
+func (h *ManagedReconcilerHarness) Client() client.Client {
+	return h.client
+}
+
+// Stop the controller manager and the envtest API server.
+func (h *ManagedReconcilerHarness) Stop() error {
+	h.cancel()
+	return errors.Wrap(h.env.Stop(), errStopEnvironment)
+}
+
+// WaitForCondition waits until the managed resource identified by the supplied
+// key has a condition of the supplied type and status. The supplied managed
+// resource is updated with the latest state read from the API server.
+func (h *ManagedReconcilerHarness) WaitForCondition(ctx context.Context, key types.NamespacedName, mg resource.Managed, ct xpv1.ConditionType, s corev1.ConditionStatus) error {
+	err := h.poll(ctx, func(ctx context.Context) (bool, error) {
+		if err := h.client.Get(ctx, key, mg); err != nil {
+			return false, resource.IgnoreNotFound(err)
+		}
+
+		return mg.GetCondition(ct).Status == s, nil
+	})
+
+	return errors.Wrapf(err, errFmtWaitCondition, ct, s)
+}
+
+// WaitForFinalizer waits until the managed resource identified by the supplied
+// key has the supplied finalizer. The supplied managed resource is updated with
+// the latest state read from the API server.
+func (h *ManagedReconcilerHarness) WaitForFinalizer(ctx context.Context, key types.NamespacedName, mg resource.Managed, finalizer string) error {
+	err := h.poll(ctx, func(ctx context.Context) (bool, error) {
+		if err := h.client.Get(ctx, key, mg); err != nil {
+			return false, resource.IgnoreNotFound(err)
+		}
+
+		return meta.FinalizerExists(mg, finalizer), nil
+	})
+
+	return errors.Wrapf(err, errFmtWaitFinalizer, finalizer)
+}
+
+// WaitForSecret waits until the Secret identified by the supplied key exists
+// and contains all of the supplied keys, then returns it.
+func (h *ManagedReconcilerHarness) WaitForSecret(ctx context.Context, key types.NamespacedName, keys ...string) (*corev1.Secret, error) {
+	s := &corev1.Secret{}
+	err := h.poll(ctx, func(ctx context.Context) (bool, error) {
+		if err := h.client.Get(ctx, key, s); err != nil {
+			return false, resource.IgnoreNotFound(err)
+		}
+
+		for _, k := range keys {
+			if _, ok := s.Data[k]; !ok {
+				return false, nil
+			}
+		}
+
+		return true, nil
+	})
+
+	return s, errors.Wrap(err, errWaitSecret)
+}
+
+// WaitForDeletion waits until the managed resource identified by the supplied
+// key no longer exists.
+func (h *ManagedReconcilerHarness) WaitForDeletion(ctx context.Context, key types.NamespacedName, mg resource.Managed) error {
+	err := h.poll(ctx, func(ctx context.Context) (bool, error) {
+		err := h.client.Get(ctx, key, mg)
+		if kerrors.IsNotFound(err) {
+			return true, nil
+		}
+
+		return false, err
+	})
+
+	return errors.Wrap(err, errWaitDeletion)
+}
+
+func (h *ManagedReconcilerHarness) poll(ctx context.Context, fn wait.ConditionWithContextFunc) error {
+	return wait.PollUntilContextTimeout(ctx, h.interval, h.timeout, true, fn)
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integration
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+)
+
+var testGV = schema.GroupVersion{Group: "integration.crossplane.io", Version: "v1"}
+
+type testManagedSpec struct {
+	ManagementPolicies xpv1.ManagementPolicies `json:"managementPolicies,omitempty"`
+}
+
+type testManaged struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   testManagedSpec        `json:"spec"`
+	Status xpv1.ConditionedStatus `json:"status,omitempty"`
+}
+
+func (m *testManaged) SetManagementPolicies(p xpv1.ManagementPolicies) {
+	m.Spec.ManagementPolicies = p
+}
+
+func (m *testManaged) GetManagementPolicies() xpv1.ManagementPolicies {
+	return m.Spec.ManagementPolicies
+}
+
+func (m *testManaged) SetConditions(c ...xpv1.Condition) {
+	m.Status.SetConditions(c...)
+}
+
+func (m *testManaged) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
+	return m.Status.GetCondition(ct)
+}
+
+func (m *testManaged) DeepCopyObject() runtime.Object {
+	out := &testManaged{TypeMeta: m.TypeMeta}
+	m.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	m.Status.DeepCopyInto(&out.Status)
+	out.Spec.ManagementPolicies = append(out.Spec.ManagementPolicies, m.Spec.ManagementPolicies...)
+
+	return out
+}
+
+type testManagedList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []testManaged `json:"items"`
+}
+
+func (l *testManagedList) DeepCopyObject() runtime.Object {
+	out := &testManagedList{TypeMeta: l.TypeMeta}
+	l.ListMeta.DeepCopyInto(&out.ListMeta)
+
+	for i := range l.Items {
+		//nolint:forcetypeassert // A deep copy of a testManaged is a testManaged.
+		out.Items = append(out.Items, *l.Items[i].DeepCopyObject().(*testManaged))
+	}
+
+	return out
+}
+
+func testManagedCRD() *apiextensionsv1.CustomResourceDefinition {
+	return &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: "testmanageds." + testGV.Group},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group: testGV.Group,
+			Names: apiextensionsv1.CustomResourceDefinitionNames{
+				Kind:     "TestManaged",
+				ListKind: "TestManagedList",
+				Plural:   "testmanageds",
+				Singular: "testmanaged",
+			},
+			Scope: apiextensionsv1.NamespaceScoped,
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{{
+				Name:    testGV.Version,
+				Served:  true,
+				Storage: true,
+				Schema: &apiextensionsv1.CustomResourceValidation{
+					OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+						Type:                   "object",
+						XPreserveUnknownFields: ptr.To(true),
+					},
+				},
+				Subresources: &apiextensionsv1.CustomResourceSubresources{
+					Status: &apiextensionsv1.CustomResourceSubresourceStatus{},
+				},
+			}},
+		},
+	}
+}
+
+func TestManagedReconcilerHarness(t *testing.T) {
+	// envtest needs an API server and etcd binaries. See
+	// https://book.kubebuilder.io/reference/envtest for how to install them.
+	if os.Getenv("KUBEBUILDER_ASSETS") == "" {
+		t.Skip("KUBEBUILDER_ASSETS is not set")
+	}
+
+	s := runtime.NewScheme()
+	s.AddKnownTypeWithName(testGV.WithKind("TestManaged"), &testManaged{})
+	s.AddKnownTypeWithName(testGV.WithKind("TestManagedList"), &testManagedList{})
+	metav1.AddToGroupVersion(s, testGV)
+
+	if err := corev1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+
+	// The reconciler uses a no-op ExternalClient by default, which reports
+	// that the external resource doesn't exist until it's created.
+	h, err := NewManagedReconcilerHarness(ctx, resource.ManagedKind(testGV.WithKind("TestManaged")),
+		WithScheme(s),
+		WithCRDs(testManagedCRD()),
+	)
+	if err != nil {
+		t.Fatalf("NewManagedReconcilerHarness(...): %v", err)
+	}
+
+	t.Cleanup(func() {
+		if err := h.Stop(); err != nil {
+			t.Errorf("h.Stop(): %v", err)
+		}
+	})
+
+	key := types.NamespacedName{Namespace: "default", Name: "cool"}
+	mg := &testManaged{ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name}}
+
+	if err := h.Client().Create(ctx, mg); err != nil {
+		t.Fatalf("Create(...): %v", err)
+	}
+
+	if err := h.WaitForFinalizer(ctx, key, mg, managed.FinalizerName); err != nil {
+		t.Errorf("WaitForFinalizer(...): %v", err)
+	}
+
+	if err := h.WaitForCondition(ctx, key, mg, xpv1.TypeSynced, corev1.ConditionTrue); err != nil {
+		t.Errorf("WaitForCondition(...): %v", err)
+	}
+
+	if err := h.Client().Delete(ctx, mg); err != nil {
+		t.Fatalf("Delete(...): %v", err)
+	}
+
+	if err := h.WaitForDeletion(ctx, key, mg); err != nil {
+		t.Errorf("WaitForDeletion(...): %v", err)
+	}
+}
@@ -0,0 +1,240 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package validation validates managed resources using CEL expressions.
+package validation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/cel-go/cel"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/fieldpath"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/webhook"
+)
+
+// VariableSelf is the variable to which a Rule's expression is bound. It
+// contains the entire object being validated, e.g. self.spec.forProvider.
+const VariableSelf = "self"
+
+// Error strings.
+const (
+	errNewEnvironment = "cannot create CEL environment"
+	errPaveObject     = "cannot pave object"
+	errFmtCompile     = "cannot compile rule %q"
+	errFmtProgram     = "cannot create program for rule %q"
+	errFmtNotBool     = "rule %q must evaluate to a bool, not %s"
+	errFmtEvaluate    = "cannot evaluate rule %q"
+	errInvalid        = "managed resource is invalid"
+)
+
+// A Rule that an object must satisfy.
+type Rule struct {
+	// Name of the rule. Used to identify which rule was violated.
+	Name string
+
+	// Expression is a CEL expression that must evaluate to true for the
+	// object to be valid. The object is available as the variable self.
+	Expression string
+
+	// Message explaining the violation when the expression evaluates to
+	// false. Defaults to the expression.
+	Message string
+
+	// FieldPath of the field the rule applies to, e.g. spec.forProvider.
+	// Optional. Used to report violations as admission errors.
+	FieldPath string
+}
+
+// A Violation of a Rule.
+type Violation struct {
+	Rule    string
+	Message string
+	Field   string
+}
+
+// Violations of one or more Rules.
+type Violations []Violation
+
+// Error returns a human readable summary of the violations.
+func (v Violations) Error() string {
+	msgs := make([]string, len(v))
+	for i := range v {
+		msgs[i] = v[i].Message
+	}
+
+	return strings.Join(msgs, "; ")
+}
+
+// Err returns the violations as an error, or nil if there are no violations.
+func (v Violations) Err() error {
+	if len(v) == 0 {
+		return nil
+	}
+
+	return v
+}
+
+// AsAdmissionError returns the violations as an Invalid API error, suitable
+// for returning from an admission webhook. It returns nil if there are no
+// violations.
+func (v Violations) AsAdmissionError(gk schema.GroupKind, name string) error {
+	if len(v) == 0 {
+		return nil
+	}
+
+	errs := make(field.ErrorList, len(v))
+	for i := range v {
+		p := field.NewPath(v[i].Field)
+		if v[i].Field == "" {
+			p = field.NewPath("spec")
+		}
+
+		errs[i] = field.Invalid(p, nil, v[i].Message)
+	}
+
+	return kerrors.NewInvalid(gk, name, errs)
+}
+
+type program struct {
+	rule Rule
+	prg  cel.Program
+}
+
+// A Validator validates objects using a set of CEL rules.
+type Validator struct {
+	programs []program
+}
+
+// NewValidator compiles the supplied rules into a Validator. It returns an
+// error if any rule cannot be compiled, or does not evaluate to a bool.
+func NewValidator(rules ...Rule) (*Validator, error) {
+	env, err := cel.NewEnv(cel.Variable(VariableSelf, cel.DynType))
+	if err != nil {
+		return nil, errors.Wrap(err, errNewEnvironment)
+	}
+
+	v := &Validator{programs: make([]program, 0, len(rules))}
+
+	for _, r := range rules {
+		ast, iss := env.Compile(r.Expression)
+		if iss.Err() != nil {
+			return nil, errors.Wrapf(iss.Err(), errFmtCompile, r.Name)
+		}
+
+		if ast.OutputType() != cel.BoolType && ast.OutputType() != cel.DynType {
+			return nil, errors.Errorf(errFmtNotBool, r.Name, ast.OutputType())
+		}
+
+		prg, err := env.Program(ast)
+		if err != nil {
+			return nil, errors.Wrapf(err, errFmtProgram, r.Name)
+		}
+
+		if r.Message == "" {
+			r.Message = fmt.Sprintf("failed rule: %s", r.Expression)
+		}
+
+		v.programs = append(v.programs, program{rule: r, prg: prg})
+	}
+
+	return v, nil
+}
+
+// Validate the supplied object against all rules. It returns the rules the
+// object violates, if any. It returns an error if a rule could not be
+// evaluated, for example because it referenced a field that does not exist.
+func (v *Validator) Validate(ctx context.Context, o runtime.Object) (Violations, error) {
+	p, err := fieldpath.PaveObject(o)
+	if err != nil {
+		return nil, errors.Wrap(err, errPaveObject)
+	}
+
+	vars := map[string]any{VariableSelf: p.UnstructuredContent()}
+
+	var violations Violations
+
+	for _, pr := range v.programs {
+		out, _, err := pr.prg.ContextEval(ctx, vars)
+		if err != nil {
+			return nil, errors.Wrapf(err, errFmtEvaluate, pr.rule.Name)
+		}
+
+		ok, isBool := out.Value().(bool)
+		if !isBool {
+			return nil, errors.Errorf(errFmtNotBool, pr.rule.Name, out.Type())
+		}
+
+		if !ok {
+			violations = append(violations, Violation{Rule: pr.rule.Name, Message: pr.rule.Message, Field: pr.rule.FieldPath})
+		}
+	}
+
+	return violations, nil
+}
+
+// Initialize returns an error if the supplied managed resource violates any
+// rules. This allows a Validator to be used as a managed reconciler
+// Initializer, in which case violations are surfaced as a Warning event and a
+// ReconcileError condition.
+func (v *Validator) Initialize(ctx context.Context, mg resource.Managed) error {
+	violations, err := v.Validate(ctx, mg)
+	if err != nil {
+		return err
+	}
+
+	return errors.Wrap(violations.Err(), errInvalid)
+}
+
+// ValidateCreateFn returns a function that rejects the creation of objects
+// that violate any rules, for use with a webhook.Validator.
+func (v *Validator) ValidateCreateFn() webhook.ValidateCreateFn {
+	return func(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+		return nil, v.admit(ctx, obj)
+	}
+}
+
+// ValidateUpdateFn returns a function that rejects updates that would result
+// in objects that violate any rules, for use with a webhook.Validator.
+func (v *Validator) ValidateUpdateFn() webhook.ValidateUpdateFn {
+	return func(ctx context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+		return nil, v.admit(ctx, newObj)
+	}
+}
+
+func (v *Validator) admit(ctx context.Context, obj runtime.Object) error {
+	violations, err := v.Validate(ctx, obj)
+	if err != nil {
+		return err
+	}
+
+	name := ""
+	if o, ok := obj.(client.Object); ok {
+		name = o.GetName()
+	}
+
+	return violations.AsAdmissionError(obj.GetObjectKind().GroupVersionKind().GroupKind(), name)
+}
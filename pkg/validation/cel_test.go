@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestValidate(t *testing.T) {
+	obj := func(name string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"metadata": map[string]any{"name": name},
+			"spec":     map[string]any{"forProvider": map[string]any{}},
+		}}
+	}
+
+	type args struct {
+		rules []Rule
+		obj   *unstructured.Unstructured
+	}
+
+	type want struct {
+		violations Violations
+		newErr     bool
+		err        bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"CompileError": {
+			reason: "We should return an error if a rule cannot be compiled.",
+			args: args{
+				rules: []Rule{{Name: "broken", Expression: "self.metadata.name ==="}},
+			},
+			want: want{newErr: true},
+		},
+		"NotBool": {
+			reason: "We should return an error if a rule does not evaluate to a bool.",
+			args: args{
+				rules: []Rule{{Name: "string", Expression: "'cool'"}},
+			},
+			want: want{newErr: true},
+		},
+		"Satisfied": {
+			reason: "We should return no violations if all rules are satisfied.",
+			args: args{
+				rules: []Rule{{Name: "prefix", Expression: "self.metadata.name.startsWith('cool')"}},
+				obj:   obj("cool-resource"),
+			},
+		},
+		"Violated": {
+			reason: "We should return a violation for each rule that is not satisfied.",
+			args: args{
+				rules: []Rule{
+					{Name: "prefix", Expression: "self.metadata.name.startsWith('cool')", Message: "name must start with cool", FieldPath: "metadata.name"},
+					{Name: "length", Expression: "size(self.metadata.name) < 64"},
+				},
+				obj: obj("lame-resource"),
+			},
+			want: want{
+				violations: Violations{{Rule: "prefix", Message: "name must start with cool", Field: "metadata.name"}},
+			},
+		},
+		"EvaluationError": {
+			reason: "We should return an error if a rule references a field that does not exist.",
+			args: args{
+				rules: []Rule{{Name: "missing", Expression: "self.spec.forProvider.region == 'us-east-1'"}},
+				obj:   obj("cool-resource"),
+			},
+			want: want{err: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			v, err := NewValidator(tc.args.rules...)
+			if diff := cmp.Diff(tc.want.newErr, err != nil); diff != "" {
+				t.Fatalf("\n%s\nNewValidator(...): -want error, +got error:\n%s\n%v", tc.reason, diff, err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			got, err := v.Validate(context.Background(), tc.args.obj)
+			if diff := cmp.Diff(tc.want.err, err != nil); diff != "" {
+				t.Errorf("\n%s\nv.Validate(...): -want error, +got error:\n%s\n%v", tc.reason, diff, err)
+			}
+
+			if diff := cmp.Diff(tc.want.violations, got); diff != "" {
+				t.Errorf("\n%s\nv.Validate(...): -want violations, +got violations:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
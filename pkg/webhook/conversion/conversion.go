@@ -0,0 +1,330 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package conversion provides generic conversion webhook support for managed
+// resource API versions.
+package conversion
+
+import (
+	"encoding/json"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/fieldpath"
+)
+
+// Error strings.
+const (
+	errPaveSource         = "cannot pave source object"
+	errConvertDestination = "cannot convert to destination object"
+	errFmtGetField        = "cannot get field %q"
+	errFmtSetField        = "cannot set field %q"
+	errFmtDeleteField     = "cannot delete field %q"
+	errFmtRegisterWebhook = "cannot register conversion webhook for %T"
+	errRestoreFields      = "cannot restore unconverted fields"
+	errPreserveFields     = "cannot preserve unconverted fields"
+)
+
+// AnnotationKeyUnconvertedFields is the annotation a Converter uses to
+// preserve fields that don't exist in the destination version, so that they
+// can be restored when the object is converted back.
+const AnnotationKeyUnconvertedFields = "conversion.crossplane.io/unconverted-fields"
+
+// A FieldMove declares that a field at one path in a spoke version lives at
+// another path in the hub version, e.g. spec.forProvider.zone in v1beta1
+// moved to spec.forProvider.location.zone in v1.
+type FieldMove struct {
+	// Spoke is the field path in the spoke version.
+	Spoke string
+
+	// Hub is the field path in the hub version.
+	Hub string
+}
+
+// An Option configures a Converter.
+type Option func(c *Converter)
+
+// WithFieldMoves configures the fields a Converter moves between the spoke
+// and hub versions. Moves are applied in order when converting to the hub,
+// and in reverse order when converting from the hub.
+func WithFieldMoves(m ...FieldMove) Option {
+	return func(c *Converter) {
+		c.moves = append(c.moves, m...)
+	}
+}
+
+// WithMetrics configures a Converter to record conversion metrics.
+func WithMetrics(m MetricRecorder) Option {
+	return func(c *Converter) {
+		c.metrics = m
+	}
+}
+
+// A Converter converts managed resources between a spoke version and the hub
+// version. All fields are copied by path, except for declared field moves.
+// Fields that don't exist in the destination version are preserved in the
+// AnnotationKeyUnconvertedFields annotation, and restored when the object is
+// converted back to a version that has them.
+//
+// Spoke types typically use a Converter to implement conversion.Convertible:
+//
+//	func (mg *Cool) ConvertTo(hub conversion.Hub) error {
+//		return converter.ConvertToHub(mg, hub)
+//	}
+//
+//	func (mg *Cool) ConvertFrom(hub conversion.Hub) error {
+//		return converter.ConvertFromHub(hub, mg)
+//	}
+type Converter struct {
+	moves   []FieldMove
+	metrics MetricRecorder
+}
+
+// NewConverter returns a new Converter.
+func NewConverter(o ...Option) *Converter {
+	c := &Converter{metrics: NewNopMetricRecorder()}
+	for _, fn := range o {
+		fn(c)
+	}
+
+	return c
+}
+
+// ConvertToHub converts the supplied spoke object to the supplied hub object.
+func (c *Converter) ConvertToHub(spoke, hub runtime.Object) error {
+	moves := make([]move, len(c.moves))
+	for i, m := range c.moves {
+		moves[i] = move{from: m.Spoke, to: m.Hub}
+	}
+
+	return c.convert(spoke, hub, moves)
+}
+
+// ConvertFromHub converts the supplied hub object to the supplied spoke
+// object.
+func (c *Converter) ConvertFromHub(hub, spoke runtime.Object) error {
+	moves := make([]move, len(c.moves))
+	for i, m := range c.moves {
+		moves[len(c.moves)-1-i] = move{from: m.Hub, to: m.Spoke}
+	}
+
+	return c.convert(hub, spoke, moves)
+}
+
+type move struct {
+	from string
+	to   string
+}
+
+func (c *Converter) convert(src, dst runtime.Object, moves []move) error {
+	t := time.Now()
+	err := convert(src, dst, moves)
+	c.metrics.RecordConversion(src.GetObjectKind().GroupVersionKind(), dst.GetObjectKind().GroupVersionKind(), time.Since(t), err)
+
+	return err
+}
+
+func convert(src, dst runtime.Object, moves []move) error {
+	// The destination's type metadata is set by the webhook, and must be
+	// preserved.
+	gvk := dst.GetObjectKind().GroupVersionKind()
+
+	p, err := fieldpath.PaveObject(src)
+	if err != nil {
+		return errors.Wrap(err, errPaveSource)
+	}
+
+	if err := restoreUnconverted(p.UnstructuredContent()); err != nil {
+		return errors.Wrap(err, errRestoreFields)
+	}
+
+	for _, m := range moves {
+		v, err := p.GetValue(m.from)
+		if fieldpath.IsNotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return errors.Wrapf(err, errFmtGetField, m.from)
+		}
+
+		if err := p.SetValue(m.to, v); err != nil {
+			return errors.Wrapf(err, errFmtSetField, m.to)
+		}
+
+		if err := p.DeleteField(m.from); err != nil {
+			return errors.Wrapf(err, errFmtDeleteField, m.from)
+		}
+	}
+
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(p.UnstructuredContent(), dst); err != nil {
+		return errors.Wrap(err, errConvertDestination)
+	}
+
+	if err := preserveUnconverted(p.UnstructuredContent(), dst); err != nil {
+		return errors.Wrap(err, errPreserveFields)
+	}
+
+	dst.GetObjectKind().SetGroupVersionKind(gvk)
+
+	return nil
+}
+
+// restoreUnconverted removes the AnnotationKeyUnconvertedFields annotation
+// from the supplied content, and merges the fields it preserved back in.
+// Fields that are already set take precedence.
+func restoreUnconverted(content map[string]any) error {
+	md, _ := content["metadata"].(map[string]any)
+	a, _ := md["annotations"].(map[string]any)
+
+	v, ok := a[AnnotationKeyUnconvertedFields].(string)
+	if !ok {
+		return nil
+	}
+
+	delete(a, AnnotationKeyUnconvertedFields)
+
+	if len(a) == 0 {
+		delete(md, "annotations")
+	}
+
+	fields := map[string]any{}
+	if err := json.Unmarshal([]byte(v), &fields); err != nil {
+		return err
+	}
+
+	merge(content, fields)
+
+	return nil
+}
+
+// preserveUnconverted records any fields in the supplied content that didn't
+// make it into the supplied destination object in its
+// AnnotationKeyUnconvertedFields annotation.
+func preserveUnconverted(content map[string]any, dst runtime.Object) error {
+	converted, err := runtime.DefaultUnstructuredConverter.ToUnstructured(dst)
+	if err != nil {
+		return err
+	}
+
+	fields := map[string]any{}
+
+	for k, v := range content {
+		// Type and object metadata exist in every version.
+		if k == "apiVersion" || k == "kind" || k == "metadata" {
+			continue
+		}
+
+		cv, exists := converted[k]
+		if d, ok := dropped(v, cv, exists); ok {
+			fields[k] = d
+		}
+	}
+
+	if len(fields) == 0 {
+		return nil
+	}
+
+	j, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+
+	o, err := meta.Accessor(dst)
+	if err != nil {
+		return err
+	}
+
+	a := o.GetAnnotations()
+	if a == nil {
+		a = map[string]string{}
+	}
+
+	a[AnnotationKeyUnconvertedFields] = string(j)
+	o.SetAnnotations(a)
+
+	return nil
+}
+
+// dropped returns the parts of src that are missing from dst, if any. Lists
+// are treated as values; they're either converted or dropped as a whole.
+func dropped(src, dst any, exists bool) (any, bool) {
+	// Empty values carry no data worth preserving.
+	if m, ok := src.(map[string]any); src == nil || (ok && len(m) == 0) {
+		return nil, false
+	}
+
+	if !exists {
+		return src, true
+	}
+
+	s, ok := src.(map[string]any)
+	if !ok {
+		return nil, false
+	}
+
+	d, ok := dst.(map[string]any)
+	if !ok {
+		return nil, false
+	}
+
+	out := map[string]any{}
+
+	for k, v := range s {
+		dv, exists := d[k]
+		if dv, ok := dropped(v, dv, exists); ok {
+			out[k] = dv
+		}
+	}
+
+	return out, len(out) > 0
+}
+
+// merge sets any fields in src that aren't set in dst.
+func merge(dst, src map[string]any) {
+	for k, v := range src {
+		existing, ok := dst[k]
+		if !ok {
+			dst[k] = v
+			continue
+		}
+
+		e, eok := existing.(map[string]any)
+		s, sok := v.(map[string]any)
+
+		if eok && sok {
+			merge(e, s)
+		}
+	}
+}
+
+// SetupWebhookWithManager registers a conversion webhook for each of the
+// supplied types with the supplied manager. Each type must implement
+// conversion.Hub or conversion.Convertible. It's sufficient to register one
+// version of each kind.
+func SetupWebhookWithManager(mgr ctrl.Manager, types ...runtime.Object) error {
+	for _, t := range types {
+		if err := ctrl.NewWebhookManagedBy(mgr).For(t).Complete(); err != nil {
+			return errors.Wrapf(err, errFmtRegisterWebhook, t)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conversion
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	spokeGVK = schema.GroupVersionKind{Group: "example.org", Version: "v1beta1", Kind: "Cool"}
+	hubGVK   = schema.GroupVersionKind{Group: "example.org", Version: "v1", Kind: "Cool"}
+)
+
+type spokeParameters struct {
+	Zone string `json:"zone,omitempty"`
+	Size int    `json:"size,omitempty"`
+}
+
+type spoke struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec struct {
+		ForProvider spokeParameters `json:"forProvider"`
+	} `json:"spec"`
+}
+
+func (s *spoke) DeepCopyObject() runtime.Object {
+	out := *s
+	return &out
+}
+
+type location struct {
+	Zone string `json:"zone,omitempty"`
+}
+
+type hubParameters struct {
+	Location location `json:"location"`
+	Size     int      `json:"size,omitempty"`
+}
+
+type hub struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec struct {
+		ForProvider hubParameters `json:"forProvider"`
+	} `json:"spec"`
+}
+
+func (h *hub) DeepCopyObject() runtime.Object {
+	out := *h
+	return &out
+}
+
+func TestConverter(t *testing.T) {
+	c := NewConverter(WithFieldMoves(FieldMove{Spoke: "spec.forProvider.zone", Hub: "spec.forProvider.location.zone"}))
+
+	s := &spoke{ObjectMeta: metav1.ObjectMeta{Name: "cool"}}
+	s.SetGroupVersionKind(spokeGVK)
+	s.Spec.ForProvider = spokeParameters{Zone: "us-east-1a", Size: 3}
+
+	h := &hub{}
+	h.SetGroupVersionKind(hubGVK)
+
+	if err := c.ConvertToHub(s, h); err != nil {
+		t.Fatalf("c.ConvertToHub(...): %v", err)
+	}
+
+	wantHub := &hub{ObjectMeta: metav1.ObjectMeta{Name: "cool"}}
+	wantHub.SetGroupVersionKind(hubGVK)
+	wantHub.Spec.ForProvider = hubParameters{Location: location{Zone: "us-east-1a"}, Size: 3}
+
+	if diff := cmp.Diff(wantHub, h); diff != "" {
+		t.Errorf("c.ConvertToHub(...): -want, +got:\n%s", diff)
+	}
+
+	got := &spoke{}
+	got.SetGroupVersionKind(spokeGVK)
+
+	if err := c.ConvertFromHub(h, got); err != nil {
+		t.Fatalf("c.ConvertFromHub(...): %v", err)
+	}
+
+	if diff := cmp.Diff(s, got); diff != "" {
+		t.Errorf("c.ConvertFromHub(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestConverterRoundTrip(t *testing.T) {
+	c := NewConverter(WithFieldMoves(FieldMove{Spoke: "spec.forProvider.zone", Hub: "spec.forProvider.location.zone"}))
+
+	// The hub has a field the spoke lacks.
+	h := &richHub{ObjectMeta: metav1.ObjectMeta{Name: "cool", Annotations: map[string]string{"cool": "very"}}}
+	h.SetGroupVersionKind(hubGVK)
+	h.Spec.ForProvider = richHubParameters{Location: richLocation{Zone: "us-east-1a", Region: "us-east-1"}, Size: 3, Tier: "gold"}
+
+	s := &spoke{}
+	s.SetGroupVersionKind(spokeGVK)
+
+	if err := c.ConvertFromHub(h, s); err != nil {
+		t.Fatalf("c.ConvertFromHub(...): %v", err)
+	}
+
+	if _, ok := s.GetAnnotations()[AnnotationKeyUnconvertedFields]; !ok {
+		t.Errorf("c.ConvertFromHub(...): want annotation %q", AnnotationKeyUnconvertedFields)
+	}
+
+	got := &richHub{}
+	got.SetGroupVersionKind(hubGVK)
+
+	if err := c.ConvertToHub(s, got); err != nil {
+		t.Fatalf("c.ConvertToHub(...): %v", err)
+	}
+
+	if diff := cmp.Diff(h, got); diff != "" {
+		t.Errorf("c.ConvertToHub(c.ConvertFromHub(...)): -want, +got:\n%s", diff)
+	}
+}
+
+type richLocation struct {
+	Zone   string `json:"zone,omitempty"`
+	Region string `json:"region,omitempty"`
+}
+
+type richHubParameters struct {
+	Location richLocation `json:"location"`
+	Size     int          `json:"size,omitempty"`
+	Tier     string       `json:"tier,omitempty"`
+}
+
+type richHub struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec struct {
+		ForProvider richHubParameters `json:"forProvider"`
+	} `json:"spec"`
+}
+
+func (h *richHub) DeepCopyObject() runtime.Object {
+	out := *h
+	return &out
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conversion
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const subSystem = "crossplane"
+
+// MetricRecorder records conversion metrics.
+type MetricRecorder interface {
+	Describe(ch chan<- *prometheus.Desc)
+	Collect(ch chan<- prometheus.Metric)
+
+	RecordConversion(from, to schema.GroupVersionKind, d time.Duration, err error)
+}
+
+// ConversionMetricRecorder records the number and duration of conversions.
+type ConversionMetricRecorder struct {
+	conversions *prometheus.CounterVec
+	duration    *prometheus.HistogramVec
+}
+
+// NewConversionMetricRecorder returns a new ConversionMetricRecorder.
+func NewConversionMetricRecorder() *ConversionMetricRecorder {
+	return &ConversionMetricRecorder{
+		conversions: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Subsystem: subSystem,
+			Name:      "conversion_webhook_conversions_total",
+			Help:      "ALPHA: The number of managed resource conversions, by source and destination version and result",
+		}, []string{"group_kind", "from", "to", "result"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Subsystem: subSystem,
+			Name:      "conversion_webhook_conversion_duration_seconds",
+			Help:      "ALPHA: How long it took to convert a managed resource",
+			Buckets:   prometheus.ExponentialBuckets(0.0001, 4, 8),
+		}, []string{"group_kind", "from", "to"}),
+	}
+}
+
+// Describe sends the super-set of all possible descriptors of metrics
+// collected by this Collector to the provided channel and returns once
+// the last descriptor has been sent.
+func (r *ConversionMetricRecorder) Describe(ch chan<- *prometheus.Desc) {
+	r.conversions.Describe(ch)
+	r.duration.Describe(ch)
+}
+
+// Collect is called by the Prometheus registry when collecting
+// metrics. The implementation sends each collected metric via the
+// provided channel and returns once the last metric has been sent.
+func (r *ConversionMetricRecorder) Collect(ch chan<- prometheus.Metric) {
+	r.conversions.Collect(ch)
+	r.duration.Collect(ch)
+}
+
+// RecordConversion records a conversion between the supplied versions.
+func (r *ConversionMetricRecorder) RecordConversion(from, to schema.GroupVersionKind, d time.Duration, err error) {
+	result := "success"
+	if err != nil {
+		result = "error"
+	}
+
+	gk := from.GroupKind().String()
+	r.conversions.WithLabelValues(gk, from.Version, to.Version, result).Inc()
+	r.duration.WithLabelValues(gk, from.Version, to.Version).Observe(d.Seconds())
+}
+
+// A NopMetricRecorder does nothing.
+type NopMetricRecorder struct{}
+
+// NewNopMetricRecorder returns a MetricRecorder that does nothing.
+func NewNopMetricRecorder() *NopMetricRecorder {
+	return &NopMetricRecorder{}
+}
+
+// Describe does nothing.
+func (r *NopMetricRecorder) Describe(_ chan<- *prometheus.Desc) {}
+
+// Collect does nothing.
+func (r *NopMetricRecorder) Collect(_ chan<- prometheus.Metric) {}
+
+// RecordConversion does nothing.
+func (r *NopMetricRecorder) RecordConversion(_, _ schema.GroupVersionKind, _ time.Duration, _ error) {
+}
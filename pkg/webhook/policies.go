@@ -0,0 +1,105 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"slices"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+)
+
+const (
+	errNotManageable = "object does not support management policies"
+)
+
+type manageable interface {
+	client.Object
+	resource.Manageable
+}
+
+// WithManagementPoliciesValidation adds functions that reject managed
+// resources with invalid management policies to the Validator's creation and
+// update chains. Supply the same arguments used to configure the managed
+// resource Reconciler, so that policies the Reconciler would refuse are
+// rejected when they're created instead of being reported as a condition.
+func WithManagementPoliciesValidation(managementPolicyEnabled bool, o ...managed.ManagementPoliciesResolverOption) ValidatorOption {
+	return func(v *Validator) {
+		v.CreationChain = append(v.CreationChain, ValidateManagementPoliciesOnCreate(managementPolicyEnabled, o...))
+		v.UpdateChain = append(v.UpdateChain, ValidateManagementPoliciesOnUpdate(managementPolicyEnabled, o...))
+	}
+}
+
+// ValidateManagementPoliciesOnCreate returns a ValidateCreateFn that rejects
+// managed resources with invalid management policies.
+func ValidateManagementPoliciesOnCreate(managementPolicyEnabled bool, o ...managed.ManagementPoliciesResolverOption) ValidateCreateFn {
+	return func(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+		return nil, validateManagementPolicies(obj, managementPolicyEnabled, o...)
+	}
+}
+
+// ValidateManagementPoliciesOnUpdate returns a ValidateUpdateFn that rejects
+// updates that change the management policies of a managed resource to
+// invalid policies. Updates that don't change the management policies are
+// allowed, so that a managed resource whose policies became invalid, for
+// example because the management policies feature was disabled, can still be
+// updated and deleted.
+func ValidateManagementPoliciesOnUpdate(managementPolicyEnabled bool, o ...managed.ManagementPoliciesResolverOption) ValidateUpdateFn {
+	return func(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+		om, ok := oldObj.(manageable)
+		if !ok {
+			return nil, errors.New(errNotManageable)
+		}
+
+		nm, ok := newObj.(manageable)
+		if !ok {
+			return nil, errors.New(errNotManageable)
+		}
+
+		if slices.Equal(om.GetManagementPolicies(), nm.GetManagementPolicies()) {
+			return nil, nil
+		}
+
+		return nil, validateManagementPolicies(newObj, managementPolicyEnabled, o...)
+	}
+}
+
+func validateManagementPolicies(obj runtime.Object, managementPolicyEnabled bool, o ...managed.ManagementPoliciesResolverOption) error {
+	mg, ok := obj.(manageable)
+	if !ok {
+		return errors.New(errNotManageable)
+	}
+
+	mp := mg.GetManagementPolicies()
+
+	err := managed.ValidateManagementPolicies(managementPolicyEnabled, mp, o...)
+	if err == nil {
+		return nil
+	}
+
+	return kerrors.NewInvalid(obj.GetObjectKind().GroupVersionKind().GroupKind(), mg.GetName(), field.ErrorList{
+		field.Invalid(field.NewPath("spec", "managementPolicies"), mp, err.Error()),
+	})
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2026 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+)
+
+func TestValidateManagementPolicies(t *testing.T) {
+	managed := func(p ...xpv1.ManagementAction) *fake.ModernManaged {
+		mg := &fake.ModernManaged{}
+		mg.SetName("cool")
+		mg.SetManagementPolicies(p)
+
+		return mg
+	}
+
+	type args struct {
+		enabled bool
+		oldObj  runtime.Object
+		newObj  runtime.Object
+	}
+
+	type want struct {
+		invalid bool
+		err     error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"NotManageable": {
+			reason: "We should return an error if the object doesn't support management policies.",
+			args: args{
+				enabled: true,
+				newObj:  &corev1.ConfigMap{},
+			},
+			want: want{
+				err: errors.New(errNotManageable),
+			},
+		},
+		"CreateDefault": {
+			reason: "The default management policies should be valid when the feature is disabled.",
+			args: args{
+				newObj: managed(xpv1.ManagementActionAll),
+			},
+		},
+		"CreateSupported": {
+			reason: "Supported management policies should be valid when the feature is enabled.",
+			args: args{
+				enabled: true,
+				newObj:  managed(xpv1.ManagementActionObserve),
+			},
+		},
+		"CreateFeatureDisabled": {
+			reason: "Non-default management policies should be invalid when the feature is disabled.",
+			args: args{
+				newObj: managed(xpv1.ManagementActionObserve),
+			},
+			want: want{
+				invalid: true,
+			},
+		},
+		"CreateUnsupported": {
+			reason: "Unsupported management policies should be invalid.",
+			args: args{
+				enabled: true,
+				newObj:  managed(xpv1.ManagementActionCreate),
+			},
+			want: want{
+				invalid: true,
+			},
+		},
+		"UpdateUnchanged": {
+			reason: "Updates that don't change invalid management policies should be allowed.",
+			args: args{
+				oldObj: managed(xpv1.ManagementActionObserve),
+				newObj: managed(xpv1.ManagementActionObserve),
+			},
+		},
+		"UpdateChangedToUnsupported": {
+			reason: "Updates that change the management policies to unsupported policies should be rejected.",
+			args: args{
+				enabled: true,
+				oldObj:  managed(xpv1.ManagementActionAll),
+				newObj:  managed(xpv1.ManagementActionCreate),
+			},
+			want: want{
+				invalid: true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			v := NewValidator(WithManagementPoliciesValidation(tc.args.enabled))
+
+			var err error
+			if tc.args.oldObj == nil {
+				_, err = v.ValidateCreate(context.Background(), tc.args.newObj)
+			} else {
+				_, err = v.ValidateUpdate(context.Background(), tc.args.oldObj, tc.args.newObj)
+			}
+
+			if tc.want.invalid {
+				if !kerrors.IsInvalid(err) {
+					t.Errorf("\n%s\nValidate(...): want invalid error, got: %v", tc.reason, err)
+				}
+
+				return
+			}
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nValidate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}